@@ -0,0 +1,181 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package gogram
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+	"github.com/amarnathcjd/gogram/internal/mtproto/messages"
+	"github.com/amarnathcjd/gogram/internal/mtproto/objects"
+)
+
+const (
+	// https://core.telegram.org/mtproto/service_messages#simple-container
+	maxContainerMessages = 1020
+	maxContainerSize     = 1000 * 1024 // leaves headroom for piggybacked acks under the 1 MiB limit
+	containerHeaderSize  = tl.WordLen + tl.WordLen
+	containerItemHeader  = tl.LongLen + tl.WordLen + tl.WordLen
+)
+
+// outgoingMsg is a single encrypted message waiting to be written to the transport.
+// Its msg_id, unless preset, and its seqno are assigned as its frame is written, so
+// that both grow in the order the messages reach the server.
+type outgoingMsg struct {
+	msg            *messages.Encrypted
+	contentRelated bool
+	seqNo          int32
+	register       func(msgID int64) // called with the msg_id before the message is written
+	done           chan error
+	lead           chan struct{} // makes the writer of the message the next flusher
+}
+
+func (o *outgoingMsg) size() int {
+	return containerItemHeader + len(o.msg.Msg)
+}
+
+// stamp assigns o its msg_id and seqno.
+func (o *outgoingMsg) stamp(m *MTProto) {
+	if o.msg.MsgID == 0 {
+		o.msg.MsgID = m.genMsgID(m.timeOffset.Load())
+	}
+	o.seqNo = m.nextSeqNo(o.contentRelated)
+	if o.register != nil {
+		o.register(o.msg.MsgID)
+	}
+}
+
+// sendBatcher coalesces concurrent writes into msg_container frames.
+// The first writer to find the queue idle becomes the flusher; every writer
+// that arrives while a flush is in progress is packed into the next frame.
+// A flusher writes a single pass, which carries its own message, and then hands
+// the role to the writer of the oldest message still queued.
+type sendBatcher struct {
+	m        *MTProto
+	delay    time.Duration
+	mu       sync.Mutex
+	queue    []*outgoingMsg
+	flushing bool
+}
+
+func newSendBatcher(m *MTProto, delay time.Duration) *sendBatcher {
+	return &sendBatcher{
+		m:     m,
+		delay: delay,
+	}
+}
+
+// write queues item for sending and blocks until the frame carrying it has been written.
+func (b *sendBatcher) write(item *outgoingMsg) error {
+	item.done = make(chan error, 1)
+	item.lead = make(chan struct{}, 1)
+
+	b.mu.Lock()
+	b.queue = append(b.queue, item)
+	if b.flushing {
+		b.mu.Unlock()
+		select {
+		case err := <-item.done:
+			return err
+		case <-item.lead:
+		}
+	} else {
+		b.flushing = true
+		b.mu.Unlock()
+		if b.delay > 0 {
+			time.Sleep(b.delay)
+		}
+	}
+
+	b.flush()
+	return <-item.done
+}
+
+// flush writes everything queued so far, then passes the flusher role on.
+func (b *sendBatcher) flush() {
+	b.mu.Lock()
+	queue := b.queue
+	b.queue = nil
+	b.mu.Unlock()
+
+	for len(queue) > 0 {
+		n := nextBatchLen(queue)
+		err := b.m.writeBatch(queue[:n])
+		for _, item := range queue[:n] {
+			item.done <- err
+		}
+		queue = queue[n:]
+	}
+
+	b.mu.Lock()
+	if len(b.queue) > 0 {
+		b.queue[0].lead <- struct{}{}
+	} else {
+		b.flushing = false
+	}
+	b.mu.Unlock()
+}
+
+// nextBatchLen returns how many leading messages of queue fit into one container.
+func nextBatchLen(queue []*outgoingMsg) int {
+	size := containerHeaderSize
+	for i, item := range queue {
+		size += item.size()
+		if i >= maxContainerMessages || (i > 0 && size > maxContainerSize) {
+			return i
+		}
+	}
+	return len(queue)
+}
+
+// writeBatch writes items as a single frame, wrapping them in a msg_container
+// when there is more than one. Pending acks are piggybacked onto containers.
+func (m *MTProto) writeBatch(items []*outgoingMsg) error {
+	m.transportMu.Lock()
+	defer m.transportMu.Unlock()
+
+	if m.transport == nil {
+		return errors.New("transport is nil during write")
+	}
+
+	for _, item := range items {
+		item.stamp(m)
+	}
+	if len(items) == 1 {
+		return m.transport.WriteMsg(items[0].msg, items[0].seqNo)
+	}
+
+	container := make(objects.MessageContainer, 0, len(items)+1)
+	for _, item := range items {
+		container = append(container, &messages.Encrypted{
+			Msg:   item.msg.Msg,
+			MsgID: item.msg.MsgID,
+			SeqNo: item.seqNo,
+		})
+	}
+
+	if len(container) < maxContainerMessages && m.pendingAcks.Len() > 0 {
+		if ack, err := tl.Marshal(&objects.MsgsAck{MsgIDs: m.pendingAcks.Keys()}); err == nil {
+			m.pendingAcks.Clear()
+			container = append(container, &messages.Encrypted{
+				Msg:   ack,
+				MsgID: m.genMsgID(m.timeOffset.Load()),
+				SeqNo: m.GetSeqNo(),
+			})
+		}
+	}
+
+	body, err := tl.Marshal(&container)
+	if err != nil {
+		return err
+	}
+
+	m.Logger.Trace("packing %d messages into container", len(container))
+	return m.transport.WriteMsg(&messages.Encrypted{
+		Msg:         body,
+		MsgID:       m.genMsgID(m.timeOffset.Load()),
+		AuthKeyHash: m.authKeyHash,
+	}, m.GetSeqNo())
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package gogram
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/amarnathcjd/gogram/internal/mtproto/messages"
+	"github.com/amarnathcjd/gogram/internal/utils"
+)
+
+// recordingTransport keeps the msg_id and seqno of every frame written to it.
+type recordingTransport struct {
+	t      *testing.T
+	m      *MTProto
+	msgIDs []int64
+	seqNos []int32
+}
+
+func (t *recordingTransport) Close() error                      { return nil }
+func (t *recordingTransport) ReadMsg() (messages.Common, error) { return nil, nil }
+
+func (t *recordingTransport) WriteMsg(msg messages.Common, seqNo int32) error {
+	id := msg.(*messages.Encrypted).MsgID
+	if _, ok := t.m.responseChannels.Get(int(id)); !ok {
+		t.t.Errorf("message %d written before it was registered", id)
+	}
+	t.msgIDs = append(t.msgIDs, id)
+	t.seqNos = append(t.seqNos, seqNo)
+	return nil
+}
+
+// Queued messages get their msg_id and seqno as they are written, in write order.
+func TestBatchStampsOnFlush(t *testing.T) {
+	m := &MTProto{
+		responseChannels: utils.NewSyncIntObjectChan(),
+		expectedTypes:    utils.NewSyncIntReflectTypes(),
+		pendingAcks:      utils.NewSyncSet[int64](),
+		genMsgID:         utils.NewMsgIDGenerator(),
+		Logger:           utils.NewLogger("test"),
+	}
+	tr := &recordingTransport{t: t, m: m}
+	m.transport = tr
+
+	var registered []int64
+	newMsg := func(msgID int64, contentRelated bool) *outgoingMsg {
+		return &outgoingMsg{
+			msg:            &messages.Encrypted{MsgID: msgID},
+			contentRelated: contentRelated,
+			register: func(id int64) {
+				registered = append(registered, id)
+				m.responseChannels.Add(int(id), m.getRespChannel())
+			},
+		}
+	}
+
+	const preset = 1 << 40
+	for _, item := range []*outgoingMsg{newMsg(0, true), newMsg(preset, true), newMsg(0, false), newMsg(0, true)} {
+		if err := m.writeBatch([]*outgoingMsg{item}); err != nil {
+			t.Fatalf("writing batch: %v", err)
+		}
+	}
+
+	if tr.msgIDs[1] != preset {
+		t.Fatalf("preset msg_id replaced by %d", tr.msgIDs[1])
+	}
+	if !(tr.msgIDs[0] < tr.msgIDs[2] && tr.msgIDs[2] < tr.msgIDs[3]) {
+		t.Fatalf("msg_ids not increasing in write order: %v", tr.msgIDs)
+	}
+	if want := []int32{1, 3, 4, 5}; !slices.Equal(tr.seqNos, want) {
+		t.Fatalf("seqnos = %v, want %v", tr.seqNos, want)
+	}
+	if !slices.Equal(registered, tr.msgIDs) {
+		t.Fatalf("registered %v, wrote %v", registered, tr.msgIDs)
+	}
+}
+
+// feedingTransport queues another message from a new writer during every write,
+// keeping the batcher under load until stop is closed.
+type feedingTransport struct {
+	b       *sendBatcher
+	stop    chan struct{}
+	writers sync.WaitGroup
+}
+
+func (t *feedingTransport) Close() error                      { return nil }
+func (t *feedingTransport) ReadMsg() (messages.Common, error) { return nil, nil }
+
+func (t *feedingTransport) WriteMsg(messages.Common, int32) error {
+	select {
+	case <-t.stop:
+		return nil
+	default:
+	}
+	t.writers.Add(1)
+	go func() {
+		defer t.writers.Done()
+		t.b.write(&outgoingMsg{msg: &messages.Encrypted{}})
+	}()
+	for {
+		t.b.mu.Lock()
+		queued := len(t.b.queue)
+		t.b.mu.Unlock()
+		if queued > 0 {
+			return nil
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// A writer returns once its own frame is out, however busy the batcher stays.
+func TestBatchFlusherReturns(t *testing.T) {
+	m := &MTProto{
+		pendingAcks: utils.NewSyncSet[int64](),
+		genMsgID:    utils.NewMsgIDGenerator(),
+		Logger:      utils.NewLogger("test"),
+	}
+	m.batcher = newSendBatcher(m, 0)
+	tr := &feedingTransport{b: m.batcher, stop: make(chan struct{})}
+	m.transport = tr
+
+	done := make(chan error, 1)
+	go func() { done <- m.batcher.write(&outgoingMsg{msg: &messages.Encrypted{}}) }()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("writing: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("first writer kept flushing for the others")
+	}
+	close(tr.stop)
+	tr.writers.Wait()
+}
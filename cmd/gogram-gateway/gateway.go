@@ -0,0 +1,305 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	mtproto "github.com/amarnathcjd/gogram"
+	"github.com/amarnathcjd/gogram/telegram"
+)
+
+// JSON-RPC 2.0 error codes
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeFailure        = -32000 // the call failed without a Telegram error code
+)
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type notification struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+// rpcError carries Telegram errors with their code, e.g. 420, and their type,
+// e.g. FLOOD_WAIT_X, in Data.
+type rpcError struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+	Data    any    `json:"data,omitempty"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+func errorf(code int64, format string, args ...any) *rpcError {
+	return &rpcError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// gateway answers JSON-RPC requests with a client and fans its updates out to
+// the connected listeners.
+type gateway struct {
+	client  *telegram.Client
+	timeout time.Duration
+
+	loginMu       sync.Mutex
+	phone         string // of the login in progress
+	phoneCodeHash string
+
+	mu        sync.Mutex
+	listeners map[chan []byte]struct{}
+}
+
+func newGateway(client *telegram.Client, timeout time.Duration) *gateway {
+	g := &gateway{
+		client:    client,
+		timeout:   timeout,
+		listeners: make(map[chan []byte]struct{}),
+	}
+	client.AddRawHandler(nil, func(update telegram.Update, _ *telegram.Client) error {
+		g.publish(update)
+		return nil
+	})
+	return g
+}
+
+// subscribe returns a channel receiving every update as JSON, until cancel
+// closes it. Updates are dropped while the listener lags behind by more than
+// buffer updates.
+func (g *gateway) subscribe(buffer int) (<-chan []byte, func()) {
+	ch := make(chan []byte, buffer)
+	g.mu.Lock()
+	g.listeners[ch] = struct{}{}
+	g.mu.Unlock()
+	return ch, func() {
+		g.mu.Lock()
+		delete(g.listeners, ch)
+		close(ch)
+		g.mu.Unlock()
+	}
+}
+
+func (g *gateway) publish(update telegram.Update) {
+	data, err := telegram.MarshalJSON(update)
+	if err != nil {
+		g.client.Log.Error("gateway: encoding %T: %v", update, err)
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for ch := range g.listeners {
+		select {
+		case ch <- data:
+		default:
+			g.client.Log.Warn("gateway: listener lags behind, dropping %T", update)
+		}
+	}
+}
+
+// handle answers a single request or a batch of them, returning nil when
+// there is nothing to answer, i.e. for notifications.
+func (g *gateway) handle(ctx context.Context, data []byte) []byte {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var batch []json.RawMessage
+		if err := json.Unmarshal(data, &batch); err != nil {
+			return g.encode(response{Error: errorf(codeParseError, "parse error: %v", err)})
+		}
+
+		answers := make([]json.RawMessage, len(batch))
+		var wg sync.WaitGroup
+		for i, item := range batch {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				answers[i] = g.handle(ctx, item)
+			}()
+		}
+		wg.Wait()
+
+		var out []json.RawMessage
+		for _, answer := range answers {
+			if answer != nil {
+				out = append(out, answer)
+			}
+		}
+		if len(out) == 0 {
+			return nil
+		}
+		b, _ := json.Marshal(out)
+		return b
+	}
+
+	var req request
+	if err := json.Unmarshal(data, &req); err != nil {
+		return g.encode(response{Error: errorf(codeParseError, "parse error: %v", err)})
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return g.encode(response{ID: req.ID, Error: errorf(codeInvalidRequest, "invalid request")})
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+	result, err := g.call(ctx, req.Method, req.Params)
+	if req.ID == nil {
+		return nil
+	}
+
+	resp := response{ID: req.ID, Result: result}
+	if err != nil {
+		resp.Result = nil
+		resp.Error = toRPCError(err)
+	} else if resp.Result == nil {
+		resp.Result = json.RawMessage("null")
+	}
+	return g.encode(resp)
+}
+
+func (g *gateway) encode(resp response) []byte {
+	resp.JSONRPC = "2.0"
+	if resp.ID == nil {
+		resp.ID = json.RawMessage("null")
+	}
+	b, err := json.Marshal(resp)
+	if err != nil {
+		b, _ = json.Marshal(response{JSONRPC: "2.0", ID: resp.ID, Error: errorf(codeFailure, "encoding response: %v", err)})
+	}
+	return b
+}
+
+func toRPCError(err error) *rpcError {
+	var rpcErr *rpcError
+	if errors.As(err, &rpcErr) {
+		return rpcErr
+	}
+	var tgErr *mtproto.ErrResponseCode
+	if errors.As(err, &tgErr) {
+		return &rpcError{
+			Code:    tgErr.Code,
+			Message: err.Error(),
+			Data:    map[string]any{"type": tgErr.Message, "description": tgErr.Description},
+		}
+	}
+	return &rpcError{Code: codeFailure, Message: err.Error()}
+}
+
+// call runs a gateway method or, for any other name, the schema method of that name.
+func (g *gateway) call(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+	switch method {
+	case "invoke":
+		var args struct {
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		if err := decodeParams(params, &args); err != nil {
+			return nil, err
+		}
+		if args.Method == "" {
+			return nil, errorf(codeInvalidParams, "invoke: missing method")
+		}
+		return g.invoke(ctx, args.Method, args.Params)
+	case "login.status":
+		return g.loginStatus()
+	case "login.sendCode":
+		return g.sendCode(params)
+	case "login.signIn":
+		return g.signIn(params)
+	case "login.checkPassword":
+		return g.checkPassword(params)
+	case "login.bot":
+		return g.loginBot(params)
+	case "login.logOut":
+		if err := g.client.LogOut(); err != nil {
+			return nil, err
+		}
+		return json.RawMessage("true"), nil
+	}
+	return g.invoke(ctx, method, params)
+}
+
+func (g *gateway) invoke(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+	res, err := g.client.InvokeRawJSON(ctx, method, params)
+	if errors.Is(err, telegram.ErrUnknownMethod) {
+		return nil, errorf(codeMethodNotFound, "method not found: %s", method)
+	}
+	return res, err
+}
+
+func decodeParams(params json.RawMessage, v any) error {
+	if len(params) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return errorf(codeInvalidParams, "invalid params: %v", err)
+	}
+	return nil
+}
+
+// serveStdio answers requests read line by line from r, and writes answers and
+// update notifications to w, one per line. It returns once r is exhausted.
+func (g *gateway) serveStdio(ctx context.Context, r io.Reader, w io.Writer) error {
+	var mu sync.Mutex
+	out := bufio.NewWriter(w)
+	writeLine := func(b []byte) {
+		mu.Lock()
+		defer mu.Unlock()
+		out.Write(b)
+		out.WriteByte('\n')
+		out.Flush()
+	}
+
+	updates, cancel := g.subscribe(1024)
+	defer cancel()
+	go func() {
+		for data := range updates {
+			b, _ := json.Marshal(notification{JSONRPC: "2.0", Method: "update", Params: data})
+			writeLine(b)
+		}
+	}()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxRequestSize)
+	for scanner.Scan() {
+		line := bytes.Clone(scanner.Bytes())
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if answer := g.handle(ctx, line); answer != nil {
+				writeLine(answer)
+			}
+		}()
+	}
+	return scanner.Err()
+}
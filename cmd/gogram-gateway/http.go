@@ -0,0 +1,103 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const maxRequestSize = 64 << 20
+
+// serveHTTP serves JSON-RPC on POST /rpc and updates through a telegram.UpdateServer
+// on GET /events and /getUpdates, on a TCP address or on "unix:" and a socket path,
+// until ctx is done. Unless token is empty, requests must carry it.
+func (g *gateway) serveHTTP(ctx context.Context, addr, token string) error {
+	network := "tcp"
+	if path, ok := strings.CutPrefix(addr, "unix:"); ok {
+		network, addr = "unix", path
+		os.Remove(path) // left over by a previous run
+	}
+	listener, err := net.Listen(network, addr)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", addr, err)
+	}
+	if network == "unix" {
+		if err := os.Chmod(addr, 0600); err != nil {
+			listener.Close()
+			return fmt.Errorf("restricting %s: %w", addr, err)
+		}
+	}
+
+	updates, err := g.client.NewUpdateServer()
+	if err != nil {
+		listener.Close()
+		return err
+	}
+	defer updates.Close()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /rpc", g.serveRPC)
+	mux.Handle("GET /events", updates.Handler())
+	mux.Handle("GET /getUpdates", updates.Handler())
+	srv := &http.Server{
+		Handler:           requireToken(token, mux),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		srv.Shutdown(shutdown)
+	}()
+
+	g.client.Log.Info("gateway: serving JSON-RPC on %s", listener.Addr())
+	if err := srv.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (g *gateway) serveRPC(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	answer := g.handle(r.Context(), body)
+	if answer == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(answer)
+}
+
+// requireToken serves only the requests carrying token, as "Authorization: Bearer
+// <token>" or ?token= for clients that cannot set headers, like EventSource.
+func requireToken(token string, next http.Handler) http.Handler {
+	if token == "" {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			got = r.URL.Query().Get("token")
+		}
+		if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/amarnathcjd/gogram/telegram"
+)
+
+// loginResult answers every login step. PasswordNeeded asks for
+// login.checkPassword, SignUpNeeded means the number has no account yet,
+// which only the official apps can create.
+type loginResult struct {
+	Authorized     bool            `json:"authorized"`
+	PasswordNeeded bool            `json:"password_needed,omitempty"`
+	SignUpNeeded   bool            `json:"sign_up_needed,omitempty"`
+	User           json.RawMessage `json:"user,omitempty"`
+}
+
+func (g *gateway) loginStatus() (json.RawMessage, error) {
+	if ok, _ := g.client.IsAuthorized(); !ok {
+		return json.Marshal(loginResult{})
+	}
+	return g.authorized()
+}
+
+// authorized answers a login step that left the client logged in.
+func (g *gateway) authorized() (json.RawMessage, error) {
+	me, err := g.client.GetMe()
+	if err != nil {
+		return nil, err
+	}
+	user, err := telegram.MarshalJSON(me)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(loginResult{Authorized: true, User: user})
+}
+
+func (g *gateway) sendCode(params json.RawMessage) (json.RawMessage, error) {
+	var args struct {
+		Phone string `json:"phone"`
+	}
+	if err := decodeParams(params, &args); err != nil {
+		return nil, err
+	}
+	if args.Phone == "" {
+		return nil, errorf(codeInvalidParams, "login.sendCode: missing phone")
+	}
+
+	hash, err := g.client.SendCode(args.Phone)
+	if err != nil {
+		return nil, err
+	}
+
+	g.loginMu.Lock()
+	g.phone, g.phoneCodeHash = args.Phone, hash
+	g.loginMu.Unlock()
+	return json.Marshal(map[string]string{"phone_code_hash": hash})
+}
+
+// signIn completes the login started by login.sendCode; phone and
+// phone_code_hash default to those of the last code sent.
+func (g *gateway) signIn(params json.RawMessage) (json.RawMessage, error) {
+	var args struct {
+		Phone         string `json:"phone"`
+		PhoneCodeHash string `json:"phone_code_hash"`
+		Code          string `json:"code"`
+	}
+	if err := decodeParams(params, &args); err != nil {
+		return nil, err
+	}
+
+	g.loginMu.Lock()
+	if args.Phone == "" {
+		args.Phone = g.phone
+	}
+	if args.PhoneCodeHash == "" {
+		args.PhoneCodeHash = g.phoneCodeHash
+	}
+	g.loginMu.Unlock()
+	if args.Phone == "" || args.PhoneCodeHash == "" {
+		return nil, errorf(codeInvalidParams, "login.signIn: no code sent, call login.sendCode first")
+	}
+	if args.Code == "" {
+		return nil, errorf(codeInvalidParams, "login.signIn: missing code")
+	}
+
+	_, err := g.client.Login(args.Phone, &telegram.LoginOptions{Code: args.Code, CodeHash: args.PhoneCodeHash})
+	switch {
+	case telegram.MatchError(err, "SESSION_PASSWORD_NEEDED"):
+		return json.Marshal(loginResult{PasswordNeeded: true})
+	case telegram.MatchError(err, "not registered"):
+		return json.Marshal(loginResult{SignUpNeeded: true})
+	case err != nil:
+		return nil, err
+	}
+	return g.authorized()
+}
+
+func (g *gateway) checkPassword(params json.RawMessage) (json.RawMessage, error) {
+	var args struct {
+		Password string `json:"password"`
+	}
+	if err := decodeParams(params, &args); err != nil {
+		return nil, err
+	}
+	if args.Password == "" {
+		return nil, errorf(codeInvalidParams, "login.checkPassword: missing password")
+	}
+
+	settings, err := g.client.AccountGetPassword()
+	if err != nil {
+		return nil, err
+	}
+	if !settings.HasPassword {
+		return nil, errors.New("login.checkPassword: the account has no password")
+	}
+	input, err := telegram.GetInputCheckPassword(args.Password, settings)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := g.client.AuthCheckPassword(input); err != nil {
+		return nil, err
+	}
+	return g.authorized()
+}
+
+func (g *gateway) loginBot(params json.RawMessage) (json.RawMessage, error) {
+	var args struct {
+		Token string `json:"token"`
+	}
+	if err := decodeParams(params, &args); err != nil {
+		return nil, err
+	}
+	if args.Token == "" {
+		return nil, errorf(codeInvalidParams, "login.bot: missing token")
+	}
+
+	if err := g.client.LoginBot(args.Token); err != nil {
+		return nil, err
+	}
+	return g.authorized()
+}
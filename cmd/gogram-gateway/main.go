@@ -0,0 +1,125 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+// Command gogram-gateway keeps a Telegram client running and exposes it to
+// other languages over JSON-RPC 2.0, like tdjson does for TDLib.
+//
+// Requests are read one per line from stdin, and answered one per line on
+// stdout; with -http they are also accepted as POST /rpc on a TCP address or
+// unix socket ("unix:/path/to/socket"). Over TCP every HTTP request must carry
+// the -token as "Authorization: Bearer <token>" or ?token=; the socket is only
+// open to its owner. TL objects are in the JSON form of
+// telegram.MarshalJSON, with their constructor name under "_".
+//
+//	{"jsonrpc":"2.0","id":1,"method":"login.sendCode","params":{"phone":"+15550100"}}
+//	{"jsonrpc":"2.0","id":2,"method":"login.signIn","params":{"code":"12345"}}
+//	{"jsonrpc":"2.0","id":3,"method":"messages.sendMessage","params":{"peer":"me","message":"hi"}}
+//
+// Any schema method can be called by its name, or through "invoke" with
+// {"method": name, "params": {...}}. Login steps are "login.status",
+// "login.sendCode", "login.signIn", "login.checkPassword", "login.bot" and
+// "login.logOut".
+//
+// Updates are streamed as "update" notifications on stdout and, with -http, served
+// by a telegram.UpdateServer as server-sent events on GET /events and by long
+// polling on GET /getUpdates.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/amarnathcjd/gogram/telegram"
+)
+
+func main() {
+	var (
+		appID    = flag.Int("app-id", 0, "Telegram API ID (default $APP_ID)")
+		appHash  = flag.String("app-hash", os.Getenv("APP_HASH"), "Telegram API hash (default $APP_HASH)")
+		session  = flag.String("session", "gateway.session", "session file")
+		httpAddr = flag.String("http", "", "also serve JSON-RPC on this address, e.g. 127.0.0.1:8081 or unix:/tmp/gateway.sock")
+		token    = flag.String("token", os.Getenv("GATEWAY_TOKEN"), "token HTTP requests must carry, required over TCP (default $GATEWAY_TOKEN)")
+		state    = flag.String("state", "gateway.state", "file keeping the update state, to catch up on updates missed while offline")
+		stdio    = flag.Bool("stdio", true, "serve JSON-RPC on stdin and stdout")
+		timeout  = flag.Duration("timeout", time.Minute, "time limit of each request")
+		verbose  = flag.Bool("v", false, "log debug messages to stderr")
+	)
+	flag.Parse()
+
+	if *appID == 0 {
+		fmt.Sscan(os.Getenv("APP_ID"), appID)
+	}
+	if *appID == 0 || *appHash == "" {
+		fmt.Fprintln(os.Stderr, "gogram-gateway: -app-id and -app-hash are required")
+		os.Exit(2)
+	}
+
+	if !*stdio && *httpAddr == "" {
+		fatalf("nothing to serve: enable -stdio or -http")
+	}
+	if *httpAddr != "" && *token == "" && !strings.HasPrefix(*httpAddr, "unix:") {
+		fatalf("-http on a TCP address requires -token")
+	}
+
+	level := telegram.LogInfo
+	if *verbose {
+		level = telegram.LogDebug
+	}
+	client, err := telegram.NewClient(telegram.ClientConfig{
+		AppID:            int32(*appID),
+		AppHash:          *appHash,
+		Session:          *session,
+		UpdateStateStore: telegram.NewFileUpdateStateStore(*state),
+		Logger:           telegram.NewLogger(level, telegram.LoggerConfig{Prefix: "gateway", Output: os.Stderr}),
+	})
+	if err != nil {
+		fatalf("creating client: %v", err)
+	}
+	if err := client.Connect(); err != nil {
+		fatalf("connecting: %v", err)
+	}
+	// an unauthorized client is started by the login methods instead, as Start would prompt on stdin
+	if authorized, _ := client.IsAuthorized(); authorized {
+		if err := client.Start(); err != nil {
+			fatalf("starting: %v", err)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	gw := newGateway(client, *timeout)
+	done := make(chan error, 2)
+	if *httpAddr != "" {
+		go func() {
+			done <- gw.serveHTTP(ctx, *httpAddr, *token)
+		}()
+	}
+	if *stdio {
+		go func() {
+			// the end of stdin only stops the gateway if nothing else is served
+			if err := gw.serveStdio(ctx, os.Stdin, os.Stdout); err != nil || *httpAddr == "" {
+				done <- err
+			}
+		}()
+	}
+
+	select {
+	case <-ctx.Done():
+	case err := <-done:
+		if err != nil {
+			client.Log.Error("gateway: %v", err)
+		}
+	}
+	client.Stop()
+}
+
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "gogram-gateway: "+format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package gogram
+
+import (
+	"slices"
+
+	"github.com/amarnathcjd/gogram/internal/session"
+)
+
+// storedDCAuth returns the auth key kept for dc, if any.
+func (m *MTProto) storedDCAuth(dc int, cdn bool) (session.DCAuth, bool) {
+	m.dcAuthMu.Lock()
+	defer m.dcAuthMu.Unlock()
+
+	i := slices.IndexFunc(m.dcAuths, func(a session.DCAuth) bool { return a.DC == dc && a.CDN == cdn })
+	if i < 0 {
+		return session.DCAuth{}, false
+	}
+	return m.dcAuths[i], true
+}
+
+// HasStoredAuth reports whether an auth key carrying the user's authorization
+// is stored for dc, so senders to it need no export/import of the authorization.
+func (m *MTProto) HasStoredAuth(dc int) bool {
+	auth, ok := m.storedDCAuth(dc, false)
+	return ok && auth.Authorized
+}
+
+func (m *MTProto) dcAuthSnapshot() []session.DCAuth {
+	m.dcAuthMu.Lock()
+	defer m.dcAuthMu.Unlock()
+	return slices.Clone(m.dcAuths)
+}
+
+func (m *MTProto) setDCAuths(auths []session.DCAuth) {
+	m.dcAuthMu.Lock()
+	m.dcAuths = slices.Clone(auths)
+	m.dcAuthMu.Unlock()
+}
+
+// storeDCAuth records the auth key of an exported sender and persists the session.
+func (m *MTProto) storeDCAuth(auth session.DCAuth) {
+	if auth.DC == m.GetDC() && !auth.CDN {
+		return
+	}
+
+	m.dcAuthMu.Lock()
+	i := slices.IndexFunc(m.dcAuths, func(a session.DCAuth) bool { return a.DC == auth.DC && a.CDN == auth.CDN })
+	if i >= 0 {
+		if m.dcAuths[i].Authorized == auth.Authorized && m.dcAuths[i].Salt == auth.Salt &&
+			slices.Equal(m.dcAuths[i].Key, auth.Key) && m.dcAuths[i].TimeOffset == auth.TimeOffset {
+			m.dcAuthMu.Unlock()
+			return
+		}
+		m.dcAuths[i] = auth
+	} else {
+		m.dcAuths = append(m.dcAuths, auth)
+	}
+	m.dcAuthMu.Unlock()
+
+	if err := m.SaveSession(m.memorySession); err != nil {
+		m.Logger.Debug("failed to save session: %v", err)
+	}
+}
+
+// forgetDCAuths drops the key stored for the new home DC and the authorization
+// flags of all others, which belonged to the account of the old home DC.
+func (m *MTProto) forgetDCAuths(home int) {
+	m.dcAuthMu.Lock()
+	defer m.dcAuthMu.Unlock()
+
+	m.dcAuths = slices.DeleteFunc(m.dcAuths, func(a session.DCAuth) bool { return a.DC == home && !a.CDN })
+	for i := range m.dcAuths {
+		m.dcAuths[i].Authorized = false
+	}
+}
+
+// dcAuth describes the key of an exported sender as it is stored by its parent.
+func (m *MTProto) dcAuth() session.DCAuth {
+	return session.DCAuth{
+		DC:         m.exportedDC,
+		CDN:        m.cdn,
+		Key:        m.authKey,
+		Hash:       m.authKeyHash,
+		Salt:       m.serverSalt.Load(),
+		TimeOffset: m.timeOffset.Load(),
+		Hostname:   m.GetAddr(),
+		Authorized: m.authImported.Load(),
+	}
+}
+
+// IsAuthImported reports whether the user's authorization is known to be imported
+// on the key of this exported sender.
+func (m *MTProto) IsAuthImported() bool {
+	return m.authImported.Load()
+}
+
+// SetAuthImported records whether the user's authorization has been imported on
+// the key of this exported sender, so it can be reused on the next run.
+func (m *MTProto) SetAuthImported(imported bool) {
+	m.authImported.Store(imported)
+	if m.parent != nil && len(m.authKey) > 0 {
+		m.parent.storeDCAuth(m.dcAuth())
+	}
+}
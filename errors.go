@@ -17,7 +17,8 @@ type ErrResponseCode struct {
 	Code           int64
 	Message        string
 	Description    string
-	AdditionalInfo any // some errors has additional data like timeout seconds, dc id etc.
+	AdditionalInfo any    // some errors has additional data like timeout seconds, dc id etc.
+	Method         string // of the request that failed, if known
 }
 
 func RpcErrorToNative(r *objects.RpcError, method ...string) error {
@@ -36,12 +37,117 @@ func RpcErrorToNative(r *objects.RpcError, method ...string) error {
 		desc = fmt.Sprintf("%s (method: %s)", desc, strings.Join(method, ", "))
 	}
 
-	return &ErrResponseCode{
+	return typedError(&ErrResponseCode{
 		Code:           int64(r.ErrorCode),
 		Message:        nativeErrorName,
 		Description:    desc,
 		AdditionalInfo: additionalData,
+		Method:         strings.Join(method, ", "),
+	})
+}
+
+// RPCError is the type of an error Telegram answers requests with, like
+// "PEER_ID_INVALID" or "FLOOD_WAIT_X", whose number is replaced by X. The
+// errors of requests failing with a type match it with errors.Is:
+//
+//	if errors.Is(err, telegram.ErrFloodWait) { ... }
+type RPCError string
+
+func (e RPCError) Error() string {
+	return string(e)
+}
+
+// Is reports whether target is the RPCError e is of.
+func (e *ErrResponseCode) Is(target error) bool {
+	t, ok := target.(RPCError)
+	return ok && string(t) == e.Message
+}
+
+// RetryClass tells whether a request that failed may succeed when sent again.
+type RetryClass int
+
+const (
+	RetryNever       RetryClass = iota // the request is wrong and fails again as is
+	RetryAfterWait                     // flood control, the request may be sent again after a wait
+	RetryOtherDC                       // the request must be sent to another data center
+	RetryWithBackoff                   // the server failed, the request may be sent again after a backoff
+)
+
+func (c RetryClass) String() string {
+	switch c {
+	case RetryNever:
+		return "never"
+	case RetryAfterWait:
+		return "after wait"
+	case RetryOtherDC:
+		return "other dc"
+	case RetryWithBackoff:
+		return "with backoff"
+	}
+	return fmt.Sprintf("RetryClass(%d)", int(c))
+}
+
+// RetryClass classifies e by its code: 303 SEE_OTHER errors must be repeated
+// on another data center, 420 FLOOD errors after a wait, and internal server
+// errors after a backoff; other errors are not worth a retry.
+func (e *ErrResponseCode) RetryClass() RetryClass {
+	switch {
+	case e.Code == 303:
+		return RetryOtherDC
+	case e.Code == 420:
+		return RetryAfterWait
+	case e.Code >= 500 && e.Code < 600, e.Code == -500, e.Code == -503:
+		return RetryWithBackoff
+	}
+	return RetryNever
+}
+
+// FloodWaitError is the error of requests refused by flood control, of type
+// FLOOD_WAIT_X, FLOOD_PREMIUM_WAIT_X or FLOOD_TEST_PHONE_WAIT_X.
+type FloodWaitError struct {
+	*ErrResponseCode
+	Seconds int // to wait before repeating the request
+}
+
+func (e *FloodWaitError) Unwrap() error {
+	return e.ErrResponseCode
+}
+
+// SlowModeWaitError is the error of messages sent to a chat in slow mode before
+// the user may send the next, of type SLOWMODE_WAIT_X.
+type SlowModeWaitError struct {
+	*ErrResponseCode
+	Seconds int // until the next message may be sent
+}
+
+func (e *SlowModeWaitError) Unwrap() error {
+	return e.ErrResponseCode
+}
+
+// MigrateError is the error of requests to be sent to another data center, of
+// type PHONE_MIGRATE_X, USER_MIGRATE_X, FILE_MIGRATE_X, NETWORK_MIGRATE_X or
+// STATS_MIGRATE_X.
+type MigrateError struct {
+	*ErrResponseCode
+	DC int // to send the request to
+}
+
+func (e *MigrateError) Unwrap() error {
+	return e.ErrResponseCode
+}
+
+// typedError returns e wrapped in the typed error of its type, if it has one.
+func typedError(e *ErrResponseCode) error {
+	n, _ := e.AdditionalInfo.(int)
+	switch e.Message {
+	case "FLOOD_WAIT_X", "FLOOD_PREMIUM_WAIT_X", "FLOOD_TEST_PHONE_WAIT_X":
+		return &FloodWaitError{ErrResponseCode: e, Seconds: n}
+	case "SLOWMODE_WAIT_X":
+		return &SlowModeWaitError{ErrResponseCode: e, Seconds: n}
+	case "PHONE_MIGRATE_X", "USER_MIGRATE_X", "FILE_MIGRATE_X", "NETWORK_MIGRATE_X", "STATS_MIGRATE_X":
+		return &MigrateError{ErrResponseCode: e, DC: n}
 	}
+	return e
 }
 
 type prefixSuffix struct {
@@ -51,6 +157,7 @@ type prefixSuffix struct {
 }
 
 var specificErrors = []prefixSuffix{
+	{"2FA_CONFIRM_WAIT_", "", reflect.Int},
 	{"EMAIL_UNCONFIRMED_", "", reflect.Int},
 	{"FILE_MIGRATE_", "", reflect.Int},
 	{"FILE_PART_", "_MISSING", reflect.Int},
@@ -69,9 +176,9 @@ var specificErrors = []prefixSuffix{
 	{"TAKEOUT_INIT_DELAY_", "", reflect.Int},
 	{"USER_MIGRATE_", "", reflect.Int},
 	{"PREVIOUS_CHAT_IMPORT_ACTIVE_WAIT_", "MIN", reflect.Int},
-	{"PREMIUM_SUB_ACTIVE_UNTIL_X", "", reflect.Int},
-	{"STORY_SEND_FLOOD_MONTHLY_X", "", reflect.Int},
-	{"STORY_SEND_FLOOD_WEEKLY_X", "", reflect.Int},
+	{"PREMIUM_SUB_ACTIVE_UNTIL_", "", reflect.Int},
+	{"STORY_SEND_FLOOD_MONTHLY_", "", reflect.Int},
+	{"STORY_SEND_FLOOD_WEEKLY_", "", reflect.Int},
 }
 
 func TryExpandError(errStr string) (nativeErrorName string, additionalData any) {
@@ -804,6 +911,20 @@ func (*errorSessionConfigsChanged) CRC() uint32 {
 	return 0x00000000
 }
 
+// errorRequestLost is delivered to a pending request after a reconnect when the server
+// reports it has no record of the message, so resending could execute it twice.
+type errorRequestLost struct {
+	msgID int64
+}
+
+func (e *errorRequestLost) Error() string {
+	return fmt.Sprintf("request %d was lost during reconnect and its delivery state is unknown; not resending", e.msgID)
+}
+
+func (*errorRequestLost) CRC() uint32 {
+	return 0x00000000
+}
+
 type errorDCMigrated struct {
 	dc int32
 }
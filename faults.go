@@ -0,0 +1,44 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package gogram
+
+import "github.com/amarnathcjd/gogram/internal/transport"
+
+type (
+	// FaultInjector breaks frames of the connections it is set on, see Config.Faults.
+	FaultInjector = transport.FaultInjector
+	// FaultConfig configures the faults a FaultInjector applies.
+	FaultConfig = transport.FaultConfig
+	// Fault is a failure applied to a single frame.
+	Fault = transport.Fault
+	// Frame identifies a frame of a connection for a fault script.
+	Frame = transport.Frame
+	// FaultStep is one entry of a fault script.
+	FaultStep = transport.FaultStep
+	// FrameDirection tells whether the client sends or receives a frame.
+	FrameDirection = transport.Direction
+)
+
+const (
+	FaultNone       = transport.FaultNone
+	FaultStall      = transport.FaultStall
+	FaultDrop       = transport.FaultDrop
+	FaultReorder    = transport.FaultReorder
+	FaultCut        = transport.FaultCut
+	FaultCorruptKey = transport.FaultCorruptKey
+	FaultCode404    = transport.FaultCode404
+	FaultCode429    = transport.FaultCode429
+
+	FrameOutgoing = transport.Outgoing
+	FrameIncoming = transport.Incoming
+)
+
+// NewFaultInjector returns an injector applying the faults of cfg.
+func NewFaultInjector(cfg FaultConfig) *FaultInjector {
+	return transport.NewFaultInjector(cfg)
+}
+
+// ScriptFaults returns a FaultConfig.Script striking exactly the frames of steps.
+func ScriptFaults(steps ...FaultStep) func(Frame) Fault {
+	return transport.ScriptFaults(steps...)
+}
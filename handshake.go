@@ -3,7 +3,6 @@
 package gogram
 
 import (
-	"bytes"
 	"context"
 	"encoding/binary"
 	"encoding/hex"
@@ -213,7 +212,8 @@ func (m *MTProto) makeAuthKeyInternal(expiresIn int32) error {
 	if nonceServer.Cmp(dhg.ServerNonce.Int) != 0 {
 		return fmt.Errorf("handshake: Wrong server_nonce: %v, %v", nonceServer, dhg.ServerNonce)
 	}
-	if !bytes.Equal(nonceHash1, dhg.NewNonceHash1.Bytes()) {
+	// compared as numbers, as the decoded int128 drops leading zero bytes
+	if new(big.Int).SetBytes(nonceHash1).Cmp(dhg.NewNonceHash1.Int) != 0 {
 		return fmt.Errorf(
 			"handshake: Wrong new_nonce_hash1: %v, %v",
 			hex.EncodeToString(nonceHash1),
@@ -260,6 +260,8 @@ func (m *MTProto) createTempAuthKey(expiresIn int32) error {
 		ReqTimeout:      int(m.reqTimeout.Seconds()),
 		UseWebSocket:    m.useWebSocket,
 		UseWebSocketTLS: m.useWebSocketTLS,
+		Dialer:          m.dial,
+		Faults:          m.faults,
 	}
 
 	tmp, err := NewMTProto(cfg)
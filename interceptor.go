@@ -0,0 +1,84 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package gogram
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+	"github.com/amarnathcjd/gogram/internal/utils"
+)
+
+// TLObject is any TL-serializable request or result.
+type TLObject = tl.Object
+
+// RequestInfo describes an RPC call passing through the interceptor chain.
+type RequestInfo struct {
+	Method   string    // Method name, e.g. "MessagesSendMessage"
+	DC       int       // Data center the request is sent to
+	Exported bool      // Request goes through an exported sender rather than the main connection
+	Start    time.Time // Time the call entered the chain
+}
+
+// Duration returns the time elapsed since the call entered the chain.
+func (r *RequestInfo) Duration() time.Duration {
+	return time.Since(r.Start)
+}
+
+// Invoker sends req and returns its result.
+type Invoker func(ctx context.Context, req TLObject) (any, error)
+
+// Interceptor wraps every RPC call. It may inspect or replace req before calling next,
+// inspect or replace the result after it, or return without calling next at all to
+// short-circuit the request. Interceptors run in the order they were added, the first
+// one being the outermost. MTProto service messages, like pings, acks and salt
+// requests, don't go through the chain.
+type Interceptor func(ctx context.Context, info *RequestInfo, req TLObject, next Invoker) (any, error)
+
+// AddInterceptor appends interceptors to the chain of this connection.
+// Senders exported from it afterwards inherit the chain.
+func (m *MTProto) AddInterceptor(interceptors ...Interceptor) {
+	m.interceptorsMu.Lock()
+	defer m.interceptorsMu.Unlock()
+	m.interceptors = append(slices.Clip(m.interceptors), interceptors...)
+}
+
+// Interceptors returns a copy of the interceptor chain.
+func (m *MTProto) Interceptors() []Interceptor {
+	m.interceptorsMu.RLock()
+	defer m.interceptorsMu.RUnlock()
+	return slices.Clone(m.interceptors)
+}
+
+// invoke runs data through the interceptor chain, ending in the actual request.
+// Service messages skip the chain.
+func (m *MTProto) invoke(ctx context.Context, data tl.Object, expectedTypes ...reflect.Type) (any, error) {
+	m.interceptorsMu.RLock()
+	chain := m.interceptors
+	m.interceptorsMu.RUnlock()
+
+	if len(chain) == 0 || isServiceMessage(data) {
+		return m.route(ctx, data, expectedTypes...)
+	}
+
+	info := &RequestInfo{
+		Method:   utils.FmtMethod(data),
+		DC:       m.GetDC(),
+		Exported: m.exported,
+		Start:    time.Now(),
+	}
+
+	next := func(ctx context.Context, req TLObject) (any, error) {
+		return m.route(ctx, req, expectedTypes...)
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		interceptor, inner := chain[i], next
+		next = func(ctx context.Context, req TLObject) (any, error) {
+			return interceptor(ctx, info, req, inner)
+		}
+	}
+	return next(ctx, data)
+}
@@ -81,6 +81,16 @@ func Decrypt(msg, authKey, checkData []byte) ([]byte, error) {
 	return decrypt(msg, authKey, checkData, true)
 }
 
+// EncryptServer encrypts msg the way the server does for messages to the client, the counterpart of Decrypt.
+func EncryptServer(msg, authKey []byte) (out, msgKey []byte, _ error) {
+	return encrypt(msg, authKey, true)
+}
+
+// DecryptServer decrypts a message sent by the client, the counterpart of Encrypt.
+func DecryptServer(msg, authKey, msgKey []byte) ([]byte, error) {
+	return decrypt(msg, authKey, msgKey, false)
+}
+
 func decrypt(msg, authKey, msgKey []byte, decode bool) ([]byte, error) {
 	aesKey, aesIV := aesKeys(msgKey, authKey, decode)
 
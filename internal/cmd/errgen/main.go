@@ -0,0 +1,177 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+// Command errgen generates the RPCError constants of the telegram package
+// from the table of known errors in errors.go, so that callers can match
+// errors with errors.Is instead of comparing strings.
+//
+//	go run ./internal/cmd/errgen -out telegram/errors_gen.go errors.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	var (
+		out = flag.String("out", "errors_gen.go", "file to write")
+		pkg = flag.String("pkg", "telegram", "package of the generated file")
+		tab = flag.String("table", "errorMessages", "variable holding the errors and their descriptions")
+	)
+	flag.Parse()
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: errgen [flags] errors.go")
+		os.Exit(2)
+	}
+
+	errs, err := readTable(flag.Arg(0), *tab)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	src, err := generate(*pkg, errs)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		fatalf("%v", err)
+	}
+}
+
+// rpcError is an entry of the table.
+type rpcError struct {
+	name        string // as sent by Telegram, with X for numbers
+	description string
+}
+
+// readTable reads the entries of the map literal assigned to the variable
+// named table in the file at path.
+func readTable(path, table string) ([]rpcError, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var lit *ast.CompositeLit
+	ast.Inspect(file, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok || lit != nil {
+			return lit == nil
+		}
+		for i, name := range spec.Names {
+			if name.Name == table && i < len(spec.Values) {
+				lit, _ = spec.Values[i].(*ast.CompositeLit)
+			}
+		}
+		return false
+	})
+	if lit == nil {
+		return nil, fmt.Errorf("%s: no map literal %s", path, table)
+	}
+
+	var errs []rpcError
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		name, err := stringLit(kv.Key)
+		if err != nil {
+			return nil, fmt.Errorf("%s: key: %w", path, err)
+		}
+		desc, err := stringLit(kv.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", path, name, err)
+		}
+		errs = append(errs, rpcError{name: name, description: desc})
+	}
+	slices.SortFunc(errs, func(a, b rpcError) int {
+		return strings.Compare(a.name, b.name)
+	})
+	return errs, nil
+}
+
+func stringLit(e ast.Expr) (string, error) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", fmt.Errorf("not a string literal")
+	}
+	return strconv.Unquote(lit.Value)
+}
+
+func generate(pkg string, errs []rpcError) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by errgen; DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	buf.WriteString("// Errors Telegram answers requests with. Errors carrying a number, like\n")
+	buf.WriteString("// FLOOD_WAIT_X, are named without it.\n")
+	buf.WriteString("const (\n")
+
+	seen := make(map[string]string, len(errs))
+	for _, e := range errs {
+		ident, ok := renames[e.name]
+		if !ok {
+			ident = constName(e.name, false)
+		}
+		if _, ok := seen[ident]; ok {
+			ident = constName(e.name, true) // EMAIL_UNCONFIRMED_X next to EMAIL_UNCONFIRMED
+		}
+		if other, ok := seen[ident]; ok {
+			return nil, fmt.Errorf("%s and %s are both named %s", other, e.name, ident)
+		}
+		seen[ident] = e.name
+
+		desc := strings.Join(strings.Fields(strings.ReplaceAll(e.description, "%v", "X")), " ")
+		fmt.Fprintf(&buf, "\t%s RPCError = %q // %s\n", ident, e.name, desc)
+	}
+	buf.WriteString(")\n")
+	return format.Source(buf.Bytes())
+}
+
+// renames names the errors whose name would clash with another.
+var renames = map[string]string{
+	"Timeout":        "ErrServerTimeout",    // -503, next to TIMEOUT
+	"UNKNOWN_METHOD": "ErrRPCUnknownMethod", // next to the ErrUnknownMethod of InvokeRaw
+}
+
+// initialisms are kept upper case in names, as Go style has it.
+var initialisms = map[string]bool{
+	"2FA": true, "API": true, "DC": true, "DH": true, "HTTP": true, "ID": true, "IDS": true,
+	"IP": true, "JSON": true, "MD5": true, "RPC": true, "SHA256": true, "SMS": true,
+	"SRP": true, "TTL": true, "UI": true, "URL": true, "URI": true,
+}
+
+// constName turns an error name like "PEER_ID_INVALID" into ErrPeerIDInvalid,
+// leaving out the X standing for a number unless keepX is set.
+func constName(name string, keepX bool) string {
+	var b strings.Builder
+	b.WriteString("Err")
+	for word := range strings.SplitSeq(name, "_") {
+		switch {
+		case word == "X" && keepX:
+			b.WriteString(word)
+		case word == "" || word == "X":
+		case word == "IDS":
+			b.WriteString("IDs")
+		case initialisms[word]:
+			b.WriteString(word)
+		case word == "XMIN": // the minutes of PREVIOUS_CHAT_IMPORT_ACTIVE_WAIT_XMIN
+			b.WriteString("Min")
+		default:
+			b.WriteString(word[:1] + strings.ToLower(word[1:]))
+		}
+	}
+	return b.String()
+}
+
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "errgen: "+format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	ige "github.com/amarnathcjd/gogram/internal/aes_ige"
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+	"github.com/amarnathcjd/gogram/internal/session"
+	"github.com/amarnathcjd/gogram/internal/utils"
+)
+
+const (
+	authKeySize   = 256
+	authKeyIDSize = 8
+	msgKeySize    = 16
+	headerSize    = 32 // salt, session_id, msg_id, seq_no and length of an encrypted message
+)
+
+// authKey is a key messages may be encrypted with.
+type authKey struct {
+	key  []byte
+	id   []byte // auth_key_id, the auth key hash
+	name string // where the key comes from
+}
+
+func loadKey(s, id string) (authKey, error) {
+	key, err := readBytes(s, authKeySize)
+	if err != nil {
+		return authKey{}, err
+	}
+	k := authKey{key: key, id: utils.AuthKeyHash(key), name: "-key"}
+	if id != "" {
+		if k.id, err = decodeHex(id); err != nil || len(k.id) != authKeyIDSize {
+			return authKey{}, fmt.Errorf("-key-id must be %d bytes in hex", authKeyIDSize)
+		}
+	}
+	return k, nil
+}
+
+// sessionKeys returns the auth keys of a session file, of the home data center
+// and of the others the session kept keys for.
+func sessionKeys(path string) ([]authKey, error) {
+	s, err := session.NewFromFile(path, "").Load()
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []authKey
+	add := func(key, hash []byte, name string) {
+		if len(key) != authKeySize {
+			return
+		}
+		if len(hash) != authKeyIDSize {
+			hash = utils.AuthKeyHash(key)
+		}
+		keys = append(keys, authKey{key: key, id: hash, name: name})
+	}
+	add(s.Key, s.Hash, "home dc")
+	for _, dc := range s.DCs {
+		name := fmt.Sprintf("dc %d", dc.DC)
+		if dc.CDN {
+			name += " (cdn)"
+		}
+		add(dc.Key, dc.Hash, name)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no auth key in session")
+	}
+	return keys, nil
+}
+
+// frame prints data as an MTProto message if it looks like one, decrypting it
+// with the key of keys matching its auth_key_id, and as a TL object otherwise.
+func (p *printer) frame(data []byte, keys []authKey) error {
+	if len(data) < authKeyIDSize+8 {
+		p.object(data)
+		return nil
+	}
+
+	keyID := data[:authKeyIDSize]
+	if bytes.Equal(keyID, make([]byte, authKeyIDSize)) {
+		return p.unencrypted(data[authKeyIDSize:])
+	}
+	for _, k := range keys {
+		if bytes.Equal(k.id, keyID) {
+			return p.encrypted(data[authKeyIDSize:], k)
+		}
+	}
+
+	if _, ok := tl.Name(binary.LittleEndian.Uint32(data)); ok {
+		p.object(data)
+		return nil
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("not a known TL object, and an encrypted message with auth_key_id %x needs -key or -session", keyID)
+	}
+	return fmt.Errorf("no auth key given has auth_key_id %x", keyID)
+}
+
+func (p *printer) unencrypted(data []byte) error {
+	if len(data) < 12 {
+		return fmt.Errorf("unencrypted message of %d bytes is too short", len(data))
+	}
+	msgID := int64(binary.LittleEndian.Uint64(data))
+	size := int(binary.LittleEndian.Uint32(data[8:]))
+	body := data[12:]
+	if size > len(body) {
+		return fmt.Errorf("unencrypted message: length %d exceeds the %d bytes left", size, len(body))
+	}
+
+	p.printf(0, "unencrypted message")
+	p.printf(0, "msg_id=%d (%s) length=%d", msgID, msgTime(msgID), size)
+	if len(body) > size {
+		p.printf(0, "%d bytes after the body: % x", len(body)-size, body[size:])
+	}
+	p.body(0, "", body[:size])
+	return nil
+}
+
+func (p *printer) encrypted(data []byte, k authKey) error {
+	if len(data) < msgKeySize+headerSize || (len(data)-msgKeySize)%16 != 0 {
+		return fmt.Errorf("encrypted message of %d bytes has no valid length", len(data)+authKeyIDSize)
+	}
+	msgKey, encrypted := data[:msgKeySize], data[msgKeySize:]
+
+	// msg_key hashes the plaintext with a part of the key that depends on the
+	// direction, so only the right one reproduces it
+	direction := "server to client"
+	decrypted, err := ige.Decrypt(encrypted, k.key, msgKey)
+	if err == nil && !bytes.Equal(ige.MessageKey(k.key, decrypted, true), msgKey) {
+		direction = "client to server"
+		decrypted, err = ige.DecryptServer(encrypted, k.key, msgKey)
+		if err == nil && !bytes.Equal(ige.MessageKey(k.key, decrypted, false), msgKey) {
+			return fmt.Errorf("msg_key does not match in either direction: wrong key (%s) or corrupted message", k.name)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("decrypting: %w", err)
+	}
+
+	salt := int64(binary.LittleEndian.Uint64(decrypted))
+	sessionID := int64(binary.LittleEndian.Uint64(decrypted[8:]))
+	msgID := int64(binary.LittleEndian.Uint64(decrypted[16:]))
+	seqNo := int32(binary.LittleEndian.Uint32(decrypted[24:]))
+	size := int(binary.LittleEndian.Uint32(decrypted[28:]))
+	body := decrypted[headerSize:]
+	if size > len(body) {
+		return fmt.Errorf("encrypted message: length %d exceeds the %d bytes decrypted", size, len(body))
+	}
+
+	p.printf(0, "encrypted message, %s, auth key of %s (auth_key_id %x)", direction, k.name, k.id)
+	p.printf(0, "salt=%#x session_id=%#x msg_id=%d (%s) seq_no=%d length=%d padding=%d",
+		uint64(salt), uint64(sessionID), msgID, msgTime(msgID), seqNo, size, len(body)-size)
+	p.body(0, "", body[:size])
+	return nil
+}
+
+// msgTime returns the time a msg_id was generated at, which is its upper half.
+func msgTime(msgID int64) string {
+	return time.Unix(msgID>>32, 0).UTC().Format(time.DateTime + " UTC")
+}
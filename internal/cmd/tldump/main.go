@@ -0,0 +1,173 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+// Command tldump decodes MTProto payloads, as found in logs and packet
+// captures, and prints them as a tree of TL objects with their constructor
+// names, flags and field values.
+//
+//	go run ./internal/cmd/tldump b5757299              # a TL object in hex, boolTrue
+//	go run ./internal/cmd/tldump -f frame.bin -key auth.key
+//	go run ./internal/cmd/tldump -session my.session < frame.b64
+//
+// The payload is given in hex, base64 or raw bytes, as the argument, in the
+// file of -f or on stdin. It is one of:
+//
+//   - a TL object, like the body of a message;
+//   - an unencrypted message, whose auth_key_id is zero, as sent during the handshake;
+//   - an encrypted message: auth_key_id, msg_key and the encrypted data, which
+//     is decrypted with the auth key of -key, or the key of -session matching
+//     its auth_key_id. Both directions are tried, and told apart by msg_key.
+//
+// Containers, gzip_packed and rpc_result are opened and their content
+// printed in place. Objects that fail to decode are printed as the error and
+// their bytes, and bytes left over after an object are printed as well.
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	_ "github.com/amarnathcjd/gogram/internal/mtproto/objects"
+	_ "github.com/amarnathcjd/gogram/telegram"
+)
+
+func main() {
+	var (
+		format  = flag.String("in", "auto", "input encoding: auto, hex, base64 or raw")
+		file    = flag.String("f", "", "read the payload from this file instead of stdin")
+		key     = flag.String("key", "", "auth key to decrypt with, in hex or base64, or a file holding it")
+		keyID   = flag.String("key-id", "", "auth key hash (auth_key_id) of -key, in hex; derived from the key by default")
+		session = flag.String("session", "", "session file to take the auth key from")
+		asBody  = flag.Bool("object", false, "decode the payload as a TL object, even if it looks like a message")
+	)
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: tldump [flags] [payload]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	var raw []byte
+	var err error
+	switch {
+	case flag.NArg() > 1:
+		flag.Usage()
+		os.Exit(2)
+	case flag.NArg() == 1:
+		raw = []byte(flag.Arg(0))
+	case *file != "":
+		raw, err = os.ReadFile(*file)
+	default:
+		raw, err = io.ReadAll(os.Stdin)
+	}
+	if err != nil {
+		fatalf("reading payload: %v", err)
+	}
+	data, err := decodeInput(raw, *format)
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	var keys []authKey
+	if *key != "" {
+		k, err := loadKey(*key, *keyID)
+		if err != nil {
+			fatalf("-key: %v", err)
+		}
+		keys = append(keys, k)
+	}
+	if *session != "" {
+		ks, err := sessionKeys(*session)
+		if err != nil {
+			fatalf("-session: %v", err)
+		}
+		keys = append(keys, ks...)
+	}
+
+	p := &printer{w: os.Stdout}
+	if *asBody {
+		p.object(data)
+	} else if err := p.frame(data, keys); err != nil {
+		fatalf("%v", err)
+	}
+	if p.err != nil {
+		fatalf("writing output: %v", p.err)
+	}
+}
+
+// decodeInput returns the bytes encoded in data, as format says or, for
+// "auto", as hex if data only holds hex digits, as base64 if it decodes as
+// such, and as is otherwise.
+func decodeInput(data []byte, format string) ([]byte, error) {
+	text := strings.Join(strings.Fields(string(data)), "")
+	switch format {
+	case "raw":
+		return data, nil
+	case "hex":
+		return decodeHex(text)
+	case "base64":
+		return decodeBase64(text)
+	case "auto":
+		if b, err := decodeHex(text); err == nil {
+			return b, nil
+		}
+		if b, err := decodeBase64(text); err == nil {
+			return b, nil
+		}
+		return data, nil
+	}
+	return nil, fmt.Errorf("unknown input encoding %q", format)
+}
+
+// decodeHex accepts hex dumps with an optional 0x prefix and bytes separated
+// by colons, as copied from most tools.
+func decodeHex(s string) ([]byte, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	s = strings.ReplaceAll(s, ":", "")
+	if s == "" {
+		return nil, fmt.Errorf("empty payload")
+	}
+	return hex.DecodeString(s)
+}
+
+func decodeBase64(s string) ([]byte, error) {
+	if s == "" {
+		return nil, fmt.Errorf("empty payload")
+	}
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if b, err := enc.DecodeString(s); err == nil {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid base64")
+}
+
+// readBytes reads s as hex or base64 or, if s names a file, the file's
+// content, which may also be raw bytes of the given size.
+func readBytes(s string, size int) ([]byte, error) {
+	if content, err := os.ReadFile(s); err == nil {
+		if len(content) == size {
+			return content, nil
+		}
+		s = string(bytes.TrimSpace(content))
+	}
+	b, err := decodeHex(s)
+	if err != nil {
+		if b, err = decodeBase64(s); err != nil {
+			return nil, fmt.Errorf("neither hex, base64 nor a file")
+		}
+	}
+	if len(b) != size {
+		return nil, fmt.Errorf("got %d bytes, want %d", len(b), size)
+	}
+	return b, nil
+}
+
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "tldump: "+format+"\n", args...)
+	os.Exit(1)
+}
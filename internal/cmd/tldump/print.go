@@ -0,0 +1,268 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+	"github.com/amarnathcjd/gogram/internal/mtproto/objects"
+)
+
+var crcMsgContainer = (*objects.MessageContainer)(nil).CRC()
+
+// maxBytes is how many bytes of a bytes field are printed.
+const maxBytes = 128
+
+// printer writes TL objects as an indented tree, one field per line.
+type printer struct {
+	w   io.Writer
+	err error // first write error
+}
+
+func (p *printer) printf(depth int, format string, args ...any) {
+	if p.err != nil {
+		return
+	}
+	_, p.err = fmt.Fprintf(p.w, strings.Repeat("  ", depth)+format+"\n", args...)
+}
+
+func (p *printer) object(data []byte) {
+	p.body(0, "", data)
+}
+
+// body prints the boxed object in data, prefixed by label. The service
+// objects wrapping other objects are opened here, byte by byte, as their
+// content can be anything, including bare vectors the decoder can't predict.
+func (p *printer) body(depth int, label string, data []byte) {
+	if len(data) < tl.WordLen {
+		p.printf(depth, "%struncated: % x", label, data)
+		return
+	}
+
+	switch crc := binary.LittleEndian.Uint32(data); crc {
+	case crcMsgContainer:
+		p.container(depth, label, data[tl.WordLen:])
+	case objects.CrcRpcResult:
+		if len(data) < tl.WordLen+tl.LongLen {
+			p.printf(depth, "%srpc_result#%08x truncated: % x", label, crc, data)
+			return
+		}
+		p.printf(depth, "%srpc_result#%08x", label, crc)
+		p.printf(depth+1, "ReqMsgID: %d", int64(binary.LittleEndian.Uint64(data[tl.WordLen:])))
+		p.body(depth+1, "Obj: ", data[tl.WordLen+tl.LongLen:])
+	case objects.CrcGzipPacked:
+		d, _ := tl.NewDecoder(bytes.NewReader(data[tl.WordLen:]))
+		packed := d.PopMessage()
+		if err := d.Err(); err != nil {
+			p.fail(depth, label, err, data)
+			return
+		}
+		gz, err := gzip.NewReader(bytes.NewReader(packed))
+		if err != nil {
+			p.fail(depth, label, err, data)
+			return
+		}
+		unpacked, err := io.ReadAll(gz)
+		if err != nil {
+			p.fail(depth, label, err, data)
+			return
+		}
+		p.printf(depth, "%sgzip_packed#%08x (%d bytes unpacked to %d)", label, crc, len(packed), len(unpacked))
+		p.body(depth+1, "Obj: ", unpacked)
+	case tl.CrcVector:
+		p.vector(depth, label, data[tl.WordLen:])
+	default:
+		d, _ := tl.NewDecoder(bytes.NewReader(data))
+		obj := d.PopObject()
+		if err := d.Err(); err != nil {
+			p.fail(depth, label, err, data)
+			return
+		}
+		p.value(depth, label, reflect.ValueOf(obj))
+		p.rest(depth, d)
+	}
+}
+
+// container prints the messages of a msg_container.
+func (p *printer) container(depth int, label string, data []byte) {
+	d, _ := tl.NewDecoder(bytes.NewReader(data))
+	count := int(d.PopUint())
+	p.printf(depth, "%smsg_container#%08x [%d messages]", label, crcMsgContainer, count)
+	for i := 0; i < count && d.Err() == nil; i++ {
+		msgID := d.PopLong()
+		seqNo := d.PopInt()
+		size := int(d.PopUint())
+		body := d.PopRawBytes(size)
+		if err := d.Err(); err != nil {
+			p.printf(depth+1, "[%d] error: %v", i, err)
+			return
+		}
+		p.printf(depth+1, "[%d] msg_id=%d (%s) seq_no=%d length=%d", i, msgID, msgTime(msgID), seqNo, size)
+		p.body(depth+2, "", body)
+	}
+	p.rest(depth, d)
+}
+
+// vector prints a bare vector, as returned by methods like
+// messages.getMessagesViews: of boxed objects if its first item starts with a
+// known crc, and of longs or ints if its size fits.
+func (p *printer) vector(depth int, label string, data []byte) {
+	d, _ := tl.NewDecoder(bytes.NewReader(data))
+	count := int(d.PopUint())
+	rest, _ := d.DumpWithoutRead()
+	p.printf(depth, "%svector#%08x [%d items]", label, tl.CrcVector, count)
+
+	switch {
+	case count == 0:
+	case len(rest) >= tl.WordLen && isKnownCrc(binary.LittleEndian.Uint32(rest)):
+		for i := 0; i < count && d.Err() == nil; i++ {
+			obj := d.PopObject()
+			if err := d.Err(); err != nil {
+				p.printf(depth+1, "[%d] error: %v", i, err)
+				return
+			}
+			p.value(depth+1, fmt.Sprintf("[%d] ", i), reflect.ValueOf(obj))
+		}
+	case len(rest) == count*tl.LongLen:
+		for i := 0; i < count; i++ {
+			p.printf(depth+1, "[%d] %d", i, d.PopLong())
+		}
+	case len(rest) == count*tl.WordLen:
+		for i := 0; i < count; i++ {
+			p.printf(depth+1, "[%d] %d", i, d.PopInt())
+		}
+	default:
+		p.printf(depth+1, "items of unknown type: % x", rest)
+		return
+	}
+	p.rest(depth, d)
+}
+
+func isKnownCrc(crc uint32) bool {
+	_, ok := tl.Name(crc)
+	return ok
+}
+
+// fail reports an object that failed to decode, with its bytes.
+func (p *printer) fail(depth int, label string, err error, data []byte) {
+	p.printf(depth, "%serror: %v", label, err)
+	p.printf(depth+1, "% x", data)
+}
+
+// rest reports bytes left after the object decoded by d, which the schema
+// does not account for.
+func (p *printer) rest(depth int, d *tl.Decoder) {
+	if rest, _ := d.GetRestOfMessage(); len(rest) > 0 {
+		p.printf(depth, "%d trailing bytes: % x", len(rest), rest)
+	}
+}
+
+// value prints a decoded value: objects with their constructor name, crc and
+// flags, followed by the fields they are encoded with.
+func (p *printer) value(depth int, label string, v reflect.Value) {
+	if !v.IsValid() {
+		p.printf(depth, "%snil", label)
+		return
+	}
+	if v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			p.printf(depth, "%snil", label)
+			return
+		}
+	}
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+
+	if o, ok := v.Interface().(tl.Object); ok {
+		if native := tl.UnwrapNativeTypes(o); native != any(o) {
+			p.value(depth, label, reflect.ValueOf(native))
+			return
+		}
+		name, _ := tl.Name(o.CRC())
+		if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+			p.printf(depth, "%s%s#%08x", label, name, o.CRC())
+			return
+		}
+
+		header := fmt.Sprintf("%s#%08x", name, o.CRC())
+		flags, flags2, has, has2 := tl.Flags(o)
+		if has {
+			header += fmt.Sprintf(" flags=%#x", flags)
+		}
+		if has2 {
+			header += fmt.Sprintf(" flags2=%#x", flags2)
+		}
+		p.printf(depth, "%s%s", label, header)
+		p.fields(depth+1, v.Elem(), flags, flags2)
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		p.value(depth, label, v.Elem())
+	case reflect.Struct:
+		if v.CanAddr() {
+			if s, ok := v.Addr().Interface().(fmt.Stringer); ok {
+				p.printf(depth, "%s%s", label, s)
+				return
+			}
+		}
+		p.printf(depth, "%s%s", label, v.Type())
+		p.fields(depth+1, v, 0, 0)
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			b := v.Bytes()
+			if len(b) > maxBytes {
+				p.printf(depth, "%s[%d bytes] % x ...", label, len(b), b[:maxBytes])
+				return
+			}
+			p.printf(depth, "%s[%d bytes] % x", label, len(b), b)
+			return
+		}
+		switch v.Type().Elem().Kind() {
+		case reflect.Bool, reflect.Int32, reflect.Int64, reflect.Float64, reflect.String:
+			p.printf(depth, "%s%v", label, v)
+			return
+		}
+		p.printf(depth, "%s[%d items]", label, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			p.value(depth+1, fmt.Sprintf("[%d] ", i), v.Index(i))
+		}
+	case reflect.String:
+		p.printf(depth, "%s%q", label, v.String())
+	default:
+		p.printf(depth, "%s%v", label, v)
+	}
+}
+
+// fields prints the fields of the struct v, leaving out optional fields whose
+// bit is not set in flags and flags2, and marking the others with their bit.
+func (p *printer) fields(depth int, v reflect.Value, flags, flags2 uint32) {
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		label := field.Name + ": "
+		if bit, version, ok := tl.FlagBit(typ, i); ok {
+			set, word := flags, "flags"
+			if version == 2 {
+				set, word = flags2, "flags2"
+			}
+			if set&(1<<bit) == 0 {
+				continue
+			}
+			label = fmt.Sprintf("%s (%s.%d): ", field.Name, word, bit)
+		}
+		p.value(depth, label, v.Field(i))
+	}
+}
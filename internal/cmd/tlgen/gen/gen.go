@@ -81,6 +81,13 @@ func (g *Generator) Generate(d bool) error {
 		return fmt.Errorf("generate init: %w", err)
 	}
 
+	log.Println("INFO: Generating codecs...")
+	err = g.generateFile(g.generateCodecs, filepath.Join(g.outdir, "codecs_gen.go"), d)
+	if err != nil {
+		log.Printf("ERROR: Failed to generate codecs: %v\n", err)
+		return fmt.Errorf("generate codecs: %w", err)
+	}
+
 	log.Println("INFO: Code generation completed successfully")
 	return nil
 }
@@ -0,0 +1,356 @@
+package gen
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dave/jennifer/jen"
+
+	"github.com/amarnathcjd/gogram/internal/cmd/tlgen/tlparser"
+)
+
+// codecKind is how a parameter is put on the wire.
+type codecKind int
+
+const (
+	codecInt codecKind = iota
+	codecLong
+	codecDouble
+	codecString
+	codecBytes
+	codecBool
+	codecBitflag // true, stored only as a bit of flags
+	codecEnum
+	codecInterface
+	codecStruct
+)
+
+// generateCodecs emits MarshalTL and UnmarshalTL methods for the constructors
+// of types_gen.go and interfaces_gen.go, so that they are encoded and decoded
+// without reflection. Constructors with parameters the codec can't express
+// are left to the reflection based encoder.
+func (g *Generator) generateCodecs(f *jen.File, _ bool) {
+	singles := append([]tlparser.Object(nil), g.schema.SingleInterfaceTypes...)
+	sort.Slice(singles, func(i, j int) bool {
+		return singles[i].Name < singles[j].Name
+	})
+	for _, _type := range singles {
+		g.generateCodec(f, goify(_type.Name, true), _type)
+	}
+
+	keys := make([]string, 0, len(g.schema.Types))
+	for key := range g.schema.Types {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		structs := append([]tlparser.Object(nil), g.schema.Types[key]...)
+		sort.Slice(structs, func(i, j int) bool {
+			return structs[i].Name < structs[j].Name
+		})
+		for _, _type := range structs {
+			structName := goify(_type.Name, true)
+			if structName == goify(key, true) {
+				structName = goify(_type.Name+"Obj", true)
+			}
+			g.generateCodec(f, structName, _type)
+		}
+	}
+}
+
+func (g *Generator) generateCodec(f *jen.File, structName string, definition tlparser.Object) {
+	for _, param := range definition.Parameters {
+		if param.Type == "bitflags" {
+			continue
+		}
+		if _, ok := g.codecKind(param.Type); !ok {
+			return
+		}
+	}
+
+	f.Add(g.generateMarshal(structName, definition))
+	f.Line()
+	f.Add(g.generateUnmarshal(structName, definition))
+	f.Line()
+}
+
+// codecKind classifies a schema type, reporting false for types without a codec.
+func (g *Generator) codecKind(t string) (codecKind, bool) {
+	switch t {
+	case "int":
+		return codecInt, true
+	case "long":
+		return codecLong, true
+	case "double":
+		return codecDouble, true
+	case "string":
+		return codecString, true
+	case "bytes":
+		return codecBytes, true
+	case "Bool":
+		return codecBool, true
+	case "true":
+		return codecBitflag, true
+	}
+	if _, ok := g.schema.Enums[t]; ok {
+		return codecEnum, true
+	}
+	if _, ok := g.schema.Types[t]; ok {
+		return codecInterface, true
+	}
+	if g.singleStructName(t) != "" {
+		return codecStruct, true
+	}
+	return 0, false
+}
+
+// singleStructName returns the struct of a type with a single constructor.
+func (g *Generator) singleStructName(t string) string {
+	for _, _struct := range g.schema.SingleInterfaceTypes {
+		if _struct.Interface == t {
+			return goify(_struct.Name, true)
+		}
+	}
+	return ""
+}
+
+// flagVar returns the local variable holding the bitset an optional parameter
+// refers to. Bitflags parameters are named like their variable instead.
+func flagVar(version int) string {
+	if version == 2 {
+		return "flags2"
+	}
+	return "flags"
+}
+
+func (g *Generator) generateMarshal(structName string, definition tlparser.Object) jen.Code {
+	var body []jen.Code
+	body = append(body, jen.If(jen.Id("o").Op("==").Nil()).Block(
+		jen.Return(jen.Qual(tlPackagePath, "ErrNilObject")),
+	))
+
+	// bits are set in schema order; all parameters sharing a bit are written once it is set
+	var bitsets []string
+	type bit struct {
+		version, index int
+	}
+	conds := make(map[bit][]jen.Code)
+	explicit := make(map[bit]bool)
+	var bits []bit
+	for _, param := range definition.Parameters {
+		if param.Type == "bitflags" {
+			bitsets = append(bitsets, param.Name)
+			continue
+		}
+		if !param.IsOptional {
+			continue
+		}
+		b := bit{param.Version, param.BitToTrigger}
+		if _, seen := conds[b]; !seen {
+			bits = append(bits, b)
+			conds[b] = nil
+		}
+		if explicitFields[param.Name] {
+			switch param.Type {
+			case "int", "long", "double", "Bool", "string":
+				explicit[b] = true
+			}
+		}
+		conds[b] = append(conds[b], g.nonZero(param))
+	}
+
+	if len(bitsets) > 0 {
+		vars := make([]jen.Code, len(bitsets))
+		for i, name := range bitsets {
+			vars[i] = jen.Id(name)
+		}
+		body = append(body, jen.Var().List(vars...).Uint32())
+	}
+	for _, b := range bits {
+		set := jen.Id(flagVar(b.version)).Op("|=").Lit(1).Op("<<").Lit(b.index)
+		if explicit[b] {
+			body = append(body, set)
+			continue
+		}
+		cond := jen.Empty()
+		for i, c := range conds[b] {
+			if i > 0 {
+				cond = cond.Op("||")
+			}
+			cond = cond.Add(c)
+		}
+		body = append(body, jen.If(cond).Block(set))
+	}
+
+	body = append(body, jen.Id("e").Dot("PutCRC").Call(jen.Id("o").Dot("CRC").Call()))
+	for _, param := range definition.Parameters {
+		if param.Type == "bitflags" {
+			body = append(body, jen.Id("e").Dot("PutUint").Call(jen.Id(param.Name)))
+			continue
+		}
+		if param.Type == "true" {
+			continue
+		}
+
+		put := g.encodeParam(param)
+		if param.IsOptional {
+			put = jen.If(g.bitIsSet(param)).Block(put)
+		}
+		body = append(body, put)
+	}
+	body = append(body, jen.Return(jen.Id("e").Dot("CheckErr").Call()))
+
+	return jen.Func().Params(jen.Id("o").Op("*").Id(structName)).Id("MarshalTL").Params(
+		jen.Id("e").Op("*").Qual(tlPackagePath, "Encoder"),
+	).Error().Block(body...)
+}
+
+func (g *Generator) generateUnmarshal(structName string, definition tlparser.Object) jen.Code {
+	used := make(map[string]bool) // bitsets optional parameters refer to
+	for _, param := range definition.Parameters {
+		if param.IsOptional {
+			used[flagVar(param.Version)] = true
+		}
+	}
+
+	var body []jen.Code
+	for _, param := range definition.Parameters {
+		if param.Type == "bitflags" {
+			pop := jen.Id("d").Dot("PopUint").Call()
+			if used[param.Name] {
+				pop = jen.Id(param.Name).Op(":=").Add(pop)
+			}
+			body = append(body, pop)
+			continue
+		}
+
+		field := jen.Id("o").Dot(goify(param.Name, true))
+		if param.Type == "true" {
+			body = append(body, field.Op("=").Add(g.bitIsSet(param)))
+			continue
+		}
+
+		pop := g.decodeParam(param)
+		if param.IsOptional {
+			pop = jen.If(g.bitIsSet(param)).Block(pop)
+		}
+		body = append(body, pop)
+	}
+	body = append(body, jen.Return(jen.Id("d").Dot("Err").Call()))
+
+	return jen.Func().Params(jen.Id("o").Op("*").Id(structName)).Id("UnmarshalTL").Params(
+		jen.Id("d").Op("*").Qual(tlPackagePath, "Decoder"),
+	).Error().Block(body...)
+}
+
+func (*Generator) bitIsSet(param tlparser.Parameter) jen.Code {
+	return jen.Id(flagVar(param.Version)).Op("&").Parens(jen.Lit(1).Op("<<").Lit(param.BitToTrigger)).Op("!=").Lit(0)
+}
+
+// nonZero returns the condition under which an optional parameter is sent.
+func (g *Generator) nonZero(param tlparser.Parameter) jen.Code {
+	field := jen.Id("o").Dot(goify(param.Name, true))
+	if param.IsVector {
+		return field.Op("!=").Nil()
+	}
+
+	kind, _ := g.codecKind(param.Type)
+	switch kind {
+	case codecBool, codecBitflag:
+		return field
+	case codecString:
+		return field.Op("!=").Lit("")
+	case codecBytes, codecInterface, codecStruct:
+		return field.Op("!=").Nil()
+	default:
+		return field.Op("!=").Lit(0)
+	}
+}
+
+func (g *Generator) encodeParam(param tlparser.Parameter) jen.Code {
+	field := jen.Id("o").Dot(goify(param.Name, true))
+	if !param.IsVector {
+		return g.encodeValue(param.Type, field)
+	}
+
+	return jen.Id("e").Dot("PutVectorHeader").Call(jen.Len(field.Clone())).Line().
+		For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Add(field)).Block(
+		g.encodeValue(param.Type, jen.Id("v")),
+	)
+}
+
+func (g *Generator) encodeValue(t string, value *jen.Statement) jen.Code {
+	kind, _ := g.codecKind(t)
+	e := jen.Id("e")
+	switch kind {
+	case codecInt:
+		return e.Dot("PutInt").Call(value)
+	case codecLong:
+		return e.Dot("PutLong").Call(value)
+	case codecDouble:
+		return e.Dot("PutDouble").Call(value)
+	case codecString:
+		return e.Dot("PutString").Call(value)
+	case codecBytes:
+		return e.Dot("PutMessage").Call(value)
+	case codecBool:
+		return e.Dot("PutBool").Call(value)
+	case codecEnum:
+		return e.Dot("PutUint").Call(jen.Uint32().Call(value))
+	case codecInterface, codecStruct:
+		return e.Dot("PutObject").Call(value)
+	}
+	panic(fmt.Sprintf("no encoding for %s", t))
+}
+
+func (g *Generator) decodeParam(param tlparser.Parameter) jen.Code {
+	field := jen.Id("o").Dot(goify(param.Name, true))
+	if !param.IsVector {
+		return g.decodeValue(param.Type, field)
+	}
+
+	return field.Clone().Op("=").Make(jen.Index().Add(g.codecTypeID(param.Type)), jen.Id("d").Dot("PopVectorHeader").Call()).Line().
+		For(jen.Id("i").Op(":=").Range().Add(field.Clone())).Block(
+		g.decodeValue(param.Type, field.Clone().Index(jen.Id("i"))),
+	)
+}
+
+func (g *Generator) decodeValue(t string, target *jen.Statement) jen.Code {
+	kind, _ := g.codecKind(t)
+	d := jen.Id("d")
+	switch kind {
+	case codecInt:
+		return target.Op("=").Add(d.Dot("PopInt").Call())
+	case codecLong:
+		return target.Op("=").Add(d.Dot("PopLong").Call())
+	case codecDouble:
+		return target.Op("=").Add(d.Dot("PopDouble").Call())
+	case codecString:
+		return target.Op("=").String().Call(d.Dot("PopMessage").Call())
+	case codecBytes:
+		return target.Op("=").Add(d.Dot("PopMessage").Call())
+	case codecBool:
+		return target.Op("=").Add(d.Dot("PopBool").Call())
+	case codecEnum:
+		return target.Op("=").Id(goify(t, true)).Call(d.Dot("PopUint").Call())
+	case codecInterface:
+		return target.Op("=").Qual(tlPackagePath, "PopObjectAs").Types(jen.Id(goify(t, true))).Call(d)
+	case codecStruct:
+		return target.Clone().Op("=").New(jen.Id(g.singleStructName(t))).Line().
+			Add(d.Dot("PopObjectTo").Call(target))
+	}
+	panic(fmt.Sprintf("no decoding for %s", t))
+}
+
+// codecTypeID returns the Go type of a value of schema type t.
+func (g *Generator) codecTypeID(t string) jen.Code {
+	kind, _ := g.codecKind(t)
+	switch kind {
+	case codecInterface, codecEnum:
+		return jen.Id(goify(t, true))
+	case codecStruct:
+		return jen.Op("*").Id(g.singleStructName(t))
+	}
+	return g.typeIdFromSchemaType(t)
+}
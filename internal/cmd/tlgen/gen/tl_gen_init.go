@@ -1,6 +1,7 @@
 package gen
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/dave/jennifer/jen"
@@ -15,6 +16,8 @@ func (g *Generator) generateInit(file *jen.File, _ bool) {
 		g.createInitStructs(structs...),
 		jen.Line(),
 		g.createInitEnums(enums...),
+		jen.Line(),
+		g.createInitNames(),
 	)
 
 	file.Add(initFunc)
@@ -45,3 +48,40 @@ func (*Generator) createInitEnums(itemNames ...string) jen.Code {
 		enums...,
 	)
 }
+
+// createInitNames registers the schema names of all constructors and methods,
+// which the JSON codec and the raw invoker look objects up by.
+func (g *Generator) createInitNames() jen.Code {
+	crcs := make(map[string]uint32)
+	for _, items := range g.schema.Types {
+		for _, _struct := range items {
+			crcs[_struct.Name] = _struct.CRC
+		}
+	}
+	for _, _struct := range g.schema.SingleInterfaceTypes {
+		crcs[_struct.Name] = _struct.CRC
+	}
+	for _, items := range g.schema.Enums {
+		for _, enum := range items {
+			crcs[enum.Name] = enum.CRC
+		}
+	}
+	for _, method := range g.schema.Methods {
+		crcs[method.Name] = method.CRC
+	}
+
+	names := make([]string, 0, len(crcs))
+	for name := range crcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	dict := jen.Dict{}
+	for _, name := range names {
+		dict[jen.Id(fmt.Sprintf("%#v", crcs[name]))] = jen.Lit(name)
+	}
+
+	return jen.Qual(tlPackagePath, "RegisterNames").Call(
+		jen.Map(jen.Uint32()).String().Values(dict),
+	)
+}
@@ -72,6 +72,9 @@ func (g *Generator) generateMethods(f *jen.File, d bool) {
 		if method.Comment != "" {
 			f.Comment(method.Comment)
 		}
+		f.Add(g.generateMethodDelegate(&method))
+		f.Line()
+		f.Comment(goify(method.Name, true) + "Ctx is the context-aware variant of " + goify(method.Name, true) + ".")
 		f.Add(g.generateMethodFunction(&method))
 		f.Line()
 	}
@@ -183,18 +186,44 @@ func cleanHTMLContent(content string) string {
 	return strings.TrimSpace(content)
 }
 
-func (g *Generator) generateMethodFunction(obj *tlparser.Method) jen.Code {
-	resp := g.typeIdFromSchemaType(obj.Response.Type)
+// methodResponse returns the result type of a method and its zero value.
+func (g *Generator) methodResponse(obj *tlparser.Method) (resp, zero *jen.Statement) {
+	resp = g.typeIdFromSchemaType(obj.Response.Type)
 	if obj.Response.IsList {
 		resp = jen.Index().Add(resp)
 	}
-
-	nuk := jen.Nil()
+	zero = jen.Nil()
 	if obj.Response.Type == "Bool" {
 		resp = jen.Op("").Qual("", "bool")
-		nuk = jen.Bool()
+		zero = jen.Bool()
 	}
+	return resp, zero
+}
 
+// generateMethodDelegate emits the method without a context, which calls the
+// Ctx variant with context.Background().
+func (g *Generator) generateMethodDelegate(obj *tlparser.Method) jen.Code {
+	resp, _ := g.methodResponse(obj)
+	name := goify(obj.Name, true)
+
+	callArgs := []jen.Code{jen.Qual("context", "Background").Call()}
+	if len(obj.Parameters) > maximumPositionalArguments {
+		callArgs = append(callArgs, jen.Id("params"))
+	} else {
+		for _, p := range obj.Parameters {
+			if p.Type != "bitflags" {
+				callArgs = append(callArgs, jen.Id(goify(p.Name, false)))
+			}
+		}
+	}
+
+	return jen.Func().Params(jen.Id("c").Op("*").Id("Client")).Id(name).Params(g.generateArgumentsForMethod(obj)...).Params(resp, jen.Error()).Block(
+		jen.Return(jen.Id("c").Dot(name + "Ctx").Call(callArgs...)),
+	)
+}
+
+func (g *Generator) generateMethodFunction(obj *tlparser.Method) jen.Code {
+	resp, nuk := g.methodResponse(obj)
 	responses := []jen.Code{resp, jen.Error()}
 
 	//	data, err := c.MakeRequest(params)
@@ -209,8 +238,14 @@ func (g *Generator) generateMethodFunction(obj *tlparser.Method) jen.Code {
 	//
 	//	return resp, nil
 
-	method := jen.Func().Params(jen.Id("c").Op("*").Id("Client")).Id(goify(obj.Name, true)).Params(g.generateArgumentsForMethod(obj)...).Params(responses...).Block(
-		jen.List(jen.Id("responseData"), jen.Id("err")).Op(":=").Id("c").Dot("MakeRequest").Call(g.generateMethodArgumentForMakingRequest(obj)),
+	// the Ctx variant takes a leading context.Context and sends through MakeRequestCtx,
+	// so cancellation and deadlines reach the pending response channel.
+	name := goify(obj.Name, true) + "Ctx"
+	args := append([]jen.Code{jen.Id("ctx").Qual("context", "Context")}, g.generateArgumentsForMethod(obj)...)
+	makeRequest := jen.Id("c").Dot("MakeRequestCtx").Call(jen.Id("ctx"), g.generateMethodArgumentForMakingRequest(obj))
+
+	method := jen.Func().Params(jen.Id("c").Op("*").Id("Client")).Id(name).Params(args...).Params(responses...).Block(
+		jen.List(jen.Id("responseData"), jen.Id("err")).Op(":=").Add(makeRequest),
 		jen.If(jen.Err().Op("!=").Nil()).Block(
 			jen.Return(nuk, jen.Qual("fmt", "Errorf").Call(jen.Lit("sending "+goify(obj.Name, true)+": %w"), jen.Err())),
 		),
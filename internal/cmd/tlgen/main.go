@@ -332,10 +332,11 @@ func minorFixes(outdir, layer string) {
 	}`)
 
 	replace(filepath.Join(execWorkDir, "methods_gen.go"), `errors []SecureValueError`, `errorsw []SecureValueError`)
-	replace(filepath.Join(execWorkDir, "methods_gen.go"), `responseData, err := c.MakeRequest(&UsersSetSecureValueErrorsParams{
+	replace(filepath.Join(execWorkDir, "methods_gen.go"), `c.UsersSetSecureValueErrorsCtx(context.Background(), id, errors)`, `c.UsersSetSecureValueErrorsCtx(context.Background(), id, errorsw)`)
+	replace(filepath.Join(execWorkDir, "methods_gen.go"), `responseData, err := c.MakeRequestCtx(ctx, &UsersSetSecureValueErrorsParams{
 		Errors: errors,
 		ID:     id,
-	})`, `responseData, err := c.MakeRequest(&UsersSetSecureValueErrorsParams{
+	})`, `responseData, err := c.MakeRequestCtx(ctx, &UsersSetSecureValueErrorsParams{
 		Errors: errorsw,
 		ID:     id,
 	})`)
@@ -0,0 +1,150 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package tl
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// The helpers below are used by the MarshalTL and UnmarshalTL methods tlgen
+// generates, which encode objects without reflection. Objects without those
+// methods go through the reflection based encoder and decoder instead.
+
+// ErrNilObject is returned when encoding a nil object where one is required.
+var ErrNilObject = errors.New("value can't be nil")
+
+// reflectPkg names a package whose MarshalTL and UnmarshalTL methods are
+// ignored, so that its types go through the reflection based encoder and
+// decoder. Only the benchmarks comparing both set it.
+var reflectPkg string
+
+func marshalerOf(v any) (Marshaler, bool) {
+	m, ok := v.(Marshaler)
+	if ok && reflectPkg != "" && pkgOf(v) == reflectPkg {
+		return nil, false
+	}
+	return m, ok
+}
+
+func unmarshalerOf(v any) (Unmarshaler, bool) {
+	m, ok := v.(Unmarshaler)
+	if ok && reflectPkg != "" && pkgOf(v) == reflectPkg {
+		return nil, false
+	}
+	return m, ok
+}
+
+func pkgOf(v any) string {
+	t := reflect.TypeOf(v)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.PkgPath()
+}
+
+// PutObject encodes a boxed object: its crc followed by its fields.
+func (e *Encoder) PutObject(o Object) {
+	if e.err != nil {
+		return
+	}
+	if o == nil {
+		e.err = ErrNilObject
+		return
+	}
+	if m, ok := marshalerOf(o); ok {
+		if err := m.MarshalTL(e); err != nil {
+			e.err = err
+		}
+		return
+	}
+	e.encodeValue(reflect.ValueOf(o))
+}
+
+// PutVectorHeader writes the crc and the length of a vector, which the caller
+// follows with its items.
+func (e *Encoder) PutVectorHeader(n int) {
+	e.PutCRC(CrcVector)
+	e.PutUint(uint32(n))
+}
+
+// Err returns the first error that occurred while decoding.
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+// PopObject decodes a boxed object of any registered type.
+func (d *Decoder) PopObject() Object {
+	if d.err != nil {
+		return nil
+	}
+	return d.decodeRegisteredObject()
+}
+
+// PopObjectTo decodes a boxed object into o, which must be of the type the crc
+// read names.
+func (d *Decoder) PopObjectTo(o Object) {
+	if d.err != nil {
+		return
+	}
+	crc := d.PopCRC()
+	if d.err != nil {
+		d.err = fmt.Errorf("read crc: %w", d.err)
+		return
+	}
+	if crc != o.CRC() {
+		d.err = fmt.Errorf("invalid crc code: %#v, want: %#v", crc, o.CRC())
+		return
+	}
+
+	if m, ok := unmarshalerOf(o); ok {
+		if err := m.UnmarshalTL(d); err != nil {
+			d.err = fmt.Errorf("decode object %T: %w", o, err)
+		}
+		return
+	}
+	d.decodeObject(o, true)
+}
+
+// PopVectorHeader reads the crc and the length of a vector, which the caller
+// then reads the items of. It fails on lengths the remaining input can't hold.
+func (d *Decoder) PopVectorHeader() int {
+	crc := d.PopCRC()
+	if d.err != nil {
+		return 0
+	}
+	if crc != CrcVector {
+		d.err = fmt.Errorf("not a vector: 0x%08x, want: 0x%08x", crc, CrcVector)
+		return 0
+	}
+
+	size := d.PopUint()
+	if d.err != nil {
+		d.err = fmt.Errorf("read vector size: %w", d.err)
+		return 0
+	}
+	// every item takes at least a word
+	if int64(size)*WordLen > int64(d.buf.Len()) {
+		d.err = fmt.Errorf("vector of %d items exceeds the %d bytes left", size, d.buf.Len())
+		return 0
+	}
+	return int(size)
+}
+
+// PopObjectAs decodes a boxed object of any registered type that implements T,
+// usually the interface of a TL type.
+func PopObjectAs[T any](d *Decoder) T {
+	var zero T
+	obj := d.PopObject()
+	if d.err != nil {
+		return zero
+	}
+
+	v, ok := any(obj).(T)
+	if !ok {
+		d.err = fmt.Errorf("got %T, want %v", obj, reflect.TypeFor[T]())
+		return zero
+	}
+	return v
+}
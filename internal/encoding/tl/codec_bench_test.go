@@ -0,0 +1,86 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package tl_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+	"github.com/amarnathcjd/gogram/telegram"
+)
+
+const telegramPkg = "github.com/amarnathcjd/gogram/telegram"
+
+// messagesPayload returns a messages.messages with 100 messages from 10 users,
+// like an answer to messages.getHistory.
+func messagesPayload() *telegram.MessagesMessagesObj {
+	payload := &telegram.MessagesMessagesObj{
+		Topics: []telegram.ForumTopic{},
+		Chats:  []telegram.Chat{},
+	}
+	for i := range 10 {
+		payload.Users = append(payload.Users, &telegram.UserObj{
+			ID:         int64(1000 + i),
+			AccessHash: int64(i) * 7919,
+			FirstName:  fmt.Sprintf("User %d", i),
+			Username:   fmt.Sprintf("user%d", i),
+			Premium:    i%2 == 0,
+		})
+	}
+	for i := range 100 {
+		payload.Messages = append(payload.Messages, &telegram.MessageObj{
+			ID:      int32(i + 1),
+			FromID:  &telegram.PeerUser{UserID: int64(1000 + i%10)},
+			PeerID:  &telegram.PeerChannel{ChannelID: 77},
+			Date:    1700000000 + int32(i),
+			Message: fmt.Sprintf("message number %d, with some bold text in it", i),
+			Entities: []telegram.MessageEntity{
+				&telegram.MessageEntityBold{Offset: 20, Length: 9},
+			},
+			Views:    int32(i * 3),
+			Forwards: int32(i),
+			Replies:  &telegram.MessageReplies{Comments: true, Replies: int32(i % 4), ChannelID: 78},
+			Post:     true,
+		})
+	}
+	return payload
+}
+
+func BenchmarkMarshal(b *testing.B) {
+	payload := messagesPayload()
+	run := func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := tl.Marshal(payload); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+	b.Run("reflection", func(b *testing.B) {
+		defer tl.UseReflection(telegramPkg)()
+		run(b)
+	})
+	b.Run("generated", run)
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	data, err := tl.Marshal(messagesPayload())
+	if err != nil {
+		b.Fatal(err)
+	}
+	run := func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(data)))
+		for b.Loop() {
+			if _, err := tl.DecodeUnknownObject(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+	b.Run("reflection", func(b *testing.B) {
+		defer tl.UseReflection(telegramPkg)()
+		run(b)
+	})
+	b.Run("generated", run)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package tl_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+	"github.com/amarnathcjd/gogram/telegram"
+)
+
+// marshalBoth encodes v with the generated codec and with reflection.
+func marshalBoth(t testing.TB, v tl.Object) (generated, reflected []byte) {
+	t.Helper()
+	generated, err := tl.Marshal(v)
+	if err != nil {
+		t.Fatalf("generated: %v", err)
+	}
+	restore := tl.UseReflection(telegramPkg)
+	defer restore()
+	reflected, err = tl.Marshal(v)
+	if err != nil {
+		t.Fatalf("reflection: %v", err)
+	}
+	return generated, reflected
+}
+
+// words reads data as little-endian 32-bit words.
+func words(data []byte) []uint32 {
+	w := make([]uint32, len(data)/tl.WordLen)
+	for i := range w {
+		w[i] = binary.LittleEndian.Uint32(data[i*tl.WordLen:])
+	}
+	return w
+}
+
+// Parameters sharing a flag bit are all written once any of them sets it, as
+// the decoder reads every one of them.
+func TestSharedFlagBit(t *testing.T) {
+	replies := &telegram.MessageReplies{Comments: true, Replies: 3, RepliesPts: 7}
+	generated, reflected := marshalBoth(t, replies)
+	if !bytes.Equal(generated, reflected) {
+		t.Fatalf("encoders differ:\ngenerated  %x\nreflection %x", generated, reflected)
+	}
+	// crc, flags, replies, replies_pts, channel_id
+	want := []uint32{replies.CRC(), 1 << 0, 3, 7, 0, 0}
+	if got := words(generated); !reflect.DeepEqual(got, want) {
+		t.Fatalf("messageReplies{comments} = %#x, want %#x", got, want)
+	}
+
+	obj, err := tl.DecodeUnknownObject(generated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := obj.(*telegram.MessageReplies); !ok || !reflect.DeepEqual(got, replies) {
+		t.Fatalf("decoded %#v, want %#v", obj, replies)
+	}
+
+	msg := &telegram.MessageObj{ID: 1, PeerID: &telegram.PeerUser{UserID: 2}, Views: 5}
+	generated, reflected = marshalBoth(t, msg)
+	if !bytes.Equal(generated, reflected) {
+		t.Fatalf("encoders differ:\ngenerated  %x\nreflection %x", generated, reflected)
+	}
+	obj, err = tl.DecodeUnknownObject(generated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := obj.(*telegram.MessageObj); !ok || got.Views != 5 || got.Forwards != 0 {
+		t.Fatalf("decoded %#v, want views 5 and forwards 0", obj)
+	}
+}
+
+// Bitsets are written even for constructors without optional parameters.
+func TestBitsetWithoutOptionalParams(t *testing.T) {
+	bot := &telegram.ConnectedBot{
+		BotID:      42,
+		Recipients: &telegram.BusinessBotRecipients{},
+		Rights:     &telegram.BusinessBotRights{},
+	}
+	data, err := tl.Marshal(bot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := words(data)[:4]; !reflect.DeepEqual(got, []uint32{bot.CRC(), 0, 42, 0}) {
+		t.Fatalf("connectedBot starts with %#x, want crc, flags and bot_id", got)
+	}
+	obj, err := tl.DecodeUnknownObject(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := obj.(*telegram.ConnectedBot); !ok || got.BotID != 42 {
+		t.Fatalf("decoded %#v", obj)
+	}
+}
+
+func TestGeneratedMatchesReflection(t *testing.T) {
+	payload := messagesPayload()
+	generated, reflected := marshalBoth(t, payload)
+	if !bytes.Equal(generated, reflected) {
+		t.Fatal("generated and reflection encoders differ")
+	}
+
+	decoded, err := tl.DecodeUnknownObject(generated)
+	if err != nil {
+		t.Fatalf("generated: %v", err)
+	}
+	restore := tl.UseReflection(telegramPkg)
+	reflectDecoded, err := tl.DecodeUnknownObject(generated)
+	restore()
+	if err != nil {
+		t.Fatalf("reflection: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, reflectDecoded) {
+		t.Fatal("generated and reflection decoders differ")
+	}
+}
+
+// Optional parameters of flags2 set bits of their own bitset, written right
+// after flags, in both encoders.
+func TestFlags2(t *testing.T) {
+	msg := &telegram.MessageObj{
+		ID:               1,
+		Silent:           true,
+		Offline:          true,
+		PeerID:           &telegram.PeerUser{UserID: 2},
+		ViaBusinessBotID: 3,
+		Effect:           4,
+	}
+	generated, reflected := marshalBoth(t, msg)
+	if !bytes.Equal(generated, reflected) {
+		t.Fatalf("encoders differ:\ngenerated  %x\nreflection %x", generated, reflected)
+	}
+	// crc, flags, flags2, id
+	want := []uint32{msg.CRC(), 1 << 13, 1<<0 | 1<<1 | 1<<2, 1}
+	if got := words(generated)[:4]; !reflect.DeepEqual(got, want) {
+		t.Fatalf("message starts with %#x, want %#x", got, want)
+	}
+
+	restore := tl.UseReflection(telegramPkg)
+	obj, err := tl.DecodeUnknownObject(reflected)
+	restore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := obj.(*telegram.MessageObj); !ok || !got.Offline || got.ViaBusinessBotID != 3 || got.Effect != 4 {
+		t.Fatalf("decoded %#v", obj)
+	}
+}
@@ -18,6 +18,8 @@ type Decoder struct {
 
 	// see Decoder.ExpectTypesInInterface description
 	expectedTypes []reflect.Type
+
+	scratch [LongLen]byte // backs fixed size values, saving an allocation per read
 }
 
 // NewDecoder returns a new decoder that reads from r.
@@ -79,7 +81,7 @@ func (d *Decoder) unread(count int) {
 }
 
 func (d *Decoder) PopLong() int64 {
-	val := make([]byte, LongLen)
+	val := d.scratch[:LongLen]
 	d.read(val)
 	if d.err != nil {
 		return 0
@@ -89,7 +91,7 @@ func (d *Decoder) PopLong() int64 {
 }
 
 func (d *Decoder) PopDouble() float64 {
-	val := make([]byte, DoubleLen)
+	val := d.scratch[:DoubleLen]
 	d.read(val)
 	if d.err != nil {
 		return 0
@@ -99,7 +101,7 @@ func (d *Decoder) PopDouble() float64 {
 }
 
 func (d *Decoder) PopUint() uint32 {
-	val := make([]byte, WordLen)
+	val := d.scratch[:WordLen]
 	d.read(val)
 	if d.err != nil {
 		return 0
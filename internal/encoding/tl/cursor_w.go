@@ -14,6 +14,8 @@ type Encoder struct {
 	// this error is last unsuccessful write into w. if this err != nil,
 	// write() method will not write anything more.
 	err error
+
+	scratch [LongLen]byte // backs fixed size values, saving an allocation per write
 }
 
 func NewEncoder(w io.Writer) *Encoder {
@@ -54,12 +56,12 @@ func (e *Encoder) PutBool(v bool) {
 }
 
 func (e *Encoder) putUint8(v uint8) {
-	tmp := [1]byte{v}
-	e.write(tmp[:])
+	e.scratch[0] = v
+	e.write(e.scratch[:1])
 }
 
 func (e *Encoder) PutUint(v uint32) {
-	buf := make([]byte, WordLen)
+	buf := e.scratch[:WordLen]
 	binary.LittleEndian.PutUint32(buf, v)
 	e.write(buf)
 }
@@ -74,13 +76,13 @@ func (e *Encoder) PutInt(v int32) {
 }
 
 func (e *Encoder) PutLong(v int64) {
-	buf := make([]byte, LongLen)
+	buf := e.scratch[:LongLen]
 	binary.LittleEndian.PutUint64(buf, uint64(v))
 	e.write(buf)
 }
 
 func (e *Encoder) PutDouble(v float64) {
-	buf := make([]byte, DoubleLen)
+	buf := e.scratch[:DoubleLen]
 	binary.LittleEndian.PutUint64(buf, math.Float64bits(v))
 	e.write(buf)
 }
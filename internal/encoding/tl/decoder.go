@@ -159,7 +159,12 @@ func (d *Decoder) decodeValue(value reflect.Value) {
 		return
 	}
 
-	if m, ok := value.Interface().(Unmarshaler); ok {
+	if m, ok := unmarshalerOf(value.Interface()); ok {
+		// boxed objects carry their crc, bare values like Int128 don't
+		if o, ok := m.(Object); ok {
+			d.PopObjectTo(o)
+			return
+		}
 		err := m.UnmarshalTL(d)
 		if err != nil {
 			d.err = err
@@ -361,10 +366,10 @@ func (d *Decoder) decodeRegisteredObject() Object {
 
 	o := reflect.New(_typ.Elem()).Interface().(Object)
 
-	if m, ok := o.(Unmarshaler); ok {
+	if m, ok := unmarshalerOf(o); ok {
 		err := m.UnmarshalTL(d)
 		if err != nil {
-			d.err = err
+			d.err = fmt.Errorf("decode registered object %T: %w", o, err)
 			return nil
 		}
 		return o
@@ -30,7 +30,7 @@ func Marshal(v any) ([]byte, error) {
 }
 
 func (c *Encoder) encodeValue(value reflect.Value) {
-	if m, ok := value.Interface().(Marshaler); ok {
+	if m, ok := marshalerOf(value.Interface()); ok {
 		if c.err != nil {
 			return
 		}
@@ -101,7 +101,7 @@ func (c *Encoder) encodeStruct(v reflect.Value) {
 	}
 
 	var hasFlagsField bool
-	var flag uint32
+	var flag, flag2 uint32
 	var flagIndex int
 	g, ok := v.Interface().(FlagIndexGetter)
 	if ok {
@@ -122,10 +122,42 @@ func (c *Encoder) encodeStruct(v reflect.Value) {
 	vtyp := v.Type()
 	cachedTags := GetCachedTags(vtyp)
 
+	// positions of the bitsets in tmpObjects; flags2 follows flags or precedes
+	// the first flag2 field, whichever comes later, as the decoder reads it
+	flagPos, flag2Pos := -1, -1
+	var needFlag2 bool
+
+	// a bit is set by any field using it, and then every field using it is
+	// written, as the decoder reads them all
+	for i := 0; i < v.NumField(); i++ {
+		info := cachedTags[i]
+		if info == nil || info.ignore {
+			continue
+		}
+		if info.encodedInBitflag && vtyp.Field(i).Type.Kind() != reflect.Bool {
+			c.err = fmt.Errorf("field '%s': only bool values can be encoded in bitflag", vtyp.Field(i).Name)
+			return
+		}
+		// explicit tag forces encoding even when zero value
+		if v.Field(i).IsZero() && !info.explicit {
+			continue
+		}
+		if info.version == 2 {
+			flag2 |= 1 << info.index
+		} else {
+			flag |= 1 << info.index
+		}
+	}
+
 	for i := 0; i < v.NumField(); i++ {
 		// THIS PART is appending to object meta value, that actually don't writing in real encodeValue
 		if hasFlagsField && flagIndex == i {
+			flagPos = len(tmpObjects)
 			tmpObjects = append(tmpObjects, reflect.ValueOf(0))
+			if needFlag2 {
+				flag2Pos = len(tmpObjects)
+				tmpObjects = append(tmpObjects, reflect.ValueOf(0))
+			}
 		}
 
 		info := cachedTags[i]
@@ -140,20 +172,21 @@ func (c *Encoder) encodeStruct(v reflect.Value) {
 			continue
 		}
 
-		if info.encodedInBitflag && vtyp.Field(i).Type.Kind() != reflect.Bool {
-			c.err = fmt.Errorf("field '%s': only bool values can be encoded in bitflag", vtyp.Field(i).Name)
-			return
-		}
-
-		fieldVal := v.Field(i)
-		// explicit tag forces encoding even when zero value
-		if !fieldVal.IsZero() || info.explicit {
-			// Tag is there, this is 100% optional field
-			flag |= 1 << info.index
-			if info.encodedInBitflag {
-				continue
+		if info.version == 2 && flag2Pos < 0 {
+			if flagPos >= 0 {
+				flag2Pos = len(tmpObjects)
+				tmpObjects = append(tmpObjects, reflect.ValueOf(0))
+			} else {
+				needFlag2 = true
 			}
+		}
 
+		// Tag is there, this is 100% optional field
+		bits := flag
+		if info.version == 2 {
+			bits = flag2
+		}
+		if bits&(1<<info.index) != 0 && !info.encodedInBitflag {
 			tmpObjects = append(tmpObjects, v.Field(i))
 		}
 	}
@@ -161,9 +194,13 @@ func (c *Encoder) encodeStruct(v reflect.Value) {
 	for i, elem := range tmpObjects {
 		// if you asking, wtf is here: continuing, cause we injected int value (native int, not int32), so we
 		// CAN skip this iter
-		if hasFlagsField && flagIndex == i {
+		switch i {
+		case flagPos:
 			c.PutUint(flag)
 			continue
+		case flag2Pos:
+			c.PutUint(flag2)
+			continue
 		}
 
 		c.encodeValue(elem)
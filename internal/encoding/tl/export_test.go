@@ -0,0 +1,10 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package tl
+
+// UseReflection makes the types of the package at pkgPath go through the
+// reflection based encoder and decoder until restore is called.
+func UseReflection(pkgPath string) (restore func()) {
+	reflectPkg = pkgPath
+	return func() { reflectPkg = "" }
+}
@@ -0,0 +1,426 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package tl
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// JSON form of TL objects: every object is a JSON object whose "_" member is
+// its schema name, followed by "flags" and "flags2" when it has optional
+// fields, and by its fields under their Go names. Optional fields that are
+// unset are left out, enums are written as {"_": name}, bytes as base64.
+//
+//	{"_":"peerUser","UserID":777000}
+//
+// UnmarshalJSON reads that form back into the concrete Go types, using the
+// registry to resolve "_" for fields of interface types. Fields may also be
+// named like schema parameters ("user_id"), as names are matched ignoring
+// case and underscores. Flags are derived from the fields and ignored.
+
+// MarshalJSON encodes v, usually a TL object or a slice of them, as JSON that
+// UnmarshalJSON decodes back into the same Go values.
+func MarshalJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := encodeJSON(&buf, reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes JSON written by MarshalJSON into v, which must be a
+// non-nil pointer, usually to a TL interface, an object or a slice of them.
+func UnmarshalJSON(data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("decoding json into %T: need a non-nil pointer", v)
+	}
+	return decodeJSON(data, rv.Elem())
+}
+
+var objectType = reflect.TypeFor[Object]()
+
+func encodeJSON(buf *bytes.Buffer, v reflect.Value) error {
+	if !v.IsValid() {
+		buf.WriteString("null")
+		return nil
+	}
+	if v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Type().Implements(objectType) {
+		switch v.Kind() {
+		case reflect.Pointer:
+			if v.IsNil() {
+				buf.WriteString("null")
+				return nil
+			}
+			o := v.Interface().(Object)
+			if native := UnwrapNativeTypes(o); native != o {
+				return encodeJSON(buf, reflect.ValueOf(native))
+			}
+			if v.Elem().Kind() == reflect.Struct {
+				return encodeObjectJSON(buf, o.CRC(), v.Elem())
+			}
+		case reflect.Uint32:
+			name, ok := Name(uint32(v.Uint()))
+			if !ok {
+				return fmt.Errorf("unknown %s: 0x%08x", v.Type(), v.Uint())
+			}
+			writeNameJSON(buf, name)
+			buf.WriteByte('}')
+			return nil
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		return encodeJSON(buf, v.Elem())
+	case reflect.Struct:
+		buf.WriteByte('{')
+		return encodeFieldsJSON(buf, v, false)
+	case reflect.Slice:
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		fallthrough
+	case reflect.Array:
+		buf.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := encodeJSON(buf, v.Index(i)); err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			break
+		}
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(a.String(), b.String())
+		})
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			b, _ := json.Marshal(key.String())
+			buf.Write(b)
+			buf.WriteByte(':')
+			if err := encodeJSON(buf, v.MapIndex(key)); err != nil {
+				return fmt.Errorf("key %s: %w", key, err)
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+	}
+
+	b, err := json.Marshal(v.Interface())
+	if err != nil {
+		return err
+	}
+	buf.Write(b)
+	return nil
+}
+
+func writeNameJSON(buf *bytes.Buffer, name string) {
+	buf.WriteString(`{"_":`)
+	b, _ := json.Marshal(name)
+	buf.Write(b)
+}
+
+// encodeObjectJSON writes the struct v of the object registered with crc.
+func encodeObjectJSON(buf *bytes.Buffer, crc uint32, v reflect.Value) error {
+	name, ok := Name(crc)
+	if !ok {
+		name = v.Type().String()
+	}
+	writeNameJSON(buf, name)
+
+	flags, flags2, has, has2 := objectFlags(v)
+	if has {
+		buf.WriteString(`,"flags":`)
+		buf.WriteString(strconv.FormatUint(uint64(flags), 10))
+	}
+	if has2 {
+		buf.WriteString(`,"flags2":`)
+		buf.WriteString(strconv.FormatUint(uint64(flags2), 10))
+	}
+	return encodeFieldsJSON(buf, v, true)
+}
+
+// encodeFieldsJSON writes the fields of v after the opening brace, or after
+// the members written so far if more is set, and closes the JSON object.
+func encodeFieldsJSON(buf *bytes.Buffer, v reflect.Value, more bool) error {
+	typ := v.Type()
+	tags := GetCachedTags(typ)
+	for i := 0; i < v.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		info := tags[i]
+		if info != nil && (info.ignore || info.optional && v.Field(i).IsZero() && !info.explicit) {
+			continue
+		}
+
+		if more {
+			buf.WriteByte(',')
+		}
+		more = true
+		b, _ := json.Marshal(field.Name)
+		buf.Write(b)
+		buf.WriteByte(':')
+		if err := encodeJSON(buf, v.Field(i)); err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+// Flags returns the flags fields o is encoded with, derived from which of its
+// optional fields are set. has and has2 report whether o has flags and flags2 at all.
+func Flags(o Object) (flags, flags2 uint32, has, has2 bool) {
+	v := reflect.ValueOf(o)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return 0, 0, false, false
+	}
+	return objectFlags(v.Elem())
+}
+
+// objectFlags computes the bitsets the encoder would write for v.
+func objectFlags(v reflect.Value) (flags, flags2 uint32, has, has2 bool) {
+	for i, info := range GetCachedTags(v.Type()) {
+		if info == nil || info.ignore || info.version == 0 {
+			continue
+		}
+		set := !v.Field(i).IsZero() || info.explicit
+		if info.version == 2 {
+			has2 = true
+			if set {
+				flags2 |= 1 << info.index
+			}
+			continue
+		}
+		has = true
+		if set {
+			flags |= 1 << info.index
+		}
+	}
+	return flags, flags2, has, has2
+}
+
+var jsonNull = []byte("null")
+
+func decodeJSON(data []byte, v reflect.Value) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, jsonNull) {
+		v.SetZero()
+		return nil
+	}
+
+	typ := v.Type()
+	switch {
+	case typ.Kind() == reflect.Interface:
+		if typ.NumMethod() == 0 {
+			if name, _ := nameJSON(data); name == "" {
+				// plain JSON values stored in an any
+				return json.Unmarshal(data, v.Addr().Interface())
+			}
+		}
+		o, err := newObjectJSON(data)
+		if err != nil {
+			return err
+		}
+		ov := reflect.ValueOf(o)
+		if !ov.Type().AssignableTo(typ) {
+			return fmt.Errorf("%s is not a %s", ov.Type(), typ)
+		}
+		if ov.Kind() == reflect.Pointer {
+			if err := decodeJSON(data, ov.Elem()); err != nil {
+				return err
+			}
+		}
+		v.Set(ov)
+		return nil
+
+	case typ.Kind() == reflect.Uint32 && typ.Implements(objectType):
+		var name string
+		if data[0] == '"' {
+			if err := json.Unmarshal(data, &name); err != nil {
+				return err
+			}
+		} else {
+			var err error
+			if name, err = nameJSON(data); err != nil {
+				return err
+			}
+		}
+		crc, ok := crcByName[name]
+		if !ok || objectByCrc[crc] != typ {
+			return fmt.Errorf("%q is not a %s", name, typ)
+		}
+		v.SetUint(uint64(crc))
+		return nil
+
+	case typ.Kind() == reflect.Pointer:
+		if typ.Elem().Kind() == reflect.Struct && typ.Implements(objectType) {
+			if name, err := nameJSON(data); err == nil && name != "" {
+				if crc, ok := crcByName[name]; !ok || objectByCrc[crc] != typ {
+					return fmt.Errorf("%q is not a %s", name, typ)
+				}
+			}
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(typ.Elem()))
+		}
+		return decodeJSON(data, v.Elem())
+	}
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		return decodeFieldsJSON(data, v)
+
+	case reflect.Slice:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		s := reflect.MakeSlice(typ, len(items), len(items))
+		for i, item := range items {
+			if err := decodeJSON(item, s.Index(i)); err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+		v.Set(s)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(unquoteJSON(data), 10, typ.Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(unquoteJSON(data), 10, typ.Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+		return nil
+	}
+
+	return json.Unmarshal(data, v.Addr().Interface())
+}
+
+// unquoteJSON returns a JSON number, also accepting numbers written as strings
+// so that 64 bit ids survive JavaScript.
+func unquoteJSON(data []byte) string {
+	return strings.Trim(string(data), `"`)
+}
+
+// nameJSON returns the "_" member of a JSON object.
+func nameJSON(data []byte) (string, error) {
+	var head struct {
+		Name string `json:"_"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return "", err
+	}
+	return head.Name, nil
+}
+
+// newObjectJSON returns an empty object of the type the "_" member of the JSON
+// object data names.
+func newObjectJSON(data []byte) (Object, error) {
+	name, err := nameJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, errors.New(`missing constructor name "_"`)
+	}
+	o, ok := NewObject(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown constructor %q", name)
+	}
+	return o, nil
+}
+
+// jsonFields indexes the fields of struct types by normalized name.
+var jsonFields sync.Map // map[reflect.Type]map[string]int
+
+func normalizeJSONName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+func decodeFieldsJSON(data []byte, v reflect.Value) error {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return err
+	}
+
+	typ := v.Type()
+	cached, ok := jsonFields.Load(typ)
+	if !ok {
+		index := make(map[string]int, typ.NumField())
+		for i := 0; i < typ.NumField(); i++ {
+			if typ.Field(i).IsExported() {
+				index[normalizeJSONName(typ.Field(i).Name)] = i
+			}
+		}
+		cached, _ = jsonFields.LoadOrStore(typ, index)
+	}
+	index := cached.(map[string]int)
+
+	for key, raw := range members {
+		switch key {
+		case "_", "flags", "flags2":
+			continue
+		}
+		i, ok := index[normalizeJSONName(key)]
+		if !ok {
+			return fmt.Errorf("unknown field %q of %s", key, typ)
+		}
+		if err := decodeJSON(raw, v.Field(i)); err != nil {
+			return fmt.Errorf("field %s: %w", typ.Field(i).Name, err)
+		}
+	}
+	return nil
+}
@@ -11,13 +11,20 @@ var (
 	// used by decoder, guaranteed that types are convertible to tl.Object
 	objectByCrc = make(map[uint32]reflect.Type) // this value setting by registerObject(), DO NOT CALL IT BY HANDS
 	enumCrcs    = make(map[uint32]struct{})
+
+	objectNames = make(map[uint32]string) // schema names, set by RegisterNames()
+	crcByName   = make(map[string]uint32) // schema names and Go type names of the registered objects
 )
 
 func registerObject(o Object) {
 	if o == nil {
 		panic("object is nil")
 	}
-	objectByCrc[o.CRC()] = reflect.TypeOf(o)
+	typ := reflect.TypeOf(o)
+	objectByCrc[o.CRC()] = typ
+	if typ.Kind() == reflect.Pointer {
+		crcByName[typ.Elem().String()] = o.CRC()
+	}
 }
 
 func registerEnum(o Object) {
@@ -44,3 +51,52 @@ func RegisterEnums(enums ...Object) {
 		registerEnum(e)
 	}
 }
+
+// RegisterNames registers the schema names of objects by their crc, like
+// "messages.sendMessage" or "peerUser".
+func RegisterNames(names map[uint32]string) {
+	for crc, name := range names {
+		objectNames[crc] = name
+		crcByName[name] = crc
+	}
+}
+
+// Name returns the schema name of the object registered with crc, or its Go
+// type name qualified by package, like "objects.Ping", if it has no schema name.
+func Name(crc uint32) (string, bool) {
+	if name, ok := objectNames[crc]; ok {
+		return name, true
+	}
+	typ, ok := objectByCrc[crc]
+	if !ok {
+		return "", false
+	}
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	return typ.String(), true
+}
+
+// NewObject returns an empty object of the type registered under name, which
+// is either a schema name or a qualified Go type name. Enums are returned set to name.
+func NewObject(name string) (Object, bool) {
+	crc, ok := crcByName[name]
+	if !ok {
+		return nil, false
+	}
+	return NewObjectByCrc(crc)
+}
+
+// NewObjectByCrc returns an empty object of the type registered with crc.
+func NewObjectByCrc(crc uint32) (Object, bool) {
+	typ, ok := objectByCrc[crc]
+	if !ok {
+		return nil, false
+	}
+	if typ.Kind() == reflect.Pointer {
+		return reflect.New(typ.Elem()).Interface().(Object), true
+	}
+	v := reflect.New(typ).Elem()
+	v.SetUint(uint64(crc))
+	return v.Interface().(Object), true
+}
@@ -48,6 +48,17 @@ func GetCachedTags(t reflect.Type) []*fieldTag {
 	return fields
 }
 
+// FlagBit returns the bit that field i of the struct type t is flagged by, and
+// which flags field holds it, 1 for flags and 2 for flags2. ok is false for
+// fields that are always encoded.
+func FlagBit(t reflect.Type, i int) (bit, flags int, ok bool) {
+	info := GetCachedTags(t)[i]
+	if info == nil || info.ignore || info.version == 0 {
+		return 0, 0, false
+	}
+	return info.index, info.version, true
+}
+
 func parseTag(s reflect.StructTag) (*fieldTag, error) {
 	tags, err := parseFunc(string(s))
 	if err != nil {
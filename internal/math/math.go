@@ -22,10 +22,8 @@ func DoRSAencrypt(block []byte, key *rsa.PublicKey) []byte {
 
 	c := big.NewInt(0).Exp(z, exponent, key.N)
 
-	res := make([]byte, 256)
-	copy(res, c.Bytes())
-
-	return res
+	// right-aligned: a ciphertext with leading zero bytes is still 256 bytes long
+	return c.FillBytes(make([]byte, 256))
 }
 
 func MakeGAB(g int32, g_a, dh_prime *big.Int) (b, g_b, g_ab *big.Int) {
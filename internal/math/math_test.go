@@ -0,0 +1,34 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package math
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"math/big"
+	"testing"
+)
+
+// Ciphertexts with a leading zero byte, about one in 256, still decrypt.
+func TestDoRSAencryptLeadingZero(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	block := make([]byte, 255)
+	for range 10000 {
+		rand.Read(block)
+		c := new(big.Int).Exp(new(big.Int).SetBytes(block), big.NewInt(int64(key.E)), key.N)
+		if c.BitLen() > 255*8 {
+			continue
+		}
+		encrypted := DoRSAencrypt(block, &key.PublicKey)
+		decrypted := new(big.Int).Exp(new(big.Int).SetBytes(encrypted), key.D, key.N).FillBytes(make([]byte, 255))
+		if !bytes.Equal(decrypted, block) {
+			t.Fatal("ciphertext with a leading zero byte does not decrypt to the block")
+		}
+		return
+	}
+	t.Skip("no ciphertext with a leading zero byte found")
+}
@@ -13,6 +13,7 @@ func init() {
 		&SetClientDHParamsParams{},
 		&PingParams{},
 		&PingDelayDisconnectParams{},
+		&GetFutureSaltsParams{},
 		&ResPQ{},
 		&PQInnerData{},
 		&PQInnerDataTempDc{},
@@ -39,10 +40,52 @@ func init() {
 		&BadMsgNotification{},
 		&BadServerSalt{},
 		&MsgResendReq{},
+		&MsgResendAnsReq{},
 		&MsgsStateReq{},
 		&MsgsStateInfo{},
 		&MsgsAllInfo{},
 		&MsgsDetailedInfo{},
 		&MsgsNewDetailedInfo{},
 	)
+
+	tl.RegisterNames(map[uint32]string{
+		0x60469778:    "req_pq",
+		0xd712e4be:    "req_DH_params",
+		0xf5045f1f:    "set_client_DH_params",
+		0x7abe77ec:    "ping",
+		0xf3427b8c:    "ping_delay_disconnect",
+		0xb921bd04:    "get_future_salts",
+		0x05162463:    "resPQ",
+		0x83c95aec:    "p_q_inner_data",
+		0x56fddf88:    "p_q_inner_data_temp_dc",
+		0x79cb045d:    "server_DH_params_fail",
+		0xd0e8075c:    "server_DH_params_ok",
+		0xb5890dba:    "server_DH_inner_data",
+		0x6643b654:    "client_DH_inner_data",
+		0x3bcbf734:    "dh_gen_ok",
+		0x46dc1fb9:    "dh_gen_retry",
+		0xa69dae02:    "dh_gen_fail",
+		CrcRpcResult:  "rpc_result",
+		0x2144ca19:    "rpc_error",
+		0x5e2ad36e:    "rpc_answer_unknown",
+		0xcd78e586:    "rpc_answer_dropped_running",
+		0xa43ad8b7:    "rpc_answer_dropped",
+		0x0949d9dc:    "future_salt",
+		0xae500895:    "future_salts",
+		0x347773c5:    "pong",
+		0x9ec20908:    "new_session_created",
+		0x73f1f8dc:    "msg_container",
+		0xe06046b2:    "msg_copy",
+		CrcGzipPacked: "gzip_packed",
+		0x62d6b459:    "msgs_ack",
+		0xa7eff811:    "bad_msg_notification",
+		0xedab447b:    "bad_server_salt",
+		0x7d861a08:    "msg_resend_req",
+		0x8610baeb:    "msg_resend_ans_req",
+		0xda69fb52:    "msgs_state_req",
+		0x04deb57d:    "msgs_state_info",
+		0x8cc0d131:    "msgs_all_info",
+		0x276d3ec6:    "msg_detailed_info",
+		0x809db6df:    "msg_new_detailed_info",
+	})
 }
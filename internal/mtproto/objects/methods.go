@@ -136,7 +136,28 @@ func SetClientDHParams(m requester, nonce, serverNonce *tl.Int128, encryptedData
 }
 
 // rpc_drop_answer
-// get_future_salts
+
+type GetFutureSaltsParams struct {
+	Num int32
+}
+
+func (*GetFutureSaltsParams) CRC() uint32 {
+	return 0xb921bd04
+}
+
+func GetFutureSalts(m requester, num int32) (*FutureSalts, error) {
+	data, err := m.MakeRequest(&GetFutureSaltsParams{Num: num})
+	if err != nil {
+		return nil, fmt.Errorf("sending GetFutureSalts: %w", err)
+	}
+
+	resp, ok := data.(*FutureSalts)
+	if !ok {
+		return nil, errors.New("got invalid response type: " + reflect.TypeOf(data).String())
+	}
+
+	return resp, nil
+}
 
 type PingParams struct {
 	PingID int64
@@ -162,7 +183,6 @@ func (*PingDelayDisconnectParams) CRC() uint32 {
 // set_client_DH_params#f5045f1f nonce:int128 server_nonce:int128 encrypted_data:bytes = Set_client_DH_params_answer;
 
 // rpc_drop_answer#58e4a740 req_msg_id:long = RpcDropAnswer;
-// get_future_salts#b921bd04 num:int = FutureSalts;
 // ping_delay_disconnect#f3427b8c ping_id:long disconnect_delay:int = Pong;
 // destroy_session#e7512126 session_id:long = DestroySessionRes;
 
@@ -241,6 +241,37 @@ func (*FutureSalts) CRC() uint32 {
 	return 0xae500895
 }
 
+// future_salts#ae500895 req_msg_id:long now:int salts:vector<future_salt> = FutureSalts;
+// salts is a bare vector of bare future_salt, so neither the vector nor its items carry a crc.
+func (t *FutureSalts) MarshalTL(e *tl.Encoder) error {
+	e.PutUint(t.CRC())
+	e.PutLong(t.ReqMsgID)
+	e.PutInt(t.Now)
+	e.PutInt(int32(len(t.Salts)))
+	for _, salt := range t.Salts {
+		e.PutInt(salt.ValidSince)
+		e.PutInt(salt.ValidUntil)
+		e.PutLong(salt.Salt)
+	}
+	return e.CheckErr()
+}
+
+func (t *FutureSalts) UnmarshalTL(d *tl.Decoder) error {
+	t.ReqMsgID = d.PopLong()
+	t.Now = d.PopInt()
+	count := int(d.PopInt())
+	t.Salts = make([]*FutureSalt, count)
+	for i := 0; i < count; i++ {
+		t.Salts[i] = &FutureSalt{
+			ValidSince: d.PopInt(),
+			ValidUntil: d.PopInt(),
+			Salt:       d.PopLong(),
+		}
+	}
+
+	return nil
+}
+
 type Pong struct {
 	MsgID  int64
 	PingID int64
@@ -285,8 +316,7 @@ func (t *MessageContainer) MarshalTL(e *tl.Encoder) error {
 	for _, msg := range *t {
 		e.PutLong(msg.MsgID)
 		e.PutInt(msg.SeqNo)
-		//       msgID        seqNo        len                object
-		e.PutInt(tl.LongLen + tl.WordLen + tl.WordLen + int32(len(msg.Msg)))
+		e.PutInt(int32(len(msg.Msg)))
 		e.PutRawBytes(msg.Msg)
 	}
 	return e.CheckErr()
@@ -417,6 +447,16 @@ func (*MsgResendReq) CRC() uint32 {
 	return 0x7d861a08
 }
 
+// MsgResendAnsReq asks the server to re-send the answers to the given requests of the
+// client, rather than the messages of the server themselves as MsgResendReq does.
+type MsgResendAnsReq struct {
+	MsgIDs []int64
+}
+
+func (*MsgResendAnsReq) CRC() uint32 {
+	return 0x8610baeb
+}
+
 type MsgsStateReq struct {
 	MsgIDs []int64
 }
@@ -114,11 +114,31 @@ func (l *genericFileSessionLoader) Delete() error {
 }
 
 type tokenStorageFormat struct {
-	Key      string `json:"key"`
-	Hash     string `json:"hash"`
-	Salt     string `json:"salt"`
-	Hostname string `json:"hostname"`
-	AppID    int32  `json:"app_id"`
+	Key         string             `json:"key"`
+	Hash        string             `json:"hash"`
+	Salt        string             `json:"salt"`
+	Hostname    string             `json:"hostname"`
+	AppID       int32              `json:"app_id"`
+	FutureSalts []futureSaltFormat `json:"future_salts,omitempty"`
+	TimeOffset  int64              `json:"time_offset,omitempty"`
+	DCs         []dcAuthFormat     `json:"dcs,omitempty"`
+}
+
+type dcAuthFormat struct {
+	DC         int    `json:"dc"`
+	CDN        bool   `json:"cdn,omitempty"`
+	Key        string `json:"key"`
+	Hash       string `json:"hash"`
+	Salt       string `json:"salt"`
+	TimeOffset int64  `json:"time_offset,omitempty"`
+	Hostname   string `json:"hostname,omitempty"`
+	Authorized bool   `json:"authorized,omitempty"`
+}
+
+type futureSaltFormat struct {
+	ValidSince int32  `json:"valid_since"`
+	ValidUntil int32  `json:"valid_until"`
+	Salt       string `json:"salt"`
 }
 
 func (t *tokenStorageFormat) writeSession(s *Session) {
@@ -127,6 +147,28 @@ func (t *tokenStorageFormat) writeSession(s *Session) {
 	t.Salt = encodeInt64ToBase64(s.Salt)
 	t.Hostname = s.Hostname
 	t.AppID = s.AppID
+	t.FutureSalts = make([]futureSaltFormat, 0, len(s.FutureSalts))
+	for _, fs := range s.FutureSalts {
+		t.FutureSalts = append(t.FutureSalts, futureSaltFormat{
+			ValidSince: fs.ValidSince,
+			ValidUntil: fs.ValidUntil,
+			Salt:       encodeInt64ToBase64(fs.Salt),
+		})
+	}
+	t.TimeOffset = s.TimeOffset
+	t.DCs = make([]dcAuthFormat, 0, len(s.DCs))
+	for _, dc := range s.DCs {
+		t.DCs = append(t.DCs, dcAuthFormat{
+			DC:         dc.DC,
+			CDN:        dc.CDN,
+			Key:        base64.StdEncoding.EncodeToString(dc.Key),
+			Hash:       base64.StdEncoding.EncodeToString(dc.Hash),
+			Salt:       encodeInt64ToBase64(dc.Salt),
+			TimeOffset: dc.TimeOffset,
+			Hostname:   dc.Hostname,
+			Authorized: dc.Authorized,
+		})
+	}
 }
 
 func (t *tokenStorageFormat) readSession() (*Session, error) {
@@ -147,6 +189,37 @@ func (t *tokenStorageFormat) readSession() (*Session, error) {
 	}
 	s.Hostname = t.Hostname
 	s.AppID = t.AppID
+	for _, fs := range t.FutureSalts {
+		salt, err := decodeInt64ToBase64(fs.Salt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid binary data of 'future_salts': %w", err)
+		}
+		s.FutureSalts = append(s.FutureSalts, FutureSalt{
+			ValidSince: fs.ValidSince,
+			ValidUntil: fs.ValidUntil,
+			Salt:       salt,
+		})
+	}
+	s.TimeOffset = t.TimeOffset
+	for _, dc := range t.DCs {
+		auth := DCAuth{
+			DC:         dc.DC,
+			CDN:        dc.CDN,
+			TimeOffset: dc.TimeOffset,
+			Hostname:   dc.Hostname,
+			Authorized: dc.Authorized,
+		}
+		if auth.Key, err = base64.StdEncoding.DecodeString(dc.Key); err != nil {
+			return nil, fmt.Errorf("invalid binary data of 'dcs.key': %w", err)
+		}
+		if auth.Hash, err = base64.StdEncoding.DecodeString(dc.Hash); err != nil {
+			return nil, fmt.Errorf("invalid binary data of 'dcs.hash': %w", err)
+		}
+		if auth.Salt, err = decodeInt64ToBase64(dc.Salt); err != nil {
+			return nil, fmt.Errorf("invalid binary data of 'dcs.salt': %w", err)
+		}
+		s.DCs = append(s.DCs, auth)
+	}
 	return s, nil
 }
 
@@ -18,11 +18,34 @@ type SessionLoader interface {
 // Session is a basic data of specific session. Typically, session stores default hostname of mtproto server
 // (cause all accounts ties to specific server after sign in), session key, server hash and salt.
 type Session struct {
-	Key      []byte
-	Hash     []byte
-	Salt     int64
-	Hostname string
-	AppID    int32
+	Key         []byte
+	Hash        []byte
+	Salt        int64
+	Hostname    string
+	AppID       int32
+	FutureSalts []FutureSalt
+	TimeOffset  int64    // server time minus local time, in seconds
+	DCs         []DCAuth // auth keys of data centers other than the home one
+}
+
+// DCAuth is an auth key created for a data center other than the home one, kept so
+// exported senders and CDN connections can skip the handshake on the next run.
+type DCAuth struct {
+	DC         int    `json:"dc"`
+	CDN        bool   `json:"cdn,omitempty"`
+	Key        []byte `json:"key"`
+	Hash       []byte `json:"hash"`
+	Salt       int64  `json:"salt,omitempty"`
+	TimeOffset int64  `json:"time_offset,omitempty"`
+	Hostname   string `json:"hostname,omitempty"`
+	Authorized bool   `json:"authorized,omitempty"` // the user's authorization was imported on this key
+}
+
+// FutureSalt is a server salt together with the time window (server unix time) in which it is accepted.
+type FutureSalt struct {
+	ValidSince int32
+	ValidUntil int32
+	Salt       int64
 }
 
 var (
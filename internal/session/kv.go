@@ -0,0 +1,85 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// KVStore is the minimal key-value interface a session can be kept in, such as a
+// database table, Redis or an embedded store.
+type KVStore interface {
+	Get(key string) ([]byte, error) // returns nil, nil if key does not exist
+	Set(key string, value []byte) error
+	Delete(key string) error
+}
+
+type kvSessionLoader struct {
+	store  KVStore
+	key    string
+	aesKey string
+}
+
+var _ SessionLoader = (*kvSessionLoader)(nil)
+
+// NewKV keeps the session under key in store, in the same format as session files.
+// The value is encrypted when aesKey is set.
+func NewKV(store KVStore, key string, aesKey string) SessionLoader {
+	return &kvSessionLoader{store: store, key: key, aesKey: aesKey}
+}
+
+func (l *kvSessionLoader) Path() string {
+	return "kv:" + l.key
+}
+
+func (l *kvSessionLoader) Key() string {
+	return l.key
+}
+
+func (l *kvSessionLoader) Exists() bool {
+	data, err := l.store.Get(l.key)
+	return err == nil && len(data) > 0
+}
+
+func (l *kvSessionLoader) Load() (*Session, error) {
+	data, err := l.store.Get(l.key)
+	if err != nil {
+		return nil, fmt.Errorf("reading session %q: %w", l.key, err)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	if l.aesKey != "" {
+		if data, err = decodeBytes(data, l.aesKey); err != nil {
+			return nil, fmt.Errorf("decrypting session: %w", err)
+		}
+	}
+
+	file := new(tokenStorageFormat)
+	if err := json.Unmarshal(data, file); err != nil {
+		return nil, fmt.Errorf("parsing session: %w", err)
+	}
+	return file.readSession()
+}
+
+func (l *kvSessionLoader) Store(s *Session) error {
+	file := new(tokenStorageFormat)
+	file.writeSession(s)
+	data, err := json.Marshal(file)
+	if err != nil {
+		return fmt.Errorf("encoding session: %w", err)
+	}
+
+	if l.aesKey != "" {
+		if data, err = encodeBytes(data, l.aesKey); err != nil {
+			return fmt.Errorf("encrypting session: %w", err)
+		}
+	}
+	return l.store.Set(l.key, data)
+}
+
+func (l *kvSessionLoader) Delete() error {
+	return l.store.Delete(l.key)
+}
@@ -22,11 +22,12 @@ var (
 )
 
 type StringSession struct {
-	AuthKey     []byte `json:"key,omitempty"`     // AUTH_KEY
-	AuthKeyHash []byte `json:"hash,omitempty"`    // AUTH_KEY_HASH
-	DcID        int    `json:"dc_id,omitempty"`   // DC ID
-	IpAddr      string `json:"ip_addr,omitempty"` // IP address of DC
-	AppID       int32  `json:"app_id,omitempty"`  // APP_ID
+	AuthKey     []byte   `json:"key,omitempty"`     // AUTH_KEY
+	AuthKeyHash []byte   `json:"hash,omitempty"`    // AUTH_KEY_HASH
+	DcID        int      `json:"dc_id,omitempty"`   // DC ID
+	IpAddr      string   `json:"ip_addr,omitempty"` // IP address of DC
+	AppID       int32    `json:"app_id,omitempty"`  // APP_ID
+	DCs         []DCAuth `json:"dcs,omitempty"`     // auth keys of other DCs
 }
 
 func NewStringSession(authKey, authKeyHash []byte, dcID int, ipAddr string, appID int32) *StringSession {
@@ -4,16 +4,18 @@ package transport
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
+	"os"
 	"strings"
 	"time"
 )
 
 type tcpConn struct {
 	reader  *Reader
-	conn    *net.TCPConn
+	conn    net.Conn
 	timeout time.Duration
 }
 
@@ -36,6 +38,18 @@ func NewTCP(cfg TCPConnConfig) (Conn, bool, error) {
 
 	cfg.Host = strings.TrimPrefix(cfg.Host, ":")
 
+	if cfg.Dial != nil {
+		conn, err := cfg.Dial(cfg.Ctx, tcpPrefix, cfg.Host)
+		if err != nil {
+			return nil, false, fmt.Errorf("dialing tcp: %w", err)
+		}
+		return &tcpConn{
+			reader:  NewReader(cfg.Ctx, conn),
+			conn:    conn,
+			timeout: cfg.Timeout,
+		}, false, nil
+	}
+
 	tcpAddr, err := net.ResolveTCPAddr(tcpPrefix, cfg.Host)
 	if err != nil {
 		return nil, false, fmt.Errorf("resolving tcp addr: %w", err)
@@ -96,7 +110,7 @@ func newSocksTCP(cfg TCPConnConfig) (Conn, bool, error) {
 
 	return &tcpConn{
 		reader:  NewReader(cfg.Ctx, conn),
-		conn:    conn.(*net.TCPConn),
+		conn:    conn,
 		timeout: cfg.Timeout,
 	}, false, nil
 }
@@ -136,10 +150,9 @@ func (t *tcpConn) Read(b []byte) (int, error) {
 
 	n, err := t.reader.Read(b)
 	if err != nil {
-		if e, ok := err.(*net.OpError); ok || err == io.ErrClosedPipe {
-			if e.Err.Error() == "i/o timeout" || err == io.ErrClosedPipe {
-				return 0, fmt.Errorf("required to reconnect: %w", err)
-			}
+		// timeouts of TCP and dialed connections alike wrap os.ErrDeadlineExceeded
+		if errors.Is(err, os.ErrDeadlineExceeded) || errors.Is(err, io.ErrClosedPipe) {
+			return 0, fmt.Errorf("required to reconnect: %w", err)
 		}
 		switch err {
 		case io.EOF, context.Canceled:
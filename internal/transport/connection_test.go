@@ -0,0 +1,53 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package transport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+)
+
+func dialPipe(t *testing.T) (Conn, net.Conn, net.Conn) {
+	t.Helper()
+	client, server := net.Pipe()
+	conn, _, err := NewTCP(TCPConnConfig{CommonConfig: CommonConfig{
+		Ctx:  context.Background(),
+		Host: "127.0.0.1:443",
+		Dial: func(context.Context, string, string) (net.Conn, error) { return client, nil },
+	}})
+	if err != nil {
+		t.Fatalf("dialing: %v", err)
+	}
+	return conn, client, server
+}
+
+// Reading a closed connection asks for a reconnect; it used to dereference a
+// nil *net.OpError when the error was io.ErrClosedPipe.
+func TestReadClosedPipe(t *testing.T) {
+	conn, client, server := dialPipe(t)
+	defer server.Close()
+	client.Close()
+
+	_, err := conn.Read(make([]byte, 4))
+	if !errors.Is(err, io.ErrClosedPipe) || !strings.Contains(err.Error(), "required to reconnect") {
+		t.Fatalf("read: %v, want a reconnect on io.ErrClosedPipe", err)
+	}
+}
+
+func TestReadPeerClosed(t *testing.T) {
+	conn, client, server := dialPipe(t)
+	defer client.Close()
+	go func() {
+		server.Write([]byte{1, 2})
+		server.Close()
+	}()
+
+	_, err := conn.Read(make([]byte, 4))
+	if err != io.EOF {
+		t.Fatalf("read: %v, want io.EOF", err)
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package transport
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+)
+
+// Fault is a failure a FaultInjector applies to a frame.
+type Fault uint8
+
+const (
+	FaultNone       Fault = iota
+	FaultStall            // holds the frame back for FaultConfig.Stall
+	FaultDrop             // loses the frame
+	FaultReorder          // swaps the frame with the next one in the same direction
+	FaultCut              // closes the connection in the middle of the frame
+	FaultCorruptKey       // garbles the auth key id; the server answers such a frame with -404
+	FaultCode404          // delivers transport error -404 ahead of the next incoming frame
+	FaultCode429          // delivers transport error -429 ahead of the next incoming frame
+)
+
+var faultNames = [...]string{"none", "stall", "drop", "reorder", "cut", "corrupt key", "code -404", "code -429"}
+
+func (f Fault) String() string {
+	if int(f) < len(faultNames) {
+		return faultNames[f]
+	}
+	return fmt.Sprintf("fault(%d)", uint8(f))
+}
+
+// Direction tells whether the client sends or receives a frame.
+type Direction uint8
+
+const (
+	Outgoing Direction = iota
+	Incoming
+)
+
+func (d Direction) String() string {
+	if d == Incoming {
+		return "incoming"
+	}
+	return "outgoing"
+}
+
+// Frame identifies the frame a fault is picked for.
+type Frame struct {
+	Conn      int // connections opened through the injector before this one
+	Direction Direction
+	Index     int // frames before this one in the same direction of the connection
+}
+
+// FaultConfig configures a FaultInjector. Faults strike at random with the
+// chances given, unless a Script picks them.
+type FaultConfig struct {
+	Latency time.Duration // added to every frame, in both directions
+	Jitter  time.Duration // random extra latency of up to this much per frame
+	Stall   time.Duration // how long FaultStall holds a frame back (default: 5s)
+
+	Chances map[Fault]float64  // chance from 0 to 1 that a frame suffers each fault; at most one strikes per frame
+	Script  func(Frame) Fault  // picks the fault of every frame instead of Chances, see ScriptFaults
+	OnFault func(Frame, Fault) // called for every fault that strikes
+	Seed    uint64             // seeds the chances and the jitter; 0 picks a random seed
+}
+
+// FaultStep is a fault a script applies to one frame.
+type FaultStep struct {
+	Conn      int
+	Direction Direction
+	Index     int
+	Fault     Fault
+}
+
+// ScriptFaults returns a FaultConfig.Script striking the frames of steps with
+// their faults and leaving every other frame alone.
+func ScriptFaults(steps ...FaultStep) func(Frame) Fault {
+	script := make(map[Frame]Fault, len(steps))
+	for _, step := range steps {
+		script[Frame{Conn: step.Conn, Direction: step.Direction, Index: step.Index}] = step.Fault
+	}
+	return func(frame Frame) Fault {
+		return script[frame]
+	}
+}
+
+// FaultInjector breaks the connections of a transport in configurable ways, to
+// test how the client copes with unreliable networks and servers. It works on
+// whole frames: each frame written or read suffers at most one fault.
+// Transport error codes only strike incoming frames; codes picked for
+// outgoing frames are ignored.
+type FaultInjector struct {
+	cfg   FaultConfig
+	mu    sync.Mutex
+	rng   *rand.Rand
+	conns int
+}
+
+// NewFaultInjector returns an injector for cfg. Share it between the
+// connections that should count as one sequence for the script.
+func NewFaultInjector(cfg FaultConfig) *FaultInjector {
+	if cfg.Stall == 0 {
+		cfg.Stall = 5 * time.Second
+	}
+	seed := cfg.Seed
+	if seed == 0 {
+		seed = rand.Uint64()
+	}
+	return &FaultInjector{
+		cfg: cfg,
+		rng: rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+// wrap installs the injector between mode and conn, which mode writes to.
+func (f *FaultInjector) wrap(conn Conn, newMode func(Conn) (Mode, error)) (Conn, Mode, error) {
+	c := &cuttableConn{Conn: conn, budget: -1}
+	mode, err := newMode(c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f.mu.Lock()
+	id := f.conns
+	f.conns++
+	f.mu.Unlock()
+	return c, &faultyMode{Mode: mode, f: f, conn: c, id: id}, nil
+}
+
+func (f *FaultInjector) pick(frame Frame) Fault {
+	var fault Fault
+	if f.cfg.Script != nil {
+		fault = f.cfg.Script(frame)
+	} else {
+		fault = f.roll(frame.Direction)
+	}
+	if frame.Direction == Outgoing && (fault == FaultCode404 || fault == FaultCode429) {
+		fault = FaultNone
+	}
+	if fault != FaultNone && f.cfg.OnFault != nil {
+		f.cfg.OnFault(frame, fault)
+	}
+	return fault
+}
+
+func (f *FaultInjector) roll(dir Direction) Fault {
+	if len(f.cfg.Chances) == 0 {
+		return FaultNone
+	}
+	f.mu.Lock()
+	r := f.rng.Float64()
+	f.mu.Unlock()
+
+	for fault := FaultStall; fault <= FaultCode429; fault++ {
+		if dir == Outgoing && fault >= FaultCode404 {
+			break
+		}
+		if r -= f.cfg.Chances[fault]; r < 0 {
+			return fault
+		}
+	}
+	return FaultNone
+}
+
+// delay waits out the latency of a frame.
+func (f *FaultInjector) delay() {
+	d := f.cfg.Latency
+	if f.cfg.Jitter > 0 {
+		f.mu.Lock()
+		d += time.Duration(f.rng.Int64N(int64(f.cfg.Jitter)))
+		f.mu.Unlock()
+	}
+	if d > 0 {
+		time.Sleep(d)
+	}
+}
+
+var errFaultCut = errors.New("connection cut by fault injector")
+
+// faultyMode applies the faults of an injector to the frames of a mode.
+type faultyMode struct {
+	Mode
+	f    *FaultInjector
+	conn *cuttableConn
+	id   int
+
+	writeMu sync.Mutex
+	written int
+	held    []byte // outgoing frame swapped with the next one
+
+	readMu  sync.Mutex
+	read    int
+	pending []byte // incoming frame swapped with the one delivered before it
+}
+
+func (m *faultyMode) WriteMsg(msg []byte) error {
+	m.writeMu.Lock()
+	defer m.writeMu.Unlock()
+
+	fault := m.f.pick(Frame{Conn: m.id, Direction: Outgoing, Index: m.written})
+	m.written++
+	m.f.delay()
+
+	switch fault {
+	case FaultStall:
+		time.Sleep(m.f.cfg.Stall)
+	case FaultDrop:
+		return nil
+	case FaultReorder:
+		if m.held == nil {
+			m.held = slices.Clone(msg)
+			return nil
+		}
+	case FaultCut:
+		// the frame header and half of the payload make it to the wire
+		m.conn.limit(len(msg) / 2)
+		err := m.Mode.WriteMsg(msg)
+		m.conn.limit(-1)
+		m.conn.Close()
+		if err == nil {
+			err = errFaultCut
+		}
+		return err
+	case FaultCorruptKey:
+		msg = corruptKeyID(msg)
+	}
+
+	if err := m.Mode.WriteMsg(msg); err != nil {
+		return err
+	}
+	if held := m.held; held != nil {
+		m.held = nil
+		return m.Mode.WriteMsg(held)
+	}
+	return nil
+}
+
+func (m *faultyMode) ReadMsg() ([]byte, error) {
+	m.readMu.Lock()
+	defer m.readMu.Unlock()
+
+	if pending := m.pending; pending != nil {
+		m.pending = nil
+		return pending, nil
+	}
+
+	for {
+		fault := m.f.pick(Frame{Conn: m.id, Direction: Incoming, Index: m.read})
+		m.read++
+
+		switch fault {
+		case FaultCode404:
+			m.f.delay()
+			return errorCodeFrame(-404), nil
+		case FaultCode429:
+			m.f.delay()
+			return errorCodeFrame(-429), nil
+		}
+
+		data, err := m.Mode.ReadMsg()
+		if err != nil {
+			return nil, err
+		}
+		m.f.delay()
+
+		switch fault {
+		case FaultStall:
+			time.Sleep(m.f.cfg.Stall)
+		case FaultDrop:
+			continue
+		case FaultReorder:
+			next, err := m.Mode.ReadMsg()
+			if err != nil {
+				return nil, err
+			}
+			m.read++
+			m.pending, data = data, next
+		case FaultCut:
+			m.conn.Close()
+			// what reading a frame the peer stopped sending halfway through fails with
+			return nil, fmt.Errorf("unexpected error: %w", io.ErrUnexpectedEOF)
+		case FaultCorruptKey:
+			data = corruptKeyID(data)
+		}
+		return data, nil
+	}
+}
+
+// corruptKeyID returns a copy of frame with the auth key id garbled.
+func corruptKeyID(frame []byte) []byte {
+	frame = slices.Clone(frame)
+	for i := 0; i < tl.DoubleLen && i < len(frame); i++ {
+		frame[i] ^= 0xa5
+	}
+	return frame
+}
+
+// errorCodeFrame returns the frame the server reports a transport error with.
+func errorCodeFrame(code int32) []byte {
+	frame := make([]byte, tl.WordLen)
+	binary.LittleEndian.PutUint32(frame, uint32(code))
+	return frame
+}
+
+// cuttableConn closes the connection once a byte budget for writes is spent.
+// Only faultyMode.WriteMsg sets a budget, which it holds writeMu for.
+type cuttableConn struct {
+	Conn
+	budget int // bytes left to write, negative for no limit
+}
+
+func (c *cuttableConn) limit(n int) {
+	c.budget = n
+}
+
+func (c *cuttableConn) Write(b []byte) (int, error) {
+	if c.budget < 0 {
+		return c.Conn.Write(b)
+	}
+	if len(b) <= c.budget {
+		n, err := c.Conn.Write(b)
+		c.budget -= n
+		return n, err
+	}
+
+	n, err := c.Conn.Write(b[:c.budget])
+	c.budget = -1
+	c.Conn.Close()
+	if err == nil {
+		err = errFaultCut
+	}
+	return n, err
+}
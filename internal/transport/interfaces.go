@@ -5,6 +5,7 @@ package transport
 import (
 	"context"
 	"io"
+	"net"
 	"time"
 
 	"github.com/amarnathcjd/gogram/internal/utils"
@@ -29,8 +30,14 @@ type CommonConfig struct {
 	ModeVariant uint8
 	DC          int
 	Logger      *utils.Logger
+	OnTraffic   func(in, out int) // called with the number of bytes read from or written to the wire
+	Dial        DialFunc          // opens TCP connections instead of net.DialTCP when set
+	Faults      *FaultInjector    // breaks frames on purpose, for testing
 }
 
+// DialFunc opens a connection to addr, like net.Dialer.DialContext.
+type DialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
 type TCPConnConfig struct {
 	CommonConfig
 	IpV6 bool
@@ -34,16 +34,22 @@ func NewTransport(m messages.MessageInformator, conn ConnConfig, modeVariant mod
 	var err error
 	var isObfuscated bool
 	var isMTProxy bool
+	var onTraffic func(in, out int)
+	var faults *FaultInjector
 	switch cfg := conn.(type) {
 	case TCPConnConfig:
 		t.conn, isObfuscated, err = NewTCP(cfg)
 		if cfg.Socks != nil && cfg.Socks.Type == "mtproxy" {
 			isMTProxy = true
 		}
+		onTraffic = cfg.OnTraffic
+		faults = cfg.Faults
 	case WSConnConfig:
 		cfg.ModeVariant = uint8(modeVariant)
 		t.conn, err = NewWebSocket(cfg)
 		isObfuscated = true
+		onTraffic = cfg.OnTraffic
+		faults = cfg.Faults
 	default:
 		return nil, fmt.Errorf("unsupported connection type %v", reflect.TypeOf(conn).String())
 	}
@@ -51,17 +57,27 @@ func NewTransport(m messages.MessageInformator, conn ConnConfig, modeVariant mod
 		return nil, fmt.Errorf("setup connection: %w", err)
 	}
 
+	if onTraffic != nil {
+		t.conn = &countingConn{Conn: t.conn, onTraffic: onTraffic}
+	}
+
 	// For MTProxy, always use Intermediate mode since the obfuscation header
 	// contains the protocol tag (0xeeeeeeee or 0xdddddddd)
 	if isMTProxy {
 		modeVariant = mode.Intermediate
 	}
 
-	// already sent in obfuscation handshake
-	if isObfuscated {
-		t.mode, err = mode.NewWithoutAnnouncement(modeVariant, t.conn)
+	newMode := func(conn Conn) (Mode, error) {
+		// already sent in obfuscation handshake
+		if isObfuscated {
+			return mode.NewWithoutAnnouncement(modeVariant, conn)
+		}
+		return mode.New(modeVariant, conn)
+	}
+	if faults != nil {
+		t.conn, t.mode, err = faults.wrap(t.conn, newMode)
 	} else {
-		t.mode, err = mode.New(modeVariant, t.conn)
+		t.mode, err = newMode(t.conn)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("setup mode: %w", err)
@@ -145,3 +161,25 @@ type ErrCode int64
 func (e ErrCode) Error() string {
 	return fmt.Sprintf("code %v", int64(e))
 }
+
+// countingConn reports the bytes passing through the underlying connection.
+type countingConn struct {
+	Conn
+	onTraffic func(in, out int)
+}
+
+func (c *countingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.onTraffic(n, 0)
+	}
+	return n, err
+}
+
+func (c *countingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	if n > 0 {
+		c.onTraffic(0, n)
+	}
+	return n, err
+}
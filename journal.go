@@ -0,0 +1,162 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package gogram
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+	"github.com/amarnathcjd/gogram/internal/mtproto/objects"
+	"github.com/amarnathcjd/gogram/internal/utils"
+)
+
+// Kinds of journal entries.
+const (
+	JournalCall   = "call"
+	JournalUpdate = "update"
+)
+
+// JournalEntry is one record of an RPC journal, stored as a line of JSON.
+type JournalEntry struct {
+	Time       time.Time     `json:"time"`
+	Kind       string        `json:"kind"`                  // JournalCall or JournalUpdate
+	DC         int           `json:"dc"`                    // Data center of the connection
+	Method     string        `json:"method,omitempty"`      // Method of a call, e.g. "MessagesGetMessages"
+	Type       string        `json:"type"`                  // Go type of Data
+	Data       []byte        `json:"data"`                  // TL-encoded request, or the update as received
+	ResultType string        `json:"result_type,omitempty"` // Go type of Result
+	Result     []byte        `json:"result,omitempty"`      // TL-encoded result of a successful call
+	Error      *JournalError `json:"error,omitempty"`       // RPC error a call failed with
+}
+
+// JournalError is an RPC error as sent by the server.
+type JournalError struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"` // e.g. "FLOOD_WAIT_5"
+}
+
+// Recorder writes every request answered by the server, together with its
+// answer, and every update received to a journal of newline-delimited JSON.
+// Requests are recorded per attempt, so retries show up as separate calls;
+// attempts that got no answer at all are left out. It is safe for concurrent use.
+type Recorder struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+	err error
+}
+
+// NewRecorder returns a recorder writing to w.
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{enc: json.NewEncoder(w)}
+}
+
+// Err returns the first error that occurred while writing the journal.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+func (r *Recorder) write(entry *JournalEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return
+	}
+	if err := r.enc.Encode(entry); err != nil {
+		r.err = fmt.Errorf("writing journal: %w", err)
+	}
+}
+
+// recordCall records req together with the answer resp of the server.
+func (r *Recorder) recordCall(dc int, req tl.Object, resp tl.Object) error {
+	data, err := tl.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshaling request: %w", err)
+	}
+
+	entry := &JournalEntry{
+		Time:   time.Now(),
+		Kind:   JournalCall,
+		DC:     dc,
+		Method: utils.FmtMethod(req),
+		Type:   fmt.Sprintf("%T", req),
+		Data:   data,
+	}
+	switch resp := resp.(type) {
+	case *objects.RpcError:
+		entry.Error = &JournalError{Code: resp.ErrorCode, Message: resp.ErrorMessage}
+	case *tl.WrappedSlice:
+		entry.ResultType = fmt.Sprintf("%T", resp.Unwrap())
+		entry.Result, err = tl.Marshal(resp.Unwrap())
+	default:
+		entry.ResultType = fmt.Sprintf("%T", resp)
+		entry.Result, err = tl.Marshal(resp)
+	}
+	if err != nil {
+		return fmt.Errorf("marshaling result: %w", err)
+	}
+
+	r.write(entry)
+	return nil
+}
+
+// recordUpdate records an update as it came in from the server.
+func (r *Recorder) recordUpdate(dc int, update tl.Object, data []byte) {
+	r.write(&JournalEntry{
+		Time: time.Now(),
+		Kind: JournalUpdate,
+		DC:   dc,
+		Type: fmt.Sprintf("%T", update),
+		Data: data,
+	})
+}
+
+// ReadJournal reads all entries of a journal written by a Recorder.
+func ReadJournal(r io.Reader) ([]JournalEntry, error) {
+	var entries []JournalEntry
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry JournalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("journal line %d: %w", line, err)
+		}
+		if entry.Kind != JournalCall && entry.Kind != JournalUpdate {
+			return nil, fmt.Errorf("journal line %d: unknown kind %q", line, entry.Kind)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading journal: %w", err)
+	}
+	return entries, nil
+}
+
+// Recorder returns the recorder journaling the traffic of this connection, if any.
+func (m *MTProto) Recorder() *Recorder {
+	return m.recorder
+}
+
+// record journals an answer of the server to req.
+func (m *MTProto) record(req tl.Object, resp tl.Object) {
+	if m.recorder == nil || isServiceMessage(req) {
+		return
+	}
+	switch resp.(type) {
+	case *errorSessionConfigsChanged, *errorRequestLost, *objects.Null:
+		// produced locally, not by the server
+		return
+	}
+	if err := m.recorder.recordCall(m.GetDC(), req, resp); err != nil {
+		m.Logger.Debug("recording %s: %v", utils.FmtMethod(req), err)
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package gogram
+
+import "strings"
+
+// MetricsSink receives connection-level measurements from a connection and from every
+// sender exported from it. Implementations must be safe for concurrent use.
+type MetricsSink interface {
+	ObserveReconnect(dc int, err error)
+	ObserveFloodWait(method string, seconds int)
+	ObserveTraffic(transport string, dc int, in, out int)
+}
+
+// trafficObserver returns the byte counter handed to the transport, or nil without a sink.
+func (m *MTProto) trafficObserver(dc int) func(in, out int) {
+	if m.metrics == nil {
+		return nil
+	}
+	transportType := strings.ToLower(m.GetTransportType())
+	return func(in, out int) {
+		m.metrics.ObserveTraffic(transportType, dc, in, out)
+	}
+}
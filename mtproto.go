@@ -8,9 +8,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -61,13 +63,16 @@ type MTProto struct {
 
 	noRedirect bool
 
-	serverSalt atomic.Int64
-	encrypted  atomic.Bool
-	sessionId  atomic.Int64
+	serverSalt  atomic.Int64
+	salts       *saltTimeline
+	saltRefresh chan struct{}
+	encrypted   atomic.Bool
+	sessionId   atomic.Int64
 
 	responseChannels *utils.SyncIntObjectChan
 	expectedTypes    *utils.SyncIntReflectTypes
 	pendingAcks      *utils.SyncSet[int64]
+	batcher          *sendBatcher
 
 	genMsgID     func(int64) int64
 	currentSeqNo atomic.Int32
@@ -90,15 +95,29 @@ type MTProto struct {
 	floodHandler          func(err error) bool
 	errorHandler          func(err error) bool
 	connectionHandler     func(err error) error
+	interceptors          []Interceptor
+	interceptorsMu        sync.RWMutex
+	metrics               MetricsSink
+	onFloodWait           func(method string, req tl.Object, wait time.Duration)
+	recorder              *Recorder
+	pool                  *connPool
+	poolMu                sync.RWMutex
 	exported              bool
 	cdn                   bool
+	parent                *MTProto    // connection an exported sender was created from
+	exportedDC            int         // DC an exported sender connects to
+	authImported          atomic.Bool // exported sender's key carries the user's authorization
+	dcAuthMu              sync.Mutex
+	dcAuths               []session.DCAuth // auth keys of other DCs, persisted with the session
 	terminated            atomic.Bool
 	senderCounters        sync.Map // map[int]int32 - tracks sender count per DC
 	// Reconnection state (consolidated for simplicity)
 	reconnectInProgress   atomic.Bool
+	keepPending           atomic.Bool // leave pending requests untouched while reconnecting, see recoverPendingRequests
 	reconnectAttempts     atomic.Int32
 	consecutiveTimeouts   atomic.Int32
 	lastSuccessfulConnect atomic.Int64 // Unix timestamp
+	lastInbound           atomic.Int64 // unix nano of the last message read from the server
 
 	// Reconnection configuration
 	timeout              time.Duration
@@ -109,6 +128,8 @@ type MTProto struct {
 	useWebSocket    bool
 	useWebSocketTLS bool
 	enablePFS       bool
+	dial            DialFunc
+	faults          *FaultInjector
 
 	onMigration func()
 
@@ -116,6 +137,8 @@ type MTProto struct {
 	messageTracker  *utils.SyncIntInt64
 	messageTypesMap sync.Map // msgID -> request type name
 	maxRetryDepth   int      // Maximum retry depth to prevent stack overflow
+	retryPolicies   *RetryPolicies
+	fallbackSender  func(dcID int) (*MTProto, error)
 }
 
 type Config struct {
@@ -130,6 +153,15 @@ type Config struct {
 	FloodHandler      func(err error) bool  // Called on FLOOD_WAIT; return true to retry
 	ErrorHandler      func(err error) bool  // Called on errors; return true to retry
 	ConnectionHandler func(err error) error // Custom reconnection handler
+	Interceptors      []Interceptor         // Wrap every RPC call, outermost first
+	Metrics           MetricsSink           // Receives reconnect, flood wait and traffic measurements
+	Recorder          *Recorder             // Journals every answered request and every update received
+	RetryPolicies     *RetryPolicies        // Decide which failed requests are sent again and how; FloodHandler and ErrorHandler are asked only for requests no policy covers
+
+	// Returns an authorized connection to another DC, for RetryPolicy.FallbackDC
+	FallbackSender func(dcID int) (*MTProto, error)
+	// Called on every FLOOD_WAIT, including the ones retried, with the method as in RequestInfo.Method
+	OnFloodWait func(method string, req TLObject, wait time.Duration)
 
 	ServerHost      string         // Telegram server address (IP:port)
 	PublicKey       *rsa.PublicKey // RSA public key for server verification
@@ -144,6 +176,11 @@ type Config struct {
 	ReqTimeout      int            // RPC request timeout (seconds)
 	UseWebSocket    bool           // Use WebSocket transport
 	UseWebSocketTLS bool           // Use secure WebSocket (wss://)
+	Dialer          DialFunc       // Opens TCP connections instead of dialing the server directly
+	Faults          *FaultInjector // Breaks frames on purpose to test recovery, never set in production
+
+	DisableBatching bool          // Write every request as its own frame instead of packing into containers
+	BatchDelay      time.Duration // Time to linger for more requests before flushing a container (default: 0)
 
 	MaxReconnectAttempts int           // Max reconnection attempts (default: 2000)
 	BaseReconnectDelay   time.Duration // Initial reconnect delay (default: 2s)
@@ -152,7 +189,11 @@ type Config struct {
 	OnMigration func() // Called after DC migration completes
 }
 
+// DialFunc opens a connection to addr, like net.Dialer.DialContext.
+type DialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
 func NewMTProto(c Config) (*MTProto, error) {
+	fileBacked := c.SessionStorage == nil && !c.MemorySession
 	if c.SessionStorage == nil {
 		if c.MemorySession {
 			c.SessionStorage = session.NewInMemory()
@@ -167,8 +208,10 @@ func NewMTProto(c Config) (*MTProto, error) {
 			// if the error is not because of file not found or path not found, return the error
 			// else, continue with the execution
 			// check if you have write permission in the directory
-			if _, err := os.OpenFile(filepath.Dir(c.AuthKeyFile), os.O_WRONLY, 0222); err != nil {
-				return nil, fmt.Errorf("check if you have write permission in the directory: %w", err)
+			if fileBacked {
+				if _, err := os.OpenFile(filepath.Dir(c.AuthKeyFile), os.O_WRONLY, 0222); err != nil {
+					return nil, fmt.Errorf("check if you have write permission in the directory: %w", err)
+				}
 			}
 			return nil, fmt.Errorf("loading session: %w", err)
 		}
@@ -184,6 +227,8 @@ func NewMTProto(c Config) (*MTProto, error) {
 		responseChannels:      utils.NewSyncIntObjectChan(),
 		expectedTypes:         utils.NewSyncIntReflectTypes(),
 		pendingAcks:           utils.NewSyncSet[int64](),
+		salts:                 newSaltTimeline(),
+		saltRefresh:           make(chan struct{}, 1),
 		genMsgID:              utils.NewMsgIDGenerator(),
 		serverRequestHandlers: make([]func(i any) bool, 0),
 		Logger:                c.Logger,
@@ -205,9 +250,24 @@ func NewMTProto(c Config) (*MTProto, error) {
 		useWebSocket:          c.UseWebSocket,
 		useWebSocketTLS:       c.UseWebSocketTLS,
 		enablePFS:             c.EnablePFS,
+		dial:                  c.Dialer,
+		faults:                c.Faults,
+		interceptors:          slices.Clone(c.Interceptors),
+		metrics:               c.Metrics,
+		onFloodWait:           c.OnFloodWait,
+		recorder:              c.Recorder,
 		onMigration:           c.OnMigration,
 		messageTracker:        utils.NewSyncIntInt64(),
 		maxRetryDepth:         10, // Maximum retry depth to prevent stack overflow
+		retryPolicies:         c.RetryPolicies,
+		fallbackSender:        c.FallbackSender,
+	}
+	if c.RetryPolicies != nil {
+		mtproto.maxRetryDepth = max(mtproto.maxRetryDepth, c.RetryPolicies.maxAttempts())
+	}
+
+	if !c.DisableBatching {
+		mtproto.batcher = newSendBatcher(mtproto, c.BatchDelay)
 	}
 
 	mtproto.SetAddr(c.ServerHost)
@@ -284,11 +344,14 @@ func (m *MTProto) loadAuth(stringSession string, sess *session.Session) error {
 
 func (m *MTProto) ExportAuth() (*session.Session, int) {
 	return &session.Session{
-		Key:      m.authKey,
-		Hash:     m.authKeyHash,
-		Salt:     m.serverSalt.Load(),
-		Hostname: m.GetAddr(),
-		AppID:    m.AppID(),
+		Key:         m.authKey,
+		Hash:        m.authKeyHash,
+		Salt:        m.serverSalt.Load(),
+		Hostname:    m.GetAddr(),
+		AppID:       m.AppID(),
+		FutureSalts: m.salts.snapshot(),
+		TimeOffset:  m.timeOffset.Load(),
+		DCs:         m.dcAuthSnapshot(),
 	}, m.GetDC()
 }
 
@@ -315,6 +378,9 @@ func (m *MTProto) ImportAuth(stringSession string) (bool, error) {
 	m.authKey = sessionString.AuthKey
 	m.authKeyHash = sessionString.AuthKeyHash
 	m.SetAddr(sessionString.IpAddr)
+	if len(sessionString.DCs) > 0 {
+		m.setDCAuths(sessionString.DCs)
+	}
 
 	if m.appID == 0 {
 		m.appID = sessionString.AppID
@@ -397,6 +463,8 @@ func (m *MTProto) SwitchDc(dc int) error {
 	}
 
 	m.Logger.Debug("initiating migration to DC%d", dc)
+	m.DetachPool()
+	m.forgetDCAuths(dc)
 
 	m.reconnectInProgress.Store(true)
 	defer m.reconnectInProgress.Store(false)
@@ -413,6 +481,7 @@ func (m *MTProto) SwitchDc(dc int) error {
 	m.authKey = nil
 	m.authKeyHash = nil
 	m.serverSalt.Store(0)
+	m.salts.clear()
 	m.encrypted.Store(false)
 	m.sessionId.Store(utils.GenerateSessionID())
 
@@ -444,6 +513,10 @@ func (m *MTProto) SwitchDc(dc int) error {
 
 func (m *MTProto) ExportNewSender(dcID int, mem bool, cdn ...bool) (*MTProto, error) {
 	newAddr := m.DcList.GetHostIP(dcID, false, m.IpV6)
+	if dcID == m.GetDC() {
+		// the home DC is reached where the main connection is, which may be a configured address
+		newAddr = m.GetAddr()
+	}
 
 	var senderNum int32
 	if val, ok := m.senderCounters.Load(dcID); ok {
@@ -478,13 +551,27 @@ func (m *MTProto) ExportNewSender(dcID int, mem bool, cdn ...bool) (*MTProto, er
 		ReqTimeout:      int(m.reqTimeout.Seconds()),
 		UseWebSocket:    m.useWebSocket,
 		UseWebSocketTLS: m.useWebSocketTLS,
-	}
-
-	if dcID == m.GetDC() {
+		Dialer:          m.dial,
+		Faults:          m.faults,
+		Interceptors:    m.Interceptors(),
+		Metrics:         m.metrics,
+		OnFloodWait:     m.onFloodWait,
+		Recorder:        m.recorder,
+		RetryPolicies:   m.retryPolicies,
+	}
+
+	isCdn := len(cdn) > 0 && cdn[0]
+	stored, hasStored := m.storedDCAuth(dcID, isCdn)
+	if dcID == m.GetDC() && !isCdn {
 		cfg.SessionStorage = m.sessionStorage
 		cfg.StringSession = session.NewStringSession(
 			m.authKey, m.authKeyHash, dcID, newAddr, m.appID,
 		).Encode()
+	} else if hasStored {
+		m.Logger.Debug("reusing stored auth key for DC%d", dcID)
+		cfg.StringSession = session.NewStringSession(
+			stored.Key, stored.Hash, dcID, newAddr, m.appID,
+		).Encode()
 	}
 
 	sender, err := NewMTProto(cfg)
@@ -492,10 +579,16 @@ func (m *MTProto) ExportNewSender(dcID int, mem bool, cdn ...bool) (*MTProto, er
 		return nil, fmt.Errorf("creating new MTProto: %w", err)
 	}
 
+	sender.DcList.SetDCs(m.DcList.DCs, m.DcList.CDNDCs)
 	sender.noRedirect = true
 	sender.exported = true
-	if len(cdn) > 0 && cdn[0] {
-		sender.cdn = true
+	sender.cdn = isCdn
+	sender.parent = m
+	sender.exportedDC = dcID
+	if hasStored && (dcID != m.GetDC() || isCdn) {
+		sender.serverSalt.Store(stored.Salt)
+		sender.timeOffset.Store(stored.TimeOffset)
+		sender.authImported.Store(stored.Authorized)
 	}
 
 	if err := sender.CreateConnection(false); err != nil {
@@ -617,6 +710,10 @@ func (m *MTProto) CreateConnection(withLog bool) error {
 		m.startPFSManager(ctx)
 	}
 
+	if !m.cdn {
+		m.startSaltManager(ctx)
+	}
+
 	return nil
 }
 
@@ -642,6 +739,9 @@ func (m *MTProto) connect(ctx context.Context) error {
 		ModeVariant: uint8(m.mode),
 		DC:          dcId,
 		Logger:      m.Logger,
+		OnTraffic:   m.trafficObserver(dcId),
+		Dial:        transport.DialFunc(m.dial),
+		Faults:      m.faults,
 	}
 
 	var newTransport transport.Transport
@@ -766,7 +866,7 @@ func (m *MTProto) makeRequest(data tl.Object, expectedTypes ...reflect.Type) (an
 }
 
 func (m *MTProto) makeRequestCtx(ctx context.Context, data tl.Object, expectedTypes ...reflect.Type) (any, error) {
-	return m.makeRequestCtxWithDepth(ctx, data, 0, expectedTypes...)
+	return m.invoke(ctx, data, expectedTypes...)
 }
 
 func (m *MTProto) makeRequestCtxWithDepth(ctx context.Context, data tl.Object, retryDepth int, expectedTypes ...reflect.Type) (any, error) {
@@ -774,14 +874,16 @@ func (m *MTProto) makeRequestCtxWithDepth(ctx context.Context, data tl.Object, r
 		return nil, fmt.Errorf("maximum retry depth exceeded (%d) - aborting request", m.maxRetryDepth)
 	}
 
+	// never (re)send on behalf of a caller that has already given up
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("request canceled: %w", err)
+	}
+	if m.terminated.Load() {
+		return nil, errTerminated
+	}
+
 	if err := m.tcpState.WaitForActive(ctx); err != nil {
-		if m.shouldRetryError(fmt.Errorf("tcp inactive: %w", err)) && retryDepth < m.maxRetryDepth {
-			m.Logger.Trace("tcp inactive, retrying (depth=%d/%d)", retryDepth+1, m.maxRetryDepth)
-			retryCtx, cancel := context.WithTimeout(context.Background(), m.reqTimeout)
-			defer cancel()
-			return m.makeRequestCtxWithDepth(retryCtx, data, retryDepth+1, expectedTypes...)
-		}
-		return nil, fmt.Errorf("tcp inactive: %w", err)
+		return m.retryFailed(ctx, data, fmt.Errorf("tcp inactive: %w", err), false, m.shouldRetryError, retryDepth, expectedTypes...)
 	}
 
 	respChan, msgID, err := m.sendPacket(data, expectedTypes...)
@@ -798,13 +900,7 @@ func (m *MTProto) makeRequestCtxWithDepth(ctx context.Context, data tl.Object, r
 			return nil, fmt.Errorf("max retries reached after transport error: %w", err)
 		}
 
-		if m.shouldRetryError(err) && retryDepth < m.maxRetryDepth {
-			m.Logger.Trace("retrying request (depth=%d/%d): %v", retryDepth+1, m.maxRetryDepth, err)
-			retryCtx, cancel := context.WithTimeout(context.Background(), m.reqTimeout)
-			defer cancel()
-			return m.makeRequestCtxWithDepth(retryCtx, data, retryDepth+1, expectedTypes...)
-		}
-		return nil, err
+		return m.retryFailed(ctx, data, err, false, m.shouldRetryError, retryDepth, expectedTypes...)
 	}
 
 	if msgID != 0 {
@@ -846,17 +942,15 @@ func (m *MTProto) makeRequestCtxWithDepth(ctx context.Context, data tl.Object, r
 			m.consecutiveTimeouts.Store(0)
 		}
 
-		err := fmt.Errorf("request timeout: %w", ctx.Err())
-		if m.shouldRetryError(err) && retryDepth < m.maxRetryDepth {
-			m.Logger.Trace("timeout retry (depth=%d/%d)", retryDepth+1, m.maxRetryDepth)
-			retryCtx, cancel := context.WithTimeout(context.Background(), m.reqTimeout)
-			defer cancel()
-			return m.makeRequestCtxWithDepth(retryCtx, data, retryDepth+1, expectedTypes...)
-		}
-		return nil, err
+		return m.retryFailed(ctx, data, fmt.Errorf("request timeout: %w", ctx.Err()), true, m.shouldRetryError, retryDepth, expectedTypes...)
 
-	case resp := <-respChan:
+	case resp, ok := <-respChan:
+		if !ok {
+			// Terminate closes the channels of the requests still waiting
+			return nil, errTerminated
+		}
 		m.consecutiveTimeouts.Store(0)
+		m.record(data, resp)
 		if msgID != 0 {
 			m.messageTracker.Delete(int(msgID))
 			if reqType, ok := m.messageTypesMap.LoadAndDelete(msgID); ok {
@@ -871,19 +965,46 @@ func (m *MTProto) makeRequestCtxWithDepth(ctx context.Context, data tl.Object, r
 				}
 			}
 		}
-		return m.handleRPCResult(data, resp, expectedTypes...)
+		return m.handleRPCResult(ctx, data, resp, retryDepth, expectedTypes...)
 	}
 }
 
+// errTerminated fails the requests made through, or still waiting on, a terminated connection.
+var errTerminated = errors.New("connection terminated")
+
+// callerCtxKey marks contexts handed in through MakeRequestCtx; its value is the caller's context.
+type callerCtxKey struct{}
+
+// retryContext returns the context a retried request runs under. Requests made with a
+// caller supplied context keep honouring its cancellation and deadline across retries,
+// everything else gets a fresh request timeout per attempt.
+func (m *MTProto) retryContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if caller, ok := ctx.Value(callerCtxKey{}).(context.Context); ok {
+		return m.attemptContext(caller)
+	}
+	return context.WithTimeout(context.Background(), m.reqTimeout)
+}
+
+// attemptContext bounds a single attempt made with caller by the request timeout,
+// unless the caller already set a deadline of its own.
+func (m *MTProto) attemptContext(caller context.Context) (context.Context, context.CancelFunc) {
+	ctx := context.WithValue(caller, callerCtxKey{}, caller)
+	if _, ok := caller.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, m.reqTimeout)
+}
+
 func (m *MTProto) shouldRetryError(err error) bool {
 	return m.errorHandler != nil && m.errorHandler(err)
 }
 
-func (m *MTProto) handleRPCResult(data tl.Object, response tl.Object, expectedTypes ...reflect.Type) (any, error) {
+func (m *MTProto) handleRPCResult(ctx context.Context, data tl.Object, response tl.Object, retryDepth int, expectedTypes ...reflect.Type) (any, error) {
 	switch r := response.(type) {
 	case *objects.RpcError:
+		err := RpcErrorToNative(r, utils.FmtMethod(data))
 		var rpcError *ErrResponseCode
-		errors.As(RpcErrorToNative(r, utils.FmtMethod(data)), &rpcError)
+		errors.As(err, &rpcError)
 
 		// handle dc migration (code 303)
 		if rpcError.Code == 303 {
@@ -899,21 +1020,27 @@ func (m *MTProto) handleRPCResult(data tl.Object, response tl.Object, expectedTy
 					}
 				}
 			}
-			return nil, rpcError
+			return nil, err
 		}
 
 		// handle flood wait errors (code 420)
 		if strings.Contains(rpcError.Message, "FLOOD_WAIT_") || strings.Contains(rpcError.Message, "FLOOD_PREMIUM_WAIT_") {
-			if m.floodHandler(rpcError) {
-				ctx, cancel := context.WithTimeout(context.Background(), m.reqTimeout)
-				defer cancel()
-				return m.makeRequestCtx(ctx, data, expectedTypes...)
+			if seconds, ok := rpcError.AdditionalInfo.(int); ok {
+				if m.metrics != nil {
+					m.metrics.ObserveFloodWait(utils.FmtMethod(data), seconds)
+				}
+				if m.onFloodWait != nil {
+					m.onFloodWait(utils.FmtMethod(data), data, time.Duration(seconds)*time.Second)
+				}
 			}
-			return nil, rpcError
+			return m.retryFailed(ctx, data, err, true, func(error) bool { return m.floodHandler(rpcError) }, retryDepth, expectedTypes...)
 		}
 
 		m.Logger.Trace("rpc error: code=%d message=%s", rpcError.Code, rpcError.Message)
-		return nil, rpcError
+		return m.retryFailed(ctx, data, err, true, nil, retryDepth, expectedTypes...)
+
+	case *errorRequestLost:
+		return m.retryFailed(ctx, data, r, true, nil, retryDepth, expectedTypes...)
 
 	case *errorSessionConfigsChanged:
 		if m.exported {
@@ -921,10 +1048,10 @@ func (m *MTProto) handleRPCResult(data tl.Object, response tl.Object, expectedTy
 		} else {
 			m.Logger.Debug("session config changed, retrying request")
 		}
-		ctx, cancel := context.WithTimeout(context.Background(), m.reqTimeout)
+		retryCtx, cancel := m.retryContext(ctx)
 		defer cancel()
 		// Start fresh with depth 0 for session config changes
-		return m.makeRequestCtxWithDepth(ctx, data, 0, expectedTypes...)
+		return m.makeRequestCtxWithDepth(retryCtx, data, 0, expectedTypes...)
 	}
 
 	return tl.UnwrapNativeTypes(response), nil
@@ -956,7 +1083,9 @@ func (m *MTProto) stopRoutines() {
 	}
 	m.transportMu.Unlock()
 
-	m.notifyPendingRequestsOfConfigChange()
+	if !m.keepPending.Load() {
+		m.notifyPendingRequestsOfConfigChange()
+	}
 }
 
 func (m *MTProto) Disconnect() error {
@@ -980,6 +1109,7 @@ func (m *MTProto) Disconnect() error {
 
 func (m *MTProto) Terminate() error {
 	m.terminated.Store(true)
+	m.DetachPool()
 	m.stopRoutines()
 	m.responseChannels.Close()
 
@@ -1018,17 +1148,36 @@ func (m *MTProto) Reconnect(loggy bool) error {
 		m.Logger.Debug("reconnecting to %s (%s)", utils.FmtIP(m.GetAddr()), m.GetTransportType())
 	}
 
+	// keep in-flight requests waiting across the reconnect; their fate is
+	// resolved with msgs_state_req once the new connection is up. The snapshot
+	// is taken only then, so requests sent while reconnecting are covered too.
+	m.keepPending.Store(true)
+
 	err := m.Disconnect()
 	if err != nil {
 		m.Logger.WithError(err).Warn("error during disconnect in reconnect")
 	}
 
 	err = m.CreateConnection(loggy)
+	pending := m.pendingRPCs()
+	m.keepPending.Store(false)
+	if m.metrics != nil {
+		m.metrics.ObserveReconnect(m.GetDC(), err)
+	}
 	if err != nil {
+		m.notifyPendingRequestsOfConfigChange()
 		m.Logger.WithError(err).Error("failed to recreate connection")
 		return fmt.Errorf("recreating connection: %w", err)
 	}
 
+	if len(pending) > 0 {
+		go func() {
+			if err := m.recoverPendingRequests(pending); err != nil {
+				m.Logger.Debug("recovering pending requests: %v", err)
+			}
+		}()
+	}
+
 	duration := time.Since(startTime)
 	if loggy {
 		m.Logger.Info("reconnected to %s (%s) in %v", utils.FmtIP(m.GetAddr()), m.GetTransportType(), duration)
@@ -1256,6 +1405,7 @@ func (m *MTProto) readMsg() error {
 			return fmt.Errorf("reading message: %w", err)
 		}
 	}
+	m.lastInbound.Store(time.Now().UnixNano())
 
 	if m.serviceModeActivated {
 		var obj tl.Object
@@ -1309,6 +1459,7 @@ messageTypeSwitching:
 
 	case *objects.BadServerSalt:
 		m.serverSalt.Store(message.NewSalt)
+		m.invalidateSalts()
 		if err := m.SaveSession(m.memorySession); err != nil {
 			m.Logger.Debug("failed to save session: %v", err)
 		}
@@ -1359,6 +1510,17 @@ messageTypeSwitching:
 		m.Logger.Debug("bad-msg-notification: code=%d msg=%s", badMsg.Code, badMsg.Error())
 		return badMsg
 
+	case *objects.FutureSalts:
+		// future_salts is not wrapped in rpc_result, but still answers a request
+		if err := m.writeRPCResponse(int(message.ReqMsgID), message); err != nil {
+			m.Logger.Debug("writing future salts response: %v", err)
+		}
+
+	case *objects.MsgsStateInfo:
+		if err := m.writeRPCResponse(int(message.ReqMsgID), message); err != nil {
+			m.Logger.Debug("writing msgs state info: %v", err)
+		}
+
 	case *objects.RpcResult:
 		obj := message.Obj
 		if v, ok := obj.(*objects.GzipPacked); ok {
@@ -1381,6 +1543,9 @@ messageTypeSwitching:
 		goto messageTypeSwitching
 
 	default:
+		if m.recorder != nil {
+			m.recorder.recordUpdate(m.GetDC(), message, msg.GetMsg())
+		}
 		processed := false
 		for _, f := range m.serverRequestHandlers {
 			processed = f(message)
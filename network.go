@@ -28,44 +28,26 @@ func (m *MTProto) sendPacketWithMsgID(request tl.Object, msgID int64, expectedTy
 		return nil, 0, fmt.Errorf("marshaling request: %w", err)
 	}
 
-	if msgID == 0 {
-		msgID = m.genMsgID(m.timeOffset.Load())
-	}
-
-	if len(expectedTypes) > 0 {
-		m.expectedTypes.Add(int(msgID), expectedTypes)
-	}
-
 	resp := m.getRespChannel()
-	if isNullableResponse(request) {
+	nullable := isNullableResponse(request)
+	if nullable {
 		go func() {
 			resp <- &objects.Null{}
 		}()
-	} else {
-		m.responseChannels.Add(int(msgID), resp)
 	}
 
-	var data messages.Common
-	if m.encrypted.Load() {
-		data = &messages.Encrypted{
-			Msg:         msg,
-			MsgID:       msgID,
-			AuthKeyHash: m.authKeyHash,
+	// register delivers the response to the message, once it has its msg_id, to resp;
+	// batched messages get theirs, unless preset, when their frame is flushed
+	register := func(id int64) {
+		msgID = id
+		if len(expectedTypes) > 0 {
+			m.expectedTypes.Add(int(msgID), expectedTypes)
 		}
-	} else {
-		data = &messages.Unencrypted{
-			Msg:   msg,
-			MsgID: msgID,
+		if !nullable {
+			m.responseChannels.Add(int(msgID), resp)
 		}
 	}
 
-	var seqNo int32
-	if isNotContentRelated(request) {
-		seqNo = m.GetSeqNo()
-	} else {
-		seqNo = m.UpdateSeqNo()
-	}
-
 	if m.transport == nil || !m.IsTcpActive() {
 		err := m.CreateConnection(false)
 		if err != nil || m.transport == nil {
@@ -73,15 +55,48 @@ func (m *MTProto) sendPacketWithMsgID(request tl.Object, msgID int64, expectedTy
 		}
 	}
 
+	contentRelated := !isNotContentRelated(request)
 	maxRetries := 2
 sendPacket:
 	m.transportMu.Lock()
 	if m.transport == nil {
 		m.transportMu.Unlock()
+		m.releaseResponse(msgID)
 		return nil, 0, errors.New("transport is nil during write")
 	}
-	errorSendPacket := m.transport.WriteMsg(data, seqNo)
-	m.transportMu.Unlock()
+	var errorSendPacket error
+	if m.encrypted.Load() && m.batcher != nil && !m.serviceModeActivated {
+		m.transportMu.Unlock()
+		errorSendPacket = m.batcher.write(&outgoingMsg{
+			msg: &messages.Encrypted{
+				Msg:         msg,
+				MsgID:       msgID,
+				AuthKeyHash: m.authKeyHash,
+			},
+			contentRelated: contentRelated,
+			register:       register,
+		})
+	} else {
+		if msgID == 0 {
+			msgID = m.genMsgID(m.timeOffset.Load())
+		}
+		register(msgID)
+		var data messages.Common
+		if m.encrypted.Load() {
+			data = &messages.Encrypted{
+				Msg:         msg,
+				MsgID:       msgID,
+				AuthKeyHash: m.authKeyHash,
+			}
+		} else {
+			data = &messages.Unencrypted{
+				Msg:   msg,
+				MsgID: msgID,
+			}
+		}
+		errorSendPacket = m.transport.WriteMsg(data, m.nextSeqNo(contentRelated))
+		m.transportMu.Unlock()
+	}
 
 	if errorSendPacket != nil {
 		if maxRetries > 0 && (strings.Contains(errorSendPacket.Error(), "connection was aborted") || strings.Contains(errorSendPacket.Error(), "connection reset")) {
@@ -91,11 +106,19 @@ sendPacket:
 				goto sendPacket
 			}
 		}
+		m.releaseResponse(msgID)
 		return nil, msgID, fmt.Errorf("writing message: %w", errorSendPacket)
 	}
 	return resp, msgID, nil
 }
 
+// releaseResponse forgets the response channel of a request that was never written,
+// which would otherwise be kept across a reconnect and queried as pending.
+func (m *MTProto) releaseResponse(msgID int64) {
+	m.responseChannels.Delete(int(msgID))
+	m.expectedTypes.Delete(int(msgID))
+}
+
 func (m *MTProto) writeRPCResponse(msgID int, data tl.Object) error {
 	v, ok := m.responseChannels.Get(msgID)
 	if !ok {
@@ -130,7 +153,8 @@ func (m *MTProto) getRespChannel() chan tl.Object {
 	if m.serviceModeActivated {
 		return m.serviceChannel
 	}
-	return make(chan tl.Object)
+	// buffered, so an answer arriving before the caller waits on it is not dropped
+	return make(chan tl.Object, 1)
 }
 
 func isNotContentRelated(t tl.Object) bool {
@@ -146,7 +170,7 @@ func isNotContentRelated(t tl.Object) bool {
 
 func isNullableResponse(t tl.Object) bool {
 	switch t.(type) {
-	case *objects.Pong, *objects.MsgsAck:
+	case *objects.Pong, *objects.MsgsAck, *objects.MsgResendReq, *objects.MsgResendAnsReq:
 		return true
 	default:
 		return false
@@ -167,6 +191,14 @@ func (m *MTProto) UpdateSeqNo() int32 {
 	return (m.currentSeqNo.Add(1)-1)*2 + 1
 }
 
+// nextSeqNo returns the seqno of the next message, counting it if it is content-related.
+func (m *MTProto) nextSeqNo(contentRelated bool) int32 {
+	if contentRelated {
+		return m.UpdateSeqNo()
+	}
+	return m.GetSeqNo()
+}
+
 // GetServerSalt returns current server salt
 func (m *MTProto) GetServerSalt() int64 {
 	return m.serverSalt.Load()
@@ -191,7 +223,11 @@ func (m *MTProto) MakeRequest(msg tl.Object) (any, error) {
 	return m.makeRequest(msg)
 }
 
+// MakeRequestCtx sends msg and waits for its answer until ctx is done. Retries stay
+// bound to ctx; if ctx carries no deadline, each attempt is limited by the request timeout.
 func (m *MTProto) MakeRequestCtx(ctx context.Context, msg tl.Object) (any, error) {
+	ctx, cancel := m.attemptContext(ctx)
+	defer cancel()
 	return m.makeRequestCtx(ctx, msg)
 }
 
@@ -207,12 +243,23 @@ func (m *MTProto) AddCustomServerRequestHandler(handler func(i any) bool) {
 }
 
 func (m *MTProto) SaveSession(mem bool) (err error) {
+	// exported senders to other DCs hand their key to the session of their parent
+	if m.parent != nil {
+		if (m.exportedDC != m.parent.GetDC() || m.cdn) && len(m.authKey) > 0 {
+			m.parent.storeDCAuth(m.dcAuth())
+		}
+		return nil
+	}
+
 	sess := &session.Session{
-		Key:      m.authKey,
-		Hash:     m.authKeyHash,
-		Salt:     m.serverSalt.Load(),
-		Hostname: m.GetAddr(),
-		AppID:    m.appID,
+		Key:         m.authKey,
+		Hash:        m.authKeyHash,
+		Salt:        m.serverSalt.Load(),
+		Hostname:    m.GetAddr(),
+		AppID:       m.appID,
+		FutureSalts: m.salts.snapshot(),
+		TimeOffset:  m.timeOffset.Load(),
+		DCs:         m.dcAuthSnapshot(),
 	}
 
 	if !mem {
@@ -233,6 +280,14 @@ func (m *MTProto) _loadSession(s *session.Session) {
 	m.serverSalt.Store(s.Salt)
 	m.SetAddr(s.Hostname)
 	m.appID = s.AppID
+	m.timeOffset.Store(s.TimeOffset)
+	m.setDCAuths(s.DCs)
+	if len(s.FutureSalts) > 0 {
+		m.salts.set(s.FutureSalts, 0)
+		if salt, _, ok := m.salts.current(); ok {
+			m.serverSalt.Store(salt)
+		}
+	}
 }
 
 func (m *MTProto) reqPQ(nonce *tl.Int128) (*objects.ResPQ, error) {
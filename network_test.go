@@ -0,0 +1,37 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package gogram
+
+import (
+	"testing"
+
+	"github.com/amarnathcjd/gogram/internal/mtproto/objects"
+	"github.com/amarnathcjd/gogram/internal/utils"
+)
+
+// An answer read before the caller starts waiting for it is kept, not dropped.
+func TestResponseBeforeWait(t *testing.T) {
+	m := &MTProto{
+		responseChannels: utils.NewSyncIntObjectChan(),
+		expectedTypes:    utils.NewSyncIntReflectTypes(),
+	}
+	const msgID = 42
+	resp := m.getRespChannel()
+	m.responseChannels.Add(msgID, resp)
+
+	answer := &objects.Pong{MsgID: msgID, PingID: 7}
+	if err := m.writeRPCResponse(msgID, answer); err != nil {
+		t.Fatalf("writing response: %v", err)
+	}
+	select {
+	case got := <-resp:
+		if got != answer {
+			t.Fatalf("got %#v, want %#v", got, answer)
+		}
+	default:
+		t.Fatal("response dropped")
+	}
+	if _, ok := m.responseChannels.Get(msgID); ok {
+		t.Fatal("response channel kept after the answer")
+	}
+}
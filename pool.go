@@ -0,0 +1,239 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package gogram
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+	"github.com/amarnathcjd/gogram/internal/mtproto/objects"
+	"github.com/amarnathcjd/gogram/internal/utils"
+)
+
+const (
+	defaultStallTimeout = 20 * time.Second
+	poolMonitorInterval = 5 * time.Second
+)
+
+var serviceMessagesPkg = reflect.TypeFor[objects.Null]().PkgPath()
+
+// connPool spreads requests over several connections to the home DC that share
+// the auth key but each run their own session.
+type connPool struct {
+	mu      sync.RWMutex
+	members []*poolMember
+	wrap    func(TLObject) TLObject
+
+	stallTimeout time.Duration
+	stop         chan struct{}
+	stopOnce     sync.Once
+}
+
+type poolMember struct {
+	conn         *MTProto
+	pending      atomic.Int32
+	lastProgress atomic.Int64 // unix nano of the last answer or of the first request after idling
+	evicted      atomic.Bool
+}
+
+func (p *poolMember) begin() {
+	if p.pending.Add(1) == 1 {
+		p.lastProgress.Store(time.Now().UnixNano())
+	}
+}
+
+func (p *poolMember) end() {
+	p.pending.Add(-1)
+	p.lastProgress.Store(time.Now().UnixNano())
+}
+
+// stalled tells whether requests are in flight on the connection while neither an
+// answer nor any other message came in over it for timeout.
+func (p *poolMember) stalled(now time.Time, timeout time.Duration) bool {
+	last := max(p.lastProgress.Load(), p.conn.lastInbound.Load())
+	return p.pending.Load() > 0 && now.Sub(time.Unix(0, last)) > timeout
+}
+
+// PoolConfig configures the connection pool of the home DC.
+type PoolConfig struct {
+	// Wrap is applied to every request sent over a pooled connection, typically to
+	// invoke it without subscribing that connection to updates.
+	Wrap func(TLObject) TLObject
+	// A pooled connection with requests in flight that received nothing for this
+	// long is taken out of rotation and reconnected (default: 20s).
+	StallTimeout time.Duration
+}
+
+// AttachPool routes requests made through this connection over conns as well,
+// picking whichever connection has the fewest requests in flight. The connections
+// must belong to the same DC and auth key; they are terminated with this one.
+func (m *MTProto) AttachPool(conns []*MTProto, cfg PoolConfig) {
+	pool := &connPool{
+		wrap:         cfg.Wrap,
+		stallTimeout: utils.OrDefault(cfg.StallTimeout, defaultStallTimeout),
+		stop:         make(chan struct{}),
+	}
+	pool.members = append(pool.members, &poolMember{conn: m})
+	for _, conn := range conns {
+		pool.members = append(pool.members, &poolMember{conn: conn})
+	}
+
+	m.poolMu.Lock()
+	old := m.pool
+	m.pool = pool
+	m.poolMu.Unlock()
+
+	if old != nil {
+		old.close(m)
+	}
+	go pool.monitor(m)
+	m.Logger.Debug("connection pool of %d connections attached", len(pool.members))
+}
+
+// DetachPool terminates the pooled connections and sends everything over this connection again.
+func (m *MTProto) DetachPool() {
+	m.poolMu.Lock()
+	pool := m.pool
+	m.pool = nil
+	m.poolMu.Unlock()
+
+	if pool != nil {
+		pool.close(m)
+	}
+}
+
+// PoolStats returns the number of requests in flight on each pooled connection,
+// this connection first. It returns nil when no pool is attached.
+func (m *MTProto) PoolStats() []int {
+	m.poolMu.RLock()
+	pool := m.pool
+	m.poolMu.RUnlock()
+	if pool == nil {
+		return nil
+	}
+
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+	stats := make([]int, 0, len(pool.members))
+	for _, member := range pool.members {
+		stats = append(stats, int(member.pending.Load()))
+	}
+	return stats
+}
+
+// route sends data over the least loaded pooled connection, or over m itself
+// when no pool is attached. Service messages always stay on m.
+func (m *MTProto) route(ctx context.Context, data tl.Object, expectedTypes ...reflect.Type) (any, error) {
+	m.poolMu.RLock()
+	pool := m.pool
+	m.poolMu.RUnlock()
+
+	if pool == nil || isServiceMessage(data) {
+		return m.makeRequestCtxWithDepth(ctx, data, 0, expectedTypes...)
+	}
+
+	member := pool.pick()
+	member.begin()
+	defer member.end()
+
+	if member.conn == m {
+		return m.makeRequestCtxWithDepth(ctx, data, 0, expectedTypes...)
+	}
+	if pool.wrap != nil {
+		data = pool.wrap(data)
+	}
+	return member.conn.makeRequestCtxWithDepth(ctx, data, 0, expectedTypes...)
+}
+
+// pick returns the connection with the fewest requests in flight.
+func (p *connPool) pick() *poolMember {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	var best *poolMember
+	for _, member := range p.members {
+		if member.evicted.Load() || member.conn.terminated.Load() {
+			continue
+		}
+		if best == nil || member.pending.Load() < best.pending.Load() {
+			best = member
+		}
+	}
+	if best == nil {
+		// the owning connection is never evicted
+		best = p.members[0]
+	}
+	return best
+}
+
+// monitor evicts stalled connections and brings them back once reconnected.
+func (p *connPool) monitor(owner *MTProto) {
+	ticker := time.NewTicker(poolMonitorInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case now := <-ticker.C:
+			p.mu.RLock()
+			members := slices.Clone(p.members[1:])
+			p.mu.RUnlock()
+
+			for _, member := range members {
+				if member.evicted.Load() || !member.stalled(now, p.stallTimeout) {
+					continue
+				}
+				member.evicted.Store(true)
+				owner.Logger.Debug("pooled connection stalled with %d requests in flight, evicting", member.pending.Load())
+				go func() {
+					if err := member.conn.Reconnect(false); err != nil {
+						owner.Logger.Debug("reconnecting pooled connection: %v", err)
+						p.remove(member)
+						member.conn.Terminate()
+						return
+					}
+					member.lastProgress.Store(time.Now().UnixNano())
+					member.evicted.Store(false)
+				}()
+			}
+		}
+	}
+}
+
+func (p *connPool) remove(member *poolMember) {
+	p.mu.Lock()
+	p.members = slices.DeleteFunc(p.members, func(o *poolMember) bool { return o == member })
+	p.mu.Unlock()
+}
+
+func (p *connPool) close(owner *MTProto) {
+	p.stopOnce.Do(func() {
+		close(p.stop)
+		p.mu.RLock()
+		defer p.mu.RUnlock()
+		for _, member := range p.members {
+			if member.conn != owner {
+				member.conn.Terminate()
+			}
+		}
+	})
+}
+
+// isServiceMessage reports whether obj is an MTProto service message, which is
+// bound to the session it is sent on.
+func isServiceMessage(obj tl.Object) bool {
+	t := reflect.TypeOf(obj)
+	if t == nil {
+		return false
+	}
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.PkgPath() == serviceMessagesPkg
+}
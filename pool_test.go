@@ -0,0 +1,30 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package gogram
+
+import (
+	"testing"
+	"time"
+)
+
+// A connection still receiving messages is not stalled, however slow its answers.
+func TestPoolMemberStalled(t *testing.T) {
+	const timeout = 20 * time.Second
+	now := time.Now()
+	member := &poolMember{conn: &MTProto{}}
+	member.pending.Store(1)
+	member.lastProgress.Store(now.Add(-time.Minute).UnixNano())
+
+	member.conn.lastInbound.Store(now.Add(-time.Second).UnixNano())
+	if member.stalled(now, timeout) {
+		t.Fatal("stalled despite inbound traffic")
+	}
+	member.conn.lastInbound.Store(now.Add(-time.Minute).UnixNano())
+	if !member.stalled(now, timeout) {
+		t.Fatal("not stalled without inbound traffic")
+	}
+	member.pending.Store(0)
+	if member.stalled(now, timeout) {
+		t.Fatal("stalled without requests in flight")
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package gogram
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+	"github.com/amarnathcjd/gogram/internal/mtproto/objects"
+)
+
+// https://core.telegram.org/mtproto/service_messages_about_messages#request-for-message-status-information
+const (
+	msgStateUnknown     = 1  // nothing is known about the message, msg_id too low
+	msgStateNotReceived = 2  // msg_id within the stored range, but certainly not received
+	msgStateTooHigh     = 3  // msg_id too high, certainly not received yet
+	msgStateReceived    = 4  // message received
+	msgStateProcessing  = 32 // rpc query being processed or processing already complete
+	msgStateAnswered    = 64 // content-related response to message already generated
+)
+
+// pendingRPCs returns the msg_ids of RPC requests that are still waiting for an answer.
+func (m *MTProto) pendingRPCs() []int64 {
+	var ids []int64
+	for _, id := range m.responseChannels.Keys() {
+		if _, ok := m.messageTypesMap.Load(int64(id)); ok {
+			ids = append(ids, int64(id))
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// recoverPendingRequests asks the server what happened to requests that were in flight
+// when the connection dropped. Requests the server never received are re-sent, answers
+// that were generated but lost are re-requested with msg_resend_ans_req, and requests the
+// server has no record of are failed instead of being blindly executed twice.
+func (m *MTProto) recoverPendingRequests(ids []int64) error {
+	ids = slices.DeleteFunc(ids, func(id int64) bool {
+		return !m.responseChannels.Has(int(id))
+	})
+	if len(ids) == 0 {
+		return nil
+	}
+
+	m.Logger.Debug("querying state of %d pending requests after reconnect", len(ids))
+	resp, err := m.MakeRequest(&objects.MsgsStateReq{MsgIDs: ids})
+	if err != nil {
+		return fmt.Errorf("sending msgs_state_req: %w", err)
+	}
+
+	info, ok := resp.(*objects.MsgsStateInfo)
+	if !ok {
+		return fmt.Errorf("got invalid response type for msgs_state_req: %T", resp)
+	}
+	if len(info.Info) != len(ids) {
+		return fmt.Errorf("msgs_state_info has %d entries, want %d", len(info.Info), len(ids))
+	}
+
+	var resendAnswers []int64
+	for i, id := range ids {
+		state := info.Info[i]
+		switch state & 7 {
+		case msgStateNotReceived, msgStateTooHigh:
+			m.Logger.Trace("request %d never reached the server, resending", id)
+			m.deliverPending(id, &errorSessionConfigsChanged{})
+		case msgStateReceived:
+			if state&msgStateAnswered != 0 {
+				resendAnswers = append(resendAnswers, id)
+			}
+			// otherwise the server is still processing it and will answer on its own
+		case msgStateUnknown:
+			m.Logger.Debug("server has no record of request %d, failing it", id)
+			m.deliverPending(id, &errorRequestLost{msgID: id})
+		}
+	}
+
+	if len(resendAnswers) > 0 {
+		m.Logger.Trace("requesting %d lost answers", len(resendAnswers))
+		if _, err := m.MakeRequest(&objects.MsgResendAnsReq{MsgIDs: resendAnswers}); err != nil {
+			return fmt.Errorf("sending msg_resend_ans_req: %w", err)
+		}
+	}
+	return nil
+}
+
+// deliverPending hands obj to the caller waiting on msgID, removing it from the pending set.
+func (m *MTProto) deliverPending(msgID int64, obj tl.Object) {
+	ch, ok := m.responseChannels.Get(int(msgID))
+	if !ok {
+		return
+	}
+	m.responseChannels.Delete(int(msgID))
+	m.expectedTypes.Delete(int(msgID))
+
+	select {
+	case ch <- obj:
+	case <-time.After(1 * time.Millisecond):
+	}
+}
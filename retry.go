@@ -0,0 +1,277 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package gogram
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+	"github.com/amarnathcjd/gogram/internal/utils"
+)
+
+const (
+	defaultRetryBaseDelay = 500 * time.Millisecond
+	defaultRetryMaxDelay  = 30 * time.Second
+)
+
+// Idempotency tells whether a request may be sent again when it may already have
+// been executed, that is after a timeout, a lost request or an internal server error.
+type Idempotency uint8
+
+const (
+	IdempotentReads  Idempotency = iota // Only methods that read, like messages.getHistory or users.getUsers
+	IdempotentAlways                    // Every method
+	IdempotentNever                     // No method
+)
+
+// RetryPolicy tells how a failed request is sent again. Every attempt counts toward
+// MaxAttempts, whatever it failed with; the zero value never retries.
+type RetryPolicy struct {
+	MaxAttempts  int           // Attempts in total, the first one included
+	BaseDelay    time.Duration // Backoff before the first retry, doubled for every other (default: 500ms)
+	MaxDelay     time.Duration // Upper bound of the backoff (default: 30s)
+	Jitter       float64       // Fraction of each backoff chosen at random, from 0 to 1
+	MaxFloodWait time.Duration // Flood waits up to this long are slept through before retrying; longer ones fail
+	Idempotency  Idempotency   // Whether the request may be resent when it may have been executed already
+	FallbackDC   int           // DC to send the request through once attempts on its own DC are used up (0: none)
+}
+
+// RetryPolicies chooses the policy of a failed request: the one of its method if
+// there is one, else the one of the class of the error, else Default. A request
+// covered by a policy is sent again only as the policy allows; ErrorHandler and
+// FloodHandler are asked only for requests none covers, which takes a zero Default.
+type RetryPolicies struct {
+	Default RetryPolicy
+	Methods map[string]RetryPolicy     // Per method, keyed like RequestInfo.Method (e.g. "MessagesSendMessage")
+	Classes map[RetryClass]RetryPolicy // Per class of error; timeouts and lost requests are RetryWithBackoff
+}
+
+// DefaultRetryPolicies returns policies that make up to three attempts at requests
+// failing with server errors or timeouts, absorb flood waits of up to a minute, and
+// resend only methods that read when a request may have been executed already.
+func DefaultRetryPolicies() *RetryPolicies {
+	return &RetryPolicies{
+		Default: RetryPolicy{
+			MaxAttempts:  3,
+			BaseDelay:    defaultRetryBaseDelay,
+			MaxDelay:     defaultRetryMaxDelay,
+			Jitter:       0.2,
+			MaxFloodWait: time.Minute,
+		},
+	}
+}
+
+// policy returns the policy of method failing with an error of class, and false
+// if none covers it.
+func (p *RetryPolicies) policy(method string, class RetryClass) (RetryPolicy, bool) {
+	if policy, ok := p.Methods[method]; ok {
+		return policy, true
+	}
+	if policy, ok := p.Classes[class]; ok {
+		return policy, true
+	}
+	return p.Default, p.Default != RetryPolicy{}
+}
+
+// maxAttempts returns the most attempts any of the policies allows.
+func (p *RetryPolicies) maxAttempts() int {
+	n := p.Default.MaxAttempts
+	for _, policy := range p.Methods {
+		n = max(n, policy.MaxAttempts)
+	}
+	for _, policy := range p.Classes {
+		n = max(n, policy.MaxAttempts)
+	}
+	return n
+}
+
+// backoff returns the delay before retry number attempt, counted from zero.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	base := utils.OrDefault(p.BaseDelay, defaultRetryBaseDelay)
+	maxDelay := utils.OrDefault(p.MaxDelay, defaultRetryMaxDelay)
+	delay := maxDelay
+	if attempt < 32 && base<<attempt > 0 {
+		delay = min(base<<attempt, maxDelay)
+	}
+	if jitter := min(max(p.Jitter, 0), 1); jitter > 0 {
+		delay -= time.Duration(rand.Float64() * jitter * float64(delay))
+	}
+	return delay
+}
+
+func (p RetryPolicy) idempotent(req tl.Object) bool {
+	switch p.Idempotency {
+	case IdempotentAlways:
+		return true
+	case IdempotentNever:
+		return false
+	}
+	return IsReadMethod(req)
+}
+
+// retryAfter tells whether req, which failed with err on attempt number attempt,
+// may be sent again under p, and after how long.
+func (p RetryPolicy) retryAfter(req tl.Object, err error, sent bool, attempt int) (time.Duration, bool) {
+	if attempt+1 >= p.MaxAttempts {
+		return 0, false
+	}
+	var flood *FloodWaitError
+	if errors.As(err, &flood) {
+		wait := time.Duration(flood.Seconds) * time.Second
+		return wait, wait <= p.MaxFloodWait
+	}
+	if retryClassOf(err) != RetryWithBackoff || (mayHaveRun(err, sent) && !p.idempotent(req)) {
+		return 0, false
+	}
+	return p.backoff(attempt), true
+}
+
+// retryClassOf extends ErrResponseCode.RetryClass to the errors of requests
+// that got no answer.
+func retryClassOf(err error) RetryClass {
+	var rpcErr *ErrResponseCode
+	var lost *errorRequestLost
+	switch {
+	case errors.As(err, &rpcErr):
+		return rpcErr.RetryClass()
+	case errors.As(err, &lost), errors.Is(err, context.DeadlineExceeded):
+		return RetryWithBackoff
+	}
+	return RetryNever
+}
+
+// mayHaveRun tells whether a request that failed with err may have been executed
+// by the server anyway. sent tells whether it was written to the connection.
+func mayHaveRun(err error, sent bool) bool {
+	if !sent {
+		return false
+	}
+	var rpcErr *ErrResponseCode
+	if errors.As(err, &rpcErr) {
+		return rpcErr.RetryClass() == RetryWithBackoff
+	}
+	return true
+}
+
+// readVerbs start the names of the methods that change nothing on the server.
+// "check" is left out, as auth.checkPassword uses up its SRP challenge.
+var readVerbs = []string{"get", "search", "resolve"}
+
+// notReads are methods named like reads that have side effects.
+var notReads = map[string]bool{
+	"messages.getBotCallbackAnswer": true, // presses the button
+	"messages.getMessagesViews":     true, // counts a view when increment is set
+	"messages.getInlineBotResults":  true, // sends the query to the bot
+}
+
+// IsReadMethod tells from its schema name, like messages.getHistory, whether req
+// only reads, looking through invokeWithLayer and the like.
+func IsReadMethod(req tl.Object) bool {
+	name, ok := tl.Name(unwrapQuery(req).CRC())
+	if !ok || notReads[name] {
+		return false
+	}
+	_, method, _ := strings.Cut(name, ".")
+	verb := method
+	if i := strings.IndexFunc(method, unicode.IsUpper); i >= 0 {
+		verb = method[:i]
+	}
+	return slices.Contains(readVerbs, verb)
+}
+
+// unwrapQuery returns the request wrapped by invokeWithLayer, invokeWithoutUpdates
+// and the like, or req itself.
+func unwrapQuery(req tl.Object) tl.Object {
+	for {
+		v := reflect.ValueOf(req)
+		if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+			return req
+		}
+		field := v.Elem().FieldByName("Query")
+		if !field.IsValid() || field.Kind() != reflect.Interface || field.IsNil() {
+			return req
+		}
+		query, ok := field.Interface().(tl.Object)
+		if !ok {
+			return req
+		}
+		req = query
+	}
+}
+
+// waitRetry sleeps for d, or until the caller of the request made with ctx gives up.
+func waitRetry(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	caller, ok := ctx.Value(callerCtxKey{}).(context.Context)
+	if !ok {
+		caller = context.Background()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-caller.Done():
+		return caller.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryFailed sends data again after it failed with err, if its retry policy allows
+// it or, when no policy covers it, handler does, and through the fallback DC of the
+// policy once attempts are used up. It returns err when the request is not sent
+// again. sent tells whether data was written to the connection.
+func (m *MTProto) retryFailed(ctx context.Context, data tl.Object, err error, sent bool, handler func(error) bool, retryDepth int, expectedTypes ...reflect.Type) (any, error) {
+	var policy RetryPolicy
+	var delay time.Duration
+	retry, covered := false, false
+	if m.retryPolicies != nil {
+		policy, covered = m.retryPolicies.policy(utils.FmtMethod(unwrapQuery(data)), retryClassOf(err))
+		if covered {
+			delay, retry = policy.retryAfter(data, err, sent, retryDepth)
+		}
+	}
+	if !covered && handler != nil {
+		retry = handler(err) && retryDepth+1 < m.maxRetryDepth
+	}
+
+	if !retry {
+		if policy.FallbackDC != 0 && retryClassOf(err) == RetryWithBackoff && (!mayHaveRun(err, sent) || policy.idempotent(data)) {
+			return m.sendToFallback(ctx, data, policy.FallbackDC, err, expectedTypes...)
+		}
+		return nil, err
+	}
+
+	m.Logger.Trace("retrying %s in %s (depth=%d/%d): %v", utils.FmtMethod(unwrapQuery(data)), delay, retryDepth+1, m.maxRetryDepth, err)
+	if waitErr := waitRetry(ctx, delay); waitErr != nil {
+		return nil, err
+	}
+	retryCtx, cancel := m.retryContext(ctx)
+	defer cancel()
+	return m.makeRequestCtxWithDepth(retryCtx, data, retryDepth+1, expectedTypes...)
+}
+
+// sendToFallback sends data through a connection to dc, returning err, the error
+// it failed with on this connection, if there is no such connection.
+func (m *MTProto) sendToFallback(ctx context.Context, data tl.Object, dc int, err error, expectedTypes ...reflect.Type) (any, error) {
+	if m.fallbackSender == nil || dc == m.GetDC() {
+		return nil, err
+	}
+	sender, senderErr := m.fallbackSender(dc)
+	if senderErr != nil {
+		m.Logger.Debug("no fallback connection to DC%d: %v", dc, senderErr)
+		return nil, err
+	}
+	m.Logger.Debug("sending %s through fallback DC%d after: %v", utils.FmtMethod(unwrapQuery(data)), dc, err)
+	retryCtx, cancel := m.retryContext(ctx)
+	defer cancel()
+	return sender.invoke(retryCtx, data, expectedTypes...)
+}
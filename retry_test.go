@@ -0,0 +1,89 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package gogram
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+)
+
+// testMethod stands in for the request of the method registered under its crc.
+type testMethod uint32
+
+func (m testMethod) CRC() uint32 { return uint32(m) }
+
+const (
+	sendMessage testMethod = 0x545cd15a
+	getHistory  testMethod = 0x4423e6c5
+)
+
+func init() {
+	tl.RegisterNames(map[uint32]string{
+		uint32(sendMessage): "messages.sendMessage",
+		uint32(getHistory):  "messages.getHistory",
+	})
+}
+
+// Under IdempotentReads, a timed out read is sent again, a timed out write is not.
+func TestRetryPolicyIdempotentReads(t *testing.T) {
+	policy := DefaultRetryPolicies().Default
+	if _, ok := policy.retryAfter(sendMessage, context.DeadlineExceeded, true, 0); ok {
+		t.Fatal("messages.sendMessage retried after a timeout")
+	}
+	delay, ok := policy.retryAfter(getHistory, context.DeadlineExceeded, true, 0)
+	if !ok || delay <= 0 {
+		t.Fatalf("messages.getHistory after a timeout: retry %v after %s", ok, delay)
+	}
+	if _, ok := policy.retryAfter(getHistory, context.DeadlineExceeded, true, policy.MaxAttempts-1); ok {
+		t.Fatal("retried past MaxAttempts")
+	}
+}
+
+// The policy of a method wins over the one of a class, which wins over Default.
+func TestRetryPoliciesPrecedence(t *testing.T) {
+	policies := &RetryPolicies{
+		Default: RetryPolicy{MaxAttempts: 1},
+		Classes: map[RetryClass]RetryPolicy{RetryWithBackoff: {MaxAttempts: 2}},
+		Methods: map[string]RetryPolicy{"MessagesSendMessage": {MaxAttempts: 3}},
+	}
+	tests := []struct {
+		method string
+		class  RetryClass
+		want   int
+	}{
+		{"MessagesSendMessage", RetryWithBackoff, 3},
+		{"MessagesGetHistory", RetryWithBackoff, 2},
+		{"MessagesGetHistory", RetryNever, 1},
+	}
+	for _, tt := range tests {
+		policy, ok := policies.policy(tt.method, tt.class)
+		if !ok || policy.MaxAttempts != tt.want {
+			t.Errorf("%s, class %d: got MaxAttempts %d (covered %v), want %d", tt.method, tt.class, policy.MaxAttempts, ok, tt.want)
+		}
+	}
+
+	if _, ok := (&RetryPolicies{}).policy("MessagesGetHistory", RetryWithBackoff); ok {
+		t.Error("zero Default covers requests")
+	}
+}
+
+// A flood wait is slept through up to MaxFloodWait, and fails past it.
+func TestRetryPolicyMaxFloodWait(t *testing.T) {
+	policy := DefaultRetryPolicies().Default
+	floodWait := func(seconds int) error {
+		return &FloodWaitError{
+			ErrResponseCode: &ErrResponseCode{Code: 420, Message: "FLOOD_WAIT_X", AdditionalInfo: seconds},
+			Seconds:         seconds,
+		}
+	}
+
+	if delay, ok := policy.retryAfter(sendMessage, floodWait(30), true, 0); !ok || delay != 30*time.Second {
+		t.Fatalf("30s flood wait: retry %v after %s, want after 30s", ok, delay)
+	}
+	if _, ok := policy.retryAfter(sendMessage, floodWait(120), true, 0); ok {
+		t.Fatal("flood wait above MaxFloodWait retried")
+	}
+}
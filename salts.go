@@ -0,0 +1,190 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package gogram
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/amarnathcjd/gogram/internal/mtproto/objects"
+	"github.com/amarnathcjd/gogram/internal/session"
+)
+
+const (
+	futureSaltsCount        = 64               // number of salts requested per get_future_salts call
+	saltSwitchMargin        = 60               // seconds before valid_until at which a salt is no longer used
+	saltRefreshBefore       = 60 * 60          // refetch when the timeline runs out within an hour
+	saltManagerMaxSleep     = 10 * time.Minute // upper bound between timeline checks
+	saltManagerRetryOnError = 30 * time.Second
+)
+
+// saltTimeline keeps the future salts announced by the server, ordered by ValidSince.
+type saltTimeline struct {
+	mu     sync.Mutex
+	salts  []session.FutureSalt
+	offset int64 // server time - local time, as reported in future_salts.now
+}
+
+func newSaltTimeline() *saltTimeline {
+	return &saltTimeline{}
+}
+
+func (t *saltTimeline) now() int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return time.Now().Unix() + t.offset
+}
+
+// set merges salts into the timeline, dropping duplicates and expired entries.
+func (t *saltTimeline) set(salts []session.FutureSalt, serverNow int32) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if serverNow != 0 {
+		t.offset = int64(serverNow) - time.Now().Unix()
+	}
+	now := time.Now().Unix() + t.offset
+
+	merged := make([]session.FutureSalt, 0, len(t.salts)+len(salts))
+	for _, s := range append(t.salts, salts...) {
+		if int64(s.ValidUntil) <= now {
+			continue
+		}
+		if slices.ContainsFunc(merged, func(o session.FutureSalt) bool { return o.Salt == s.Salt }) {
+			continue
+		}
+		merged = append(merged, s)
+	}
+	slices.SortFunc(merged, func(a, b session.FutureSalt) int {
+		return int(a.ValidSince - b.ValidSince)
+	})
+	t.salts = merged
+}
+
+func (t *saltTimeline) clear() {
+	t.mu.Lock()
+	t.salts = nil
+	t.mu.Unlock()
+}
+
+// current returns the salt to use right now, and the server time at which it should be replaced.
+func (t *saltTimeline) current() (salt int64, switchAt int64, ok bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now().Unix() + t.offset
+	for _, s := range t.salts {
+		if int64(s.ValidSince) <= now && now < int64(s.ValidUntil)-saltSwitchMargin {
+			return s.Salt, int64(s.ValidUntil) - saltSwitchMargin, true
+		}
+	}
+	return 0, 0, false
+}
+
+// coveredUntil returns the server time up to which the timeline holds a usable salt.
+func (t *saltTimeline) coveredUntil() int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var until int64
+	for _, s := range t.salts {
+		until = max(until, int64(s.ValidUntil)-saltSwitchMargin)
+	}
+	return until
+}
+
+func (t *saltTimeline) snapshot() []session.FutureSalt {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slices.Clone(t.salts)
+}
+
+// fetchFutureSalts asks the server for upcoming salts and merges them into the timeline.
+func (m *MTProto) fetchFutureSalts() error {
+	resp, err := objects.GetFutureSalts(m, futureSaltsCount)
+	if err != nil {
+		return err
+	}
+
+	salts := make([]session.FutureSalt, 0, len(resp.Salts))
+	for _, s := range resp.Salts {
+		salts = append(salts, session.FutureSalt{
+			ValidSince: s.ValidSince,
+			ValidUntil: s.ValidUntil,
+			Salt:       s.Salt,
+		})
+	}
+	m.salts.set(salts, resp.Now)
+	m.Logger.Trace("received %d future salts", len(salts))
+	return nil
+}
+
+// applyScheduledSalt switches to the salt the timeline says is valid now.
+// It returns the server time at which the next switch is due, or 0 if the timeline is empty.
+func (m *MTProto) applyScheduledSalt() int64 {
+	salt, switchAt, ok := m.salts.current()
+	if !ok {
+		return 0
+	}
+
+	if m.serverSalt.Swap(salt) != salt {
+		m.Logger.Trace("switched to scheduled server salt")
+		if err := m.SaveSession(m.memorySession); err != nil {
+			m.Logger.Debug("failed to save session: %v", err)
+		}
+	}
+	return switchAt
+}
+
+// startSaltManager keeps the salt timeline filled and rotates the active salt before
+// the server starts rejecting it, so rotation never costs a bad_server_salt round trip.
+func (m *MTProto) startSaltManager(ctx context.Context) {
+	m.routineswg.Add(1)
+	go func() {
+		defer m.routineswg.Done()
+		defer m.Logger.Trace("salt manager stopped")
+
+		m.Logger.Trace("salt manager started")
+		for {
+			sleep := saltManagerMaxSleep
+
+			if m.salts.coveredUntil()-m.salts.now() < saltRefreshBefore {
+				if err := m.fetchFutureSalts(); err != nil {
+					m.Logger.Debug("fetching future salts: %v", err)
+					sleep = saltManagerRetryOnError
+				}
+			}
+
+			if switchAt := m.applyScheduledSalt(); switchAt != 0 {
+				if untilSwitch := time.Duration(switchAt-m.salts.now()) * time.Second; untilSwitch > 0 {
+					sleep = min(sleep, untilSwitch)
+				}
+			}
+
+			if refreshAt := m.salts.coveredUntil() - saltRefreshBefore; refreshAt > 0 {
+				if untilRefresh := time.Duration(refreshAt-m.salts.now()) * time.Second; untilRefresh > 0 {
+					sleep = min(sleep, untilRefresh)
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-m.saltRefresh:
+			case <-time.After(max(sleep, time.Second)):
+			}
+		}
+	}()
+}
+
+// invalidateSalts drops the timeline after the server rejected a scheduled salt
+// and wakes the manager so it refetches immediately.
+func (m *MTProto) invalidateSalts() {
+	m.salts.clear()
+	select {
+	case m.saltRefresh <- struct{}{}:
+	default:
+	}
+}
@@ -3,6 +3,7 @@
 package telegram
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
@@ -75,7 +76,8 @@ func (c *Client) AuthPrompt() error {
 	return errors.New("authentication failed: maximum retry attempts exceeded")
 }
 
-// LoginBot authenticates the client using a bot token.
+// LoginBot authenticates the client using a bot token. After a fresh login the
+// missed updates are caught up in the background, see onAuthorization.
 func (c *Client) LoginBot(botToken string) error {
 	if botToken == "" {
 		return errors.New("bot token cannot be empty")
@@ -88,6 +90,7 @@ func (c *Client) LoginBot(botToken string) error {
 	}
 
 	if authorized, _ := c.IsAuthorized(); authorized {
+		c.resumeUpdates()
 		return nil
 	}
 
@@ -200,6 +203,7 @@ func (c *Client) Login(phoneNumber string, options ...*LoginOptions) (bool, erro
 	}
 
 	if authorized, _ := c.IsAuthorized(); authorized {
+		c.resumeUpdates()
 		return true, nil
 	}
 
@@ -243,8 +247,6 @@ func (c *Client) Login(phoneNumber string, options ...*LoginOptions) (bool, erro
 	case *AuthAuthorizationObj:
 		switch user := auth.User.(type) {
 		case *UserObj:
-			c.clientData.botAcc = user.Bot
-			go c.Cache.UpdateUser(user)
 			return true, nil
 
 		case *UserEmpty:
@@ -261,6 +263,33 @@ func (c *Client) Login(phoneNumber string, options ...*LoginOptions) (bool, erro
 	}
 }
 
+// onAuthorization finishes a login once a request authorizes the client, whether
+// it went through Login, LoginBot or the auth methods themselves: it records the
+// account, then starts the connection pool and the catch-up of updates, which
+// the login methods leave to it.
+func (c *Client) onAuthorization(ctx context.Context, info *RequestInfo, req TLObject, next Invoker) (any, error) {
+	resp, err := next(ctx, req)
+	if info.Exported {
+		// exported senders import the authorization the client already has
+		return resp, err
+	}
+	auth := resp
+	if token, ok := resp.(*AuthLoginTokenSuccess); ok {
+		auth = token.Authorization
+	}
+	authorization, ok := auth.(*AuthAuthorizationObj)
+	if !ok {
+		return resp, err
+	}
+	if user, ok := authorization.User.(*UserObj); ok {
+		c.clientData.botAcc = user.Bot
+		go c.Cache.UpdateUser(user)
+	}
+	go c.startConnPool()
+	go c.resumeUpdates()
+	return resp, err
+}
+
 func applyLoginDefaults(opts *LoginOptions) {
 	if opts.CodeCallback == nil {
 		opts.CodeCallback = DefaultCodeCallback
@@ -11,6 +11,7 @@ import (
 	"os/signal"
 	"regexp"
 	"runtime/debug"
+	"slices"
 	"strconv"
 	"sync"
 	"syscall"
@@ -49,6 +50,7 @@ type clientData struct {
 	me               *UserObj
 	commandPrefixes  string
 	proxy            Proxy
+	poolSize         int
 }
 
 // Client is the main struct of the library
@@ -64,6 +66,10 @@ type Client struct {
 	exportedKeys map[int]*AuthExportedAuthorization
 	Log          Logger
 	Data         *ContextStore
+	metrics      *Metrics
+	rateLimiter  *RateLimiter
+	poolMu       sync.Mutex // held while the connection pool is being built
+	poolDC       int        // home DC the connection pool was built for
 }
 
 type DeviceConfig struct {
@@ -86,9 +92,10 @@ type ClientConfig struct {
 	SessionAESKey    string               // AES-256 key for encrypting session file
 	ParseMode        string               // Default message parse mode: "HTML" or "Markdown"
 	MemorySession    bool                 // Keep session in memory only, don't persist to disk
+	SessionStorage   SessionStorage       // Custom session storage; overrides Session, SessionAESKey and MemorySession
 	DataCenter       int                  // Initial DC to connect to (1-5, default: 4)
 	IpAddr           string               // Custom DC IP address (overrides DataCenter)
-	PublicKeys       []*rsa.PublicKey     // RSA public keys for server verification
+	PublicKeys       []*rsa.PublicKey     // RSA public keys for server verification (default: those of Telegram)
 	NoUpdates        bool                 // Disable update handling (bot-only mode)
 	DisableCache     bool                 // Disable peer/chat caching
 	TestMode         bool                 // Connect to Telegram test servers
@@ -96,6 +103,8 @@ type ClientConfig struct {
 	Logger           Logger               // Custom logger implementation
 	Proxy            Proxy                // Proxy configuration (SOCKS5, HTTP, MTProxy)
 	LocalAddr        string               // Local network interface to bind (IP:port)
+	Dialer           DialFunc             // Custom dialer for TCP connections, e.g. to an in-memory test server
+	Faults           *FaultInjector       // Inject latency, lost frames and broken connections, for testing only
 	ForceIPv6        bool                 // Prefer IPv6 connections to Telegram
 	NoPreconnect     bool                 // Delay connection until Connect() is called
 	Cache            *CACHE               // Custom cache instance
@@ -106,12 +115,35 @@ type ClientConfig struct {
 	CommandPrefixes  string               // Bot command prefixes (default: "/!")
 	FloodHandler     func(err error) bool // Called on FLOOD_WAIT; return true to retry after wait
 	ErrorHandler     func(err error) bool // Called on request errors; return true to retry
+	RetryPolicies    *RetryPolicies       // Per method and error class retry rules; FloodHandler and ErrorHandler are asked only for requests none covers
 	Timeout          int                  // TCP connection timeout in seconds (default: 60)
 	ReqTimeout       int                  // RPC request timeout in seconds (default: 60)
 	UseWebSocket     bool                 // Use WebSocket transport instead of TCP
 	UseWebSocketTLS  bool                 // Use secure WebSocket (wss://)
 	EnablePFS        bool                 // Enable Perfect Forward Secrecy with temp auth keys
-}
+	DisableBatching  bool                 // Send every request in its own frame instead of msg_container batches
+	Interceptors     []Interceptor        // Wrap every RPC call, including those of exported senders
+	EnableMetrics    bool                 // Collect RPC, flood wait, traffic and handler metrics, see Client.Metrics
+	RateLimiter      *RateLimiter         // Schedule requests within rate limits and hold back flood-waited methods
+	PoolSize         int                  // Parallel connections to the home DC, requests go to the least busy one (default: 1)
+	Recorder         *Recorder            // Journal every answered request and every update, e.g. to replay them with telegramtest
+	UpdateStateStore UpdateStateStore     // Persist pts/qts/seq and channel pts, and fetch the updates missed while offline on Start
+	WorkerPool       *WorkerPoolConfig    // Run handlers on a bounded pool, in order per chat, instead of a goroutine per update
+	UpdateJournal    *UpdateJournal       // Append every incoming update to a rotating file, see Client.ReplayUpdates
+}
+
+type (
+	// Interceptor wraps an RPC call; see AddInterceptor.
+	Interceptor = mtproto.Interceptor
+	// Invoker continues an intercepted RPC call.
+	Invoker = mtproto.Invoker
+	// RequestInfo describes an intercepted RPC call.
+	RequestInfo = mtproto.RequestInfo
+	// TLObject is any TL-serializable request or result.
+	TLObject = mtproto.TLObject
+	// DialFunc opens a connection to addr, like net.Dialer.DialContext.
+	DialFunc = mtproto.DialFunc
+)
 
 func NewClient(config ClientConfig) (*Client, error) {
 	client := &Client{
@@ -151,10 +183,18 @@ func NewClient(config ClientConfig) (*Client, error) {
 	if err := client.setupMTProto(config); err != nil {
 		return nil, err
 	}
+	client.AddInterceptor(client.onAuthorization)
 	if config.NoUpdates {
 		client.Log.Debug("updates disabled, skipping dispatcher initialization")
 	} else {
 		client.setupDispatcher()
+		if config.UpdateStateStore != nil {
+			client.dispatcher.setStateStore(config.UpdateStateStore)
+		}
+		if config.WorkerPool != nil {
+			client.dispatcher.workers = newWorkerPool(*config.WorkerPool)
+		}
+		client.dispatcher.journal = config.UpdateJournal
 	}
 	if err := client.clientWarnings(config); err != nil {
 		return nil, err
@@ -189,18 +229,30 @@ func (c *Client) setupMTProto(config ClientConfig) error {
 				lp("mtproto", config.SessionName)),
 		StringSession:   config.StringSession,
 		LocalAddr:       config.LocalAddr,
-		MemorySession:   config.MemorySession,
+		Dialer:          config.Dialer,
+		Faults:          config.Faults,
+		MemorySession:   config.MemorySession && config.SessionStorage == nil,
+		SessionStorage:  config.SessionStorage,
 		Ipv6:            config.ForceIPv6,
 		CustomHost:      customHost,
 		FloodHandler:    config.FloodHandler,
 		ErrorHandler:    config.ErrorHandler,
+		RetryPolicies:   config.RetryPolicies,
+		FallbackSender:  c.fallbackSender,
 		Timeout:         config.Timeout,
 		ReqTimeout:      config.ReqTimeout,
 		UseWebSocket:    config.UseWebSocket,
 		UseWebSocketTLS: config.UseWebSocketTLS,
 		EnablePFS:       config.EnablePFS,
+		DisableBatching: config.DisableBatching,
+		Recorder:        config.Recorder,
 		OnMigration: func() {
 			c.InitialRequest()
+			go func() {
+				if is, err := c.IsAuthorized(); err == nil && is {
+					c.startConnPool()
+				}
+			}()
 		},
 	}
 
@@ -208,6 +260,18 @@ func (c *Client) setupMTProto(config ClientConfig) error {
 		mtpCfg.Proxy = config.Proxy.toInternal()
 	}
 
+	mtpCfg.Interceptors = slices.Clip(config.Interceptors)
+	if config.RateLimiter != nil {
+		c.rateLimiter = config.RateLimiter
+		mtpCfg.Interceptors = append(mtpCfg.Interceptors, c.rateLimiter.Interceptor)
+		mtpCfg.OnFloodWait = c.rateLimiter.floodWaitHook()
+	}
+	if config.EnableMetrics {
+		c.metrics = newMetrics(c)
+		mtpCfg.Metrics = c.metrics
+		mtpCfg.Interceptors = append(mtpCfg.Interceptors, c.metrics.interceptor)
+	}
+
 	mtproto, err := mtproto.NewMTProto(mtpCfg)
 	if err != nil {
 		return fmt.Errorf("creating mtproto client: %w", err)
@@ -229,7 +293,11 @@ func (c *Client) clientWarnings(config ClientConfig) error {
 	if config.NoUpdates {
 		c.Log.Debug("running in no-updates mode; update handlers will not be called")
 	}
-	if !doesSessionFileExist(config.Session) && config.StringSession == "" && (c.AppID() == 0 || c.AppHash() == "") {
+	hasSession := doesSessionFileExist(config.Session)
+	if config.SessionStorage != nil {
+		hasSession = config.SessionStorage.Exists()
+	}
+	if !hasSession && config.StringSession == "" && (c.AppID() == 0 || c.AppHash() == "") {
 		if c.AppID() == 0 {
 			log.Print("app id is empty, fetch from api.telegram.org? (y/n): ")
 			if !utils.AskForConfirmation() {
@@ -265,7 +333,9 @@ func (c *Client) cleanClientConfig(config ClientConfig) ClientConfig {
 	} else {
 		config.DataCenter = getValue(config.DataCenter, DefaultDataCenter)
 	}
-	config.PublicKeys = keys.GetRSAKeys()
+	if len(config.PublicKeys) == 0 {
+		config.PublicKeys = keys.GetRSAKeys()
+	}
 	return config
 }
 
@@ -287,6 +357,7 @@ func (c *Client) setupClientData(cnf ClientConfig) {
 		albumWaitTime:    getValue(cnf.AlbumWaitTime, 600),
 		commandPrefixes:  getValue(cnf.CommandPrefixes, "/!"),
 		proxy:            cnf.Proxy,
+		poolSize:         cnf.PoolSize,
 	}
 
 	if cnf.DeviceConfig.Params != nil {
@@ -378,10 +449,47 @@ func (c *Client) Connect() error {
 
 	if is, err := c.IsAuthorized(); err == nil && is {
 		_, _ = c.GetMe()
+		go c.startConnPool()
 	}
 	return err
 }
 
+// startConnPool opens the extra home DC connections requested by ClientConfig.PoolSize
+// and attaches them to the main connection. The connections share the auth key of
+// the main one, so they are opened once it is authorized. The pool is built once
+// per home DC; calls finding it attached for the current DC do nothing.
+func (c *Client) startConnPool() {
+	if c.clientData.poolSize <= 1 {
+		return
+	}
+	c.poolMu.Lock()
+	defer c.poolMu.Unlock()
+	if c.poolDC == c.GetDC() && c.MTProto.PoolStats() != nil {
+		return
+	}
+
+	conns := make([]*mtproto.MTProto, 0, c.clientData.poolSize-1)
+	for range c.clientData.poolSize - 1 {
+		conn, err := c.exportSender(c.GetDC(), false)
+		if err != nil {
+			c.Log.Warn("opening pooled connection to DC%d: %v", c.GetDC(), err)
+			break
+		}
+		conns = append(conns, conn)
+	}
+	if len(conns) == 0 {
+		return
+	}
+
+	c.MTProto.AttachPool(conns, mtproto.PoolConfig{
+		// pooled connections only carry requests; updates keep arriving on the main one
+		Wrap: func(query TLObject) TLObject {
+			return &InvokeWithoutUpdatesParams{Query: query}
+		},
+	})
+	c.poolDC = c.GetDC()
+}
+
 // Wrapper for Connect()
 func (c *Client) Conn() (*Client, error) {
 	return c, c.Connect()
@@ -399,7 +507,8 @@ func (c *Client) Start() error {
 			return err
 		}
 	}
-	if au, err := c.IsAuthorized(); err != nil && !au {
+	au, err := c.IsAuthorized()
+	if err != nil && !au {
 		if err := c.AuthPrompt(); err != nil {
 			return err
 		}
@@ -408,6 +517,10 @@ func (c *Client) Start() error {
 	}
 
 	c.stopCh = make(chan struct{}) // reset the stop channel
+	if au {
+		// a fresh login catches up on updates through onAuthorization instead
+		c.resumeUpdates()
+	}
 	return nil
 }
 
@@ -580,6 +693,16 @@ func (es *ExSenders) Close() {
 
 // CreateExportedSender creates a new exported sender for the given DC
 func (c *Client) CreateExportedSender(dcID int, cdn bool, authParams ...*AuthExportedAuthorization) (*mtproto.MTProto, error) {
+	exported, err := c.exportSender(dcID, cdn, authParams...)
+	if err == nil && c.metrics != nil {
+		c.metrics.observeExportedSender(dcID)
+	}
+	return exported, err
+}
+
+// exportSender creates a new connection to dcID, leaving it out of the metrics of
+// exported senders; the connection pool opens its connections with it.
+func (c *Client) exportSender(dcID int, cdn bool, authParams ...*AuthExportedAuthorization) (*mtproto.MTProto, error) {
 	if dcID <= 0 {
 		return nil, errors.New("invalid data center ID")
 	}
@@ -629,7 +752,11 @@ func (c *Client) CreateExportedSender(dcID int, cdn bool, authParams ...*AuthExp
 			Query:          &HelpGetConfigParams{},
 		}
 
-		if c.MTProto.GetDC() != exported.GetDC() {
+		if c.MTProto.GetDC() != exported.GetDC() && exported.IsAuthImported() {
+			// the key was restored from the session together with its authorization;
+			// asking for ourselves fails with 401 if the authorization is gone
+			initialReq.Query = &UsersGetUsersParams{ID: []InputUser{&InputUserSelf{}}}
+		} else if c.MTProto.GetDC() != exported.GetDC() {
 			var auth *AuthExportedAuthorization
 			if authParam.ID != 0 {
 				auth = &AuthExportedAuthorization{
@@ -667,6 +794,11 @@ func (c *Client) CreateExportedSender(dcID int, cdn bool, authParams ...*AuthExp
 		c.Log.Debug("exported sender DC%d ready", dcID)
 
 		if err != nil {
+			if rpcErr := c.ToRpcError(err); rpcErr != nil && rpcErr.Code == 401 && exported.IsAuthImported() {
+				exported.SetAuthImported(false)
+				c.Log.Debug("stored authorization for DC%d is no longer valid, re-importing", dcID)
+				continue
+			}
 			if c.MatchRPCError(err, "AUTH_BYTES_INVALID") {
 				authParam.ID = 0
 				c.Log.Debug("AUTH_BYTES_INVALID: re-exporting authorization")
@@ -685,6 +817,9 @@ func (c *Client) CreateExportedSender(dcID int, cdn bool, authParams ...*AuthExp
 		}
 
 		lastError = nil
+		if c.MTProto.GetDC() != exported.GetDC() && !cdn && !exported.IsAuthImported() {
+			exported.SetAuthImported(true)
+		}
 		return exported, nil
 	}
 
@@ -771,7 +906,9 @@ func (c *Client) ImportStringSession(sessionString string) (bool, error) {
 func (c *Client) ExportSession() string {
 	authSession, dcId := c.MTProto.ExportAuth()
 	c.Log.Debug("exporting session to string")
-	return session.NewStringSession(authSession.Key, authSession.Hash, dcId, authSession.Hostname, authSession.AppID).Encode()
+	stringSession := session.NewStringSession(authSession.Key, authSession.Hash, dcId, authSession.Hostname, authSession.AppID)
+	stringSession.DCs = authSession.DCs
+	return stringSession.Encode()
 }
 
 // ImportSession imports a session from a string
@@ -843,6 +980,7 @@ func (c *Client) SetCommandPrefixes(prefixes string) {
 
 // Terminate client and disconnect from telegram server
 func (c *Client) Terminate() error {
+	c.stopWorkers()
 	return c.MTProto.Terminate()
 }
 
@@ -869,6 +1007,12 @@ func (c *Client) Stop() error {
 	default:
 		close(c.stopCh)
 	}
+	if c.dispatcher != nil {
+		if err := c.dispatcher.saveState(); err != nil {
+			c.Log.Error("saving update state: %v", err)
+		}
+	}
+	c.stopWorkers()
 
 	return c.MTProto.Terminate()
 }
@@ -1020,6 +1164,11 @@ func (b *ClientConfigBuilder) WithLogLevel(level LogLevel) *ClientConfigBuilder
 	return b
 }
 
+func (b *ClientConfigBuilder) WithSessionStorage(storage SessionStorage) *ClientConfigBuilder {
+	b.config.SessionStorage = storage
+	return b
+}
+
 func (b *ClientConfigBuilder) WithMemorySession() *ClientConfigBuilder {
 	b.config.MemorySession = true
 	return b
@@ -1050,6 +1199,11 @@ func (b *ClientConfigBuilder) WithPublicKeys(publicKeys []*rsa.PublicKey) *Clien
 	return b
 }
 
+func (b *ClientConfigBuilder) WithDialer(dialer DialFunc) *ClientConfigBuilder {
+	b.config.Dialer = dialer
+	return b
+}
+
 func (b *ClientConfigBuilder) WithCache(cache *CACHE) *ClientConfigBuilder {
 	b.config.Cache = cache
 	return b
@@ -1075,6 +1229,11 @@ func (b *ClientConfigBuilder) WithErrorHandler(handler func(err error) bool) *Cl
 	return b
 }
 
+func (b *ClientConfigBuilder) WithRetryPolicies(policies *RetryPolicies) *ClientConfigBuilder {
+	b.config.RetryPolicies = policies
+	return b
+}
+
 func (b *ClientConfigBuilder) WithSessionName(name string) *ClientConfigBuilder {
 	b.config.SessionName = name
 	return b
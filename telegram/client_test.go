@@ -0,0 +1,25 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import (
+	"crypto/rsa"
+	"math/big"
+	"testing"
+)
+
+// Keys given in the config are used in place of the ones of Telegram, as a
+// test server signs its handshake with its own.
+func TestCleanClientConfigPublicKeys(t *testing.T) {
+	c := &Client{}
+	key := &rsa.PublicKey{N: big.NewInt(3233), E: 17}
+	config := c.cleanClientConfig(ClientConfig{PublicKeys: []*rsa.PublicKey{key}})
+	if len(config.PublicKeys) != 1 || config.PublicKeys[0] != key {
+		t.Fatalf("public keys replaced: %v", config.PublicKeys)
+	}
+
+	config = c.cleanClientConfig(ClientConfig{})
+	if len(config.PublicKeys) == 0 {
+		t.Fatal("no default public keys")
+	}
+}
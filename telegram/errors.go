@@ -0,0 +1,28 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import mtproto "github.com/amarnathcjd/gogram"
+
+//go:generate go run ../internal/cmd/errgen -out errors_gen.go ../errors.go
+
+type (
+	// RPCError is the type of an error Telegram answers requests with; the
+	// Err constants of errors_gen.go list the known ones.
+	RPCError = mtproto.RPCError
+	// RetryClass tells whether a request that failed may succeed when sent again.
+	RetryClass = mtproto.RetryClass
+	// FloodWaitError is the error of requests refused by flood control.
+	FloodWaitError = mtproto.FloodWaitError
+	// SlowModeWaitError is the error of messages sent too soon to a chat in slow mode.
+	SlowModeWaitError = mtproto.SlowModeWaitError
+	// MigrateError is the error of requests to be sent to another data center.
+	MigrateError = mtproto.MigrateError
+)
+
+const (
+	RetryNever       = mtproto.RetryNever
+	RetryAfterWait   = mtproto.RetryAfterWait
+	RetryOtherDC     = mtproto.RetryOtherDC
+	RetryWithBackoff = mtproto.RetryWithBackoff
+)
@@ -0,0 +1,616 @@
+// Code generated by errgen; DO NOT EDIT.
+
+package telegram
+
+// Errors Telegram answers requests with. Errors carrying a number, like
+// FLOOD_WAIT_X, are named without it.
+const (
+	Err2FAConfirmWait                   RPCError = "2FA_CONFIRM_WAIT_X"                    // You'll be able to reset your account in X seconds. If not, account will be deleted in 1 week for security reasons.
+	ErrAboutTooLong                     RPCError = "ABOUT_TOO_LONG"                        // About string too long.
+	ErrAccessTokenExpired               RPCError = "ACCESS_TOKEN_EXPIRED"                  // Access token expired.
+	ErrAccessTokenInvalid               RPCError = "ACCESS_TOKEN_INVALID"                  // Access token invalid.
+	ErrActiveUserRequired               RPCError = "ACTIVE_USER_REQUIRED"                  // The method is only available to already activated users.
+	ErrAddressInvalid                   RPCError = "ADDRESS_INVALID"                       // The specified geopoint address is invalid.
+	ErrAdminsTooMuch                    RPCError = "ADMINS_TOO_MUCH"                       // There are too many admins.
+	ErrAdminIDInvalid                   RPCError = "ADMIN_ID_INVALID"                      // The specified admin ID is invalid.
+	ErrAdminRankEmojiNotAllowed         RPCError = "ADMIN_RANK_EMOJI_NOT_ALLOWED"          // An admin rank cannot contain emojis.
+	ErrAdminRankInvalid                 RPCError = "ADMIN_RANK_INVALID"                    // The specified admin rank is invalid.
+	ErrAdminRightsEmpty                 RPCError = "ADMIN_RIGHTS_EMPTY"                    // The admin rights configuration has no rights set.
+	ErrAlbumPhotosTooMany               RPCError = "ALBUM_PHOTOS_TOO_MANY"                 // You have uploaded too many profile photos, delete some before retrying.
+	ErrAnonymousReactionsDisabled       RPCError = "ANONYMOUS_REACTIONS_DISABLED"          // Sorry, anonymous administrators cannot leave reactions or participate in polls.
+	ErrAPIIDInvalid                     RPCError = "API_ID_INVALID"                        // API ID invalid.
+	ErrAPIIDPublishedFlood              RPCError = "API_ID_PUBLISHED_FLOOD"                // This API ID was published somewhere, you can't use it now.
+	ErrArticleTitleEmpty                RPCError = "ARTICLE_TITLE_EMPTY"                   // The title of the article is empty.
+	ErrAudioContentURLEmpty             RPCError = "AUDIO_CONTENT_URL_EMPTY"               // The remote URL specified in the content field is empty.
+	ErrAudioTitleEmpty                  RPCError = "AUDIO_TITLE_EMPTY"                     // An empty audio title was provided.
+	ErrAuthBytesInvalid                 RPCError = "AUTH_BYTES_INVALID"                    // The provided authorization is invalid.
+	ErrAuthKeyDuplicated                RPCError = "AUTH_KEY_DUPLICATED"                   // The authorization key was used under two different IP addresses simultaneously and is now invalid.
+	ErrAuthKeyInvalid                   RPCError = "AUTH_KEY_INVALID"                      // The Authorization Key is invalid.
+	ErrAuthKeyPermEmpty                 RPCError = "AUTH_KEY_PERM_EMPTY"                   // The method is unavailable for temporary authorization keys, not bound to permanent.
+	ErrAuthKeyUnregistered              RPCError = "AUTH_KEY_UNREGISTERED"                 // The key is not registered in the system.
+	ErrAuthRestart                      RPCError = "AUTH_RESTART"                          // Restart the authorization process.
+	ErrAuthTokenAlreadyAccepted         RPCError = "AUTH_TOKEN_ALREADY_ACCEPTED"           // The specified auth token was already accepted.
+	ErrAuthTokenException               RPCError = "AUTH_TOKEN_EXCEPTION"                  // An error occurred while importing the auth token.
+	ErrAuthTokenExpired                 RPCError = "AUTH_TOKEN_EXPIRED"                    // The authorization token has expired.
+	ErrAuthTokenInvalid                 RPCError = "AUTH_TOKEN_INVALID"                    // The specified auth token is invalid.
+	ErrAuthTokenInvalid2                RPCError = "AUTH_TOKEN_INVALID2"                   // An invalid authorization token was provided.
+	ErrAuthTokenInvalidx                RPCError = "AUTH_TOKEN_INVALIDX"                   // The specified auth token is invalid.
+	ErrAutoarchiveNotAvailable          RPCError = "AUTOARCHIVE_NOT_AVAILABLE"             // The autoarchive setting is not available at this time; please check the client configuration.
+	ErrBankCardNumberInvalid            RPCError = "BANK_CARD_NUMBER_INVALID"              // The specified card number is invalid.
+	ErrBannedRightsInvalid              RPCError = "BANNED_RIGHTS_INVALID"                 // You provided some invalid flags in the banned rights.
+	ErrBasePortLocInvalid               RPCError = "BASE_PORT_LOC_INVALID"                 // Base port location invalid.
+	ErrBoostsEmpty                      RPCError = "BOOSTS_EMPTY"                          // No boost slots were specified.
+	ErrBoostsRequired                   RPCError = "BOOSTS_REQUIRED"                       // The specified channel must first be boosted by its users in order to perform this action.
+	ErrBoostNotModified                 RPCError = "BOOST_NOT_MODIFIED"                    // You are already boosting the specified channel.
+	ErrBoostPeerInvalid                 RPCError = "BOOST_PEER_INVALID"                    // The specified boost_peer is invalid.
+	ErrBotsTooMuch                      RPCError = "BOTS_TOO_MUCH"                         // There are too many bots in this chat/channel.
+	ErrBotAppInvalid                    RPCError = "BOT_APP_INVALID"                       // The specified bot app is invalid.
+	ErrBotAppShortnameInvalid           RPCError = "BOT_APP_SHORTNAME_INVALID"             // The specified bot app short name is invalid.
+	ErrBotChannelsNa                    RPCError = "BOT_CHANNELS_NA"                       // Bots can't edit admin privileges.
+	ErrBotCommandsTooMuch               RPCError = "BOT_COMMANDS_TOO_MUCH"                 // The provided commands are too many.
+	ErrBotCommandDescriptionInvalid     RPCError = "BOT_COMMAND_DESCRIPTION_INVALID"       // The specified command description is invalid.
+	ErrBotCommandInvalid                RPCError = "BOT_COMMAND_INVALID"                   // The specified command is invalid.
+	ErrBotDomainInvalid                 RPCError = "BOT_DOMAIN_INVALID"                    // Bot domain invalid.
+	ErrBotGamesDisabled                 RPCError = "BOT_GAMES_DISABLED"                    // Bot games cannot be used in this type of chat.
+	ErrBotGroupsBlocked                 RPCError = "BOT_GROUPS_BLOCKED"                    // This bot can't be added to groups.
+	ErrBotInlineDisabled                RPCError = "BOT_INLINE_DISABLED"                   // This bot can't be used in inline mode.
+	ErrBotInvalid                       RPCError = "BOT_INVALID"                           // This is not a valid bot.
+	ErrBotMethodInvalid                 RPCError = "BOT_METHOD_INVALID"                    // The API access for bot users is restricted. This method cannot be executed as a bot.
+	ErrBotMissing                       RPCError = "BOT_MISSING"                           // Only bots can call this method.
+	ErrBotOnesideNotAvail               RPCError = "BOT_ONESIDE_NOT_AVAIL"                 // Bots can't pin messages in PM just for themselves.
+	ErrBotPaymentsDisabled              RPCError = "BOT_PAYMENTS_DISABLED"                 // Please enable bot payments in BotFather before calling this method.
+	ErrBotPollsDisabled                 RPCError = "BOT_POLLS_DISABLED"                    // You cannot create polls under a bot account.
+	ErrBotResponseTimeout               RPCError = "BOT_RESPONSE_TIMEOUT"                  // A timeout occurred while fetching data from the bot.
+	ErrBotScoreNotModified              RPCError = "BOT_SCORE_NOT_MODIFIED"                // The score wasn't modified.
+	ErrBotWebviewDisabled               RPCError = "BOT_WEBVIEW_DISABLED"                  // A webview cannot be opened in the specified conditions.
+	ErrBroadcastCallsDisabled           RPCError = "BROADCAST_CALLS_DISABLED"              // Broadcast calls are disabled for this chat/channel.
+	ErrBroadcastForbidden               RPCError = "BROADCAST_FORBIDDEN"                   // Channel poll voters and reactions cannot be fetched to prevent deanonymization.
+	ErrBroadcastIDInvalid               RPCError = "BROADCAST_ID_INVALID"                  // Broadcast ID invalid.
+	ErrBroadcastPublicVotersForbidden   RPCError = "BROADCAST_PUBLIC_VOTERS_FORBIDDEN"     // You can't forward polls with public voters.
+	ErrBroadcastRequired                RPCError = "BROADCAST_REQUIRED"                    // This method can only be called on a channel.
+	ErrButtonDataInvalid                RPCError = "BUTTON_DATA_INVALID"                   // The data of one or more of the buttons you provided is invalid.
+	ErrButtonTextInvalid                RPCError = "BUTTON_TEXT_INVALID"                   // The specified button text is invalid.
+	ErrButtonTypeInvalid                RPCError = "BUTTON_TYPE_INVALID"                   // The type of one or more of the buttons you provided is invalid.
+	ErrButtonURLInvalid                 RPCError = "BUTTON_URL_INVALID"                    // Button URL invalid.
+	ErrButtonUserInvalid                RPCError = "BUTTON_USER_INVALID"                   // The user_id passed to the button is invalid.
+	ErrButtonUserPrivacyRestricted      RPCError = "BUTTON_USER_PRIVACY_RESTRICTED"        // The privacy setting of the user specified in the button do not allow creating such a button.
+	ErrCallAlreadyAccepted              RPCError = "CALL_ALREADY_ACCEPTED"                 // The call was already accepted.
+	ErrCallAlreadyDeclined              RPCError = "CALL_ALREADY_DECLINED"                 // The call was already declined.
+	ErrCallOccupyFailed                 RPCError = "CALL_OCCUPY_FAILED"                    // The call failed because the user is already making another call.
+	ErrCallPeerInvalid                  RPCError = "CALL_PEER_INVALID"                     // The provided call peer object is invalid.
+	ErrCallProtocolCompatLayerInvalid   RPCError = "CALL_PROTOCOL_COMPAT_LAYER_INVALID"    // The other side of the call does not support any of the VoIP protocols supported by the local client.
+	ErrCallProtocolFlagsInvalid         RPCError = "CALL_PROTOCOL_FLAGS_INVALID"           // Call protocol flags invalid.
+	ErrCdnMethodInvalid                 RPCError = "CDN_METHOD_INVALID"                    // You can't call this method in a CDN DC.
+	ErrCdnUploadTimeout                 RPCError = "CDN_UPLOAD_TIMEOUT"                    // A server-side timeout occurred while reuploading the file to the CDN DC.
+	ErrChannelsAdminLocatedTooMuch      RPCError = "CHANNELS_ADMIN_LOCATED_TOO_MUCH"       // The user has reached the limit of public geogroups.
+	ErrChannelsAdminPublicTooMuch       RPCError = "CHANNELS_ADMIN_PUBLIC_TOO_MUCH"        // You're admin of too many public channels, make some channels private to change the username of this channel.
+	ErrChannelsTooMuch                  RPCError = "CHANNELS_TOO_MUCH"                     // You have joined too many channels/supergroups.
+	ErrChannelAddInvalid                RPCError = "CHANNEL_ADD_INVALID"                   // The specified channel is invalid.
+	ErrChannelBanned                    RPCError = "CHANNEL_BANNED"                        // The channel is banned.
+	ErrChannelForumMissing              RPCError = "CHANNEL_FORUM_MISSING"                 // This supergroup is not a forum.
+	ErrChannelIDInvalid                 RPCError = "CHANNEL_ID_INVALID"                    // The specified supergroup ID is invalid.
+	ErrChannelInvalid                   RPCError = "CHANNEL_INVALID"                       // The provided channel is invalid.
+	ErrChannelParticipantMissing        RPCError = "CHANNEL_PARTICIPANT_MISSING"           // The current user is not in the channel.
+	ErrChannelPrivate                   RPCError = "CHANNEL_PRIVATE"                       // You haven't joined this channel/supergroup.
+	ErrChannelPublicGroupNa             RPCError = "CHANNEL_PUBLIC_GROUP_NA"               // Channel/supergroup not available.
+	ErrChannelTooBig                    RPCError = "CHANNEL_TOO_BIG"                       // This channel has too many participants (>1000) to be deleted.
+	ErrChannelTooLarge                  RPCError = "CHANNEL_TOO_LARGE"                     // Channel is too large to be deleted.
+	ErrChatlistExcludeInvalid           RPCError = "CHATLIST_EXCLUDE_INVALID"              // The specified exclude_peers are invalid.
+	ErrChatAboutNotModified             RPCError = "CHAT_ABOUT_NOT_MODIFIED"               // About text has not changed.
+	ErrChatAboutTooLong                 RPCError = "CHAT_ABOUT_TOO_LONG"                   // Chat about too long.
+	ErrChatAdminInviteRequired          RPCError = "CHAT_ADMIN_INVITE_REQUIRED"            // You do not have the rights to do this.
+	ErrChatAdminRequired                RPCError = "CHAT_ADMIN_REQUIRED"                   // You must be an admin in this chat to do this.
+	ErrChatDiscussionUnallowed          RPCError = "CHAT_DISCUSSION_UNALLOWED"             // You can't enable forum topics in a discussion group linked to a channel.
+	ErrChatForbidden                    RPCError = "CHAT_FORBIDDEN"                        // You cannot write in this chat.
+	ErrChatForwardsRestricted           RPCError = "CHAT_FORWARDS_RESTRICTED"              // You can't forward messages from a protected chat.
+	ErrChatGetFailed                    RPCError = "CHAT_GET_FAILED"                       // Chat retrieval failed.
+	ErrChatGuestSendForbidden           RPCError = "CHAT_GUEST_SEND_FORBIDDEN"             // You must join the discussion group before commenting.
+	ErrChatIDEmpty                      RPCError = "CHAT_ID_EMPTY"                         // The provided chat ID is empty.
+	ErrChatIDGenerateFailed             RPCError = "CHAT_ID_GENERATE_FAILED"               // Failure while generating the chat ID.
+	ErrChatIDInvalid                    RPCError = "CHAT_ID_INVALID"                       // The provided chat id is invalid.
+	ErrChatInvalid                      RPCError = "CHAT_INVALID"                          // Invalid chat.
+	ErrChatInvitePermanent              RPCError = "CHAT_INVITE_PERMANENT"                 // You can't set an expiration date on permanent invite links.
+	ErrChatLinkExists                   RPCError = "CHAT_LINK_EXISTS"                      // The chat is public, you can't hide the history to new users.
+	ErrChatNotModified                  RPCError = "CHAT_NOT_MODIFIED"                     // No changes were made to chat information because the new information is identical to the current information.
+	ErrChatPublicRequired               RPCError = "CHAT_PUBLIC_REQUIRED"                  // You can only enable join requests in public groups.
+	ErrChatRestricted                   RPCError = "CHAT_RESTRICTED"                       // You can't send messages in this chat, you were restricted.
+	ErrChatRevokeDateUnsupported        RPCError = "CHAT_REVOKE_DATE_UNSUPPORTED"          // Date restrictions are not available for using with non-user peers.
+	ErrChatSendAudiosForbidden          RPCError = "CHAT_SEND_AUDIOS_FORBIDDEN"            // You can't send audio messages in this chat.
+	ErrChatSendDocsForbidden            RPCError = "CHAT_SEND_DOCS_FORBIDDEN"              // You can't send documents in this chat.
+	ErrChatSendGameForbidden            RPCError = "CHAT_SEND_GAME_FORBIDDEN"              // You can't send a game to this chat.
+	ErrChatSendGifsForbidden            RPCError = "CHAT_SEND_GIFS_FORBIDDEN"              // You can't send gifs in this chat.
+	ErrChatSendInlineForbidden          RPCError = "CHAT_SEND_INLINE_FORBIDDEN"            // You can't send inline messages in this group.
+	ErrChatSendMediaForbidden           RPCError = "CHAT_SEND_MEDIA_FORBIDDEN"             // You can't send media in this chat.
+	ErrChatSendPhotosForbidden          RPCError = "CHAT_SEND_PHOTOS_FORBIDDEN"            // You can't send photos in this chat.
+	ErrChatSendPlainForbidden           RPCError = "CHAT_SEND_PLAIN_FORBIDDEN"             // You can't send non-media (text) messages in this chat.
+	ErrChatSendPollForbidden            RPCError = "CHAT_SEND_POLL_FORBIDDEN"              // You can't send polls in this chat.
+	ErrChatSendStickersForbidden        RPCError = "CHAT_SEND_STICKERS_FORBIDDEN"          // You can't send stickers in this chat.
+	ErrChatSendVideosForbidden          RPCError = "CHAT_SEND_VIDEOS_FORBIDDEN"            // You can't send videos in this chat.
+	ErrChatSendVoicesForbidden          RPCError = "CHAT_SEND_VOICES_FORBIDDEN"            // You can't send voice recordings in this chat.
+	ErrChatTitleEmpty                   RPCError = "CHAT_TITLE_EMPTY"                      // No chat title provided.
+	ErrChatTooBig                       RPCError = "CHAT_TOO_BIG"                          // This method is not available for groups that are too big.
+	ErrChatWriteForbidden               RPCError = "CHAT_WRITE_FORBIDDEN"                  // You can't write in this chat.
+	ErrChpCallFail                      RPCError = "CHP_CALL_FAIL"                         // The statistics cannot be retrieved at this time.
+	ErrCodeEmpty                        RPCError = "CODE_EMPTY"                            // The provided code is empty.
+	ErrCodeHashInvalid                  RPCError = "CODE_HASH_INVALID"                     // Code hash invalid.
+	ErrCodeInvalid                      RPCError = "CODE_INVALID"                          // Code invalid.
+	ErrColorInvalid                     RPCError = "COLOR_INVALID"                         // The specified color palette ID was invalid.
+	ErrConnectionAPIIDInvalid           RPCError = "CONNECTION_API_ID_INVALID"             // The provided API id is invalid.
+	ErrConnectionAppVersionEmpty        RPCError = "CONNECTION_APP_VERSION_EMPTY"          // App version is empty.
+	ErrConnectionDeviceModelEmpty       RPCError = "CONNECTION_DEVICE_MODEL_EMPTY"         // Device model empty.
+	ErrConnectionLangPackInvalid        RPCError = "CONNECTION_LANG_PACK_INVALID"          // The specified language pack is not valid.
+	ErrConnectionLayerInvalid           RPCError = "CONNECTION_LAYER_INVALID"              // Layer invalid.
+	ErrConnectionNotInited              RPCError = "CONNECTION_NOT_INITED"                 // Connection not initialized.
+	ErrConnectionSystemEmpty            RPCError = "CONNECTION_SYSTEM_EMPTY"               // Connection system empty.
+	ErrConnectionSystemLangCodeEmpty    RPCError = "CONNECTION_SYSTEM_LANG_CODE_EMPTY"     // The system language string was empty during connection.
+	ErrContactAddMissing                RPCError = "CONTACT_ADD_MISSING"                   // Contact to add is missing.
+	ErrContactIDInvalid                 RPCError = "CONTACT_ID_INVALID"                    // The provided contact ID is invalid.
+	ErrContactMissing                   RPCError = "CONTACT_MISSING"                       // The specified user is not a contact.
+	ErrContactNameEmpty                 RPCError = "CONTACT_NAME_EMPTY"                    // Contact name empty.
+	ErrContactReqMissing                RPCError = "CONTACT_REQ_MISSING"                   // Missing contact request.
+	ErrCreateCallFailed                 RPCError = "CREATE_CALL_FAILED"                    // An error occurred while creating the call.
+	ErrCurrencyTotalAmountInvalid       RPCError = "CURRENCY_TOTAL_AMOUNT_INVALID"         // The total amount of all prices is invalid.
+	ErrCustomReactionsTooMany           RPCError = "CUSTOM_REACTIONS_TOO_MANY"             // Too many custom reactions were specified.
+	ErrDataInvalid                      RPCError = "DATA_INVALID"                          // Encrypted data invalid.
+	ErrDataJSONInvalid                  RPCError = "DATA_JSON_INVALID"                     // The provided JSON data is invalid.
+	ErrDataTooLong                      RPCError = "DATA_TOO_LONG"                         // Data too long.
+	ErrDateEmpty                        RPCError = "DATE_EMPTY"                            // Date empty.
+	ErrDCIDInvalid                      RPCError = "DC_ID_INVALID"                         // The provided DC ID is invalid.
+	ErrDHGAInvalid                      RPCError = "DH_G_A_INVALID"                        // g_a invalid.
+	ErrDocumentInvalid                  RPCError = "DOCUMENT_INVALID"                      // The specified document is invalid.
+	ErrEditBotInviteForbidden           RPCError = "EDIT_BOT_INVITE_FORBIDDEN"             // Normal users can't edit invites that were created by bots.
+	ErrEmailHashExpired                 RPCError = "EMAIL_HASH_EXPIRED"                    // Email hash expired.
+	ErrEmailInstallMissing              RPCError = "EMAIL_INSTALL_MISSING"                 // No email was set up for this account.
+	ErrEmailInvalid                     RPCError = "EMAIL_INVALID"                         // The specified email is invalid.
+	ErrEmailNotSetup                    RPCError = "EMAIL_NOT_SETUP"                       // Login email not set up.
+	ErrEmailUnconfirmed                 RPCError = "EMAIL_UNCONFIRMED"                     // Email unconfirmed.
+	ErrEmailUnconfirmedX                RPCError = "EMAIL_UNCONFIRMED_X"                   // Email unconfirmed, the length of the code must be X.
+	ErrEmailVerifyExpired               RPCError = "EMAIL_VERIFY_EXPIRED"                  // The verification email has expired.
+	ErrEmojiInvalid                     RPCError = "EMOJI_INVALID"                         // The specified theme emoji is invalid.
+	ErrEmojiMarkupInvalid               RPCError = "EMOJI_MARKUP_INVALID"                  // The specified video_emoji_markup was invalid.
+	ErrEmojiNotModified                 RPCError = "EMOJI_NOT_MODIFIED"                    // The theme wasn't changed.
+	ErrEmoticonEmpty                    RPCError = "EMOTICON_EMPTY"                        // The emoji is empty.
+	ErrEmoticonInvalid                  RPCError = "EMOTICON_INVALID"                      // The specified emoji is invalid.
+	ErrEmoticonStickerpackMissing       RPCError = "EMOTICON_STICKERPACK_MISSING"          // The emoji cannot be empty.
+	ErrEncryptedMessageInvalid          RPCError = "ENCRYPTED_MESSAGE_INVALID"             // Encrypted message invalid.
+	ErrEncryptionAlreadyAccepted        RPCError = "ENCRYPTION_ALREADY_ACCEPTED"           // Secret chat already accepted.
+	ErrEncryptionAlreadyDeclined        RPCError = "ENCRYPTION_ALREADY_DECLINED"           // The secret chat was already declined.
+	ErrEncryptionDeclined               RPCError = "ENCRYPTION_DECLINED"                   // The secret chat was declined.
+	ErrEncryptionIDInvalid              RPCError = "ENCRYPTION_ID_INVALID"                 // The provided secret chat ID is invalid.
+	ErrEncryptionOccupyFailed           RPCError = "ENCRYPTION_OCCUPY_FAILED"              // Internal server error while accepting secret chat.
+	ErrEntitiesTooLong                  RPCError = "ENTITIES_TOO_LONG"                     // You provided too many styled message entities.
+	ErrEntityBoundsInvalid              RPCError = "ENTITY_BOUNDS_INVALID"                 // A specified entity offset or length is invalid.
+	ErrEntityMentionUserInvalid         RPCError = "ENTITY_MENTION_USER_INVALID"           // You mentioned an invalid user.
+	ErrErrorTextEmpty                   RPCError = "ERROR_TEXT_EMPTY"                      // The provided error message is empty.
+	ErrExpireDateInvalid                RPCError = "EXPIRE_DATE_INVALID"                   // The specified expiration date is invalid.
+	ErrExpireForbidden                  RPCError = "EXPIRE_FORBIDDEN"                      // The provided expire date is forbidden.
+	ErrExportCardInvalid                RPCError = "EXPORT_CARD_INVALID"                   // Provided card is invalid.
+	ErrExternalURLInvalid               RPCError = "EXTERNAL_URL_INVALID"                  // External URL invalid.
+	ErrFieldNameEmpty                   RPCError = "FIELD_NAME_EMPTY"                      // A required field is missing.
+	ErrFieldNameInvalid                 RPCError = "FIELD_NAME_INVALID"                    // A provided field is invalid.
+	ErrFilerefUpgradeNeeded             RPCError = "FILEREF_UPGRADE_NEEDED"                // The client has to be updated in order to support file references.
+	ErrFileContentTypeInvalid           RPCError = "FILE_CONTENT_TYPE_INVALID"             // File content-type is invalid.
+	ErrFileEmpty                        RPCError = "FILE_EMPTY"                            // An empty file was provided.
+	ErrFileEmtpy                        RPCError = "FILE_EMTPY"                            // An empty file was provided.
+	ErrFileIDInvalid                    RPCError = "FILE_ID_INVALID"                       // The provided file id is invalid.
+	ErrFileMigrate                      RPCError = "FILE_MIGRATE_X"                        // The file to be accessed is currently stored in DC X.
+	ErrFilePartsInvalid                 RPCError = "FILE_PARTS_INVALID"                    // The number of file parts is invalid.
+	ErrFilePartEmpty                    RPCError = "FILE_PART_EMPTY"                       // The provided file part is empty.
+	ErrFilePartInvalid                  RPCError = "FILE_PART_INVALID"                     // The file part number is invalid.
+	ErrFilePartLengthInvalid            RPCError = "FILE_PART_LENGTH_INVALID"              // The length of a file part is invalid.
+	ErrFilePartSizeChanged              RPCError = "FILE_PART_SIZE_CHANGED"                // Provided file part size has changed.
+	ErrFilePartSizeInvalid              RPCError = "FILE_PART_SIZE_INVALID"                // The provided file part size is invalid.
+	ErrFilePartTooBig                   RPCError = "FILE_PART_TOO_BIG"                     // The uploaded file part is too big.
+	ErrFilePartMissing                  RPCError = "FILE_PART_X_MISSING"                   // Part X of the file is missing from storage.
+	ErrFileReferenceEmpty               RPCError = "FILE_REFERENCE_EMPTY"                  // An empty file reference was specified.
+	ErrFileReferenceExpired             RPCError = "FILE_REFERENCE_EXPIRED"                // File reference expired, it must be refetched.
+	ErrFileReferenceInvalid             RPCError = "FILE_REFERENCE_INVALID"                // The specified file reference is invalid.
+	ErrFileTitleEmpty                   RPCError = "FILE_TITLE_EMPTY"                      // An empty file title was specified.
+	ErrFileTokenInvalid                 RPCError = "FILE_TOKEN_INVALID"                    // The specified file token is invalid.
+	ErrFilterIDInvalid                  RPCError = "FILTER_ID_INVALID"                     // The specified filter ID is invalid.
+	ErrFilterIncludeEmpty               RPCError = "FILTER_INCLUDE_EMPTY"                  // The include_peers vector of the filter is empty.
+	ErrFilterNotSupported               RPCError = "FILTER_NOT_SUPPORTED"                  // The specified filter cannot be used in this context.
+	ErrFilterTitleEmpty                 RPCError = "FILTER_TITLE_EMPTY"                    // The title field of the filter is empty.
+	ErrFirstnameInvalid                 RPCError = "FIRSTNAME_INVALID"                     // The first name is invalid.
+	ErrFloodPremiumWait                 RPCError = "FLOOD_PREMIUM_WAIT_X"                  // A wait of X seconds is required before calling the method.
+	ErrFloodTestPhoneWait               RPCError = "FLOOD_TEST_PHONE_WAIT_X"               // A wait of X seconds is required in the test servers.
+	ErrFloodWait                        RPCError = "FLOOD_WAIT_X"                          // Please wait X seconds before repeating the action.
+	ErrFolderIDEmpty                    RPCError = "FOLDER_ID_EMPTY"                       // An empty folder ID was specified.
+	ErrFolderIDInvalid                  RPCError = "FOLDER_ID_INVALID"                     // Invalid folder ID.
+	ErrForumEnabled                     RPCError = "FORUM_ENABLED"                         // You can't execute the specified action because the group is a forum; disable forum functionality to continue.
+	ErrFreshChangeAdminsForbidden       RPCError = "FRESH_CHANGE_ADMINS_FORBIDDEN"         // You were just elected admin, you can't add or modify other admins yet.
+	ErrFreshChangePhoneForbidden        RPCError = "FRESH_CHANGE_PHONE_FORBIDDEN"          // You can't change phone number right after logging in, please wait at least 24 hours.
+	ErrFreshResetAuthorisationForbidden RPCError = "FRESH_RESET_AUTHORISATION_FORBIDDEN"   // You can't logout other sessions if less than 24 hours have passed since you logged on the current session.
+	ErrFromMessageBotDisabled           RPCError = "FROM_MESSAGE_BOT_DISABLED"             // Bots can't use fromMessage min constructors.
+	ErrFromPeerInvalid                  RPCError = "FROM_PEER_INVALID"                     // The specified from_id is invalid.
+	ErrFrozenMethodInvalid              RPCError = "FROZEN_METHOD_INVALID"                 // You tried to use a method that is not available for frozen accounts.
+	ErrFrozenParticipantMissing         RPCError = "FROZEN_PARTICIPANT_MISSING"            // Your account is frozen and can't access the chat.
+	ErrGameBotInvalid                   RPCError = "GAME_BOT_INVALID"                      // Bots can't send another bot's game.
+	ErrGeneralModifyIconForbidden       RPCError = "GENERAL_MODIFY_ICON_FORBIDDEN"         // You can't modify the icon of the General topic.
+	ErrGeoPointInvalid                  RPCError = "GEO_POINT_INVALID"                     // Invalid geoposition provided.
+	ErrGiftSlugExpired                  RPCError = "GIFT_SLUG_EXPIRED"                     // The specified gift slug has expired.
+	ErrGiftSlugInvalid                  RPCError = "GIFT_SLUG_INVALID"                     // The specified slug is invalid.
+	ErrGifContentTypeInvalid            RPCError = "GIF_CONTENT_TYPE_INVALID"              // GIF content-type invalid.
+	ErrGifIDInvalid                     RPCError = "GIF_ID_INVALID"                        // The provided GIF ID is invalid.
+	ErrGraphExpiredReload               RPCError = "GRAPH_EXPIRED_RELOAD"                  // This graph has expired, please obtain a new graph token.
+	ErrGraphInvalidReload               RPCError = "GRAPH_INVALID_RELOAD"                  // Invalid graph token provided, please reload the stats and provide the updated token.
+	ErrGraphOutdatedReload              RPCError = "GRAPH_OUTDATED_RELOAD"                 // The graph is outdated, please get a new async token.
+	ErrGroupcallAddParticipantsFailed   RPCError = "GROUPCALL_ADD_PARTICIPANTS_FAILED"     // Failed to add participants to the group call.
+	ErrGroupcallAlreadyDiscarded        RPCError = "GROUPCALL_ALREADY_DISCARDED"           // The group call was already discarded.
+	ErrGroupcallAlreadyStarted          RPCError = "GROUPCALL_ALREADY_STARTED"             // The groupcall has already started, you can join directly.
+	ErrGroupcallForbidden               RPCError = "GROUPCALL_FORBIDDEN"                   // The group call has already ended.
+	ErrGroupcallInvalid                 RPCError = "GROUPCALL_INVALID"                     // The specified group call is invalid.
+	ErrGroupcallJoinMissing             RPCError = "GROUPCALL_JOIN_MISSING"                // You haven't joined this group call.
+	ErrGroupcallNotModified             RPCError = "GROUPCALL_NOT_MODIFIED"                // Group call settings weren't modified.
+	ErrGroupcallSsrcDuplicateMuch       RPCError = "GROUPCALL_SSRC_DUPLICATE_MUCH"         // The app needs to retry joining the group call with a new SSRC value.
+	ErrGroupedMediaInvalid              RPCError = "GROUPED_MEDIA_INVALID"                 // Invalid grouped media.
+	ErrGroupCallInvalid                 RPCError = "GROUP_CALL_INVALID"                    // Group call invalid.
+	ErrHashInvalid                      RPCError = "HASH_INVALID"                          // The provided hash is invalid.
+	ErrHideRequesterMissing             RPCError = "HIDE_REQUESTER_MISSING"                // The join request was missing or was already handled.
+	ErrHistoryGetFailed                 RPCError = "HISTORY_GET_FAILED"                    // Fetching of history failed.
+	ErrImageProcessFailed               RPCError = "IMAGE_PROCESS_FAILED"                  // Failure while processing image.
+	ErrImportFileInvalid                RPCError = "IMPORT_FILE_INVALID"                   // The specified chat export file is invalid.
+	ErrImportFormatUnrecognized         RPCError = "IMPORT_FORMAT_UNRECOGNIZED"            // The specified chat export file was exported from an unsupported chat app.
+	ErrImportIDInvalid                  RPCError = "IMPORT_ID_INVALID"                     // The specified import ID is invalid.
+	ErrImportTokenInvalid               RPCError = "IMPORT_TOKEN_INVALID"                  // The specified token is invalid.
+	ErrInlineBotRequired                RPCError = "INLINE_BOT_REQUIRED"                   // Only the inline bot can edit message.
+	ErrInlineResultExpired              RPCError = "INLINE_RESULT_EXPIRED"                 // The inline query expired.
+	ErrInputChatlistInvalid             RPCError = "INPUT_CHATLIST_INVALID"                // The specified folder is invalid.
+	ErrInputConstructorInvalid          RPCError = "INPUT_CONSTRUCTOR_INVALID"             // The provided constructor is invalid.
+	ErrInputFetchError                  RPCError = "INPUT_FETCH_ERROR"                     // An error occurred while deserializing TL parameters.
+	ErrInputFetchErrorX                 RPCError = "INPUT_FETCH_ERROR_X"                   // An error occurred while deserializing TL parameters: X.
+	ErrInputFetchFail                   RPCError = "INPUT_FETCH_FAIL"                      // Failed deserializing TL payload.
+	ErrInputFilterInvalid               RPCError = "INPUT_FILTER_INVALID"                  // The specified filter is invalid.
+	ErrInputLayerInvalid                RPCError = "INPUT_LAYER_INVALID"                   // The provided layer is invalid.
+	ErrInputMethodInvalid               RPCError = "INPUT_METHOD_INVALID"                  // The specified method is invalid.
+	ErrInputRequestTooLong              RPCError = "INPUT_REQUEST_TOO_LONG"                // The input request was too long.
+	ErrInputTextEmpty                   RPCError = "INPUT_TEXT_EMPTY"                      // The specified text is empty.
+	ErrInputTextTooLong                 RPCError = "INPUT_TEXT_TOO_LONG"                   // The specified text is too long.
+	ErrInputUserDeactivated             RPCError = "INPUT_USER_DEACTIVATED"                // The specified user was deleted.
+	ErrInterdcCallError                 RPCError = "INTERDC_X_CALL_ERROR"                  // An error occurred while communicating with DC X.
+	ErrInterdcCallRichError             RPCError = "INTERDC_X_CALL_RICH_ERROR"             // A rich error occurred while communicating with DC X.
+	ErrInvitesTooMuch                   RPCError = "INVITES_TOO_MUCH"                      // The maximum number of per-folder invites was reached.
+	ErrInviteForbiddenWithJoinas        RPCError = "INVITE_FORBIDDEN_WITH_JOINAS"          // You cannot invite users while anonymously joined as a channel.
+	ErrInviteHashEmpty                  RPCError = "INVITE_HASH_EMPTY"                     // The invite hash is empty.
+	ErrInviteHashExpired                RPCError = "INVITE_HASH_EXPIRED"                   // The invite link has expired.
+	ErrInviteHashInvalid                RPCError = "INVITE_HASH_INVALID"                   // The invite hash is invalid.
+	ErrInviteRequestSent                RPCError = "INVITE_REQUEST_SENT"                   // You have successfully requested to join this chat or channel.
+	ErrInviteRevokedMissing             RPCError = "INVITE_REVOKED_MISSING"                // The specified invite link was already revoked or is invalid.
+	ErrInviteSlugEmpty                  RPCError = "INVITE_SLUG_EMPTY"                     // The specified invite slug is empty.
+	ErrInviteSlugExpired                RPCError = "INVITE_SLUG_EXPIRED"                   // The specified chat folder link has expired.
+	ErrInvoicePayloadInvalid            RPCError = "INVOICE_PAYLOAD_INVALID"               // The specified invoice payload is invalid.
+	ErrJoinAsPeerInvalid                RPCError = "JOIN_AS_PEER_INVALID"                  // The specified peer cannot be used to join a group call.
+	ErrLangCodeInvalid                  RPCError = "LANG_CODE_INVALID"                     // The specified language code is invalid.
+	ErrLangCodeNotSupported             RPCError = "LANG_CODE_NOT_SUPPORTED"               // The specified language code is not supported.
+	ErrLangPackInvalid                  RPCError = "LANG_PACK_INVALID"                     // The provided language pack is invalid.
+	ErrLastnameInvalid                  RPCError = "LASTNAME_INVALID"                      // The last name is invalid.
+	ErrLimitInvalid                     RPCError = "LIMIT_INVALID"                         // The provided limit is invalid.
+	ErrLinkNotModified                  RPCError = "LINK_NOT_MODIFIED"                     // Discussion link not modified.
+	ErrLocationInvalid                  RPCError = "LOCATION_INVALID"                      // The provided location is invalid.
+	ErrMaxDateInvalid                   RPCError = "MAX_DATE_INVALID"                      // The specified maximum date is invalid.
+	ErrMaxIDInvalid                     RPCError = "MAX_ID_INVALID"                        // The provided max ID is invalid.
+	ErrMaxQtsInvalid                    RPCError = "MAX_QTS_INVALID"                       // The specified max_qts is invalid.
+	ErrMD5ChecksumInvalid               RPCError = "MD5_CHECKSUM_INVALID"                  // The MD5 checksums do not match.
+	ErrMediaCaptionTooLong              RPCError = "MEDIA_CAPTION_TOO_LONG"                // The caption is too long.
+	ErrMediaEmpty                       RPCError = "MEDIA_EMPTY"                           // The provided media object is invalid.
+	ErrMediaFileInvalid                 RPCError = "MEDIA_FILE_INVALID"                    // The specified media file is invalid.
+	ErrMediaGroupedInvalid              RPCError = "MEDIA_GROUPED_INVALID"                 // You tried to send media of different types in an album.
+	ErrMediaInvalid                     RPCError = "MEDIA_INVALID"                         // Media invalid.
+	ErrMediaNewInvalid                  RPCError = "MEDIA_NEW_INVALID"                     // The new media is invalid.
+	ErrMediaPrevInvalid                 RPCError = "MEDIA_PREV_INVALID"                    // Previous media invalid.
+	ErrMediaTTLInvalid                  RPCError = "MEDIA_TTL_INVALID"                     // The specified media TTL is invalid.
+	ErrMediaTypeInvalid                 RPCError = "MEDIA_TYPE_INVALID"                    // The specified media type cannot be used in stories.
+	ErrMediaVideoStoryMissing           RPCError = "MEDIA_VIDEO_STORY_MISSING"             // A non-story video cannot be republished as a story.
+	ErrMegagroupGeoRequired             RPCError = "MEGAGROUP_GEO_REQUIRED"                // This method can only be invoked on a geogroup.
+	ErrMegagroupIDInvalid               RPCError = "MEGAGROUP_ID_INVALID"                  // Invalid supergroup ID.
+	ErrMegagroupPrehistoryHidden        RPCError = "MEGAGROUP_PREHISTORY_HIDDEN"           // Group with hidden history for new members can't be set as discussion groups.
+	ErrMegagroupRequired                RPCError = "MEGAGROUP_REQUIRED"                    // You can only use this method on a supergroup.
+	ErrMemberNoLocation                 RPCError = "MEMBER_NO_LOCATION"                    // An internal failure occurred while fetching user info (couldn't find location).
+	ErrMemberOccupyPrimaryLocFailed     RPCError = "MEMBER_OCCUPY_PRIMARY_LOC_FAILED"      // Occupation of primary member location failed.
+	ErrMessageAuthorRequired            RPCError = "MESSAGE_AUTHOR_REQUIRED"               // Message author required.
+	ErrMessageDeleteForbidden           RPCError = "MESSAGE_DELETE_FORBIDDEN"              // You can't delete one of the messages you tried to delete, most likely because it is a service message.
+	ErrMessageEditTimeExpired           RPCError = "MESSAGE_EDIT_TIME_EXPIRED"             // You can't edit this message anymore, too much time has passed since its creation.
+	ErrMessageEmpty                     RPCError = "MESSAGE_EMPTY"                         // The provided message is empty.
+	ErrMessageIDsEmpty                  RPCError = "MESSAGE_IDS_EMPTY"                     // No message ids were provided.
+	ErrMessageIDInvalid                 RPCError = "MESSAGE_ID_INVALID"                    // The provided message id is invalid.
+	ErrMessageNotModified               RPCError = "MESSAGE_NOT_MODIFIED"                  // The provided message data is identical to the previous message data, the message wasn't modified.
+	ErrMessagePollClosed                RPCError = "MESSAGE_POLL_CLOSED"                   // Poll closed.
+	ErrMessageTooLong                   RPCError = "MESSAGE_TOO_LONG"                      // The provided message is too long.
+	ErrMethodInvalid                    RPCError = "METHOD_INVALID"                        // The specified method is invalid.
+	ErrMinDateInvalid                   RPCError = "MIN_DATE_INVALID"                      // The specified minimum date is invalid.
+	ErrMsgidDecreaseRetry               RPCError = "MSGID_DECREASE_RETRY"                  // The request should be retried with a lower message ID.
+	ErrMsgIDInvalid                     RPCError = "MSG_ID_INVALID"                        // Invalid message ID provided.
+	ErrMsgTooOld                        RPCError = "MSG_TOO_OLD"                           // Time has passed since the message was sent, read receipts were deleted.
+	ErrMsgWaitFailed                    RPCError = "MSG_WAIT_FAILED"                       // A waiting call returned an error.
+	ErrMtSendQueueTooLong               RPCError = "MT_SEND_QUEUE_TOO_LONG"                // The message was not sent because the send queue is too long.
+	ErrMultiMediaTooLong                RPCError = "MULTI_MEDIA_TOO_LONG"                  // Too many media files for album.
+	ErrNeedChatInvalid                  RPCError = "NEED_CHAT_INVALID"                     // The provided chat is invalid.
+	ErrNeedMemberInvalid                RPCError = "NEED_MEMBER_INVALID"                   // The provided member is invalid or does not exist.
+	ErrNetworkMigrate                   RPCError = "NETWORK_MIGRATE_X"                     // The source IP address is associated with DC X.
+	ErrNewSaltInvalid                   RPCError = "NEW_SALT_INVALID"                      // The new salt is invalid.
+	ErrNewSettingsEmpty                 RPCError = "NEW_SETTINGS_EMPTY"                    // No password is set on the current account, and no new password was specified in new_settings.
+	ErrNewSettingsInvalid               RPCError = "NEW_SETTINGS_INVALID"                  // The new password settings are invalid.
+	ErrNextOffsetInvalid                RPCError = "NEXT_OFFSET_INVALID"                   // The specified offset is longer than 64 bytes.
+	ErrNotAllowed                       RPCError = "NOT_ALLOWED"                           // Action not allowed.
+	ErrOffsetInvalid                    RPCError = "OFFSET_INVALID"                        // The provided offset is invalid.
+	ErrOffsetPeerIDInvalid              RPCError = "OFFSET_PEER_ID_INVALID"                // The provided offset peer is invalid.
+	ErrOptionsTooMuch                   RPCError = "OPTIONS_TOO_MUCH"                      // Too many options provided.
+	ErrOptionInvalid                    RPCError = "OPTION_INVALID"                        // Invalid option selected.
+	ErrOrderInvalid                     RPCError = "ORDER_INVALID"                         // The specified username order is invalid.
+	ErrPackShortNameInvalid             RPCError = "PACK_SHORT_NAME_INVALID"               // Short pack name invalid.
+	ErrPackShortNameOccupied            RPCError = "PACK_SHORT_NAME_OCCUPIED"              // A stickerpack with this name already exists.
+	ErrPackTitleInvalid                 RPCError = "PACK_TITLE_INVALID"                    // The stickerpack title is invalid.
+	ErrParticipantsTooFew               RPCError = "PARTICIPANTS_TOO_FEW"                  // Not enough participants.
+	ErrParticipantCallFailed            RPCError = "PARTICIPANT_CALL_FAILED"               // Failure while making call.
+	ErrParticipantIDInvalid             RPCError = "PARTICIPANT_ID_INVALID"                // The specified participant ID is invalid.
+	ErrParticipantJoinMissing           RPCError = "PARTICIPANT_JOIN_MISSING"              // User must join the Video Chat before enabling presentation.
+	ErrParticipantVersionOutdated       RPCError = "PARTICIPANT_VERSION_OUTDATED"          // The other participant does not use an up to date telegram client with support for calls.
+	ErrPasswordEmpty                    RPCError = "PASSWORD_EMPTY"                        // The provided password is empty.
+	ErrPasswordHashInvalid              RPCError = "PASSWORD_HASH_INVALID"                 // The provided password hash is invalid.
+	ErrPasswordMissing                  RPCError = "PASSWORD_MISSING"                      // You must enable 2FA in order to transfer ownership of a channel.
+	ErrPasswordRecoveryExpired          RPCError = "PASSWORD_RECOVERY_EXPIRED"             // The recovery code has expired.
+	ErrPasswordRecoveryNa               RPCError = "PASSWORD_RECOVERY_NA"                  // No email was set, can't recover password via email.
+	ErrPasswordRequired                 RPCError = "PASSWORD_REQUIRED"                     // A 2FA password must be configured to use Telegram Passport.
+	ErrPasswordTooFresh                 RPCError = "PASSWORD_TOO_FRESH_X"                  // The password was modified less than 24 hours ago, try again in X seconds.
+	ErrPaymentProviderInvalid           RPCError = "PAYMENT_PROVIDER_INVALID"              // The specified payment provider is invalid.
+	ErrPaymentUnsupported               RPCError = "PAYMENT_UNSUPPORTED"                   // This payment method is not acceptable.
+	ErrPeersListEmpty                   RPCError = "PEERS_LIST_EMPTY"                      // The specified list of peers is empty.
+	ErrPeerFlood                        RPCError = "PEER_FLOOD"                            // Too many requests.
+	ErrPeerHistoryEmpty                 RPCError = "PEER_HISTORY_EMPTY"                    // You can't pin an empty chat with a user.
+	ErrPeerIDInvalid                    RPCError = "PEER_ID_INVALID"                       // The provided peer id is invalid.
+	ErrPeerIDNotSupported               RPCError = "PEER_ID_NOT_SUPPORTED"                 // The provided peer ID is not supported.
+	ErrPersistentTimestampEmpty         RPCError = "PERSISTENT_TIMESTAMP_EMPTY"            // Persistent timestamp empty.
+	ErrPersistentTimestampInvalid       RPCError = "PERSISTENT_TIMESTAMP_INVALID"          // Persistent timestamp invalid.
+	ErrPersistentTimestampOutdated      RPCError = "PERSISTENT_TIMESTAMP_OUTDATED"         // Channel internal replication issues, try again later.
+	ErrPhoneCodeEmpty                   RPCError = "PHONE_CODE_EMPTY"                      // phone_code is missing.
+	ErrPhoneCodeExpired                 RPCError = "PHONE_CODE_EXPIRED"                    // The phone code you provided has expired.
+	ErrPhoneCodeHashEmpty               RPCError = "PHONE_CODE_HASH_EMPTY"                 // phone_code_hash is missing.
+	ErrPhoneCodeInvalid                 RPCError = "PHONE_CODE_INVALID"                    // The provided phone code is invalid.
+	ErrPhoneHashExpired                 RPCError = "PHONE_HASH_EXPIRED"                    // An invalid or expired phone_code_hash was provided.
+	ErrPhoneMigrate                     RPCError = "PHONE_MIGRATE_X"                       // The phone number a user is trying to use for authorization is associated with DC X.
+	ErrPhoneNotOccupied                 RPCError = "PHONE_NOT_OCCUPIED"                    // No user is associated to the specified phone number.
+	ErrPhoneNumberAppSignupForbidden    RPCError = "PHONE_NUMBER_APP_SIGNUP_FORBIDDEN"     // You can't sign up using this app.
+	ErrPhoneNumberBanned                RPCError = "PHONE_NUMBER_BANNED"                   // The provided phone number is banned from telegram.
+	ErrPhoneNumberFlood                 RPCError = "PHONE_NUMBER_FLOOD"                    // You asked for the code too many times.
+	ErrPhoneNumberInvalid               RPCError = "PHONE_NUMBER_INVALID"                  // The phone number is invalid.
+	ErrPhoneNumberOccupied              RPCError = "PHONE_NUMBER_OCCUPIED"                 // The phone number is already in use.
+	ErrPhoneNumberUnoccupied            RPCError = "PHONE_NUMBER_UNOCCUPIED"               // The phone number is not yet being used.
+	ErrPhonePasswordFlood               RPCError = "PHONE_PASSWORD_FLOOD"                  // You have tried logging in too many times.
+	ErrPhonePasswordProtected           RPCError = "PHONE_PASSWORD_PROTECTED"              // This phone is password protected.
+	ErrPhotoContentTypeInvalid          RPCError = "PHOTO_CONTENT_TYPE_INVALID"            // Photo mime-type invalid.
+	ErrPhotoContentURLEmpty             RPCError = "PHOTO_CONTENT_URL_EMPTY"               // Photo URL invalid.
+	ErrPhotoCropFileMissing             RPCError = "PHOTO_CROP_FILE_MISSING"               // Photo crop file missing.
+	ErrPhotoCropSizeSmall               RPCError = "PHOTO_CROP_SIZE_SMALL"                 // Photo is too small.
+	ErrPhotoExtInvalid                  RPCError = "PHOTO_EXT_INVALID"                     // The extension of the photo is invalid.
+	ErrPhotoFileMissing                 RPCError = "PHOTO_FILE_MISSING"                    // Profile photo file missing.
+	ErrPhotoIDInvalid                   RPCError = "PHOTO_ID_INVALID"                      // Photo ID invalid.
+	ErrPhotoInvalid                     RPCError = "PHOTO_INVALID"                         // Photo invalid.
+	ErrPhotoInvalidDimensions           RPCError = "PHOTO_INVALID_DIMENSIONS"              // The photo dimensions are invalid.
+	ErrPhotoSaveFileInvalid             RPCError = "PHOTO_SAVE_FILE_INVALID"               // Internal issues, try again later.
+	ErrPhotoThumbURLEmpty               RPCError = "PHOTO_THUMB_URL_EMPTY"                 // Photo thumbnail URL is empty.
+	ErrPinnedDialogsTooMuch             RPCError = "PINNED_DIALOGS_TOO_MUCH"               // Too many pinned dialogs.
+	ErrPinRestricted                    RPCError = "PIN_RESTRICTED"                        // You can't pin messages.
+	ErrPollAnswersInvalid               RPCError = "POLL_ANSWERS_INVALID"                  // Invalid poll answers were provided.
+	ErrPollAnswerInvalid                RPCError = "POLL_ANSWER_INVALID"                   // One of the poll answers is not acceptable.
+	ErrPollOptionDuplicate              RPCError = "POLL_OPTION_DUPLICATE"                 // Duplicate poll options provided.
+	ErrPollOptionInvalid                RPCError = "POLL_OPTION_INVALID"                   // Invalid poll option provided.
+	ErrPollQuestionInvalid              RPCError = "POLL_QUESTION_INVALID"                 // One of the poll questions is not acceptable.
+	ErrPollUnsupported                  RPCError = "POLL_UNSUPPORTED"                      // This layer does not support polls in the issued method.
+	ErrPollVoteRequired                 RPCError = "POLL_VOTE_REQUIRED"                    // Cast a vote in the poll before calling this method.
+	ErrPostponedTimeout                 RPCError = "POSTPONED_TIMEOUT"                     // An internal timeout occurred with the Telegram server, try again later.
+	ErrPremiumAccountRequired           RPCError = "PREMIUM_ACCOUNT_REQUIRED"              // A premium account is required to execute this action.
+	ErrPremiumCurrentlyUnavailable      RPCError = "PREMIUM_CURRENTLY_UNAVAILABLE"         // Premium is currently unavailable.
+	ErrPremiumSubActiveUntil            RPCError = "PREMIUM_SUB_ACTIVE_UNTIL_X"            // You already have a premium subscription active until unixtime X.
+	ErrPreviousChatImportActiveWaitMin  RPCError = "PREVIOUS_CHAT_IMPORT_ACTIVE_WAIT_XMIN" // Import for this chat is already in progress, wait X minutes before starting a new one.
+	ErrPrivacyKeyInvalid                RPCError = "PRIVACY_KEY_INVALID"                   // The privacy key is invalid.
+	ErrPrivacyPremiumRequired           RPCError = "PRIVACY_PREMIUM_REQUIRED"              // You need a Telegram Premium subscription to send a message to this user.
+	ErrPrivacyTooLong                   RPCError = "PRIVACY_TOO_LONG"                      // Too many privacy rules were specified, the current limit is 1000.
+	ErrPrivacyValueInvalid              RPCError = "PRIVACY_VALUE_INVALID"                 // The specified privacy rule combination is invalid.
+	ErrPtsChangeEmpty                   RPCError = "PTS_CHANGE_EMPTY"                      // No PTS change.
+	ErrPublicChannelMissing             RPCError = "PUBLIC_CHANNEL_MISSING"                // You can only export group call invite links for public chats or channels.
+	ErrPublicKeyRequired                RPCError = "PUBLIC_KEY_REQUIRED"                   // A public key is required.
+	ErrQueryIDEmpty                     RPCError = "QUERY_ID_EMPTY"                        // The query ID is empty.
+	ErrQueryIDInvalid                   RPCError = "QUERY_ID_INVALID"                      // The query ID is invalid.
+	ErrQueryTooShort                    RPCError = "QUERY_TOO_SHORT"                       // The query string is too short.
+	ErrQuizAnswerMissing                RPCError = "QUIZ_ANSWER_MISSING"                   // You can forward a quiz while hiding the original author only after choosing an option in the quiz.
+	ErrQuizCorrectAnswersEmpty          RPCError = "QUIZ_CORRECT_ANSWERS_EMPTY"            // No correct quiz answer was specified.
+	ErrQuizCorrectAnswersTooMuch        RPCError = "QUIZ_CORRECT_ANSWERS_TOO_MUCH"         // You specified too many correct answers in a quiz, quizzes can only have one right answer!
+	ErrQuizCorrectAnswerInvalid         RPCError = "QUIZ_CORRECT_ANSWER_INVALID"           // An invalid value was provided to the correct_answers field.
+	ErrQuizMultipleInvalid              RPCError = "QUIZ_MULTIPLE_INVALID"                 // Quizzes can't have the multiple_choice flag set!
+	ErrRandomIDDuplicate                RPCError = "RANDOM_ID_DUPLICATE"                   // You provided a random ID that was already used.
+	ErrRandomIDEmpty                    RPCError = "RANDOM_ID_EMPTY"                       // Random ID empty.
+	ErrRandomIDInvalid                  RPCError = "RANDOM_ID_INVALID"                     // A provided random ID is invalid.
+	ErrRandomLengthInvalid              RPCError = "RANDOM_LENGTH_INVALID"                 // Random length invalid.
+	ErrRangesInvalid                    RPCError = "RANGES_INVALID"                        // Invalid range provided.
+	ErrReactionsTooMany                 RPCError = "REACTIONS_TOO_MANY"                    // The message already has too many reaction emojis, you can't react with a new emoji.
+	ErrReactionEmpty                    RPCError = "REACTION_EMPTY"                        // Empty reaction provided.
+	ErrReactionInvalid                  RPCError = "REACTION_INVALID"                      // The specified reaction is invalid.
+	ErrReflectorNotAvailable            RPCError = "REFLECTOR_NOT_AVAILABLE"               // Invalid call reflector server.
+	ErrRegIDGenerateFailed              RPCError = "REG_ID_GENERATE_FAILED"                // Failure while generating registration ID.
+	ErrReplyMarkupBuyEmpty              RPCError = "REPLY_MARKUP_BUY_EMPTY"                // Reply markup for buy button empty.
+	ErrReplyMarkupGameEmpty             RPCError = "REPLY_MARKUP_GAME_EMPTY"               // The provided reply markup for the game is empty.
+	ErrReplyMarkupInvalid               RPCError = "REPLY_MARKUP_INVALID"                  // The provided reply markup is invalid.
+	ErrReplyMarkupTooLong               RPCError = "REPLY_MARKUP_TOO_LONG"                 // The specified reply_markup is too long.
+	ErrReplyMessageIDInvalid            RPCError = "REPLY_MESSAGE_ID_INVALID"              // The specified reply-to message ID is invalid.
+	ErrReplyToInvalid                   RPCError = "REPLY_TO_INVALID"                      // The specified reply_to field is invalid.
+	ErrReplyToUserInvalid               RPCError = "REPLY_TO_USER_INVALID"                 // The replied-to user is invalid.
+	ErrResetRequestMissing              RPCError = "RESET_REQUEST_MISSING"                 // No password reset is in progress.
+	ErrResultsTooMuch                   RPCError = "RESULTS_TOO_MUCH"                      // Too many results were provided.
+	ErrResultIDDuplicate                RPCError = "RESULT_ID_DUPLICATE"                   // You provided a duplicate result ID.
+	ErrResultIDEmpty                    RPCError = "RESULT_ID_EMPTY"                       // Result ID empty.
+	ErrResultIDInvalid                  RPCError = "RESULT_ID_INVALID"                     // One of the specified result IDs is invalid.
+	ErrResultTypeInvalid                RPCError = "RESULT_TYPE_INVALID"                   // Result type invalid.
+	ErrRevoteNotAllowed                 RPCError = "REVOTE_NOT_ALLOWED"                    // You cannot change your vote.
+	ErrRightsNotModified                RPCError = "RIGHTS_NOT_MODIFIED"                   // The new admin rights are equal to the old rights, no change was made.
+	ErrRightForbidden                   RPCError = "RIGHT_FORBIDDEN"                       // Your admin rights do not allow you to do this.
+	ErrRPCCallFail                      RPCError = "RPC_CALL_FAIL"                         // Telegram is having internal issues, please try again later.
+	ErrRPCMcgetFail                     RPCError = "RPC_MCGET_FAIL"                        // Telegram is having internal issues, please try again later.
+	ErrRsaDecryptFailed                 RPCError = "RSA_DECRYPT_FAILED"                    // Internal RSA decryption failed.
+	ErrScheduleBotNotAllowed            RPCError = "SCHEDULE_BOT_NOT_ALLOWED"              // Bots cannot schedule messages.
+	ErrScheduleDateInvalid              RPCError = "SCHEDULE_DATE_INVALID"                 // Invalid schedule date provided.
+	ErrScheduleDateTooLate              RPCError = "SCHEDULE_DATE_TOO_LATE"                // You can't schedule a message this far in the future.
+	ErrScheduleStatusPrivate            RPCError = "SCHEDULE_STATUS_PRIVATE"               // Can't schedule until user is online, if the user's last seen timestamp is hidden by their privacy settings.
+	ErrScheduleTooMuch                  RPCError = "SCHEDULE_TOO_MUCH"                     // There are too many scheduled messages.
+	ErrScoreInvalid                     RPCError = "SCORE_INVALID"                         // The specified game score is invalid.
+	ErrSearchQueryEmpty                 RPCError = "SEARCH_QUERY_EMPTY"                    // The search query is empty.
+	ErrSearchWithLinkNotSupported       RPCError = "SEARCH_WITH_LINK_NOT_SUPPORTED"        // You cannot provide a search query and an invite link at the same time.
+	ErrSecondsInvalid                   RPCError = "SECONDS_INVALID"                       // Invalid duration provided.
+	ErrSendAsPeerInvalid                RPCError = "SEND_AS_PEER_INVALID"                  // You can't send messages as the specified peer.
+	ErrSendCodeUnavailable              RPCError = "SEND_CODE_UNAVAILABLE"                 // Returned when all available options for this type of number were already used.
+	ErrSendMediaInvalid                 RPCError = "SEND_MEDIA_INVALID"                    // The specified media is invalid.
+	ErrSendMessageMediaInvalid          RPCError = "SEND_MESSAGE_MEDIA_INVALID"            // Invalid media provided.
+	ErrSendMessageTypeInvalid           RPCError = "SEND_MESSAGE_TYPE_INVALID"             // The message type is invalid.
+	ErrSensitiveChangeForbidden         RPCError = "SENSITIVE_CHANGE_FORBIDDEN"            // You can't change your sensitive content settings.
+	ErrSessionExpired                   RPCError = "SESSION_EXPIRED"                       // The authorization has expired.
+	ErrSessionPasswordNeeded            RPCError = "SESSION_PASSWORD_NEEDED"               // 2FA is enabled, use a password to login.
+	ErrSessionRevoked                   RPCError = "SESSION_REVOKED"                       // The authorization has been invalidated because the user terminated all sessions.
+	ErrSessionTooFresh                  RPCError = "SESSION_TOO_FRESH_X"                   // This session was created less than 24 hours ago, try again in X seconds.
+	ErrSettingsInvalid                  RPCError = "SETTINGS_INVALID"                      // Invalid settings were provided.
+	ErrSHA256HashInvalid                RPCError = "SHA256_HASH_INVALID"                   // The provided SHA256 hash is invalid.
+	ErrShortnameOccupyFailed            RPCError = "SHORTNAME_OCCUPY_FAILED"               // An error occurred when trying to register the short-name used for the sticker pack. Try a different name.
+	ErrShortNameInvalid                 RPCError = "SHORT_NAME_INVALID"                    // The specified short name is invalid.
+	ErrShortNameOccupied                RPCError = "SHORT_NAME_OCCUPIED"                   // The specified short name is already in use.
+	ErrSignInFailed                     RPCError = "SIGN_IN_FAILED"                        // Failure while signing in.
+	ErrSlotsEmpty                       RPCError = "SLOTS_EMPTY"                           // The specified slot list is empty.
+	ErrSlowmodeMultiMsgsDisabled        RPCError = "SLOWMODE_MULTI_MSGS_DISABLED"          // Slowmode is enabled, you cannot forward multiple messages to this group.
+	ErrSlowmodeWait                     RPCError = "SLOWMODE_WAIT_X"                       // Slowmode is enabled in this chat: wait X seconds before sending another message to this chat.
+	ErrSlugInvalid                      RPCError = "SLUG_INVALID"                          // The specified invoice slug is invalid.
+	ErrSMSCodeCreateFailed              RPCError = "SMS_CODE_CREATE_FAILED"                // An error occurred while creating the SMS code.
+	ErrSRPIDInvalid                     RPCError = "SRP_ID_INVALID"                        // Invalid SRP ID provided.
+	ErrSRPPasswordChanged               RPCError = "SRP_PASSWORD_CHANGED"                  // Password has changed.
+	ErrStartParamEmpty                  RPCError = "START_PARAM_EMPTY"                     // The start parameter is empty.
+	ErrStartParamInvalid                RPCError = "START_PARAM_INVALID"                   // Start parameter invalid.
+	ErrStartParamTooLong                RPCError = "START_PARAM_TOO_LONG"                  // Start parameter is too long.
+	ErrStatsMigrate                     RPCError = "STATS_MIGRATE_X"                       // The channel statistics must be fetched from DC X.
+	ErrStickerpackStickersTooMuch       RPCError = "STICKERPACK_STICKERS_TOO_MUCH"         // There are too many stickers in this stickerpack, you can't add any more.
+	ErrStickersetInvalid                RPCError = "STICKERSET_INVALID"                    // The provided sticker set is invalid.
+	ErrStickersetOwnerAnonymous         RPCError = "STICKERSET_OWNER_ANONYMOUS"            // Provided stickerset can't be installed as group stickerset to prevent admin deanonymization.
+	ErrStickersEmpty                    RPCError = "STICKERS_EMPTY"                        // No sticker provided.
+	ErrStickersTooMuch                  RPCError = "STICKERS_TOO_MUCH"                     // There are too many stickers in this stickerpack, you can't add any more.
+	ErrStickerDocumentInvalid           RPCError = "STICKER_DOCUMENT_INVALID"              // The specified sticker document is invalid.
+	ErrStickerEmojiInvalid              RPCError = "STICKER_EMOJI_INVALID"                 // Sticker emoji invalid.
+	ErrStickerFileInvalid               RPCError = "STICKER_FILE_INVALID"                  // Sticker file invalid.
+	ErrStickerGifDimensions             RPCError = "STICKER_GIF_DIMENSIONS"                // The specified video sticker has invalid dimensions.
+	ErrStickerIDInvalid                 RPCError = "STICKER_ID_INVALID"                    // The provided sticker ID is invalid.
+	ErrStickerInvalid                   RPCError = "STICKER_INVALID"                       // The provided sticker is invalid.
+	ErrStickerMimeInvalid               RPCError = "STICKER_MIME_INVALID"                  // The specified sticker MIME type is invalid.
+	ErrStickerPngDimensions             RPCError = "STICKER_PNG_DIMENSIONS"                // Sticker png dimensions invalid.
+	ErrStickerPngNopng                  RPCError = "STICKER_PNG_NOPNG"                     // One of the specified stickers is not a valid PNG file.
+	ErrStickerTgsNodoc                  RPCError = "STICKER_TGS_NODOC"                     // You must send the animated sticker as a document.
+	ErrStickerTgsNotgs                  RPCError = "STICKER_TGS_NOTGS"                     // Invalid TGS sticker provided.
+	ErrStickerThumbPngNopng             RPCError = "STICKER_THUMB_PNG_NOPNG"               // Incorrect stickerset thumb file provided, PNG / WEBP expected.
+	ErrStickerThumbTgsNotgs             RPCError = "STICKER_THUMB_TGS_NOTGS"               // Incorrect stickerset TGS thumb file provided.
+	ErrStickerVideoBig                  RPCError = "STICKER_VIDEO_BIG"                     // The specified video sticker is too big.
+	ErrStickerVideoNodoc                RPCError = "STICKER_VIDEO_NODOC"                   // You must send the video sticker as a document.
+	ErrStickerVideoNowebm               RPCError = "STICKER_VIDEO_NOWEBM"                  // The specified video sticker is not in webm format.
+	ErrStorageCheckFailed               RPCError = "STORAGE_CHECK_FAILED"                  // Server storage check failed.
+	ErrStoreInvalidScalarType           RPCError = "STORE_INVALID_SCALAR_TYPE"             // Invalid scalar type.
+	ErrStoriesNeverCreated              RPCError = "STORIES_NEVER_CREATED"                 // This peer hasn't ever posted any stories.
+	ErrStoriesTooMuch                   RPCError = "STORIES_TOO_MUCH"                      // You have hit the maximum active stories limit; you should buy a Premium subscription or wait for the oldest story to expire.
+	ErrStoryIDEmpty                     RPCError = "STORY_ID_EMPTY"                        // You specified no story IDs.
+	ErrStoryIDInvalid                   RPCError = "STORY_ID_INVALID"                      // The specified story ID is invalid.
+	ErrStoryNotModified                 RPCError = "STORY_NOT_MODIFIED"                    // The new story information you passed is equal to the previous story information, thus it wasn't modified.
+	ErrStoryPeriodInvalid               RPCError = "STORY_PERIOD_INVALID"                  // The specified story period is invalid for this account.
+	ErrStorySendFloodMonthly            RPCError = "STORY_SEND_FLOOD_MONTHLY_X"            // You've hit the monthly story limit; wait for the specified number of seconds before posting a new story.
+	ErrStorySendFloodWeekly             RPCError = "STORY_SEND_FLOOD_WEEKLY_X"             // You've hit the weekly story limit; wait for the specified number of seconds before posting a new story.
+	ErrSwitchPmTextEmpty                RPCError = "SWITCH_PM_TEXT_EMPTY"                  // The switch_pm.text field was empty.
+	ErrTakeoutInitDelay                 RPCError = "TAKEOUT_INIT_DELAY_X"                  // Sorry, for security reasons, you will be able to begin downloading your data in X seconds.
+	ErrTakeoutInvalid                   RPCError = "TAKEOUT_INVALID"                       // The specified takeout ID is invalid.
+	ErrTakeoutRequired                  RPCError = "TAKEOUT_REQUIRED"                      // A takeout session needs to be initialized first.
+	ErrTaskAlreadyExists                RPCError = "TASK_ALREADY_EXISTS"                   // An email reset was already requested.
+	ErrTempAuthKeyAlreadyBound          RPCError = "TEMP_AUTH_KEY_ALREADY_BOUND"           // The passed temporary key is already bound to another perm_auth_key_id.
+	ErrTempAuthKeyEmpty                 RPCError = "TEMP_AUTH_KEY_EMPTY"                   // No temporary auth key provided.
+	ErrThemeFileInvalid                 RPCError = "THEME_FILE_INVALID"                    // Invalid theme file provided.
+	ErrThemeFormatInvalid               RPCError = "THEME_FORMAT_INVALID"                  // Invalid theme format provided.
+	ErrThemeInvalid                     RPCError = "THEME_INVALID"                         // Invalid theme provided.
+	ErrThemeMimeInvalid                 RPCError = "THEME_MIME_INVALID"                    // The theme's MIME type is invalid.
+	ErrThemeTitleInvalid                RPCError = "THEME_TITLE_INVALID"                   // The specified theme title is invalid.
+	ErrTimeout                          RPCError = "TIMEOUT"                               // A timeout occurred while fetching data from the worker.
+	ErrTitleInvalid                     RPCError = "TITLE_INVALID"                         // The specified stickerpack title is invalid.
+	ErrTmpPasswordDisabled              RPCError = "TMP_PASSWORD_DISABLED"                 // The temporary password is disabled.
+	ErrTmpPasswordInvalid               RPCError = "TMP_PASSWORD_INVALID"                  // Password auth needs to be regenerated.
+	ErrTokenEmpty                       RPCError = "TOKEN_EMPTY"                           // The specified token is empty.
+	ErrTokenInvalid                     RPCError = "TOKEN_INVALID"                         // The provided token is invalid.
+	ErrTokenTypeInvalid                 RPCError = "TOKEN_TYPE_INVALID"                    // The specified token type is invalid.
+	ErrTopicsEmpty                      RPCError = "TOPICS_EMPTY"                          // You specified no topic IDs.
+	ErrTopicClosed                      RPCError = "TOPIC_CLOSED"                          // This topic was closed, you can't send messages to it anymore.
+	ErrTopicCloseSeparately             RPCError = "TOPIC_CLOSE_SEPARATELY"                // The close flag cannot be provided together with any of the other flags.
+	ErrTopicDeleted                     RPCError = "TOPIC_DELETED"                         // The specified topic was deleted.
+	ErrTopicHideSeparately              RPCError = "TOPIC_HIDE_SEPARATELY"                 // The hide flag cannot be provided together with any of the other flags.
+	ErrTopicIDInvalid                   RPCError = "TOPIC_ID_INVALID"                      // The specified topic ID is invalid.
+	ErrTopicNotModified                 RPCError = "TOPIC_NOT_MODIFIED"                    // The updated topic info is equal to the current topic info, nothing was changed.
+	ErrTopicTitleEmpty                  RPCError = "TOPIC_TITLE_EMPTY"                     // The specified topic title is empty.
+	ErrToLangInvalid                    RPCError = "TO_LANG_INVALID"                       // The specified destination language is invalid.
+	ErrTranscriptionFailed              RPCError = "TRANSCRIPTION_FAILED"                  // Audio transcription failed.
+	ErrTTLDaysInvalid                   RPCError = "TTL_DAYS_INVALID"                      // The provided TTL is invalid.
+	ErrTTLMediaInvalid                  RPCError = "TTL_MEDIA_INVALID"                     // Invalid media Time To Live was provided.
+	ErrTTLPeriodInvalid                 RPCError = "TTL_PERIOD_INVALID"                    // The specified TTL period is invalid.
+	ErrTypesEmpty                       RPCError = "TYPES_EMPTY"                           // No top peer type was provided.
+	ErrTypeConstructorInvalid           RPCError = "TYPE_CONSTRUCTOR_INVALID"              // The type constructor is invalid.
+	ErrTimedout                         RPCError = "Timedout"                              // Timeout while fetching data.
+	ErrServerTimeout                    RPCError = "Timeout"                               // Timeout while fetching data.
+	ErrUnknownError                     RPCError = "UNKNOWN_ERROR"                         // The server has returned an unknown error.
+	ErrRPCUnknownMethod                 RPCError = "UNKNOWN_METHOD"                        // The method you tried to call cannot be called on non-CDN DCs.
+	ErrUntilDateInvalid                 RPCError = "UNTIL_DATE_INVALID"                    // Invalid until date provided.
+	ErrUpdateAppToLogin                 RPCError = "UPDATE_APP_TO_LOGIN"                   // This layer no longer supports logging in, please update your app.
+	ErrURLInvalid                       RPCError = "URL_INVALID"                           // Invalid URL provided.
+	ErrUsageLimitInvalid                RPCError = "USAGE_LIMIT_INVALID"                   // The specified usage limit is invalid.
+	ErrUsernamesActiveTooMuch           RPCError = "USERNAMES_ACTIVE_TOO_MUCH"             // The maximum number of active usernames was reached.
+	ErrUsernameInvalid                  RPCError = "USERNAME_INVALID"                      // The provided username is not valid.
+	ErrUsernameNotModified              RPCError = "USERNAME_NOT_MODIFIED"                 // The username was not modified.
+	ErrUsernameNotOccupied              RPCError = "USERNAME_NOT_OCCUPIED"                 // The provided username is not occupied.
+	ErrUsernameOccupied                 RPCError = "USERNAME_OCCUPIED"                     // The provided username is already occupied.
+	ErrUsernamePurchaseAvailable        RPCError = "USERNAME_PURCHASE_AVAILABLE"           // The specified username can be purchased.
+	ErrUserpicPrivacyRequired           RPCError = "USERPIC_PRIVACY_REQUIRED"              // You need to disable privacy settings for your profile picture in order to make your geolocation public.
+	ErrUserpicUploadRequired            RPCError = "USERPIC_UPLOAD_REQUIRED"               // You must have a profile picture to publish your geolocation.
+	ErrUsersTooFew                      RPCError = "USERS_TOO_FEW"                         // Not enough users.
+	ErrUsersTooMuch                     RPCError = "USERS_TOO_MUCH"                        // The maximum number of users has been exceeded.
+	ErrUserAdminInvalid                 RPCError = "USER_ADMIN_INVALID"                    // You're not an admin.
+	ErrUserAlreadyInvited               RPCError = "USER_ALREADY_INVITED"                  // You have already invited this user.
+	ErrUserAlreadyParticipant           RPCError = "USER_ALREADY_PARTICIPANT"              // The user is already in the group.
+	ErrUserBannedInChannel              RPCError = "USER_BANNED_IN_CHANNEL"                // You're banned from sending messages in supergroups/channels.
+	ErrUserBlocked                      RPCError = "USER_BLOCKED"                          // User blocked.
+	ErrUserBot                          RPCError = "USER_BOT"                              // Bots can only be admins in channels.
+	ErrUserBotInvalid                   RPCError = "USER_BOT_INVALID"                      // This method can only be invoked by bot accounts.
+	ErrUserBotRequired                  RPCError = "USER_BOT_REQUIRED"                     // This method can only be called by a bot.
+	ErrUserChannelsTooMuch              RPCError = "USER_CHANNELS_TOO_MUCH"                // One of the users you tried to add is already in too many channels/supergroups.
+	ErrUserCreator                      RPCError = "USER_CREATOR"                          // You can't leave this channel, because you're its creator.
+	ErrUserDeactivated                  RPCError = "USER_DEACTIVATED"                      // The user has been deleted/deactivated.
+	ErrUserDeactivatedBan               RPCError = "USER_DEACTIVATED_BAN"                  // The user has been deleted/deactivated.
+	ErrUserDeleted                      RPCError = "USER_DELETED"                          // You can't send this secret message because the other participant deleted their account.
+	ErrUserIDInvalid                    RPCError = "USER_ID_INVALID"                       // The provided user ID is invalid.
+	ErrUserInvalid                      RPCError = "USER_INVALID"                          // Invalid user provided.
+	ErrUserIsBlocked                    RPCError = "USER_IS_BLOCKED"                       // You were blocked by this user.
+	ErrUserIsBot                        RPCError = "USER_IS_BOT"                           // Bots can't send messages to other bots.
+	ErrUserKicked                       RPCError = "USER_KICKED"                           // This user was kicked from this supergroup/channel.
+	ErrUserMigrate                      RPCError = "USER_MIGRATE_X"                        // The user whose identity is being used to execute queries is associated with DC X.
+	ErrUserNotMutualContact             RPCError = "USER_NOT_MUTUAL_CONTACT"               // The provided user is not a mutual contact.
+	ErrUserNotParticipant               RPCError = "USER_NOT_PARTICIPANT"                  // You're not a member of this supergroup/channel.
+	ErrUserPrivacyRestricted            RPCError = "USER_PRIVACY_RESTRICTED"               // The user's privacy settings do not allow you to do this.
+	ErrUserPublicMissing                RPCError = "USER_PUBLIC_MISSING"                   // Cannot generate a link to stories posted by a peer without a username.
+	ErrUserRestricted                   RPCError = "USER_RESTRICTED"                       // You're spamreported, you can't create channels or chats.
+	ErrUserVolumeInvalid                RPCError = "USER_VOLUME_INVALID"                   // The specified user volume is invalid.
+	ErrVenueIDInvalid                   RPCError = "VENUE_ID_INVALID"                      // The specified venue ID is invalid.
+	ErrVideoContentTypeInvalid          RPCError = "VIDEO_CONTENT_TYPE_INVALID"            // The video's content type is invalid.
+	ErrVideoFileInvalid                 RPCError = "VIDEO_FILE_INVALID"                    // The specified video file is invalid.
+	ErrVideoTitleEmpty                  RPCError = "VIDEO_TITLE_EMPTY"                     // The specified video title is empty.
+	ErrVoiceMessagesForbidden           RPCError = "VOICE_MESSAGES_FORBIDDEN"              // This user's privacy settings forbid you from sending voice messages.
+	ErrWallpaperFileInvalid             RPCError = "WALLPAPER_FILE_INVALID"                // The specified wallpaper file is invalid.
+	ErrWallpaperInvalid                 RPCError = "WALLPAPER_INVALID"                     // The specified wallpaper is invalid.
+	ErrWallpaperMimeInvalid             RPCError = "WALLPAPER_MIME_INVALID"                // The specified wallpaper MIME type is invalid.
+	ErrWallpaperNotFound                RPCError = "WALLPAPER_NOT_FOUND"                   // The specified wallpaper could not be found.
+	ErrWcConvertURLInvalid              RPCError = "WC_CONVERT_URL_INVALID"                // WC convert URL invalid.
+	ErrWebdocumentInvalid               RPCError = "WEBDOCUMENT_INVALID"                   // Invalid webdocument URL provided.
+	ErrWebdocumentMimeInvalid           RPCError = "WEBDOCUMENT_MIME_INVALID"              // Invalid webdocument mime type provided.
+	ErrWebdocumentSizeTooBig            RPCError = "WEBDOCUMENT_SIZE_TOO_BIG"              // Webdocument is too big!
+	ErrWebdocumentURLInvalid            RPCError = "WEBDOCUMENT_URL_INVALID"               // The specified webdocument URL is invalid.
+	ErrWebpageCurlFailed                RPCError = "WEBPAGE_CURL_FAILED"                   // Failure while fetching the webpage with cURL.
+	ErrWebpageMediaEmpty                RPCError = "WEBPAGE_MEDIA_EMPTY"                   // Webpage media empty.
+	ErrWebpageNotFound                  RPCError = "WEBPAGE_NOT_FOUND"                     // A preview for the specified webpage url could not be generated.
+	ErrWebpageURLInvalid                RPCError = "WEBPAGE_URL_INVALID"                   // The specified webpage url is invalid.
+	ErrWebpushAuthInvalid               RPCError = "WEBPUSH_AUTH_INVALID"                  // The specified web push authentication secret is invalid.
+	ErrWebpushKeyInvalid                RPCError = "WEBPUSH_KEY_INVALID"                   // The specified web push elliptic curve Diffie-Hellman public key is invalid.
+	ErrWebpushTokenInvalid              RPCError = "WEBPUSH_TOKEN_INVALID"                 // The specified web push token is invalid.
+	ErrWorkerBusyTooLongRetry           RPCError = "WORKER_BUSY_TOO_LONG_RETRY"            // Telegram workers are too busy to respond immediately.
+	ErrYouBlockedUser                   RPCError = "YOU_BLOCKED_USER"                      // You blocked this user.
+)
@@ -0,0 +1,414 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import (
+	"bytes"
+	"strconv"
+	"time"
+)
+
+// ReactionUpdate is sent when the reactions to a message change, with the new
+// reaction counters.
+type ReactionUpdate struct {
+	Client         *Client
+	OriginalUpdate *UpdateMessageReactions
+	Peer           Peer
+	MsgID          int32
+	TopMsgID       int32
+	Reactions      *MessageReactions
+}
+
+func (ru *ReactionUpdate) ChatID() int64    { return peerChatID(ru.Peer) }
+func (ru *ReactionUpdate) ChannelID() int64 { return peerKey(ru.Peer) }
+func (ru *ReactionUpdate) ChatType() string { return peerChatType(ru.Peer) }
+
+// Counts returns the number of each reaction, keyed like ReactionString.
+func (ru *ReactionUpdate) Counts() map[string]int32 {
+	counts := make(map[string]int32)
+	if ru.Reactions != nil {
+		for _, r := range ru.Reactions.Results {
+			counts[ReactionString(r.Reaction)] = r.Count
+		}
+	}
+	return counts
+}
+
+func (ru *ReactionUpdate) Total() int32 {
+	var total int32
+	if ru.Reactions != nil {
+		for _, r := range ru.Reactions.Results {
+			total += r.Count
+		}
+	}
+	return total
+}
+
+// Chosen returns the reactions the current user put on the message.
+func (ru *ReactionUpdate) Chosen() []Reaction {
+	var chosen []Reaction
+	if ru.Reactions != nil {
+		for _, r := range ru.Reactions.Results {
+			if r.ChosenOrder != 0 {
+				chosen = append(chosen, r.Reaction)
+			}
+		}
+	}
+	return chosen
+}
+
+func (ru *ReactionUpdate) GetMessage() (*NewMessage, error) {
+	return ru.Client.GetMessageByID(ru.Peer, ru.MsgID)
+}
+
+func (ru *ReactionUpdate) Marshal(noindent ...bool) string {
+	return MarshalWithTypeName(ru.OriginalUpdate, noindent...)
+}
+
+// BotReactionUpdate is sent to bots when a user changes their reactions to a
+// message in a chat where the bot is an admin.
+type BotReactionUpdate struct {
+	Client         *Client
+	OriginalUpdate *UpdateBotMessageReaction
+	Peer           Peer
+	Actor          Peer
+	MsgID          int32
+	Old            []Reaction
+	New            []Reaction
+	Date           int32
+}
+
+func (br *BotReactionUpdate) ChatID() int64    { return peerChatID(br.Peer) }
+func (br *BotReactionUpdate) ChannelID() int64 { return peerKey(br.Peer) }
+func (br *BotReactionUpdate) ChatType() string { return peerChatType(br.Peer) }
+func (br *BotReactionUpdate) UserID() int64    { return peerChatID(br.Actor) }
+
+func (br *BotReactionUpdate) GetUser() (*UserObj, error) {
+	return br.Client.GetUser(br.UserID())
+}
+
+// Added returns the reactions in New that were not in Old.
+func (br *BotReactionUpdate) Added() []Reaction {
+	return reactionsDiff(br.New, br.Old)
+}
+
+// Removed returns the reactions in Old that are not in New.
+func (br *BotReactionUpdate) Removed() []Reaction {
+	return reactionsDiff(br.Old, br.New)
+}
+
+func (br *BotReactionUpdate) IsCleared() bool {
+	return len(br.New) == 0
+}
+
+func (br *BotReactionUpdate) GetMessage() (*NewMessage, error) {
+	return br.Client.GetMessageByID(br.Peer, br.MsgID)
+}
+
+func (br *BotReactionUpdate) Marshal(noindent ...bool) string {
+	return MarshalWithTypeName(br.OriginalUpdate, noindent...)
+}
+
+// PollUpdate is sent when the results of a poll change, or it is closed.
+type PollUpdate struct {
+	Client         *Client
+	OriginalUpdate *UpdateMessagePoll
+	PollID         int64
+	Poll           *Poll // nil unless Telegram thinks the client has not seen the poll yet
+	Results        *PollResults
+}
+
+func (pu *PollUpdate) IsClosed() bool {
+	return pu.Poll != nil && pu.Poll.Closed
+}
+
+func (pu *PollUpdate) Question() string {
+	if pu.Poll != nil && pu.Poll.Question != nil {
+		return pu.Poll.Question.Text
+	}
+	return ""
+}
+
+func (pu *PollUpdate) TotalVoters() int32 {
+	if pu.Results != nil {
+		return pu.Results.TotalVoters
+	}
+	return 0
+}
+
+// Voters returns the number of votes for the answer with the given option.
+func (pu *PollUpdate) Voters(option []byte) int32 {
+	if pu.Results != nil {
+		for _, r := range pu.Results.Results {
+			if bytes.Equal(r.Option, option) {
+				return r.Voters
+			}
+		}
+	}
+	return 0
+}
+
+func (pu *PollUpdate) Marshal(noindent ...bool) string {
+	return MarshalWithTypeName(pu.OriginalUpdate, noindent...)
+}
+
+// PollVoteUpdate is sent to bots when a user votes in a non-anonymous poll the
+// bot sent, or retracts their vote.
+type PollVoteUpdate struct {
+	Client         *Client
+	OriginalUpdate *UpdateMessagePollVote
+	PollID         int64
+	Voter          Peer
+	Options        [][]byte
+}
+
+func (pv *PollVoteUpdate) UserID() int64     { return peerChatID(pv.Voter) }
+func (pv *PollVoteUpdate) IsRetracted() bool { return len(pv.Options) == 0 }
+
+func (pv *PollVoteUpdate) HasOption(option []byte) bool {
+	for _, o := range pv.Options {
+		if bytes.Equal(o, option) {
+			return true
+		}
+	}
+	return false
+}
+
+func (pv *PollVoteUpdate) GetUser() (*UserObj, error) {
+	return pv.Client.GetUser(pv.UserID())
+}
+
+func (pv *PollVoteUpdate) Marshal(noindent ...bool) string {
+	return MarshalWithTypeName(pv.OriginalUpdate, noindent...)
+}
+
+// UserStatusUpdate is sent when a contact or a user the client talks to goes
+// online or offline.
+type UserStatusUpdate struct {
+	Client         *Client
+	OriginalUpdate *UpdateUserStatus
+	Status         UserStatus
+}
+
+func (us *UserStatusUpdate) UserID() int64    { return us.OriginalUpdate.UserID }
+func (us *UserStatusUpdate) ChatID() int64    { return us.OriginalUpdate.UserID }
+func (us *UserStatusUpdate) ChatType() string { return EntityUser }
+
+func (us *UserStatusUpdate) IsOnline() bool {
+	_, ok := us.Status.(*UserStatusOnline)
+	return ok
+}
+
+// LastSeen returns when the user was last online, or the zero time if they are
+// online or hide it.
+func (us *UserStatusUpdate) LastSeen() time.Time {
+	if offline, ok := us.Status.(*UserStatusOffline); ok {
+		return time.Unix(int64(offline.WasOnline), 0)
+	}
+	return time.Time{}
+}
+
+// Expires returns when the online status of the user runs out unless renewed,
+// or the zero time if they are offline.
+func (us *UserStatusUpdate) Expires() time.Time {
+	if online, ok := us.Status.(*UserStatusOnline); ok {
+		return time.Unix(int64(online.Expires), 0)
+	}
+	return time.Time{}
+}
+
+func (us *UserStatusUpdate) GetUser() (*UserObj, error) {
+	return us.Client.GetUser(us.UserID())
+}
+
+func (us *UserStatusUpdate) Marshal(noindent ...bool) string {
+	return MarshalWithTypeName(us.OriginalUpdate, noindent...)
+}
+
+// TypingUpdate is sent when someone starts or stops typing, or doing another
+// chat action like uploading a photo, in a private chat, a group or a channel.
+type TypingUpdate struct {
+	Client         *Client
+	OriginalUpdate Update // *UpdateUserTyping, *UpdateChatUserTyping or *UpdateChannelUserTyping
+	Peer           Peer   // the chat
+	From           Peer   // who is typing; the chat itself for anonymous admins
+	Action         SendMessageAction
+	TopMsgID       int32
+}
+
+func (tu *TypingUpdate) ChatID() int64    { return peerChatID(tu.Peer) }
+func (tu *TypingUpdate) ChannelID() int64 { return peerKey(tu.Peer) }
+func (tu *TypingUpdate) ChatType() string { return peerChatType(tu.Peer) }
+func (tu *TypingUpdate) UserID() int64    { return peerChatID(tu.From) }
+func (tu *TypingUpdate) IsPrivate() bool  { return tu.ChatType() == EntityUser }
+
+func (tu *TypingUpdate) IsTyping() bool {
+	_, ok := tu.Action.(*SendMessageTypingAction)
+	return ok
+}
+
+func (tu *TypingUpdate) IsCancel() bool {
+	_, ok := tu.Action.(*SendMessageCancelAction)
+	return ok
+}
+
+// ActionName returns the name of Action in Actions, like "typing" or "upload_photo".
+func (tu *TypingUpdate) ActionName() string {
+	if tu.Action == nil {
+		return ""
+	}
+	for name, action := range Actions {
+		if action.CRC() == tu.Action.CRC() {
+			return name
+		}
+	}
+	return ""
+}
+
+func (tu *TypingUpdate) GetUser() (*UserObj, error) {
+	return tu.Client.GetUser(tu.UserID())
+}
+
+func (tu *TypingUpdate) Marshal(noindent ...bool) string {
+	return MarshalWithTypeName(tu.OriginalUpdate, noindent...)
+}
+
+// ReadUpdate is sent when messages of a chat are read: incoming ones by the
+// current user on another device, or outgoing ones by the other side.
+type ReadUpdate struct {
+	Client           *Client
+	OriginalUpdate   Update // *UpdateReadHistoryInbox, *UpdateReadHistoryOutbox, *UpdateReadChannelInbox or *UpdateReadChannelOutbox
+	Peer             Peer
+	MaxID            int32 // messages up to this ID are read
+	StillUnreadCount int32 // inbox only
+	TopMsgID         int32
+	Outbox           bool
+}
+
+func (ru *ReadUpdate) ChatID() int64    { return peerChatID(ru.Peer) }
+func (ru *ReadUpdate) ChannelID() int64 { return peerKey(ru.Peer) }
+func (ru *ReadUpdate) ChatType() string { return peerChatType(ru.Peer) }
+func (ru *ReadUpdate) IsInbox() bool    { return !ru.Outbox }
+func (ru *ReadUpdate) IsOutbox() bool   { return ru.Outbox }
+
+func (ru *ReadUpdate) Marshal(noindent ...bool) string {
+	return MarshalWithTypeName(ru.OriginalUpdate, noindent...)
+}
+
+// ReactionString returns an emoji reaction as the emoji, a custom emoji one as
+// its document ID and a paid one as "paid".
+func ReactionString(r Reaction) string {
+	switch r := r.(type) {
+	case *ReactionEmoji:
+		return r.Emoticon
+	case *ReactionCustomEmoji:
+		return strconv.FormatInt(r.DocumentID, 10)
+	case *ReactionPaid:
+		return "paid"
+	}
+	return ""
+}
+
+// reactionsDiff returns the reactions of a missing from b.
+func reactionsDiff(a, b []Reaction) []Reaction {
+	var diff []Reaction
+	for _, r := range a {
+		found := false
+		for _, other := range b {
+			if ReactionString(r) == ReactionString(other) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			diff = append(diff, r)
+		}
+	}
+	return diff
+}
+
+func peerChatID(peer Peer) int64 {
+	switch p := peer.(type) {
+	case *PeerUser:
+		return p.UserID
+	case *PeerChat:
+		return p.ChatID
+	case *PeerChannel:
+		return p.ChannelID
+	}
+	return 0
+}
+
+func peerChatType(peer Peer) string {
+	switch peer.(type) {
+	case *PeerUser:
+		return EntityUser
+	case *PeerChat:
+		return EntityChat
+	case *PeerChannel:
+		return EntityChannel
+	}
+	return EntityUnknown
+}
+
+// packEvent wraps update in its typed event, or returns nil if it has none.
+func packEvent(c *Client, update Update) any {
+	switch u := update.(type) {
+	case *UpdateMessageReactions:
+		return &ReactionUpdate{Client: c, OriginalUpdate: u, Peer: u.Peer, MsgID: u.MsgID, TopMsgID: u.TopMsgID, Reactions: u.Reactions}
+	case *UpdateBotMessageReaction:
+		return &BotReactionUpdate{Client: c, OriginalUpdate: u, Peer: u.Peer, Actor: u.Actor, MsgID: u.MsgID, Old: u.OldReactions, New: u.NewReactions, Date: u.Date}
+	case *UpdateMessagePoll:
+		return &PollUpdate{Client: c, OriginalUpdate: u, PollID: u.PollID, Poll: u.Poll, Results: u.Results}
+	case *UpdateMessagePollVote:
+		return &PollVoteUpdate{Client: c, OriginalUpdate: u, PollID: u.PollID, Voter: u.Peer, Options: u.Options}
+	case *UpdateUserStatus:
+		return &UserStatusUpdate{Client: c, OriginalUpdate: u, Status: u.Status}
+	case *UpdateUserTyping:
+		return &TypingUpdate{Client: c, OriginalUpdate: u, Peer: &PeerUser{UserID: u.UserID}, From: &PeerUser{UserID: u.UserID}, Action: u.Action, TopMsgID: u.TopMsgID}
+	case *UpdateChatUserTyping:
+		return &TypingUpdate{Client: c, OriginalUpdate: u, Peer: &PeerChat{ChatID: u.ChatID}, From: u.FromID, Action: u.Action}
+	case *UpdateChannelUserTyping:
+		return &TypingUpdate{Client: c, OriginalUpdate: u, Peer: &PeerChannel{ChannelID: u.ChannelID}, From: u.FromID, Action: u.Action, TopMsgID: u.TopMsgID}
+	case *UpdateReadHistoryInbox:
+		return &ReadUpdate{Client: c, OriginalUpdate: u, Peer: u.Peer, MaxID: u.MaxID, StillUnreadCount: u.StillUnreadCount, TopMsgID: u.TopMsgID}
+	case *UpdateReadHistoryOutbox:
+		return &ReadUpdate{Client: c, OriginalUpdate: u, Peer: u.Peer, MaxID: u.MaxID, Outbox: true}
+	case *UpdateReadChannelInbox:
+		return &ReadUpdate{Client: c, OriginalUpdate: u, Peer: &PeerChannel{ChannelID: u.ChannelID}, MaxID: u.MaxID, StillUnreadCount: u.StillUnreadCount}
+	case *UpdateReadChannelOutbox:
+		return &ReadUpdate{Client: c, OriginalUpdate: u, Peer: &PeerChannel{ChannelID: u.ChannelID}, MaxID: u.MaxID, Outbox: true}
+	}
+	return nil
+}
+
+// handleEventUpdate runs the handlers of the typed event of update, if it has one.
+func (c *Client) handleEventUpdate(update Update) {
+	switch event := packEvent(c, update).(type) {
+	case *ReactionUpdate:
+		dispatchEvent(c, c.dispatcher.reactionHandles, event, "[ReactionHandler]")
+	case *BotReactionUpdate:
+		dispatchEvent(c, c.dispatcher.botReactionHandles, event, "[BotReactionHandler]")
+	case *PollUpdate:
+		dispatchEvent(c, c.dispatcher.pollHandles, event, "[PollHandler]")
+	case *PollVoteUpdate:
+		dispatchEvent(c, c.dispatcher.pollVoteHandles, event, "[PollVoteHandler]")
+	case *UserStatusUpdate:
+		dispatchEvent(c, c.dispatcher.userStatusHandles, event, "[UserStatusHandler]")
+	case *TypingUpdate:
+		dispatchEvent(c, c.dispatcher.typingHandles, event, "[TypingHandler]")
+	case *ReadUpdate:
+		dispatchEvent(c, c.dispatcher.readHandles, event, "[ReadHandler]")
+	}
+}
+
+// isEventUpdate tells whether update has a typed event.
+func isEventUpdate(update Update) bool {
+	switch update.(type) {
+	case *UpdateMessageReactions, *UpdateBotMessageReaction, *UpdateMessagePoll, *UpdateMessagePollVote,
+		*UpdateUserStatus, *UpdateUserTyping, *UpdateChatUserTyping, *UpdateChannelUserTyping,
+		*UpdateReadHistoryInbox, *UpdateReadHistoryOutbox, *UpdateReadChannelInbox, *UpdateReadChannelOutbox:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import mtproto "github.com/amarnathcjd/gogram"
+
+type (
+	// FaultInjector breaks frames of the client's connections on purpose, see ClientConfig.Faults.
+	FaultInjector = mtproto.FaultInjector
+	// FaultConfig configures the faults a FaultInjector applies.
+	FaultConfig = mtproto.FaultConfig
+	// Fault is a failure applied to a single frame.
+	Fault = mtproto.Fault
+	// Frame identifies a frame of a connection for a fault script.
+	Frame = mtproto.Frame
+	// FaultStep is one entry of a fault script.
+	FaultStep = mtproto.FaultStep
+	// FrameDirection tells whether the client sends or receives a frame.
+	FrameDirection = mtproto.FrameDirection
+)
+
+const (
+	FaultNone       = mtproto.FaultNone
+	FaultStall      = mtproto.FaultStall
+	FaultDrop       = mtproto.FaultDrop
+	FaultReorder    = mtproto.FaultReorder
+	FaultCut        = mtproto.FaultCut
+	FaultCorruptKey = mtproto.FaultCorruptKey
+	FaultCode404    = mtproto.FaultCode404
+	FaultCode429    = mtproto.FaultCode429
+
+	FrameOutgoing = mtproto.FrameOutgoing
+	FrameIncoming = mtproto.FrameIncoming
+)
+
+// NewFaultInjector returns an injector applying the faults of cfg.
+func NewFaultInjector(cfg FaultConfig) *FaultInjector {
+	return mtproto.NewFaultInjector(cfg)
+}
+
+// ScriptFaults returns a FaultConfig.Script striking exactly the frames of steps.
+func ScriptFaults(steps ...FaultStep) func(Frame) Fault {
+	return mtproto.ScriptFaults(steps...)
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import "testing"
+
+// Not, Any and All apply to typed events the way they do to messages.
+func TestFilterCombinatorsCheckEvents(t *testing.T) {
+	private := &ReactionUpdate{Peer: &PeerUser{UserID: 1}}
+	group := &ReactionUpdate{Peer: &PeerChat{ChatID: 2}}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		event  *ReactionUpdate
+		want   bool
+	}{
+		{"Not private, private", Not(IsPrivate), private, false},
+		{"Not private, group", Not(IsPrivate), group, true},
+		{"Any private or channel, group", Any(IsPrivate, IsChannel), group, false},
+		{"Any private or group, group", Any(IsPrivate, IsGroup), group, true},
+		{"All group and chat 2, group", All(IsGroup, InChat(2)), group, true},
+		{"All group and chat 3, group", All(IsGroup, InChat(3)), group, false},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.checkEvent(tt.event); got != tt.want {
+			t.Errorf("%s: checkEvent = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -8,4 +8,2310 @@ func init() {
 	tl.RegisterObjects(&AccountAcceptAuthorizationParams{}, &AccountAuthorizationForm{}, &AccountAuthorizations{}, &AccountAutoDownloadSettings{}, &AccountAutoSaveSettings{}, &AccountBusinessChatLinks{}, &AccountCancelPasswordEmailParams{}, &AccountChangeAuthorizationSettingsParams{}, &AccountChangePhoneParams{}, &AccountChatThemesNotModified{}, &AccountChatThemesObj{}, &AccountCheckUsernameParams{}, &AccountClearRecentEmojiStatusesParams{}, &AccountConfirmPasswordEmailParams{}, &AccountConfirmPhoneParams{}, &AccountConnectedBots{}, &AccountContentSettings{}, &AccountCreateBusinessChatLinkParams{}, &AccountCreateThemeParams{}, &AccountDaysTtl{}, &AccountDeclinePasswordResetParams{}, &AccountDeleteAccountParams{}, &AccountDeleteAutoSaveExceptionsParams{}, &AccountDeleteBusinessChatLinkParams{}, &AccountDeletePasskeyParams{}, &AccountDeleteSecureValueParams{}, &AccountDisablePeerConnectedBotParams{}, &AccountEditBusinessChatLinkParams{}, &AccountEmailVerifiedLogin{}, &AccountEmailVerifiedObj{}, &AccountEmojiStatusesNotModified{}, &AccountEmojiStatusesObj{}, &AccountFinishTakeoutSessionParams{}, &AccountGetAccountTtlParams{}, &AccountGetAllSecureValuesParams{}, &AccountGetAuthorizationFormParams{}, &AccountGetAuthorizationsParams{}, &AccountGetAutoDownloadSettingsParams{}, &AccountGetAutoSaveSettingsParams{}, &AccountGetBotBusinessConnectionParams{}, &AccountGetBusinessChatLinksParams{}, &AccountGetChannelDefaultEmojiStatusesParams{}, &AccountGetChannelRestrictedStatusEmojisParams{}, &AccountGetChatThemesParams{}, &AccountGetCollectibleEmojiStatusesParams{}, &AccountGetConnectedBotsParams{}, &AccountGetContactSignUpNotificationParams{}, &AccountGetContentSettingsParams{}, &AccountGetDefaultBackgroundEmojisParams{}, &AccountGetDefaultEmojiStatusesParams{}, &AccountGetDefaultGroupPhotoEmojisParams{}, &AccountGetDefaultProfilePhotoEmojisParams{}, &AccountGetGlobalPrivacySettingsParams{}, &AccountGetMultiWallPapersParams{}, &AccountGetNotifyExceptionsParams{}, &AccountGetNotifySettingsParams{}, &AccountGetPaidMessagesRevenueParams{}, &AccountGetPasskeysParams{}, &AccountGetPasswordParams{}, &AccountGetPasswordSettingsParams{}, &AccountGetPrivacyParams{}, &AccountGetReactionsNotifySettingsParams{}, &AccountGetRecentEmojiStatusesParams{}, &AccountGetSavedMusicIdsParams{}, &AccountGetSavedRingtonesParams{}, &AccountGetSecureValueParams{}, &AccountGetThemeParams{}, &AccountGetThemesParams{}, &AccountGetTmpPasswordParams{}, &AccountGetUniqueGiftChatThemesParams{}, &AccountGetWallPaperParams{}, &AccountGetWallPapersParams{}, &AccountGetWebAuthorizationsParams{}, &AccountInitPasskeyRegistrationParams{}, &AccountInitTakeoutSessionParams{}, &AccountInstallThemeParams{}, &AccountInstallWallPaperParams{}, &AccountInvalidateSignInCodesParams{}, &AccountPaidMessagesRevenue{}, &AccountPasskeyRegistrationOptions{}, &AccountPasskeys{}, &AccountPassword{}, &AccountPasswordInputSettings{}, &AccountPasswordSettings{}, &AccountPrivacyRules{}, &AccountRegisterDeviceParams{}, &AccountRegisterPasskeyParams{}, &AccountReorderUsernamesParams{}, &AccountReportPeerParams{}, &AccountReportProfilePhotoParams{}, &AccountResendPasswordEmailParams{}, &AccountResetAuthorizationParams{}, &AccountResetNotifySettingsParams{}, &AccountResetPasswordFailedWait{}, &AccountResetPasswordOk{}, &AccountResetPasswordParams{}, &AccountResetPasswordRequestedWait{}, &AccountResetWallPapersParams{}, &AccountResetWebAuthorizationParams{}, &AccountResetWebAuthorizationsParams{}, &AccountResolveBusinessChatLinkParams{}, &AccountResolvedBusinessChatLinks{}, &AccountSaveAutoDownloadSettingsParams{}, &AccountSaveAutoSaveSettingsParams{}, &AccountSaveMusicParams{}, &AccountSaveRingtoneParams{}, &AccountSaveSecureValueParams{}, &AccountSaveThemeParams{}, &AccountSaveWallPaperParams{}, &AccountSavedMusicIdsNotModified{}, &AccountSavedMusicIdsObj{}, &AccountSavedRingtoneConverted{}, &AccountSavedRingtoneObj{}, &AccountSavedRingtonesNotModified{}, &AccountSavedRingtonesObj{}, &AccountSendChangePhoneCodeParams{}, &AccountSendConfirmPhoneCodeParams{}, &AccountSendVerifyEmailCodeParams{}, &AccountSendVerifyPhoneCodeParams{}, &AccountSentEmailCode{}, &AccountSetAccountTtlParams{}, &AccountSetAuthorizationTtlParams{}, &AccountSetContactSignUpNotificationParams{}, &AccountSetContentSettingsParams{}, &AccountSetGlobalPrivacySettingsParams{}, &AccountSetMainProfileTabParams{}, &AccountSetPrivacyParams{}, &AccountSetReactionsNotifySettingsParams{}, &AccountTakeout{}, &AccountThemesNotModified{}, &AccountThemesObj{}, &AccountTmpPassword{}, &AccountToggleConnectedBotPausedParams{}, &AccountToggleNoPaidMessagesExceptionParams{}, &AccountToggleSponsoredMessagesParams{}, &AccountToggleUsernameParams{}, &AccountUnregisterDeviceParams{}, &AccountUpdateBirthdayParams{}, &AccountUpdateBusinessAwayMessageParams{}, &AccountUpdateBusinessGreetingMessageParams{}, &AccountUpdateBusinessIntroParams{}, &AccountUpdateBusinessLocationParams{}, &AccountUpdateBusinessWorkHoursParams{}, &AccountUpdateColorParams{}, &AccountUpdateConnectedBotParams{}, &AccountUpdateDeviceLockedParams{}, &AccountUpdateEmojiStatusParams{}, &AccountUpdateNotifySettingsParams{}, &AccountUpdatePasswordSettingsParams{}, &AccountUpdatePersonalChannelParams{}, &AccountUpdateProfileParams{}, &AccountUpdateStatusParams{}, &AccountUpdateThemeParams{}, &AccountUpdateUsernameParams{}, &AccountUploadRingtoneParams{}, &AccountUploadThemeParams{}, &AccountUploadWallPaperParams{}, &AccountVerifyEmailParams{}, &AccountVerifyPhoneParams{}, &AccountWallPapersNotModified{}, &AccountWallPapersObj{}, &AccountWebAuthorizations{}, &AttachMenuBot{}, &AttachMenuBotIcon{}, &AttachMenuBotIconColor{}, &AttachMenuBotsBot{}, &AttachMenuBotsNotModified{}, &AttachMenuBotsObj{}, &AuctionBidLevel{}, &AuthAcceptLoginTokenParams{}, &AuthAuthorizationObj{}, &AuthAuthorizationSignUpRequired{}, &AuthBindTempAuthKeyParams{}, &AuthCancelCodeParams{}, &AuthCheckPaidAuthParams{}, &AuthCheckPasswordParams{}, &AuthCheckRecoveryPasswordParams{}, &AuthDropTempAuthKeysParams{}, &AuthExportAuthorizationParams{}, &AuthExportLoginTokenParams{}, &AuthExportedAuthorization{}, &AuthFinishPasskeyLoginParams{}, &AuthImportAuthorizationParams{}, &AuthImportBotAuthorizationParams{}, &AuthImportLoginTokenParams{}, &AuthImportWebTokenAuthorizationParams{}, &AuthInitPasskeyLoginParams{}, &AuthLogOutParams{}, &AuthLoggedOut{}, &AuthLoginTokenMigrateTo{}, &AuthLoginTokenObj{}, &AuthLoginTokenSuccess{}, &AuthPasskeyLoginOptions{}, &AuthPasswordRecovery{}, &AuthRecoverPasswordParams{}, &AuthReportMissingCodeParams{}, &AuthRequestFirebaseSmsParams{}, &AuthRequestPasswordRecoveryParams{}, &AuthResendCodeParams{}, &AuthResetAuthorizationsParams{}, &AuthResetLoginEmailParams{}, &AuthSendCodeParams{}, &AuthSentCodeObj{}, &AuthSentCodePaymentRequired{}, &AuthSentCodeSuccess{}, &AuthSentCodeTypeApp{}, &AuthSentCodeTypeCall{}, &AuthSentCodeTypeEmailCode{}, &AuthSentCodeTypeFirebaseSms{}, &AuthSentCodeTypeFlashCall{}, &AuthSentCodeTypeFragmentSms{}, &AuthSentCodeTypeMissedCall{}, &AuthSentCodeTypeSetUpEmailRequired{}, &AuthSentCodeTypeSms{}, &AuthSentCodeTypeSmsPhrase{}, &AuthSentCodeTypeSmsWord{}, &AuthSignInParams{}, &AuthSignUpParams{}, &Authorization{}, &AutoDownloadSettings{}, &AutoSaveException{}, &AutoSaveSettings{}, &AvailableEffect{}, &AvailableReaction{}, &BankCardOpenURL{}, &Birthday{}, &Boost{}, &BotAppNotModified{}, &BotAppObj{}, &BotAppSettings{}, &BotBusinessConnection{}, &BotCommand{}, &BotCommandScopeChatAdmins{}, &BotCommandScopeChats{}, &BotCommandScopeDefault{}, &BotCommandScopePeer{}, &BotCommandScopePeerAdmins{}, &BotCommandScopePeerUser{}, &BotCommandScopeUsers{}, &BotInfo{}, &BotInlineMediaResult{}, &BotInlineMessageMediaAuto{}, &BotInlineMessageMediaContact{}, &BotInlineMessageMediaGeo{}, &BotInlineMessageMediaInvoice{}, &BotInlineMessageMediaVenue{}, &BotInlineMessageMediaWebPage{}, &BotInlineMessageText{}, &BotInlineResultObj{}, &BotMenuButtonCommands{}, &BotMenuButtonDefault{}, &BotMenuButtonObj{}, &BotPreviewMedia{}, &BotVerification{}, &BotVerifierSettings{}, &BotsAddPreviewMediaParams{}, &BotsAllowSendMessageParams{}, &BotsAnswerWebhookJsonQueryParams{}, &BotsBotInfo{}, &BotsCanSendMessageParams{}, &BotsCheckDownloadFileParamsParams{}, &BotsDeletePreviewMediaParams{}, &BotsEditPreviewMediaParams{}, &BotsGetAdminedBotsParams{}, &BotsGetBotCommandsParams{}, &BotsGetBotInfoParams{}, &BotsGetBotMenuButtonParams{}, &BotsGetBotRecommendationsParams{}, &BotsGetPopularAppBotsParams{}, &BotsGetPreviewInfoParams{}, &BotsGetPreviewMediasParams{}, &BotsInvokeWebViewCustomMethodParams{}, &BotsPopularAppBots{}, &BotsPreviewInfo{}, &BotsReorderPreviewMediasParams{}, &BotsReorderUsernamesParams{}, &BotsResetBotCommandsParams{}, &BotsSendCustomRequestParams{}, &BotsSetBotBroadcastDefaultAdminRightsParams{}, &BotsSetBotCommandsParams{}, &BotsSetBotGroupDefaultAdminRightsParams{}, &BotsSetBotInfoParams{}, &BotsSetBotMenuButtonParams{}, &BotsSetCustomVerificationParams{}, &BotsToggleUserEmojiStatusPermissionParams{}, &BotsToggleUsernameParams{}, &BotsUpdateStarRefProgramParams{}, &BotsUpdateUserEmojiStatusParams{}, &BusinessAwayMessage{}, &BusinessAwayMessageScheduleAlways{}, &BusinessAwayMessageScheduleCustom{}, &BusinessAwayMessageScheduleOutsideWorkHours{}, &BusinessBotRecipients{}, &BusinessBotRights{}, &BusinessChatLink{}, &BusinessGreetingMessage{}, &BusinessIntro{}, &BusinessLocation{}, &BusinessRecipients{}, &BusinessWeeklyOpen{}, &BusinessWorkHours{}, &CdnConfig{}, &CdnPublicKey{}, &Channel{}, &ChannelAdminLogEvent{}, &ChannelAdminLogEventActionChangeAbout{}, &ChannelAdminLogEventActionChangeAvailableReactions{}, &ChannelAdminLogEventActionChangeEmojiStatus{}, &ChannelAdminLogEventActionChangeEmojiStickerSet{}, &ChannelAdminLogEventActionChangeHistoryTtl{}, &ChannelAdminLogEventActionChangeLinkedChat{}, &ChannelAdminLogEventActionChangeLocation{}, &ChannelAdminLogEventActionChangePeerColor{}, &ChannelAdminLogEventActionChangePhoto{}, &ChannelAdminLogEventActionChangeProfilePeerColor{}, &ChannelAdminLogEventActionChangeStickerSet{}, &ChannelAdminLogEventActionChangeTheme{}, &ChannelAdminLogEventActionChangeTitle{}, &ChannelAdminLogEventActionChangeUsername{}, &ChannelAdminLogEventActionChangeUsernames{}, &ChannelAdminLogEventActionChangeWallpaper{}, &ChannelAdminLogEventActionCreateTopic{}, &ChannelAdminLogEventActionDefaultBannedRights{}, &ChannelAdminLogEventActionDeleteMessage{}, &ChannelAdminLogEventActionDeleteTopic{}, &ChannelAdminLogEventActionDiscardGroupCall{}, &ChannelAdminLogEventActionEditMessage{}, &ChannelAdminLogEventActionEditTopic{}, &ChannelAdminLogEventActionExportedInviteDelete{}, &ChannelAdminLogEventActionExportedInviteEdit{}, &ChannelAdminLogEventActionExportedInviteRevoke{}, &ChannelAdminLogEventActionParticipantInvite{}, &ChannelAdminLogEventActionParticipantJoin{}, &ChannelAdminLogEventActionParticipantJoinByInvite{}, &ChannelAdminLogEventActionParticipantJoinByRequest{}, &ChannelAdminLogEventActionParticipantLeave{}, &ChannelAdminLogEventActionParticipantMute{}, &ChannelAdminLogEventActionParticipantSubExtend{}, &ChannelAdminLogEventActionParticipantToggleAdmin{}, &ChannelAdminLogEventActionParticipantToggleBan{}, &ChannelAdminLogEventActionParticipantUnmute{}, &ChannelAdminLogEventActionParticipantVolume{}, &ChannelAdminLogEventActionPinTopic{}, &ChannelAdminLogEventActionSendMessage{}, &ChannelAdminLogEventActionStartGroupCall{}, &ChannelAdminLogEventActionStopPoll{}, &ChannelAdminLogEventActionToggleAntiSpam{}, &ChannelAdminLogEventActionToggleAutotranslation{}, &ChannelAdminLogEventActionToggleForum{}, &ChannelAdminLogEventActionToggleGroupCallSetting{}, &ChannelAdminLogEventActionToggleInvites{}, &ChannelAdminLogEventActionToggleNoForwards{}, &ChannelAdminLogEventActionTogglePreHistoryHidden{}, &ChannelAdminLogEventActionToggleSignatureProfiles{}, &ChannelAdminLogEventActionToggleSignatures{}, &ChannelAdminLogEventActionToggleSlowMode{}, &ChannelAdminLogEventActionUpdatePinned{}, &ChannelAdminLogEventsFilter{}, &ChannelForbidden{}, &ChannelFull{}, &ChannelLocationEmpty{}, &ChannelLocationObj{}, &ChannelMessagesFilterEmpty{}, &ChannelMessagesFilterObj{}, &ChannelParticipantAdmin{}, &ChannelParticipantBanned{}, &ChannelParticipantCreator{}, &ChannelParticipantLeft{}, &ChannelParticipantObj{}, &ChannelParticipantSelf{}, &ChannelParticipantsAdmins{}, &ChannelParticipantsBanned{}, &ChannelParticipantsBots{}, &ChannelParticipantsContacts{}, &ChannelParticipantsKicked{}, &ChannelParticipantsMentions{}, &ChannelParticipantsRecent{}, &ChannelParticipantsSearch{}, &ChannelsAdminLogResults{}, &ChannelsChannelParticipant{}, &ChannelsChannelParticipantsNotModified{}, &ChannelsChannelParticipantsObj{}, &ChannelsCheckSearchPostsFloodParams{}, &ChannelsCheckUsernameParams{}, &ChannelsConvertToGigagroupParams{}, &ChannelsCreateChannelParams{}, &ChannelsDeactivateAllUsernamesParams{}, &ChannelsDeleteChannelParams{}, &ChannelsDeleteHistoryParams{}, &ChannelsDeleteMessagesParams{}, &ChannelsDeleteParticipantHistoryParams{}, &ChannelsEditAdminParams{}, &ChannelsEditBannedParams{}, &ChannelsEditCreatorParams{}, &ChannelsEditLocationParams{}, &ChannelsEditPhotoParams{}, &ChannelsEditTitleParams{}, &ChannelsExportMessageLinkParams{}, &ChannelsGetAdminLogParams{}, &ChannelsGetAdminedPublicChannelsParams{}, &ChannelsGetChannelRecommendationsParams{}, &ChannelsGetChannelsParams{}, &ChannelsGetFullChannelParams{}, &ChannelsGetFutureCreatorAfterLeaveParams{}, &ChannelsGetGroupsForDiscussionParams{}, &ChannelsGetInactiveChannelsParams{}, &ChannelsGetLeftChannelsParams{}, &ChannelsGetMessageAuthorParams{}, &ChannelsGetMessagesParams{}, &ChannelsGetParticipantParams{}, &ChannelsGetParticipantsParams{}, &ChannelsGetSendAsParams{}, &ChannelsInviteToChannelParams{}, &ChannelsJoinChannelParams{}, &ChannelsLeaveChannelParams{}, &ChannelsReadHistoryParams{}, &ChannelsReadMessageContentsParams{}, &ChannelsReorderUsernamesParams{}, &ChannelsReportAntiSpamFalsePositiveParams{}, &ChannelsReportSpamParams{}, &ChannelsRestrictSponsoredMessagesParams{}, &ChannelsSearchPostsParams{}, &ChannelsSendAsPeers{}, &ChannelsSetBoostsToUnblockRestrictionsParams{}, &ChannelsSetDiscussionGroupParams{}, &ChannelsSetEmojiStickersParams{}, &ChannelsSetMainProfileTabParams{}, &ChannelsSetStickersParams{}, &ChannelsSponsoredMessageReportResultAdsHidden{}, &ChannelsSponsoredMessageReportResultChooseOption{}, &ChannelsSponsoredMessageReportResultReported{}, &ChannelsToggleAntiSpamParams{}, &ChannelsToggleAutotranslationParams{}, &ChannelsToggleForumParams{}, &ChannelsToggleJoinRequestParams{}, &ChannelsToggleJoinToSendParams{}, &ChannelsToggleParticipantsHiddenParams{}, &ChannelsTogglePreHistoryHiddenParams{}, &ChannelsToggleSignaturesParams{}, &ChannelsToggleSlowModeParams{}, &ChannelsToggleUsernameParams{}, &ChannelsToggleViewForumAsMessagesParams{}, &ChannelsUpdateColorParams{}, &ChannelsUpdateEmojiStatusParams{}, &ChannelsUpdatePaidMessagesPriceParams{}, &ChannelsUpdateUsernameParams{}, &ChatAdminRights{}, &ChatAdminWithInvites{}, &ChatBannedRights{}, &ChatEmpty{}, &ChatForbidden{}, &ChatFullObj{}, &ChatInviteAlready{}, &ChatInviteExported{}, &ChatInviteImporter{}, &ChatInviteObj{}, &ChatInvitePeek{}, &ChatInvitePublicJoinRequests{}, &ChatObj{}, &ChatOnlines{}, &ChatParticipantAdmin{}, &ChatParticipantCreator{}, &ChatParticipantObj{}, &ChatParticipantsForbidden{}, &ChatParticipantsObj{}, &ChatPhotoEmpty{}, &ChatPhotoObj{}, &ChatReactionsAll{}, &ChatReactionsNone{}, &ChatReactionsSome{}, &ChatThemeObj{}, &ChatThemeUniqueGift{}, &ChatlistsChatlistInviteAlready{}, &ChatlistsChatlistInviteObj{}, &ChatlistsChatlistUpdates{}, &ChatlistsCheckChatlistInviteParams{}, &ChatlistsDeleteExportedInviteParams{}, &ChatlistsEditExportedInviteParams{}, &ChatlistsExportChatlistInviteParams{}, &ChatlistsExportedChatlistInvite{}, &ChatlistsExportedInvites{}, &ChatlistsGetChatlistUpdatesParams{}, &ChatlistsGetExportedInvitesParams{}, &ChatlistsGetLeaveChatlistSuggestionsParams{}, &ChatlistsHideChatlistUpdatesParams{}, &ChatlistsJoinChatlistInviteParams{}, &ChatlistsJoinChatlistUpdatesParams{}, &ChatlistsLeaveChatlistParams{}, &CodeSettings{}, &Config{}, &ConnectedBot{}, &ConnectedBotStarRef{}, &Contact{}, &ContactBirthday{}, &ContactStatus{}, &ContactsAcceptContactParams{}, &ContactsAddContactParams{}, &ContactsBlockFromRepliesParams{}, &ContactsBlockParams{}, &ContactsBlockedObj{}, &ContactsBlockedSlice{}, &ContactsContactBirthdays{}, &ContactsContactsNotModified{}, &ContactsContactsObj{}, &ContactsDeleteByPhonesParams{}, &ContactsDeleteContactsParams{}, &ContactsEditCloseFriendsParams{}, &ContactsExportContactTokenParams{}, &ContactsFound{}, &ContactsGetBirthdaysParams{}, &ContactsGetBlockedParams{}, &ContactsGetContactIDsParams{}, &ContactsGetContactsParams{}, &ContactsGetLocatedParams{}, &ContactsGetSavedParams{}, &ContactsGetSponsoredPeersParams{}, &ContactsGetStatusesParams{}, &ContactsGetTopPeersParams{}, &ContactsImportCardParams{}, &ContactsImportContactTokenParams{}, &ContactsImportContactsParams{}, &ContactsImportedContacts{}, &ContactsResetSavedParams{}, &ContactsResetTopPeerRatingParams{}, &ContactsResolvePhoneParams{}, &ContactsResolveUsernameParams{}, &ContactsResolvedPeer{}, &ContactsSearchParams{}, &ContactsSetBlockedParams{}, &ContactsSponsoredPeersEmpty{}, &ContactsSponsoredPeersObj{}, &ContactsToggleTopPeersParams{}, &ContactsTopPeersDisabled{}, &ContactsTopPeersNotModified{}, &ContactsTopPeersObj{}, &ContactsUnblockParams{}, &ContactsUpdateContactNoteParams{}, &DataJson{}, &DcOption{}, &DefaultHistoryTtl{}, &DialogFilterChatlist{}, &DialogFilterDefault{}, &DialogFilterObj{}, &DialogFilterSuggested{}, &DialogFolder{}, &DialogObj{}, &DialogPeerFolder{}, &DialogPeerObj{}, &DisallowedGiftsSettings{}, &DocumentAttributeAnimated{}, &DocumentAttributeAudio{}, &DocumentAttributeCustomEmoji{}, &DocumentAttributeFilename{}, &DocumentAttributeHasStickers{}, &DocumentAttributeImageSize{}, &DocumentAttributeSticker{}, &DocumentAttributeVideo{}, &DocumentEmpty{}, &DocumentObj{}, &DraftMessageEmpty{}, &DraftMessageObj{}, &EmailVerificationApple{}, &EmailVerificationCode{}, &EmailVerificationGoogle{}, &EmailVerifyPurposeLoginChange{}, &EmailVerifyPurposeLoginSetup{}, &EmailVerifyPurposePassport{}, &EmojiGroupGreeting{}, &EmojiGroupObj{}, &EmojiGroupPremium{}, &EmojiKeywordDeleted{}, &EmojiKeywordObj{}, &EmojiKeywordsDifference{}, &EmojiLanguage{}, &EmojiListNotModified{}, &EmojiListObj{}, &EmojiStatusCollectible{}, &EmojiStatusEmpty{}, &EmojiStatusObj{}, &EmojiURL{}, &EncryptedChatDiscarded{}, &EncryptedChatEmpty{}, &EncryptedChatObj{}, &EncryptedChatRequested{}, &EncryptedChatWaiting{}, &EncryptedFileEmpty{}, &EncryptedFileObj{}, &EncryptedMessageObj{}, &EncryptedMessageService{}, &Error{}, &ExportedChatlistInvite{}, &ExportedContactToken{}, &ExportedMessageLink{}, &ExportedStoryLink{}, &FactCheck{}, &FileHash{}, &Folder{}, &FolderPeer{}, &FoldersDeleteFolderParams{}, &FoldersEditPeerFoldersParams{}, &ForumTopicDeleted{}, &ForumTopicObj{}, &FoundStory{}, &FragmentCollectibleInfo{}, &FragmentGetCollectibleInfoParams{}, &Game{}, &GeoPointAddress{}, &GeoPointEmpty{}, &GeoPointObj{}, &GlobalPrivacySettings{}, &GroupCallDiscarded{}, &GroupCallDonor{}, &GroupCallMessage{}, &GroupCallObj{}, &GroupCallParticipant{}, &GroupCallParticipantVideo{}, &GroupCallParticipantVideoSourceGroup{}, &GroupCallStreamChannel{}, &HelpAcceptTermsOfServiceParams{}, &HelpAppConfigNotModified{}, &HelpAppConfigObj{}, &HelpAppUpdateObj{}, &HelpCountriesListNotModified{}, &HelpCountriesListObj{}, &HelpCountry{}, &HelpCountryCode{}, &HelpDeepLinkInfoEmpty{}, &HelpDeepLinkInfoObj{}, &HelpDismissSuggestionParams{}, &HelpEditUserInfoParams{}, &HelpGetAppConfigParams{}, &HelpGetAppUpdateParams{}, &HelpGetCdnConfigParams{}, &HelpGetConfigParams{}, &HelpGetCountriesListParams{}, &HelpGetDeepLinkInfoParams{}, &HelpGetInviteTextParams{}, &HelpGetNearestDcParams{}, &HelpGetPassportConfigParams{}, &HelpGetPeerColorsParams{}, &HelpGetPeerProfileColorsParams{}, &HelpGetPremiumPromoParams{}, &HelpGetPromoDataParams{}, &HelpGetRecentMeUrlsParams{}, &HelpGetSupportNameParams{}, &HelpGetSupportParams{}, &HelpGetTermsOfServiceUpdateParams{}, &HelpGetTimezonesListParams{}, &HelpGetUserInfoParams{}, &HelpHidePromoDataParams{}, &HelpInviteText{}, &HelpNoAppUpdate{}, &HelpPassportConfigNotModified{}, &HelpPassportConfigObj{}, &HelpPeerColorOption{}, &HelpPeerColorProfileSet{}, &HelpPeerColorSetObj{}, &HelpPeerColorsNotModified{}, &HelpPeerColorsObj{}, &HelpPremiumPromo{}, &HelpPromoDataEmpty{}, &HelpPromoDataObj{}, &HelpRecentMeUrls{}, &HelpSaveAppLogParams{}, &HelpSetBotUpdatesStatusParams{}, &HelpSupport{}, &HelpSupportName{}, &HelpTermsOfService{}, &HelpTermsOfServiceUpdateEmpty{}, &HelpTermsOfServiceUpdateObj{}, &HelpTimezonesListNotModified{}, &HelpTimezonesListObj{}, &HelpUserInfoEmpty{}, &HelpUserInfoObj{}, &HighScore{}, &ImportedContact{}, &InlineBotSwitchPm{}, &InlineBotWebView{}, &InputAppEvent{}, &InputBotAppID{}, &InputBotAppShortName{}, &InputBotInlineMessageGame{}, &InputBotInlineMessageID64{}, &InputBotInlineMessageIDObj{}, &InputBotInlineMessageMediaAuto{}, &InputBotInlineMessageMediaContact{}, &InputBotInlineMessageMediaGeo{}, &InputBotInlineMessageMediaInvoice{}, &InputBotInlineMessageMediaVenue{}, &InputBotInlineMessageMediaWebPage{}, &InputBotInlineMessageText{}, &InputBotInlineResultDocument{}, &InputBotInlineResultGame{}, &InputBotInlineResultObj{}, &InputBotInlineResultPhoto{}, &InputBusinessAwayMessage{}, &InputBusinessBotRecipients{}, &InputBusinessChatLink{}, &InputBusinessGreetingMessage{}, &InputBusinessIntro{}, &InputBusinessRecipients{}, &InputChannelEmpty{}, &InputChannelFromMessage{}, &InputChannelObj{}, &InputChatPhotoEmpty{}, &InputChatPhotoObj{}, &InputChatThemeEmpty{}, &InputChatThemeObj{}, &InputChatThemeUniqueGift{}, &InputChatUploadedPhoto{}, &InputChatlistDialogFilter{}, &InputCheckPasswordEmpty{}, &InputCheckPasswordSRPObj{}, &InputClientProxy{}, &InputCollectiblePhone{}, &InputCollectibleUsername{}, &InputDialogPeerFolder{}, &InputDialogPeerObj{}, &InputDocumentEmpty{}, &InputDocumentFileLocation{}, &InputDocumentObj{}, &InputEmojiStatusCollectible{}, &InputEncryptedChat{}, &InputEncryptedFileBigUploaded{}, &InputEncryptedFileEmpty{}, &InputEncryptedFileLocation{}, &InputEncryptedFileObj{}, &InputEncryptedFileUploaded{}, &InputFileBig{}, &InputFileLocationObj{}, &InputFileObj{}, &InputFileStoryDocument{}, &InputFolderPeer{}, &InputGameID{}, &InputGameShortName{}, &InputGeoPointEmpty{}, &InputGeoPointObj{}, &InputGroupCallInviteMessage{}, &InputGroupCallObj{}, &InputGroupCallSlug{}, &InputGroupCallStream{}, &InputInvoiceBusinessBotTransferStars{}, &InputInvoiceChatInviteSubscription{}, &InputInvoiceMessage{}, &InputInvoicePremiumAuthCode{}, &InputInvoicePremiumGiftCode{}, &InputInvoicePremiumGiftStars{}, &InputInvoiceSlug{}, &InputInvoiceStarGift{}, &InputInvoiceStarGiftAuctionBid{}, &InputInvoiceStarGiftDropOriginalDetails{}, &InputInvoiceStarGiftPrepaidUpgrade{}, &InputInvoiceStarGiftResale{}, &InputInvoiceStarGiftTransfer{}, &InputInvoiceStarGiftUpgrade{}, &InputInvoiceStars{}, &InputKeyboardButtonRequestPeer{}, &InputKeyboardButtonURLAuth{}, &InputKeyboardButtonUserProfile{}, &InputMediaAreaChannelPost{}, &InputMediaAreaVenue{}, &InputMediaContact{}, &InputMediaDice{}, &InputMediaDocument{}, &InputMediaDocumentExternal{}, &InputMediaEmpty{}, &InputMediaGame{}, &InputMediaGeoLive{}, &InputMediaGeoPoint{}, &InputMediaInvoice{}, &InputMediaPaidMedia{}, &InputMediaPhoto{}, &InputMediaPhotoExternal{}, &InputMediaPoll{}, &InputMediaStakeDice{}, &InputMediaStory{}, &InputMediaTodo{}, &InputMediaUploadedDocument{}, &InputMediaUploadedPhoto{}, &InputMediaVenue{}, &InputMediaWebPage{}, &InputMessageCallbackQuery{}, &InputMessageEntityMentionName{}, &InputMessageID{}, &InputMessagePinned{}, &InputMessageReplyTo{}, &InputMessagesFilterChatPhotos{}, &InputMessagesFilterContacts{}, &InputMessagesFilterDocument{}, &InputMessagesFilterEmpty{}, &InputMessagesFilterGeo{}, &InputMessagesFilterGif{}, &InputMessagesFilterMusic{}, &InputMessagesFilterMyMentions{}, &InputMessagesFilterPhoneCalls{}, &InputMessagesFilterPhotoVideo{}, &InputMessagesFilterPhotoVideoDocuments{}, &InputMessagesFilterPhotos{}, &InputMessagesFilterPinned{}, &InputMessagesFilterRoundVideo{}, &InputMessagesFilterRoundVoice{}, &InputMessagesFilterURL{}, &InputMessagesFilterVideo{}, &InputMessagesFilterVoice{}, &InputNotifyBroadcasts{}, &InputNotifyChats{}, &InputNotifyForumTopic{}, &InputNotifyPeerObj{}, &InputNotifyUsers{}, &InputPasskeyCredentialFirebasePnv{}, &InputPasskeyCredentialPublicKey{}, &InputPasskeyResponseLogin{}, &InputPasskeyResponseRegister{}, &InputPaymentCredentialsApplePay{}, &InputPaymentCredentialsGooglePay{}, &InputPaymentCredentialsObj{}, &InputPaymentCredentialsSaved{}, &InputPeerChannel{}, &InputPeerChannelFromMessage{}, &InputPeerChat{}, &InputPeerColorCollectible{}, &InputPeerEmpty{}, &InputPeerNotifySettings{}, &InputPeerPhotoFileLocation{}, &InputPeerSelf{}, &InputPeerUser{}, &InputPeerUserFromMessage{}, &InputPhoneCall{}, &InputPhoneContact{}, &InputPhotoEmpty{}, &InputPhotoFileLocation{}, &InputPhotoLegacyFileLocation{}, &InputPhotoObj{}, &InputPrivacyValueAllowAll{}, &InputPrivacyValueAllowBots{}, &InputPrivacyValueAllowChatParticipants{}, &InputPrivacyValueAllowCloseFriends{}, &InputPrivacyValueAllowContacts{}, &InputPrivacyValueAllowPremium{}, &InputPrivacyValueAllowUsers{}, &InputPrivacyValueDisallowAll{}, &InputPrivacyValueDisallowBots{}, &InputPrivacyValueDisallowChatParticipants{}, &InputPrivacyValueDisallowContacts{}, &InputPrivacyValueDisallowUsers{}, &InputQuickReplyShortcutID{}, &InputQuickReplyShortcutObj{}, &InputReplyToMessage{}, &InputReplyToMonoForum{}, &InputReplyToStory{}, &InputSavedStarGiftChat{}, &InputSavedStarGiftSlug{}, &InputSavedStarGiftUser{}, &InputSecureFileLocation{}, &InputSecureFileObj{}, &InputSecureFileUploaded{}, &InputSecureValue{}, &InputSingleMedia{}, &InputStarGiftAuctionObj{}, &InputStarGiftAuctionSlug{}, &InputStarsTransaction{}, &InputStickerSetAnimatedEmoji{}, &InputStickerSetAnimatedEmojiAnimations{}, &InputStickerSetDice{}, &InputStickerSetEmojiChannelDefaultStatuses{}, &InputStickerSetEmojiDefaultStatuses{}, &InputStickerSetEmojiDefaultTopicIcons{}, &InputStickerSetEmojiGenericAnimations{}, &InputStickerSetEmpty{}, &InputStickerSetID{}, &InputStickerSetItem{}, &InputStickerSetPremiumGifts{}, &InputStickerSetShortName{}, &InputStickerSetThumb{}, &InputStickerSetTonGifts{}, &InputStickeredMediaDocument{}, &InputStickeredMediaPhoto{}, &InputStorePaymentAuthCode{}, &InputStorePaymentGiftPremium{}, &InputStorePaymentPremiumGiftCode{}, &InputStorePaymentPremiumGiveaway{}, &InputStorePaymentPremiumSubscription{}, &InputStorePaymentStarsGift{}, &InputStorePaymentStarsGiveaway{}, &InputStorePaymentStarsTopup{}, &InputTakeoutFileLocation{}, &InputThemeObj{}, &InputThemeSettings{}, &InputThemeSlug{}, &InputUserEmpty{}, &InputUserFromMessage{}, &InputUserObj{}, &InputUserSelf{}, &InputWallPaperNoFile{}, &InputWallPaperObj{}, &InputWallPaperSlug{}, &InputWebDocument{}, &InputWebFileAudioAlbumThumbLocation{}, &InputWebFileGeoPointLocation{}, &InputWebFileLocationObj{}, &Invoice{}, &JsonArray{}, &JsonBool{}, &JsonNull{}, &JsonNumber{}, &JsonObject{}, &JsonObjectValue{}, &JsonString{}, &KeyboardButtonBuy{}, &KeyboardButtonCallback{}, &KeyboardButtonCopy{}, &KeyboardButtonGame{}, &KeyboardButtonObj{}, &KeyboardButtonRequestGeoLocation{}, &KeyboardButtonRequestPeer{}, &KeyboardButtonRequestPhone{}, &KeyboardButtonRequestPoll{}, &KeyboardButtonRow{}, &KeyboardButtonSimpleWebView{}, &KeyboardButtonStyle{}, &KeyboardButtonSwitchInline{}, &KeyboardButtonURL{}, &KeyboardButtonURLAuth{}, &KeyboardButtonUserProfile{}, &KeyboardButtonWebView{}, &LabeledPrice{}, &LangPackDifference{}, &LangPackLanguage{}, &LangPackStringDeleted{}, &LangPackStringObj{}, &LangPackStringPluralized{}, &LangpackGetDifferenceParams{}, &LangpackGetLangPackParams{}, &LangpackGetLanguageParams{}, &LangpackGetLanguagesParams{}, &LangpackGetStringsParams{}, &MaskCoords{}, &MediaAreaChannelPost{}, &MediaAreaCoordinates{}, &MediaAreaGeoPoint{}, &MediaAreaStarGift{}, &MediaAreaSuggestedReaction{}, &MediaAreaURL{}, &MediaAreaVenue{}, &MediaAreaWeather{}, &MessageActionBoostApply{}, &MessageActionBotAllowed{}, &MessageActionChangeCreator{}, &MessageActionChannelCreate{}, &MessageActionChannelMigrateFrom{}, &MessageActionChatAddUser{}, &MessageActionChatCreate{}, &MessageActionChatDeletePhoto{}, &MessageActionChatDeleteUser{}, &MessageActionChatEditPhoto{}, &MessageActionChatEditTitle{}, &MessageActionChatJoinedByLink{}, &MessageActionChatJoinedByRequest{}, &MessageActionChatMigrateTo{}, &MessageActionConferenceCall{}, &MessageActionContactSignUp{}, &MessageActionCreatedBroadcastList{}, &MessageActionCustomAction{}, &MessageActionEmpty{}, &MessageActionGameScore{}, &MessageActionGeoProximityReached{}, &MessageActionGiftCode{}, &MessageActionGiftPremium{}, &MessageActionGiftStars{}, &MessageActionGiftTon{}, &MessageActionGiveawayLaunch{}, &MessageActionGiveawayResults{}, &MessageActionGroupCall{}, &MessageActionGroupCallScheduled{}, &MessageActionHistoryClear{}, &MessageActionInviteToGroupCall{}, &MessageActionLoginUnknownLocation{}, &MessageActionNewCreatorPending{}, &MessageActionPaidMessagesPrice{}, &MessageActionPaidMessagesRefunded{}, &MessageActionPaymentRefunded{}, &MessageActionPaymentSent{}, &MessageActionPaymentSentMe{}, &MessageActionPhoneCall{}, &MessageActionPhoneNumberRequest{}, &MessageActionPinMessage{}, &MessageActionPrizeStars{}, &MessageActionRequestedPeer{}, &MessageActionRequestedPeerSentMe{}, &MessageActionScreenshotTaken{}, &MessageActionSecureValuesSent{}, &MessageActionSecureValuesSentMe{}, &MessageActionSetChatTheme{}, &MessageActionSetChatWallPaper{}, &MessageActionSetMessagesTtl{}, &MessageActionStarGift{}, &MessageActionStarGiftPurchaseOffer{}, &MessageActionStarGiftPurchaseOfferDeclined{}, &MessageActionStarGiftUnique{}, &MessageActionSuggestBirthday{}, &MessageActionSuggestProfilePhoto{}, &MessageActionSuggestedPostApproval{}, &MessageActionSuggestedPostRefund{}, &MessageActionSuggestedPostSuccess{}, &MessageActionTodoAppendTasks{}, &MessageActionTodoCompletions{}, &MessageActionTopicCreate{}, &MessageActionTopicEdit{}, &MessageActionTtlChange{}, &MessageActionUserJoined{}, &MessageActionUserUpdatedPhoto{}, &MessageActionWebViewDataSent{}, &MessageActionWebViewDataSentMe{}, &MessageEmpty{}, &MessageEntityBankCard{}, &MessageEntityBlockquote{}, &MessageEntityBold{}, &MessageEntityBotCommand{}, &MessageEntityCashtag{}, &MessageEntityCode{}, &MessageEntityCustomEmoji{}, &MessageEntityEmail{}, &MessageEntityHashtag{}, &MessageEntityItalic{}, &MessageEntityMention{}, &MessageEntityMentionName{}, &MessageEntityPhone{}, &MessageEntityPre{}, &MessageEntitySpoiler{}, &MessageEntityStrike{}, &MessageEntityTextURL{}, &MessageEntityURL{}, &MessageEntityUnderline{}, &MessageEntityUnknown{}, &MessageExtendedMediaObj{}, &MessageExtendedMediaPreview{}, &MessageFwdHeader{}, &MessageMediaContact{}, &MessageMediaDice{}, &MessageMediaDocument{}, &MessageMediaEmpty{}, &MessageMediaGame{}, &MessageMediaGeo{}, &MessageMediaGeoLive{}, &MessageMediaGiveaway{}, &MessageMediaGiveawayResults{}, &MessageMediaInvoice{}, &MessageMediaPaidMedia{}, &MessageMediaPhoto{}, &MessageMediaPoll{}, &MessageMediaStory{}, &MessageMediaToDo{}, &MessageMediaUnsupported{}, &MessageMediaVenue{}, &MessageMediaVideoStream{}, &MessageMediaWebPage{}, &MessageObj{}, &MessagePeerReaction{}, &MessagePeerVoteInputOption{}, &MessagePeerVoteMultiple{}, &MessagePeerVoteObj{}, &MessageRange{}, &MessageReactions{}, &MessageReactor{}, &MessageReplies{}, &MessageReplyHeaderObj{}, &MessageReplyStoryHeader{}, &MessageReportOption{}, &MessageService{}, &MessageViews{}, &MessagesAcceptEncryptionParams{}, &MessagesAcceptURLAuthParams{}, &MessagesAddChatUserParams{}, &MessagesAffectedFoundMessages{}, &MessagesAffectedHistory{}, &MessagesAffectedMessages{}, &MessagesAllStickersNotModified{}, &MessagesAllStickersObj{}, &MessagesAppendTodoListParams{}, &MessagesArchivedStickers{}, &MessagesAvailableEffectsNotModified{}, &MessagesAvailableEffectsObj{}, &MessagesAvailableReactionsNotModified{}, &MessagesAvailableReactionsObj{}, &MessagesBotApp{}, &MessagesBotCallbackAnswer{}, &MessagesBotPreparedInlineMessage{}, &MessagesBotResults{}, &MessagesChannelMessages{}, &MessagesChatAdminsWithInvites{}, &MessagesChatFull{}, &MessagesChatInviteImporters{}, &MessagesChatsObj{}, &MessagesChatsSlice{}, &MessagesCheckChatInviteParams{}, &MessagesCheckHistoryImportParams{}, &MessagesCheckHistoryImportPeerParams{}, &MessagesCheckQuickReplyShortcutParams{}, &MessagesCheckedHistoryImportPeer{}, &MessagesClearAllDraftsParams{}, &MessagesClearRecentReactionsParams{}, &MessagesClearRecentStickersParams{}, &MessagesClickSponsoredMessageParams{}, &MessagesCraftStarGiftParams{}, &MessagesCreateChatParams{}, &MessagesCreateForumTopicParams{}, &MessagesDeleteChatParams{}, &MessagesDeleteChatUserParams{}, &MessagesDeleteExportedChatInviteParams{}, &MessagesDeleteFactCheckParams{}, &MessagesDeleteHistoryParams{}, &MessagesDeleteMessagesParams{}, &MessagesDeletePhoneCallHistoryParams{}, &MessagesDeleteQuickReplyMessagesParams{}, &MessagesDeleteQuickReplyShortcutParams{}, &MessagesDeleteRevokedExportedChatInvitesParams{}, &MessagesDeleteSavedHistoryParams{}, &MessagesDeleteScheduledMessagesParams{}, &MessagesDeleteTopicHistoryParams{}, &MessagesDhConfigNotModified{}, &MessagesDhConfigObj{}, &MessagesDialogFilters{}, &MessagesDialogsNotModified{}, &MessagesDialogsObj{}, &MessagesDialogsSlice{}, &MessagesDiscardEncryptionParams{}, &MessagesDiscussionMessage{}, &MessagesEditChatAboutParams{}, &MessagesEditChatAdminParams{}, &MessagesEditChatDefaultBannedRightsParams{}, &MessagesEditChatPhotoParams{}, &MessagesEditChatTitleParams{}, &MessagesEditExportedChatInviteParams{}, &MessagesEditFactCheckParams{}, &MessagesEditForumTopicParams{}, &MessagesEditInlineBotMessageParams{}, &MessagesEditMessageParams{}, &MessagesEditQuickReplyShortcutParams{}, &MessagesEmojiGameDiceInfo{}, &MessagesEmojiGameOutcome{}, &MessagesEmojiGameUnavailable{}, &MessagesEmojiGroupsNotModified{}, &MessagesEmojiGroupsObj{}, &MessagesExportChatInviteParams{}, &MessagesExportedChatInviteObj{}, &MessagesExportedChatInviteReplaced{}, &MessagesExportedChatInvites{}, &MessagesFaveStickerParams{}, &MessagesFavedStickersNotModified{}, &MessagesFavedStickersObj{}, &MessagesFeaturedStickersNotModified{}, &MessagesFeaturedStickersObj{}, &MessagesForumTopics{}, &MessagesForwardMessageParams{}, &MessagesForwardMessagesParams{}, &MessagesFoundStickerSetsNotModified{}, &MessagesFoundStickerSetsObj{}, &MessagesFoundStickersNotModified{}, &MessagesFoundStickersObj{}, &MessagesGetAdminsWithInvitesParams{}, &MessagesGetAllChatsParams{}, &MessagesGetAllDraftsParams{}, &MessagesGetAllStickersParams{}, &MessagesGetArchivedStickersParams{}, &MessagesGetAttachMenuBotParams{}, &MessagesGetAttachMenuBotsParams{}, &MessagesGetAttachedStickersParams{}, &MessagesGetAvailableEffectsParams{}, &MessagesGetAvailableReactionsParams{}, &MessagesGetBotAppParams{}, &MessagesGetBotCallbackAnswerParams{}, &MessagesGetChatInviteImportersParams{}, &MessagesGetChatsParams{}, &MessagesGetCommonChatsParams{}, &MessagesGetCraftStarGiftsParams{}, &MessagesGetCustomEmojiDocumentsParams{}, &MessagesGetDefaultHistoryTtlParams{}, &MessagesGetDefaultTagReactionsParams{}, &MessagesGetDhConfigParams{}, &MessagesGetDialogFiltersParams{}, &MessagesGetDialogUnreadMarksParams{}, &MessagesGetDialogsParams{}, &MessagesGetDiscussionMessageParams{}, &MessagesGetDocumentByHashParams{}, &MessagesGetEmojiGameInfoParams{}, &MessagesGetEmojiGroupsParams{}, &MessagesGetEmojiKeywordsDifferenceParams{}, &MessagesGetEmojiKeywordsLanguagesParams{}, &MessagesGetEmojiKeywordsParams{}, &MessagesGetEmojiProfilePhotoGroupsParams{}, &MessagesGetEmojiStatusGroupsParams{}, &MessagesGetEmojiStickerGroupsParams{}, &MessagesGetEmojiStickersParams{}, &MessagesGetEmojiURLParams{}, &MessagesGetExportedChatInviteParams{}, &MessagesGetExportedChatInvitesParams{}, &MessagesGetExtendedMediaParams{}, &MessagesGetFactCheckParams{}, &MessagesGetFavedStickersParams{}, &MessagesGetFeaturedEmojiStickersParams{}, &MessagesGetFeaturedStickersParams{}, &MessagesGetForumTopicsByIDParams{}, &MessagesGetForumTopicsParams{}, &MessagesGetFullChatParams{}, &MessagesGetGameHighScoresParams{}, &MessagesGetHistoryParams{}, &MessagesGetInlineBotResultsParams{}, &MessagesGetInlineGameHighScoresParams{}, &MessagesGetMaskStickersParams{}, &MessagesGetMessageEditDataParams{}, &MessagesGetMessageReactionsListParams{}, &MessagesGetMessageReadParticipantsParams{}, &MessagesGetMessagesParams{}, &MessagesGetMessagesReactionsParams{}, &MessagesGetMessagesViewsParams{}, &MessagesGetMyStickersParams{}, &MessagesGetOldFeaturedStickersParams{}, &MessagesGetOnlinesParams{}, &MessagesGetOutboxReadDateParams{}, &MessagesGetPaidReactionPrivacyParams{}, &MessagesGetPeerDialogsParams{}, &MessagesGetPeerSettingsParams{}, &MessagesGetPinnedDialogsParams{}, &MessagesGetPinnedSavedDialogsParams{}, &MessagesGetPollResultsParams{}, &MessagesGetPollVotesParams{}, &MessagesGetPreparedInlineMessageParams{}, &MessagesGetQuickRepliesParams{}, &MessagesGetQuickReplyMessagesParams{}, &MessagesGetRecentLocationsParams{}, &MessagesGetRecentReactionsParams{}, &MessagesGetRecentStickersParams{}, &MessagesGetRepliesParams{}, &MessagesGetSavedDialogsByIDParams{}, &MessagesGetSavedDialogsParams{}, &MessagesGetSavedGifsParams{}, &MessagesGetSavedHistoryParams{}, &MessagesGetSavedReactionTagsParams{}, &MessagesGetScheduledHistoryParams{}, &MessagesGetScheduledMessagesParams{}, &MessagesGetSearchCountersParams{}, &MessagesGetSearchResultsCalendarParams{}, &MessagesGetSearchResultsPositionsParams{}, &MessagesGetSplitRangesParams{}, &MessagesGetSponsoredMessagesParams{}, &MessagesGetStatsURLParams{}, &MessagesGetStickerSetParams{}, &MessagesGetStickersParams{}, &MessagesGetSuggestedDialogFiltersParams{}, &MessagesGetTopReactionsParams{}, &MessagesGetUnreadMentionsParams{}, &MessagesGetUnreadReactionsParams{}, &MessagesGetWebPageParams{}, &MessagesGetWebPagePreviewParams{}, &MessagesGetWebViewResultParams{}, &MessagesHideAllChatJoinRequestsParams{}, &MessagesHideChatJoinRequestParams{}, &MessagesHidePeerSettingsBarParams{}, &MessagesHighScores{}, &MessagesHistoryImport{}, &MessagesHistoryImportParsed{}, &MessagesImportChatInviteParams{}, &MessagesInactiveChats{}, &MessagesInitHistoryImportParams{}, &MessagesInstallStickerSetParams{}, &MessagesInvitedUsers{}, &MessagesMarkDialogUnreadParams{}, &MessagesMessageEditData{}, &MessagesMessageReactionsList{}, &MessagesMessageViews{}, &MessagesMessagesNotModified{}, &MessagesMessagesObj{}, &MessagesMessagesSlice{}, &MessagesMigrateChatParams{}, &MessagesMyStickers{}, &MessagesPeerDialogs{}, &MessagesPeerSettings{}, &MessagesPreparedInlineMessage{}, &MessagesProlongWebViewParams{}, &MessagesQuickRepliesNotModified{}, &MessagesQuickRepliesObj{}, &MessagesRateTranscribedAudioParams{}, &MessagesReactionsNotModified{}, &MessagesReactionsObj{}, &MessagesReadDiscussionParams{}, &MessagesReadEncryptedHistoryParams{}, &MessagesReadFeaturedStickersParams{}, &MessagesReadHistoryParams{}, &MessagesReadMentionsParams{}, &MessagesReadMessageContentsParams{}, &MessagesReadReactionsParams{}, &MessagesReadSavedHistoryParams{}, &MessagesReceivedMessagesParams{}, &MessagesReceivedQueueParams{}, &MessagesRecentStickersNotModified{}, &MessagesRecentStickersObj{}, &MessagesReorderPinnedDialogsParams{}, &MessagesReorderPinnedForumTopicsParams{}, &MessagesReorderPinnedSavedDialogsParams{}, &MessagesReorderQuickRepliesParams{}, &MessagesReorderStickerSetsParams{}, &MessagesReportEncryptedSpamParams{}, &MessagesReportMessagesDeliveryParams{}, &MessagesReportParams{}, &MessagesReportReactionParams{}, &MessagesReportSpamParams{}, &MessagesReportSponsoredMessageParams{}, &MessagesRequestAppWebViewParams{}, &MessagesRequestEncryptionParams{}, &MessagesRequestMainWebViewParams{}, &MessagesRequestSimpleWebViewParams{}, &MessagesRequestURLAuthParams{}, &MessagesRequestWebViewParams{}, &MessagesSaveDefaultSendAsParams{}, &MessagesSaveDraftParams{}, &MessagesSaveGifParams{}, &MessagesSavePreparedInlineMessageParams{}, &MessagesSaveRecentStickerParams{}, &MessagesSavedDialogsNotModified{}, &MessagesSavedDialogsObj{}, &MessagesSavedDialogsSlice{}, &MessagesSavedGifsNotModified{}, &MessagesSavedGifsObj{}, &MessagesSavedReactionTagsNotModified{}, &MessagesSavedReactionTagsObj{}, &MessagesSearchCounter{}, &MessagesSearchCustomEmojiParams{}, &MessagesSearchEmojiStickerSetsParams{}, &MessagesSearchGlobalParams{}, &MessagesSearchParams{}, &MessagesSearchResultsCalendar{}, &MessagesSearchResultsPositions{}, &MessagesSearchSentMediaParams{}, &MessagesSearchStickerSetsParams{}, &MessagesSearchStickersParams{}, &MessagesSendBotRequestedPeerParams{}, &MessagesSendEncryptedFileParams{}, &MessagesSendEncryptedParams{}, &MessagesSendEncryptedServiceParams{}, &MessagesSendInlineBotResultParams{}, &MessagesSendMediaParams{}, &MessagesSendMessageParams{}, &MessagesSendMultiMediaParams{}, &MessagesSendPaidReactionParams{}, &MessagesSendQuickReplyMessagesParams{}, &MessagesSendReactionParams{}, &MessagesSendScheduledMessagesParams{}, &MessagesSendScreenshotNotificationParams{}, &MessagesSendVoteParams{}, &MessagesSendWebViewDataParams{}, &MessagesSendWebViewResultMessageParams{}, &MessagesSentEncryptedFile{}, &MessagesSentEncryptedMessageObj{}, &MessagesSetBotCallbackAnswerParams{}, &MessagesSetBotPrecheckoutResultsParams{}, &MessagesSetBotShippingResultsParams{}, &MessagesSetChatAvailableReactionsParams{}, &MessagesSetChatThemeParams{}, &MessagesSetChatWallPaperParams{}, &MessagesSetDefaultHistoryTtlParams{}, &MessagesSetDefaultReactionParams{}, &MessagesSetEncryptedTypingParams{}, &MessagesSetGameScoreParams{}, &MessagesSetHistoryTtlParams{}, &MessagesSetInlineBotResultsParams{}, &MessagesSetInlineGameScoreParams{}, &MessagesSetTypingParams{}, &MessagesSetWebViewResultParams{}, &MessagesSponsoredMessagesEmpty{}, &MessagesSponsoredMessagesObj{}, &MessagesStartBotParams{}, &MessagesStartHistoryImportParams{}, &MessagesStickerSetInstallResultArchive{}, &MessagesStickerSetInstallResultSuccess{}, &MessagesStickerSetNotModified{}, &MessagesStickerSetObj{}, &MessagesStickersNotModified{}, &MessagesStickersObj{}, &MessagesSummarizeTextParams{}, &MessagesToggleBotInAttachMenuParams{}, &MessagesToggleDialogFilterTagsParams{}, &MessagesToggleDialogPinParams{}, &MessagesToggleNoForwardsParams{}, &MessagesTogglePaidReactionPrivacyParams{}, &MessagesTogglePeerTranslationsParams{}, &MessagesToggleSavedDialogPinParams{}, &MessagesToggleStickerSetsParams{}, &MessagesToggleSuggestedPostApprovalParams{}, &MessagesToggleTodoCompletedParams{}, &MessagesTranscribeAudioParams{}, &MessagesTranscribedAudio{}, &MessagesTranslateResult{}, &MessagesTranslateTextParams{}, &MessagesUninstallStickerSetParams{}, &MessagesUnpinAllMessagesParams{}, &MessagesUpdateDialogFilterParams{}, &MessagesUpdateDialogFiltersOrderParams{}, &MessagesUpdatePinnedForumTopicParams{}, &MessagesUpdatePinnedMessageParams{}, &MessagesUpdateSavedReactionTagParams{}, &MessagesUploadEncryptedFileParams{}, &MessagesUploadImportedMediaParams{}, &MessagesUploadMediaParams{}, &MessagesViewSponsoredMessageParams{}, &MessagesVotesList{}, &MessagesWebPage{}, &MessagesWebPagePreview{}, &MessagesWebViewResult{}, &MissingInvitee{}, &MonoForumDialog{}, &MyBoost{}, &NearestDc{}, &NotificationSoundDefault{}, &NotificationSoundLocal{}, &NotificationSoundNone{}, &NotificationSoundRingtone{}, &NotifyBroadcasts{}, &NotifyChats{}, &NotifyForumTopic{}, &NotifyPeerObj{}, &NotifyUsers{}, &OutboxReadDate{}, &Page{}, &PageBlockAnchor{}, &PageBlockAudio{}, &PageBlockAuthorDate{}, &PageBlockBlockquote{}, &PageBlockChannel{}, &PageBlockCollage{}, &PageBlockCover{}, &PageBlockDetails{}, &PageBlockDivider{}, &PageBlockEmbed{}, &PageBlockEmbedPost{}, &PageBlockFooter{}, &PageBlockHeader{}, &PageBlockKicker{}, &PageBlockList{}, &PageBlockMap{}, &PageBlockOrderedList{}, &PageBlockParagraph{}, &PageBlockPhoto{}, &PageBlockPreformatted{}, &PageBlockPullquote{}, &PageBlockRelatedArticles{}, &PageBlockSlideshow{}, &PageBlockSubheader{}, &PageBlockSubtitle{}, &PageBlockTable{}, &PageBlockTitle{}, &PageBlockUnsupported{}, &PageBlockVideo{}, &PageCaption{}, &PageListItemBlocks{}, &PageListItemText{}, &PageListOrderedItemBlocks{}, &PageListOrderedItemText{}, &PageRelatedArticle{}, &PageTableCell{}, &PageTableRow{}, &PaidReactionPrivacyAnonymous{}, &PaidReactionPrivacyDefault{}, &PaidReactionPrivacyPeer{}, &Passkey{}, &PasswordKdfAlgoSHA256SHA256Pbkdf2Hmacsha512Iter100000SHA256ModPow{}, &PasswordKdfAlgoUnknown{}, &PaymentCharge{}, &PaymentFormMethod{}, &PaymentRequestedInfo{}, &PaymentSavedCredentialsCard{}, &PaymentsApplyGiftCodeParams{}, &PaymentsAssignAppStoreTransactionParams{}, &PaymentsAssignPlayMarketTransactionParams{}, &PaymentsBankCardData{}, &PaymentsBotCancelStarsSubscriptionParams{}, &PaymentsCanPurchaseStoreParams{}, &PaymentsChangeStarsSubscriptionParams{}, &PaymentsCheckCanSendGiftParams{}, &PaymentsCheckCanSendGiftResultFail{}, &PaymentsCheckCanSendGiftResultOk{}, &PaymentsCheckGiftCodeParams{}, &PaymentsCheckedGiftCode{}, &PaymentsClearSavedInfoParams{}, &PaymentsConnectStarRefBotParams{}, &PaymentsConnectedStarRefBots{}, &PaymentsConvertStarGiftParams{}, &PaymentsCreateStarGiftCollectionParams{}, &PaymentsDeleteStarGiftCollectionParams{}, &PaymentsEditConnectedStarRefBotParams{}, &PaymentsExportInvoiceParams{}, &PaymentsExportedInvoice{}, &PaymentsFulfillStarsSubscriptionParams{}, &PaymentsGetBankCardDataParams{}, &PaymentsGetConnectedStarRefBotParams{}, &PaymentsGetConnectedStarRefBotsParams{}, &PaymentsGetGiveawayInfoParams{}, &PaymentsGetPaymentFormParams{}, &PaymentsGetPaymentReceiptParams{}, &PaymentsGetPremiumGiftCodeOptionsParams{}, &PaymentsGetResaleStarGiftsParams{}, &PaymentsGetSavedInfoParams{}, &PaymentsGetSavedStarGiftParams{}, &PaymentsGetSavedStarGiftsParams{}, &PaymentsGetStarGiftActiveAuctionsParams{}, &PaymentsGetStarGiftAuctionAcquiredGiftsParams{}, &PaymentsGetStarGiftAuctionStateParams{}, &PaymentsGetStarGiftCollectionsParams{}, &PaymentsGetStarGiftUpgradeAttributesParams{}, &PaymentsGetStarGiftUpgradePreviewParams{}, &PaymentsGetStarGiftWithdrawalURLParams{}, &PaymentsGetStarGiftsParams{}, &PaymentsGetStarsGiftOptionsParams{}, &PaymentsGetStarsGiveawayOptionsParams{}, &PaymentsGetStarsRevenueAdsAccountURLParams{}, &PaymentsGetStarsRevenueStatsParams{}, &PaymentsGetStarsRevenueWithdrawalURLParams{}, &PaymentsGetStarsStatusParams{}, &PaymentsGetStarsSubscriptionsParams{}, &PaymentsGetStarsTopupOptionsParams{}, &PaymentsGetStarsTransactionsByIDParams{}, &PaymentsGetStarsTransactionsParams{}, &PaymentsGetSuggestedStarRefBotsParams{}, &PaymentsGetUniqueStarGiftParams{}, &PaymentsGetUniqueStarGiftValueInfoParams{}, &PaymentsGiveawayInfoObj{}, &PaymentsGiveawayInfoResults{}, &PaymentsLaunchPrepaidGiveawayParams{}, &PaymentsPaymentFormObj{}, &PaymentsPaymentFormStarGift{}, &PaymentsPaymentFormStars{}, &PaymentsPaymentReceiptObj{}, &PaymentsPaymentReceiptStars{}, &PaymentsPaymentResultObj{}, &PaymentsPaymentVerificationNeeded{}, &PaymentsRefundStarsChargeParams{}, &PaymentsReorderStarGiftCollectionsParams{}, &PaymentsRequestRecurringPaymentParams{}, &PaymentsResaleStarGifts{}, &PaymentsResolveStarGiftOfferParams{}, &PaymentsSaveStarGiftParams{}, &PaymentsSavedInfo{}, &PaymentsSavedStarGifts{}, &PaymentsSendPaymentFormParams{}, &PaymentsSendStarGiftOfferParams{}, &PaymentsSendStarsFormParams{}, &PaymentsStarGiftActiveAuctionsNotModified{}, &PaymentsStarGiftActiveAuctionsObj{}, &PaymentsStarGiftAuctionAcquiredGifts{}, &PaymentsStarGiftAuctionState{}, &PaymentsStarGiftCollectionsNotModified{}, &PaymentsStarGiftCollectionsObj{}, &PaymentsStarGiftUpgradeAttributes{}, &PaymentsStarGiftUpgradePreview{}, &PaymentsStarGiftWithdrawalURL{}, &PaymentsStarGiftsNotModified{}, &PaymentsStarGiftsObj{}, &PaymentsStarsRevenueAdsAccountURL{}, &PaymentsStarsRevenueStats{}, &PaymentsStarsRevenueWithdrawalURL{}, &PaymentsStarsStatus{}, &PaymentsSuggestedStarRefBots{}, &PaymentsToggleChatStarGiftNotificationsParams{}, &PaymentsToggleStarGiftsPinnedToTopParams{}, &PaymentsTransferStarGiftParams{}, &PaymentsUniqueStarGift{}, &PaymentsUniqueStarGiftValueInfo{}, &PaymentsUpdateStarGiftCollectionParams{}, &PaymentsUpdateStarGiftPriceParams{}, &PaymentsUpgradeStarGiftParams{}, &PaymentsValidateRequestedInfoParams{}, &PaymentsValidatedRequestedInfo{}, &PeerBlocked{}, &PeerChannel{}, &PeerChat{}, &PeerColorCollectible{}, &PeerColorObj{}, &PeerLocatedObj{}, &PeerNotifySettings{}, &PeerSelfLocated{}, &PeerSettings{}, &PeerStories{}, &PeerUser{}, &PendingSuggestion{}, &PhoneAcceptCallParams{}, &PhoneCallAccepted{}, &PhoneCallDiscardReasonBusy{}, &PhoneCallDiscardReasonDisconnect{}, &PhoneCallDiscardReasonHangup{}, &PhoneCallDiscardReasonMigrateConferenceCall{}, &PhoneCallDiscardReasonMissed{}, &PhoneCallDiscarded{}, &PhoneCallEmpty{}, &PhoneCallObj{}, &PhoneCallProtocol{}, &PhoneCallRequested{}, &PhoneCallWaiting{}, &PhoneCheckGroupCallParams{}, &PhoneConfirmCallParams{}, &PhoneConnectionObj{}, &PhoneConnectionWebrtc{}, &PhoneCreateConferenceCallParams{}, &PhoneCreateGroupCallParams{}, &PhoneDeclineConferenceCallInviteParams{}, &PhoneDeleteConferenceCallParticipantsParams{}, &PhoneDeleteGroupCallMessagesParams{}, &PhoneDeleteGroupCallParticipantMessagesParams{}, &PhoneDiscardCallParams{}, &PhoneDiscardGroupCallParams{}, &PhoneEditGroupCallParticipantParams{}, &PhoneEditGroupCallTitleParams{}, &PhoneExportGroupCallInviteParams{}, &PhoneExportedGroupCallInvite{}, &PhoneGetCallConfigParams{}, &PhoneGetGroupCallChainBlocksParams{}, &PhoneGetGroupCallJoinAsParams{}, &PhoneGetGroupCallParams{}, &PhoneGetGroupCallStarsParams{}, &PhoneGetGroupCallStreamChannelsParams{}, &PhoneGetGroupCallStreamRtmpURLParams{}, &PhoneGetGroupParticipantsParams{}, &PhoneGroupCall{}, &PhoneGroupCallStars{}, &PhoneGroupCallStreamChannels{}, &PhoneGroupCallStreamRtmpURL{}, &PhoneGroupParticipants{}, &PhoneInviteConferenceCallParticipantParams{}, &PhoneInviteToGroupCallParams{}, &PhoneJoinAsPeers{}, &PhoneJoinGroupCallParams{}, &PhoneJoinGroupCallPresentationParams{}, &PhoneLeaveGroupCallParams{}, &PhoneLeaveGroupCallPresentationParams{}, &PhonePhoneCall{}, &PhoneReceivedCallParams{}, &PhoneRequestCallParams{}, &PhoneSaveCallDebugParams{}, &PhoneSaveCallLogParams{}, &PhoneSaveDefaultGroupCallJoinAsParams{}, &PhoneSaveDefaultSendAsParams{}, &PhoneSendConferenceCallBroadcastParams{}, &PhoneSendGroupCallEncryptedMessageParams{}, &PhoneSendGroupCallMessageParams{}, &PhoneSendSignalingDataParams{}, &PhoneSetCallRatingParams{}, &PhoneStartScheduledGroupCallParams{}, &PhoneToggleGroupCallRecordParams{}, &PhoneToggleGroupCallSettingsParams{}, &PhoneToggleGroupCallStartSubscriptionParams{}, &PhotoCachedSize{}, &PhotoEmpty{}, &PhotoObj{}, &PhotoPathSize{}, &PhotoSizeEmpty{}, &PhotoSizeObj{}, &PhotoSizeProgressive{}, &PhotoStrippedSize{}, &PhotosDeletePhotosParams{}, &PhotosGetUserPhotosParams{}, &PhotosPhoto{}, &PhotosPhotosObj{}, &PhotosPhotosSlice{}, &PhotosUpdateProfilePhotoParams{}, &PhotosUploadContactProfilePhotoParams{}, &PhotosUploadProfilePhotoParams{}, &Poll{}, &PollAnswer{}, &PollAnswerVoters{}, &PollResults{}, &PopularContact{}, &PostAddress{}, &PostInteractionCountersMessage{}, &PostInteractionCountersStory{}, &PremiumApplyBoostParams{}, &PremiumBoostsList{}, &PremiumBoostsStatus{}, &PremiumGetBoostsListParams{}, &PremiumGetBoostsStatusParams{}, &PremiumGetMyBoostsParams{}, &PremiumGetUserBoostsParams{}, &PremiumGiftCodeOption{}, &PremiumGiftOption{}, &PremiumMyBoosts{}, &PremiumSubscriptionOption{}, &PrepaidGiveawayObj{}, &PrepaidStarsGiveaway{}, &PrivacyValueAllowAll{}, &PrivacyValueAllowBots{}, &PrivacyValueAllowChatParticipants{}, &PrivacyValueAllowCloseFriends{}, &PrivacyValueAllowContacts{}, &PrivacyValueAllowPremium{}, &PrivacyValueAllowUsers{}, &PrivacyValueDisallowAll{}, &PrivacyValueDisallowBots{}, &PrivacyValueDisallowChatParticipants{}, &PrivacyValueDisallowContacts{}, &PrivacyValueDisallowUsers{}, &PublicForwardMessage{}, &PublicForwardStory{}, &QuickReply{}, &ReactionCount{}, &ReactionCustomEmoji{}, &ReactionEmoji{}, &ReactionEmpty{}, &ReactionPaid{}, &ReactionsNotifySettings{}, &ReadParticipantDate{}, &ReceivedNotifyMessage{}, &RecentMeURLChat{}, &RecentMeURLChatInvite{}, &RecentMeURLStickerSet{}, &RecentMeURLUnknown{}, &RecentMeURLUser{}, &RecentStory{}, &ReplyInlineMarkup{}, &ReplyKeyboardForceReply{}, &ReplyKeyboardHide{}, &ReplyKeyboardMarkup{}, &ReportResultAddComment{}, &ReportResultChooseOption{}, &ReportResultReported{}, &RequestPeerTypeBroadcast{}, &RequestPeerTypeChat{}, &RequestPeerTypeUser{}, &RequestedPeerChannel{}, &RequestedPeerChat{}, &RequestedPeerUser{}, &RequirementToContactEmpty{}, &RequirementToContactPaidMessages{}, &RequirementToContactPremium{}, &RestrictionReason{}, &SavedDialogObj{}, &SavedPhoneContact{}, &SavedReactionTag{}, &SavedStarGift{}, &SearchPostsFlood{}, &SearchResultPosition{}, &SearchResultsCalendarPeriod{}, &SecureCredentialsEncrypted{}, &SecureData{}, &SecureFileEmpty{}, &SecureFileObj{}, &SecurePasswordKdfAlgoPbkdf2Hmacsha512Iter100000{}, &SecurePasswordKdfAlgoSHA512{}, &SecurePasswordKdfAlgoUnknown{}, &SecurePlainEmail{}, &SecurePlainPhone{}, &SecureRequiredTypeObj{}, &SecureRequiredTypeOneOf{}, &SecureSecretSettings{}, &SecureValue{}, &SecureValueErrorData{}, &SecureValueErrorFile{}, &SecureValueErrorFiles{}, &SecureValueErrorFrontSide{}, &SecureValueErrorObj{}, &SecureValueErrorReverseSide{}, &SecureValueErrorSelfie{}, &SecureValueErrorTranslationFile{}, &SecureValueErrorTranslationFiles{}, &SecureValueHash{}, &SendAsPeer{}, &SendMessageCancelAction{}, &SendMessageChooseContactAction{}, &SendMessageChooseStickerAction{}, &SendMessageEmojiInteraction{}, &SendMessageEmojiInteractionSeen{}, &SendMessageGamePlayAction{}, &SendMessageGeoLocationAction{}, &SendMessageHistoryImportAction{}, &SendMessageRecordAudioAction{}, &SendMessageRecordRoundAction{}, &SendMessageRecordVideoAction{}, &SendMessageTextDraftAction{}, &SendMessageTypingAction{}, &SendMessageUploadAudioAction{}, &SendMessageUploadDocumentAction{}, &SendMessageUploadPhotoAction{}, &SendMessageUploadRoundAction{}, &SendMessageUploadVideoAction{}, &ShippingOption{}, &SmsJob{}, &SmsjobsEligibleToJoin{}, &SmsjobsFinishJobParams{}, &SmsjobsGetSmsJobParams{}, &SmsjobsGetStatusParams{}, &SmsjobsIsEligibleToJoinParams{}, &SmsjobsJoinParams{}, &SmsjobsLeaveParams{}, &SmsjobsStatus{}, &SmsjobsUpdateSettingsParams{}, &SpeakingInGroupCallAction{}, &SponsoredMessage{}, &SponsoredMessageReportOption{}, &SponsoredPeer{}, &StarGiftActiveAuctionState{}, &StarGiftAttributeBackdrop{}, &StarGiftAttributeCounter{}, &StarGiftAttributeIDBackdrop{}, &StarGiftAttributeIDModel{}, &StarGiftAttributeIDPattern{}, &StarGiftAttributeModel{}, &StarGiftAttributeOriginalDetails{}, &StarGiftAttributePattern{}, &StarGiftAttributeRarityEpic{}, &StarGiftAttributeRarityLegendary{}, &StarGiftAttributeRarityObj{}, &StarGiftAttributeRarityRare{}, &StarGiftAttributeRarityUncommon{}, &StarGiftAuctionAcquiredGift{}, &StarGiftAuctionRoundExtendable{}, &StarGiftAuctionRoundObj{}, &StarGiftAuctionStateFinished{}, &StarGiftAuctionStateNotModified{}, &StarGiftAuctionStateObj{}, &StarGiftAuctionUserState{}, &StarGiftBackground{}, &StarGiftCollection{}, &StarGiftObj{}, &StarGiftUnique{}, &StarGiftUpgradePrice{}, &StarRefProgram{}, &StarsAmountObj{}, &StarsGiftOption{}, &StarsGiveawayOption{}, &StarsGiveawayWinnersOption{}, &StarsRating{}, &StarsRevenueStatus{}, &StarsSubscription{}, &StarsSubscriptionPricing{}, &StarsTonAmount{}, &StarsTopupOption{}, &StarsTransaction{}, &StarsTransactionPeerAPI{}, &StarsTransactionPeerAds{}, &StarsTransactionPeerAppStore{}, &StarsTransactionPeerFragment{}, &StarsTransactionPeerObj{}, &StarsTransactionPeerPlayMarket{}, &StarsTransactionPeerPremiumBot{}, &StarsTransactionPeerUnsupported{}, &StatsAbsValueAndPrev{}, &StatsBroadcastStats{}, &StatsDateRangeDays{}, &StatsGetBroadcastStatsParams{}, &StatsGetMegagroupStatsParams{}, &StatsGetMessagePublicForwardsParams{}, &StatsGetMessageStatsParams{}, &StatsGetStoryPublicForwardsParams{}, &StatsGetStoryStatsParams{}, &StatsGraphAsync{}, &StatsGraphError{}, &StatsGraphObj{}, &StatsGroupTopAdmin{}, &StatsGroupTopInviter{}, &StatsGroupTopPoster{}, &StatsLoadAsyncGraphParams{}, &StatsMegagroupStats{}, &StatsMessageStats{}, &StatsPercentValue{}, &StatsPublicForwards{}, &StatsStoryStats{}, &StatsURL{}, &StickerKeyword{}, &StickerPack{}, &StickerSet{}, &StickerSetCoveredObj{}, &StickerSetFullCovered{}, &StickerSetMultiCovered{}, &StickerSetNoCovered{}, &StickersAddStickerToSetParams{}, &StickersChangeStickerParams{}, &StickersChangeStickerPositionParams{}, &StickersCheckShortNameParams{}, &StickersCreateStickerSetParams{}, &StickersDeleteStickerSetParams{}, &StickersRemoveStickerFromSetParams{}, &StickersRenameStickerSetParams{}, &StickersReplaceStickerParams{}, &StickersSetStickerSetThumbParams{}, &StickersSuggestShortNameParams{}, &StickersSuggestedShortName{}, &StoriesActivateStealthModeParams{}, &StoriesAlbumsNotModified{}, &StoriesAlbumsObj{}, &StoriesAllStoriesNotModified{}, &StoriesAllStoriesObj{}, &StoriesCanSendStoryCount{}, &StoriesCanSendStoryParams{}, &StoriesCreateAlbumParams{}, &StoriesDeleteAlbumParams{}, &StoriesDeleteStoriesParams{}, &StoriesEditStoryParams{}, &StoriesExportStoryLinkParams{}, &StoriesFoundStories{}, &StoriesGetAlbumStoriesParams{}, &StoriesGetAlbumsParams{}, &StoriesGetAllReadPeerStoriesParams{}, &StoriesGetAllStoriesParams{}, &StoriesGetChatsToSendParams{}, &StoriesGetPeerMaxIDsParams{}, &StoriesGetPeerStoriesParams{}, &StoriesGetPinnedStoriesParams{}, &StoriesGetStoriesArchiveParams{}, &StoriesGetStoriesByIDParams{}, &StoriesGetStoriesViewsParams{}, &StoriesGetStoryReactionsListParams{}, &StoriesGetStoryViewsListParams{}, &StoriesIncrementStoryViewsParams{}, &StoriesPeerStories{}, &StoriesReadStoriesParams{}, &StoriesReorderAlbumsParams{}, &StoriesReportParams{}, &StoriesSearchPostsParams{}, &StoriesSendReactionParams{}, &StoriesSendStoryParams{}, &StoriesStartLiveParams{}, &StoriesStealthMode{}, &StoriesStories{}, &StoriesStoryReactionsList{}, &StoriesStoryViews{}, &StoriesStoryViewsList{}, &StoriesToggleAllStoriesHiddenParams{}, &StoriesTogglePeerStoriesHiddenParams{}, &StoriesTogglePinnedParams{}, &StoriesTogglePinnedToTopParams{}, &StoriesUpdateAlbumParams{}, &StoryAlbum{}, &StoryFwdHeader{}, &StoryItemDeleted{}, &StoryItemObj{}, &StoryItemSkipped{}, &StoryReactionObj{}, &StoryReactionPublicForward{}, &StoryReactionPublicRepost{}, &StoryViewObj{}, &StoryViewPublicForward{}, &StoryViewPublicRepost{}, &StoryViews{}, &SuggestedPost{}, &TextAnchor{}, &TextBold{}, &TextConcat{}, &TextEmail{}, &TextEmpty{}, &TextFixed{}, &TextImage{}, &TextItalic{}, &TextMarked{}, &TextPhone{}, &TextPlain{}, &TextStrike{}, &TextSubscript{}, &TextSuperscript{}, &TextURL{}, &TextUnderline{}, &TextWithEntities{}, &Theme{}, &ThemeSettings{}, &Timezone{}, &TodoCompletion{}, &TodoItem{}, &TodoList{}, &TopPeer{}, &TopPeerCategoryPeers{}, &URLAuthResultAccepted{}, &URLAuthResultDefault{}, &URLAuthResultRequest{}, &UpdateAttachMenuBots{}, &UpdateAutoSaveSettings{}, &UpdateBotBusinessConnect{}, &UpdateBotCallbackQuery{}, &UpdateBotChatBoost{}, &UpdateBotChatInviteRequester{}, &UpdateBotCommands{}, &UpdateBotDeleteBusinessMessage{}, &UpdateBotEditBusinessMessage{}, &UpdateBotInlineQuery{}, &UpdateBotInlineSend{}, &UpdateBotMenuButton{}, &UpdateBotMessageReaction{}, &UpdateBotMessageReactions{}, &UpdateBotNewBusinessMessage{}, &UpdateBotPrecheckoutQuery{}, &UpdateBotPurchasedPaidMedia{}, &UpdateBotShippingQuery{}, &UpdateBotStopped{}, &UpdateBotSubscriptionExpire{}, &UpdateBotWebhookJson{}, &UpdateBotWebhookJsonQuery{}, &UpdateBusinessBotCallbackQuery{}, &UpdateChannel{}, &UpdateChannelAvailableMessages{}, &UpdateChannelMessageForwards{}, &UpdateChannelMessageViews{}, &UpdateChannelParticipant{}, &UpdateChannelReadMessagesContents{}, &UpdateChannelTooLong{}, &UpdateChannelUserTyping{}, &UpdateChannelViewForumAsMessages{}, &UpdateChannelWebPage{}, &UpdateChat{}, &UpdateChatDefaultBannedRights{}, &UpdateChatParticipant{}, &UpdateChatParticipantAdd{}, &UpdateChatParticipantAdmin{}, &UpdateChatParticipantDelete{}, &UpdateChatParticipants{}, &UpdateChatUserTyping{}, &UpdateConfig{}, &UpdateContactsReset{}, &UpdateDcOptions{}, &UpdateDeleteChannelMessages{}, &UpdateDeleteGroupCallMessages{}, &UpdateDeleteMessages{}, &UpdateDeleteQuickReply{}, &UpdateDeleteQuickReplyMessages{}, &UpdateDeleteScheduledMessages{}, &UpdateDialogFilter{}, &UpdateDialogFilterOrder{}, &UpdateDialogFilters{}, &UpdateDialogPinned{}, &UpdateDialogUnreadMark{}, &UpdateDraftMessage{}, &UpdateEditChannelMessage{}, &UpdateEditMessage{}, &UpdateEmojiGameInfo{}, &UpdateEncryptedChatTyping{}, &UpdateEncryptedMessagesRead{}, &UpdateEncryption{}, &UpdateFavedStickers{}, &UpdateFolderPeers{}, &UpdateGeoLiveViewed{}, &UpdateGroupCall{}, &UpdateGroupCallChainBlocks{}, &UpdateGroupCallConnection{}, &UpdateGroupCallEncryptedMessage{}, &UpdateGroupCallMessage{}, &UpdateGroupCallParticipants{}, &UpdateInlineBotCallbackQuery{}, &UpdateLangPack{}, &UpdateLangPackTooLong{}, &UpdateLoginToken{}, &UpdateMessageExtendedMedia{}, &UpdateMessageID{}, &UpdateMessagePoll{}, &UpdateMessagePollVote{}, &UpdateMessageReactions{}, &UpdateMonoForumNoPaidException{}, &UpdateMoveStickerSetToTop{}, &UpdateNewAuthorization{}, &UpdateNewChannelMessage{}, &UpdateNewEncryptedMessage{}, &UpdateNewMessage{}, &UpdateNewQuickReply{}, &UpdateNewScheduledMessage{}, &UpdateNewStickerSet{}, &UpdateNewStoryReaction{}, &UpdateNotifySettings{}, &UpdatePaidReactionPrivacy{}, &UpdatePeerBlocked{}, &UpdatePeerHistoryTtl{}, &UpdatePeerLocated{}, &UpdatePeerSettings{}, &UpdatePeerWallpaper{}, &UpdatePendingJoinRequests{}, &UpdatePhoneCall{}, &UpdatePhoneCallSignalingData{}, &UpdatePinnedChannelMessages{}, &UpdatePinnedDialogs{}, &UpdatePinnedForumTopic{}, &UpdatePinnedForumTopics{}, &UpdatePinnedMessages{}, &UpdatePinnedSavedDialogs{}, &UpdatePrivacy{}, &UpdatePtsChanged{}, &UpdateQuickReplies{}, &UpdateQuickReplyMessage{}, &UpdateReadChannelDiscussionInbox{}, &UpdateReadChannelDiscussionOutbox{}, &UpdateReadChannelInbox{}, &UpdateReadChannelOutbox{}, &UpdateReadFeaturedEmojiStickers{}, &UpdateReadFeaturedStickers{}, &UpdateReadHistoryInbox{}, &UpdateReadHistoryOutbox{}, &UpdateReadMessagesContents{}, &UpdateReadMonoForumInbox{}, &UpdateReadMonoForumOutbox{}, &UpdateReadStories{}, &UpdateRecentEmojiStatuses{}, &UpdateRecentReactions{}, &UpdateRecentStickers{}, &UpdateSavedDialogPinned{}, &UpdateSavedGifs{}, &UpdateSavedReactionTags{}, &UpdateSavedRingtones{}, &UpdateSentPhoneCode{}, &UpdateSentStoryReaction{}, &UpdateServiceNotification{}, &UpdateShort{}, &UpdateShortChatMessage{}, &UpdateShortMessage{}, &UpdateShortSentMessage{}, &UpdateSmsJob{}, &UpdateStarGiftAuctionState{}, &UpdateStarGiftAuctionUserState{}, &UpdateStarGiftCraftFail{}, &UpdateStarsBalance{}, &UpdateStarsRevenueStatus{}, &UpdateStickerSets{}, &UpdateStickerSetsOrder{}, &UpdateStoriesStealthMode{}, &UpdateStory{}, &UpdateStoryID{}, &UpdateTheme{}, &UpdateTranscribeAudio{}, &UpdateTranscribedAudio{}, &UpdateUser{}, &UpdateUserEmojiStatus{}, &UpdateUserName{}, &UpdateUserPhone{}, &UpdateUserStatus{}, &UpdateUserTyping{}, &UpdateWebPage{}, &UpdateWebViewResultSent{}, &UpdatesChannelDifferenceEmpty{}, &UpdatesChannelDifferenceObj{}, &UpdatesChannelDifferenceTooLong{}, &UpdatesCombined{}, &UpdatesDifferenceEmpty{}, &UpdatesDifferenceObj{}, &UpdatesDifferenceSlice{}, &UpdatesDifferenceTooLong{}, &UpdatesGetChannelDifferenceParams{}, &UpdatesGetDifferenceParams{}, &UpdatesGetStateParams{}, &UpdatesObj{}, &UpdatesState{}, &UpdatesTooLong{}, &UploadCdnFileObj{}, &UploadCdnFileReuploadNeeded{}, &UploadFileCdnRedirect{}, &UploadFileObj{}, &UploadGetCdnFileHashesParams{}, &UploadGetCdnFileParams{}, &UploadGetFileHashesParams{}, &UploadGetFileParams{}, &UploadGetWebFileParams{}, &UploadReuploadCdnFileParams{}, &UploadSaveBigFilePartParams{}, &UploadSaveFilePartParams{}, &UploadWebFile{}, &UserEmpty{}, &UserFull{}, &UserObj{}, &UserProfilePhotoEmpty{}, &UserProfilePhotoObj{}, &UserStatusEmpty{}, &UserStatusHidden{}, &UserStatusLastMonth{}, &UserStatusLastWeek{}, &UserStatusOffline{}, &UserStatusOnline{}, &UserStatusRecently{}, &Username{}, &UsersGetFullUserParams{}, &UsersGetRequirementsToContactParams{}, &UsersGetSavedMusicByIDParams{}, &UsersGetSavedMusicParams{}, &UsersGetUsersParams{}, &UsersSavedMusicNotModified{}, &UsersSavedMusicObj{}, &UsersSetSecureValueErrorsParams{}, &UsersSuggestBirthdayParams{}, &UsersUserFull{}, &UsersUsersObj{}, &UsersUsersSlice{}, &VideoSizeEmojiMarkup{}, &VideoSizeObj{}, &VideoSizeStickerMarkup{}, &WallPaperNoFile{}, &WallPaperObj{}, &WallPaperSettings{}, &WebAuthorization{}, &WebDocumentNoProxy{}, &WebDocumentObj{}, &WebPageAttributeStarGiftAuction{}, &WebPageAttributeStarGiftCollection{}, &WebPageAttributeStickerSet{}, &WebPageAttributeStory{}, &WebPageAttributeTheme{}, &WebPageAttributeUniqueStarGift{}, &WebPageEmpty{}, &WebPageNotModified{}, &WebPageObj{}, &WebPagePending{}, &WebPageURLPending{}, &WebViewMessageSent{}, &WebViewResultURL{})
 
 	tl.RegisterEnums(AttachMenuPeerTypeBotPm, AttachMenuPeerTypeBroadcast, AttachMenuPeerTypeChat, AttachMenuPeerTypePm, AttachMenuPeerTypeSameBotPm, AuthCodeTypeCall, AuthCodeTypeFlashCall, AuthCodeTypeFragmentSms, AuthCodeTypeMissedCall, AuthCodeTypeSms, BaseThemeArctic, BaseThemeClassic, BaseThemeDay, BaseThemeNight, BaseThemeTinted, InlineQueryPeerTypeBotPm, InlineQueryPeerTypeBroadcast, InlineQueryPeerTypeChat, InlineQueryPeerTypeMegagroup, InlineQueryPeerTypePm, InlineQueryPeerTypeSameBotPm, InputPrivacyKeyAbout, InputPrivacyKeyAddedByPhone, InputPrivacyKeyBirthday, InputPrivacyKeyChatInvite, InputPrivacyKeyForwards, InputPrivacyKeyNoPaidMessages, InputPrivacyKeyPhoneCall, InputPrivacyKeyPhoneNumber, InputPrivacyKeyPhoneP2P, InputPrivacyKeyProfilePhoto, InputPrivacyKeySavedMusic, InputPrivacyKeyStarGiftsAutoSave, InputPrivacyKeyStatusTimestamp, InputPrivacyKeyVoiceMessages, InputReportReasonChildAbuse, InputReportReasonCopyright, InputReportReasonFake, InputReportReasonGeoIrrelevant, InputReportReasonIllegalDrugs, InputReportReasonOther, InputReportReasonPersonalDetails, InputReportReasonPornography, InputReportReasonSpam, InputReportReasonViolence, MessagesMessageEmptyCrc, NullCrc, PrivacyKeyAbout, PrivacyKeyAddedByPhone, PrivacyKeyBirthday, PrivacyKeyChatInvite, PrivacyKeyForwards, PrivacyKeyNoPaidMessages, PrivacyKeyPhoneCall, PrivacyKeyPhoneNumber, PrivacyKeyPhoneP2P, PrivacyKeyProfilePhoto, PrivacyKeySavedMusic, PrivacyKeyStarGiftsAutoSave, PrivacyKeyStatusTimestamp, PrivacyKeyVoiceMessages, ProfileTabFiles, ProfileTabGifs, ProfileTabGifts, ProfileTabLinks, ProfileTabMedia, ProfileTabMusic, ProfileTabPosts, ProfileTabVoice, ReactionNotificationsFromAll, ReactionNotificationsFromContacts, SecureValueTypeAddress, SecureValueTypeBankStatement, SecureValueTypeDriverLicense, SecureValueTypeEmail, SecureValueTypeIdentityCard, SecureValueTypeInternalPassport, SecureValueTypePassport, SecureValueTypePassportRegistration, SecureValueTypePersonalDetails, SecureValueTypePhone, SecureValueTypeRentalAgreement, SecureValueTypeTemporaryRegistration, SecureValueTypeUtilityBill, StorageFileGif, StorageFileJpeg, StorageFileMov, StorageFileMp3, StorageFileMp4, StorageFilePartial, StorageFilePdf, StorageFilePng, StorageFileUnknown, StorageFileWebp, TopPeerCategoryBotsApp, TopPeerCategoryBotsInline, TopPeerCategoryBotsPm, TopPeerCategoryChannels, TopPeerCategoryCorrespondents, TopPeerCategoryForwardChats, TopPeerCategoryForwardUsers, TopPeerCategoryGroups, TopPeerCategoryPhoneCalls)
+
+	tl.RegisterNames(map[uint32]string{
+		0x1013fd9e: "contacts.deleteByPhones",
+		0x107e31a0: "messages.searchSentMedia",
+		0x1081464c: "storage.fileWebp",
+		0x108d941f: "updateChannelTooLong",
+		0x10a698e8: "smsjobs.getStatus",
+		0x10ab6dc7: "chatlists.exportedInvites",
+		0x10cf3123: "bots.setBotInfo",
+		0x10e6bd2c: "channels.checkUsername",
+		0x10e6e3a6: "chatlists.exportedChatlistInvite",
+		0x10ea6184: "messages.sendVote",
+		0x1117dd5f: "geoPointEmpty",
+		0x1142bd56: "savedPhoneContact",
+		0x114ff30d: "contacts.contactBirthdays",
+		0x1158fe3e: "stories.allStoriesNotModified",
+		0x11679fa7: "inputBusinessChatLink",
+		0x1190cf1:  "inputQuickReplyShortcutId",
+		0x11965f3a: "botInlineResult",
+		0x11b58939: "documentAttributeAnimated",
+		0x11dfa986: "updateBotChatInviteRequester",
+		0x11e831ee: "channels.getInactiveChannels",
+		0x11f812d8: "contacts.search",
+		0x120b1ab9: "businessWeeklyOpen",
+		0x124b1c00: "stickers.renameStickerSet",
+		0x12b299d4: "stickerPack",
+		0x12b3ad31: "account.getNotifySettings",
+		0x12bcbd9a: "updateNewEncryptedMessage",
+		0x12cbf0c4: "messages.reportSponsoredMessage",
+		0x12f12a07: "updateBotInlineSend",
+		0x13005788: "contacts.importContactToken",
+		0x131cc67f: "inputPrivacyValueAllowUsers",
+		0x13567e8a: "pageBlockUnsupported",
+		0x1359f4e6: "bots.canSendMessage",
+		0x13659eb0: "starsTransaction",
+		0x13704a7c: "messages.forwardMessages",
+		0x137948a5: "auth.passwordRecovery",
+		0x139f63fb: "contacts.updateContactNote",
+		0x1427a5e1: "channelParticipantsBanned",
+		0x14455871: "mediaAreaSuggestedReaction",
+		0x145ade0b: "contact",
+		0x146e958d: "payments.requestRecurringPayment",
+		0x147ee23c: "messages.searchResultsCalendar",
+		0x148677e2: "topPeerCategoryBotsInline",
+		0x14967978: "messages.uploadMedia",
+		0x14b0ed0c: "phoneCallRequested",
+		0x14b85813: "updateBotMenuButton",
+		0x15051f54: "photos.photosSlice",
+		0x1508b6af: "messages.getEmojiKeywordsDifference",
+		0x1513e7b0: "payments.toggleStarGiftsPinnedToTop",
+		0x1527bcac: "secureSecretSettings",
+		0x15590068: "documentAttributeFilename",
+		0x1592b79d: "updateWebViewResultSent",
+		0x15ad9f64: "messages.setInlineGameScore",
+		0x15ba6c40: "messages.dialogs",
+		0x15cefd00: "messageActionChatAddUser",
+		0x160544ca: "inputStorePaymentPremiumGiveaway",
+		0x16115a96: "pageBlockRelatedArticles",
+		0x161d9628: "topPeerCategoryChannels",
+		0x1662af0b: "messages.historyImport",
+		0x167fc0a1: "channels.toggleAutotranslation",
+		0x16bf744e: "sendMessageTypingAction",
+		0x16d9703b: "contactStatus",
+		0x16fcc2cb: "messages.getAttachMenuBots",
+		0x1710f156: "updateEncryptedChatTyping",
+		0x1759c560: "pageBlockPhoto",
+		0x175df251: "messages.updatePinnedForumTopic",
+		0x176f8ba1: "sendMessageGeoLocationAction",
+		0x17aeb75a: "bots.addPreviewMedia",
+		0x17b7a20b: "updateAttachMenuBots",
+		0x17c6b5f6: "help.support",
+		0x17d348d2: "privacyKeyNoPaidMessages",
+		0x17d493d5: "channelForbidden",
+		0x17d54f61: "phone.receivedCall",
+		0x17d7f87b: "account.connectedBots",
+		0x17db940b: "botInlineMediaResult",
+		0x18201aae: "account.clearRecentEmojiStatuses",
+		0x1824e40b: "updateNewStoryReaction",
+		0x182e6d6f: "account.getWebAuthorizations",
+		0x183040d3: "channelAdminLogEventActionParticipantJoin",
+		0x1837c364: "inputEncryptedFileEmpty",
+		0x1839490f: "messages.sponsoredMessagesEmpty",
+		0x184b35ce: "inputPrivacyValueAllowAll",
+		0x1871be50: "messages.exportedChatInvite",
+		0x187fa0ca: "secureValue",
+		0x18b7a10d: "dcOption",
+		0x18cb9f78: "help.inviteText",
+		0x18d1cdc2: "botInlineMessageMediaContact",
+		0x18dea0ac: "messages.getAvailableReactions",
+		0x19360dc0: "updateFolderPeers",
+		0x193b4417: "inputNotifyUsers",
+		0x194cb3b:  "inputBusinessGreetingMessage",
+		0x198fb446: "messages.requestUrlAuth",
+		0x1991b13b: "bots.popularAppBots",
+		0x19a13f71: "connectedBotStarRef",
+		0x19ba4a67: "account.getPaidMessagesRevenue",
+		0x19c2f763: "updates.getDifference",
+		0x19d8eb45: "stories.report",
+		0x1a8afc7e: "groupCallMessage",
+		0x1ab21940: "phone.getGroupCallStreamChannels",
+		0x1abfb575: "inputDocument",
+		0x1ad4a04a: "messages.updateDialogFilter",
+		0x1ae373ac: "contacts.resetTopPeerRating",
+		0x1b03f006: "channelParticipantLeft",
+		0x1b0c841a: "draftMessageEmpty",
+		0x1b0e4f07: "starsRating",
+		0x1b2286b8: "reactionEmoji",
+		0x1b287353: "messageActionSecureValuesSentMe",
+		0x1b3f4df7: "updateEditChannelMessage",
+		0x1b3faa88: "account.sendConfirmPhoneCode",
+		0x1b7907ae: "channelAdminLogEventActionToggleInvites",
+		0x1baa035:  "messageActionPhoneNumberRequest",
+		0x1bb00451: "inputMessagesFilterPinned",
+		0x1bbcf300: "messages.getSearchCounters",
+		0x1bf335b9: "updateStoryID",
+		0x1bf89d74: "messages.sendMultiMedia",
+		0x1c199183: "account.wallPapersNotModified",
+		0x1c295881: "folders.deleteFolder",
+		0x1c32b11c: "channel",
+		0x1c3db333: "account.uploadTheme",
+		0x1c50d144: "phone.leaveGroupCallPresentation",
+		0x1c570ed1: "webDocument",
+		0x1c641c2:  "webPageAttributeStarGiftAuction",
+		0x1c6e1c11: "chatPhoto",
+		0x1ca48f57: "inputChatPhotoEmpty",
+		0x1ca6ac0a: "phone.editGroupCallTitle",
+		0x1cc6e91f: "inputSingleMedia",
+		0x1ccb966a: "textPhone",
+		0x1cd7bf0d: "inputPhotoEmpty",
+		0x1cf671a0: "inputStickerSetTonGifts",
+		0x1cff7e08: "messages.getSplitRanges",
+		0x1d1b1245: "inputAppEvent",
+		0x1d2652ee: "account.finishTakeoutSession",
+		0x1d73e7ea: "messages.messages",
+		0x1d741ef7: "inputStorePaymentStarsGift",
+		0x1d998733: "contactBirthday",
+		0x1da448e2: "inputUserFromMessage",
+		0x1dab80b7: "payments.starsRevenueWithdrawalUrl",
+		0x1dbfeca0: "phone.deleteGroupCallParticipantMessages",
+		0x1dd840f5: "messages.getEmojiStickerGroups",
+		0x1e109708: "account.paidMessagesRevenue",
+		0x1e148390: "pageBlockKicker",
+		0x1e1c7c45: "encryptedChatDiscarded",
+		0x1e22c78d: "inputReportReasonViolence",
+		0x1e251c95: "help.hidePromoData",
+		0x1e287d04: "inputMediaUploadedPhoto",
+		0x1e297bfa: "updateMessageReactions",
+		0x1e36fded: "inputPhoneCall",
+		0x1e76a78c: "topPeerCategoryPhoneCalls",
+		0x1e8caaeb: "postAddress",
+		0x1e91fc99: "messages.getSavedDialogs",
+		0x1ea2fda7: "updateBusinessBotCallbackQuery",
+		0x1eb3758:  "help.userInfo",
+		0x1ecafa10: "inputInvoiceStarGiftAuctionBid",
+		0x1edaaac2: "account.setGlobalPrivacySettings",
+		0x1f01c757: "starGiftAttributeIdBackdrop",
+		0x1f040578: "auth.cancelCode",
+		0x1f0c1ad9: "paidReactionPrivacyAnonymous",
+		0x1f2b0afd: "updateNewMessage",
+		0x1f2bf4a:  "publicForwardMessage",
+		0x1f307eb7: "account.savedRingtoneConverted",
+		0x1f4a0e87: "payments.createStarGiftCollection",
+		0x1fad68cd: "channelAdminLogEvent",
+		0x1fb33026: "help.getNearestDc",
+		0x2000a518: "privacyKeyBirthday",
+		0x20212ca8: "photos.photo",
+		0x204bd158: "phone.exportedGroupCallInvite",
+		0x20529438: "updateUser",
+		0x2064674e: "updates.channelDifference",
+		0x206ad49e: "paidReactionPrivacyDefault",
+		0x206ae6d1: "inputStarsTransaction",
+		0x2085c238: "inputSavedStarGiftSlug",
+		0x208e68c9: "inputMessageEntityMentionName",
+		0x209b82db: "channelLocation",
+		0x21108ff7: "businessRecipients",
+		0x211a1788: "webPageEmpty",
+		0x21202222: "messages.getDialogUnreadMarks",
+		0x21461b5d: "privacyValueAllowBots",
+		0x219c34e6: "phone.toggleGroupCallStartSubscription",
+		0x21a548f3: "messages.getEmojiProfilePhotoGroups",
+		0x21a61057: "messages.appendTodoList",
+		0x21e1ad6:  "photoCachedSize",
+		0x21e753bc: "upload.webFile",
+		0x21ec5a5f: "securePlainEmail",
+		0x221bb5e4: "todoCompletion",
+		0x222600ef: "messages.exportedChatInviteReplaced",
+		0x22567115: "channels.checkSearchPostsFlood",
+		0x226ccefb: "auth.codeTypeFlashCall",
+		0x226e6308: "notifyForumTopic",
+		0x2271f2bf: "inputMediaAreaChannelPost",
+		0x227d824b: "payments.getSavedInfo",
+		0x22b6c214: "messages.getWebViewResult",
+		0x22ddd30c: "messages.getReplies",
+		0x23209745: "channelAdminLogEventActionStartGroupCall",
+		0x2331b22d: "photoEmpty",
+		0x236df622: "emojiKeywordDeleted",
+		0x23734b06: "encryptedMessageService",
+		0x2390fe44: "auth.sentCodeSuccess",
+		0x23e91ba3: "botPreviewMedia",
+		0x23f109b:  "forumTopicDeleted",
+		0x2433dc92: "payments.getStarsRevenueWithdrawalUrl",
+		0x243e1c66: "sendMessageUploadRoundAction",
+		0x2442485e: "account.setAccountTTL",
+		0x24596d41: "inputQuickReplyShortcut",
+		0x2478d1cc: "payments.getPaymentReceipt",
+		0x24b524c5: "channels.joinChannel",
+		0x24e6818d: "upload.getWebFile",
+		0x24f40e77: "updateMessagePollVote",
+		0x250dbaf8: "starsTransactionPeerPremiumBot",
+		0x257e962b: "premiumGiftCodeOption",
+		0x25972bcb: "sendMessageEmojiInteraction",
+		0x25a71742: "channels.getChannelRecommendations",
+		0x25ae8f4a: "payments.refundStarsCharge",
+		0x25b3eac7: "stories.getAlbums",
+		0x25e073fc: "pageListItemBlocks",
+		0x25f324f7: "updateChannelReadMessagesContents",
+		0x26219a58: "help.peerColorSet",
+		0x2633421b: "chatFull",
+		0x263d7c26: "pageBlockBlockquote",
+		0x2661bf09: "updatePhoneCallSignalingData",
+		0x269dc2c1: "messages.requestWebView",
+		0x269e3643: "messages.viewSponsoredMessage",
+		0x269e9a49: "messages.transcribeAudio",
+		0x26ae0971: "channelAdminLogEventActionToggleSignatures",
+		0x26b5dde6: "messages.messageEditData",
+		0x26cf8950: "messages.getDhConfig",
+		0x26ffde7d: "updateDialogFilter",
+		0x2714d86c: "account.checkUsername",
+		0x27477b4:  "secureRequiredTypeOneOf",
+		0x2757ba54: "payments.getPremiumGiftCodeOptions",
+		0x277add7e: "phone.saveCallDebug",
+		0x278f2868: "channelAdminLogEventActionSendMessage",
+		0x27bcbbfc: "inputPeerChannel",
+		0x28373599: "updateUserEmojiStatus",
+		0x283bd312: "updateBotPurchasedPaidMedia",
+		0x284b3639: "stickers.checkShortName",
+		0x28703c8:  "inputStickerSetAnimatedEmoji",
+		0x28a20571: "messageEntityCode",
+		0x28e16cc8: "stories.getStoriesViews",
+		0x28ecf961: "help.termsOfServiceUpdate",
+		0x29562865: "chatEmpty",
+		0x2979eeb2: "langPackStringDeleted",
+		0x29a8962c: "contacts.blockFromReplies",
+		0x29b1c66a: "messages.searchStickers",
+		0x29be5899: "inputTakeoutFileLocation",
+		0x29d0f5ee: "inputStickerSetEmojiDefaultStatuses",
+		0x29ee847a: "messages.search",
+		0x2a17bf5c: "updateUserTyping",
+		0x2a2a697c: "payments.saveStarGift",
+		0x2a3dc7ac: "groupCallParticipant",
+		0x2a862092: "messages.uploadImportedMedia",
+		0x2ad93719: "messages.dialogFilters",
+		0x2aee9191: "smsjobs.status",
+		0x2b96cd1b: "account.emailVerified",
+		0x2ba1f5ce: "help.peerColorsNotModified",
+		0x2be0dfa4: "jsonNumber",
+		0x2bf40ccc: "account.updateTheme",
+		0x2c084dc1: "updateStoriesStealthMode",
+		0x2c11c0d7: "messages.searchCustomEmoji",
+		0x2c221edd: "messages.dhConfig",
+		0x2c4ada50: "stories.getPeerStories",
+		0x2c800be5: "contacts.importContacts",
+		0x2c8f2a25: "messageActionSuggestBirthday",
+		0x2ca4fdf8: "privacyKeyStarGiftsAutoSave",
+		0x2ca51fd1: "help.getTermsOfServiceUpdate",
+		0x2cb51097: "messages.favedStickers",
+		0x2cc6383:  "channelAdminLogEventActionToggleForum",
+		0x2d0135b3: "bots.deletePreviewMedia",
+		0x2d01b9ef: "account.resetWebAuthorization",
+		0x2d03522f: "payments.sendPaymentForm",
+		0x2db873a9: "auth.importWebTokenAuthorization",
+		0x2dbf3432: "phone.groupCallStreamRtmpUrl",
+		0x2dc173c8: "inputEncryptedFileBigUploaded",
+		0x2dca16b8: "payments.getStarsTransactionsByID",
+		0x2dd14edc: "stickerSet",
+		0x2de11aae: "emojiStatusEmpty",
+		0x2df5fc0a: "channelAdminLogEventActionDefaultBannedRights",
+		0x2e16c98:  "inputStarGiftAuction",
+		0x2e2e8734: "contacts.block",
+		0x2e59d922: "inputReportReasonPornography",
+		0x2e6eab1a: "starsSubscription",
+		0x2e79d779: "payments.getBankCardData",
+		0x2e7b4543: "account.getCollectibleEmojiStatuses",
+		0x2e94c3e7: "webPageAttributeStory",
+		0x2ea2c0d4: "auth.authorization",
+		0x2eb1b658: "starGiftAttributeCounter",
+		0x2ec0533f: "messageMediaVenue",
+		0x2ecd56cd: "messages.getEmojiStatusGroups",
+		0x2ed82995: "payments.starGifts",
+		0x2eeed1c4: "starGiftAuctionUserState",
+		0x2efe1722: "phone.confirmCall",
+		0x2f2ba99f: "updateChannelWebPage",
+		0x2f2f21bf: "updateReadHistoryOutbox",
+		0x2f453e49: "inputPrivacyValueAllowCloseFriends",
+		0x2f6cb2ab: "botCommandScopeDefault",
+		0x2f98c3d5: "messages.createForumTopic",
+		0x2fe601d3: "channelParticipantCreator",
+		0x2ffe2f7a: "messageActionConferenceCall",
+		0x30535af5: "phoneCall",
+		0x3081ed9d: "inlineQueryPeerTypeSameBotPM",
+		0x30a6ec7e: "messages.stickers",
+		0x30eb63f0: "stories.canSendStory",
+		0x30f443db: "updateRecentEmojiStatuses",
+		0x310240cc: "auctionBidLevel",
+		0x31224c3:  "messageActionChatJoinedByLink",
+		0x313a9547: "starGift",
+		0x313bc7f8: "updateShortMessage",
+		0x31518e9b: "messageActionRequestedPeer",
+		0x315a4974: "users.usersSlice",
+		0x316ce548: "account.setReactionsNotifySettings",
+		0x3173d78:  "updates.getChannelDifference",
+		0x31774388: "user",
+		0x31bb5d52: "channelAdminLogEventActionChangeWallpaper",
+		0x31bd492d: "messages.messageReactionsList",
+		0x31c1c44f: "messages.getMessageReadParticipants",
+		0x31c24808: "updateStickerSets",
+		0x31c48347: "messageActionGiftCode",
+		0x31cad303: "webPageAttributeStarGiftCollection",
+		0x31f9590:  "pageBlockSlideshow",
+		0x32512c5:  "payments.getStarsSubscriptions",
+		0x3259950a: "messages.savedReactionTags",
+		0x327a30cb: "messages.saveGif",
+		0x32c3e77:  "inputGameID",
+		0x32ca960f: "messageEntitySpoiler",
+		0x32d439a4: "messages.sendEncryptedService",
+		0x32da4cf:  "account.verifyEmail",
+		0x32da9e9c: "inputStickerSetItem",
+		0x32fabf1a: "urlAuthResultRequest",
+		0x330e77f:  "messages.sendMedia",
+		0x3334b0f0: "inputSecureFileUploaded",
+		0x3354678f: "updatePtsChanged",
+		0x3371c354: "messages.peerDialogs",
+		0x3380c786: "inputBotInlineMessageMediaAuto",
+		0x33963bf9: "messages.forwardMessage",
+		0x339bef6c: "requestPeerTypeBroadcast",
+		0x33db32f8: "messages.translateResult",
+		0x33ddf480: "channels.getAdminLog",
+		0x33f0ea47: "secureCredentialsEncrypted",
+		0x3407e51b: "stickerSetMultiCovered",
+		0x34090c3b: "messages.initHistoryImport",
+		0x3417d728: "inputPaymentCredentials",
+		0x34566b6a: "pageTableCell",
+		0x3458f9c8: "chatThemeUniqueGift",
+		0x34636dd8: "secureValueErrorTranslationFiles",
+		0x34a2f297: "users.savedMusic",
+		0x34b8621:  "textMarked",
+		0x34c3bb53: "channelParticipantAdmin",
+		0x34e793f1: "inputInvoiceChatInviteSubscription",
+		0x34fdc5c3: "messages.getBotApp",
+		0x3504914f: "updateDialogFilters",
+		0x3514b3de: "channels.updateUsername",
+		0x352dafa:  "inputPrivacyKeyPhoneNumber",
+		0x354a9b09: "botInlineMessageMediaInvoice",
+		0x35553762: "textAnchor",
+		0x35705b8a: "messages.searchStickerSets",
+		0x3583fcb1: "channels.setMainProfileTab",
+		0x35a0e062: "messages.getEmojiKeywords",
+		0x35a95cb9: "inputPeerChat",
+		0x35a9e0d5: "account.getChannelRestrictedStatusEmojis",
+		0x35ddd674: "messages.editChatPhoto",
+		0x35e410a8: "messages.stickerSetInstallResultArchive",
+		0x363293ae: "dialogFilterDefault",
+		0x3637e05b: "messages.getSavedReactionTags",
+		0x36437737: "starGiftAttributeRarity",
+		0x36585ea4: "messages.botCallbackAnswer",
+		0x3660c311: "phoneCallAccepted",
+		0x367544db: "channels.deleteParticipantHistory",
+		0x367617d3: "messages.forumTopics",
+		0x36a73f77: "messages.readMessageContents",
+		0x36c6019a: "peerChat",
+		0x36e5bf4d: "messages.readMentions",
+		0x36f8c871: "documentEmpty",
+		0x37096c70: "auth.recoverPassword",
+		0x37148dbb: "payments.getPaymentForm",
+		0x37257e99: "inputPeerPhotoFileLocation",
+		0x372efcd0: "wallPaperSettings",
+		0x37381085: "mediaAreaUrl",
+		0x374fa7ad: "payments.checkCanSendGiftResultOk",
+		0x374fef40: "stats.getStoryStats",
+		0x3751b49e: "inputMessagesFilterMusic",
+		0x376d975c: "sendMessageTextDraftAction",
+		0x37c1011c: "chatPhotoEmpty",
+		0x37c9330:  "inputMediaUploadedDocument",
+		0x3823cc40: "inputPrivacyKeyAbout",
+		0x38641628: "messages.stickerSetInstallResultSuccess",
+		0x388a3b5:  "photos.uploadProfilePhoto",
+		0x38a08d3:  "help.getUserInfo",
+		0x38df3532: "account.updateDeviceLocked",
+		0x38fe25b7: "updateEncryptedMessagesRead",
+		0x390d5c5e: "auth.loginTokenSuccess",
+		0x3920e6ef: "messages.getAdminsWithInvites",
+		0x392718f8: "messages.saveRecentSticker",
+		0x39461db2: "messages.getRecentReactions",
+		0x39491cc8: "privacyKeyPhoneP2P",
+		0x394e7f21: "payments.starsRevenueAdsAccountUrl",
+		0x395f69da: "upload.getCdnFile",
+		0x396ca5fc: "stats.broadcastStats",
+		0x39854d1f: "premium.getUserBoosts",
+		0x39a51dfb: "updateNewScheduledMessage",
+		0x39c67432: "updateSavedReactionTags",
+		0x39f23300: "pageBlockCover",
+		0x3a20ecb8: "inputMessagesFilterChatPhotos",
+		0x3a5869ec: "account.getTheme",
+		0x3a912d4a: "passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow",
+		0x3aae0528: "starGiftAuctionRound",
+		0x3b1adf37: "messages.reorderPinnedDialogs",
+		0x3b6d152e: "users.userFull",
+		0x3b6ddad2: "pollAnswerVoters",
+		0x3ba47bff: "messages.getForumTopics",
+		0x3bb3b94a: "inputPhoto",
+		0x3bb842ac: "outboxReadDate",
+		0x3bd2b4a0: "phone.acceptCall",
+		0x3c134d7b: "messageActionSetMessagesTTL",
+		0x3c20629f: "inlineBotSwitchPM",
+		0x3c27b78f: "inputPasskeyCredentialPublicKey",
+		0x3c2884c1: "textUrl",
+		0x3c4301c0: "attachMenuBots",
+		0x3c479971: "phone.declineConferenceCallInvite",
+		0x3c4f04d8: "botCommandScopeUsers",
+		0x3c5693e9: "inputTheme",
+		0x3cbc93f8: "chatParticipants",
+		0x3cc04740: "messages.deleteQuickReplyShortcut",
+		0x3cd930b7: "channels.setEmojiStickers",
+		0x3d662b7b: "privacyKeyPhoneCall",
+		0x3d6ce850: "messages.getSponsoredMessages",
+		0x3d8de0f9: "bots.resetBotCommands",
+		0x3dac6a00: "secureValueTypePassport",
+		0x3dbb5986: "auth.sentCodeTypeApp",
+		0x3dbc0415: "messages.acceptEncryption",
+		0x3dc0f114: "help.getRecentMeUrls",
+		0x3dcd7a87: "inputBotInlineMessageText",
+		0x3dda5451: "updateChatParticipantAdd",
+		0x3de1dfed: "payments.starGiftUpgradePreview",
+		0x3dea5b03: "account.saveRingtone",
+		0x3ded6320: "messageMediaEmpty",
+		0x3e050d0f: "updateQuickReplyMessage",
+		0x3e0b5b6a: "searchPostsFlood",
+		0x3e0bdd7c: "account.updateUsername",
+		0x3e11affb: "updates.channelDifferenceEmpty",
+		0x3e24e573: "payments.bankCardData",
+		0x3e3bcf2f: "channels.sponsoredMessageReportResultAdsHidden",
+		0x3e63935c: "inputPasskeyResponseRegister",
+		0x3e72ba19: "auth.logOut",
+		0x3e77f614: "inputInvoicePremiumAuthCode",
+		0x3e7f6847: "channelAdminLogEventActionParticipantVolume",
+		0x3e85e92c: "updateDeleteGroupCallMessages",
+		0x3ea9feb1: "channelAdminLogEventActionChangeEmojiStatus",
+		0x3eadb1bb: "messages.checkChatInvite",
+		0x3f4e0648: "messages.messageEmpty",
+		0x3f64c076: "messages.reportReaction",
+		0x3f6d7b68: "jsonNull",
+		0x3fa53905: "keyboardButtonBuy",
+		0x3fc9053b: "exportedStoryLink",
+		0x3fd863d1: "botCommandScopePeerAdmins",
+		0x3fedc75f: "help.getDeepLinkInfo",
+		0x3ff75734: "channels.toggleForum",
+		0x40181ffe: "inputPhotoFileLocation",
+		0x40582bb2: "channels.setDiscussionGroup",
+		0x405fef0d: "inputMediaInvoice",
+		0x40bc6f52: "storage.filePartial",
+		0x40d13c0e: "stickerSetFullCovered",
+		0x40f48462: "account.changeAuthorizationSettings",
+		0x410a134e: "channelAdminLogEventActionExportedInviteRevoke",
+		0x41248786: "phone.saveCallLog",
+		0x413a3e73: "messages.requestSimpleWebView",
+		0x4167add1: "phone.saveDefaultSendAs",
+		0x416c56e8: "payments.uniqueStarGift",
+		0x417bbf11: "inputBotInlineMessageMediaVenue",
+		0x417efd8f: "keyboardButtonRequestPhone",
+		0x41845db:  "phone.getGroupCall",
+		0x418d549c: "channels.toggleSignatures",
+		0x41b3e202: "messageActionPaymentRefunded",
+		0x41bf109b: "exportedContactToken",
+		0x41c10fff: "chatlists.checkChatlistInvite",
+		0x41c87565: "privacyValueDisallowChatParticipants",
+		0x41cbf256: "chat",
+		0x41df43fc: "savedStarGift",
+		0x4203c5ef: "help.countryCode",
+		0x423ab3ad: "bots.getPreviewInfo",
+		0x4258c205: "botMenuButtonCommands",
+		0x429547e8: "account.initPasskeyRegistration",
+		0x42b00348: "starGiftAuctionAcquiredGift",
+		0x42c6978f: "langpack.getLanguages",
+		0x42e047bb: "channelAdminLogEventActionDeleteMessage",
+		0x42f1f61:  "premium.getBoostsStatus",
+		0x42ff96ed: "phone.requestCall",
+		0x42ffd42b: "privacyKeyAddedByPhone",
+		0x430d3150: "sponsoredMessageReportOption",
+		0x4345be73: "emailVerifyPurposeLoginSetup",
+		0x434bd2af: "channelAdminLogEventActionChangePhoto",
+		0x435885b5: "messages.togglePaidReactionPrivacy",
+		0x4365af6b: "payments.getUniqueStarGiftValueInfo",
+		0x4367daa0: "payments.giveawayInfo",
+		0x438865b:  "inputStickeredMediaDocument",
+		0x43b46b20: "defaultHistoryTTL",
+		0x43c57c48: "documentAttributeVideo",
+		0x43fe19f3: "messages.checkHistoryImport",
+		0x4423e6c5: "messages.getHistory",
+		0x446972fd: "messages.getDiscussionMessage",
+		0x44747e9a: "auth.authorizationSignUpRequired",
+		0x449e0b51: "account.getTmpPassword",
+		0x44ba9dd9: "messages.savedDialogsSlice",
+		0x44c1f8e9: "inputStickerSetEmojiDefaultTopicIcons",
+		0x44e56023: "messages.emojiGameDiceInfo",
+		0x44fa7a15: "messages.sendEncrypted",
+		0x4504d54f: "bots.setBotMenuButton",
+		0x455b853d: "messageViews",
+		0x4576f3f0: "attachMenuBotIconColor",
+		0x45d5b021: "messageActionGiftStars",
+		0x461b3f48: "messages.getMessageReactionsList",
+		0x4628f6e6: "messagePeerVoteMultiple",
+		0x46560264: "updateLangPackTooLong",
+		0x467a0766: "pageBlockParagraph",
+		0x4696459a: "stickers.replaceSticker",
+		0x46c6e36f: "payments.starGiftUpgradeAttributes",
+		0x46d840ab: "channelAdminLogEventActionChangeEmojiStickerSet",
+		0x46e1d13d: "recentMeUrlUnknown",
+		0x472455aa: "messages.getPaidReactionPrivacy",
+		0x4792929b: "messageActionScreenshotTaken",
+		0x47a971e0: "statsURL",
+		0x47dd8079: "messageActionWebViewDataSentMe",
+		0x481eadfa: "emojiListNotModified",
+		0x48222faf: "inputGeoPoint",
+		0x48870999: "pageBlockFooter",
+		0x4899484e: "messages.votesList",
+		0x48a30254: "replyInlineMarkup",
+		0x48aaae3c: "starGiftAttributeIdModel",
+		0x48cdc6d8: "phone.createGroupCall",
+		0x48e246c2: "updateStarGiftAuctionState",
+		0x48e91302: "messageActionGiftPremium",
+		0x48f1d94c: "encryptedChatRequested",
+		0x4959427a: "premium.boostsStatus",
+		0x496f379c: "updateBotInlineQuery",
+		0x49748553: "inputStickerSetEmojiChannelDefaultStatuses",
+		0x49a6549c: "mediaAreaWeather",
+		0x49b30240: "help.getTimezonesList",
+		0x49b92a26: "todoList",
+		0x49e9528f: "messages.getChats",
+		0x49ee584:  "invoice",
+		0x4a162433: "starGiftAttributeIdPattern",
+		0x4a27eb2d: "statsGraphAsync",
+		0x4a4ff172: "readParticipantDate",
+		0x4a5f5bd9: "inputInvoiceStarGiftTransfer",
+		0x4a8537:   "securePasswordKdfAlgoUnknown",
+		0x4a95e84e: "inputNotifyChats",
+		0x4a992157: "inputStickeredMediaPhoto",
+		0x4afe8f6d: "updates.differenceTooLong",
+		0x4b00e066: "account.updateBusinessWorkHours",
+		0x4b09ebbc: "storage.fileMov",
+		0x4b0c8c0f: "messages.reportEncryptedSpam",
+		0x4b12327b: "channels.updatePaidMessagesPrice",
+		0x4b3e14d6: "boost",
+		0x4b425864: "inputBotInlineMessageGame",
+		0x4b9e22a0: "reactionNotificationsFromAll",
+		0x4ba3a95a: "messageReactor",
+		0x4bc6589a: "messages.searchGlobal",
+		0x4bd6e798: "messageMediaPoll",
+		0x4bff8ea0: "account.authorizations",
+		0x4c2985b6: "channels.toggleJoinRequest",
+		0x4c3e069d: "account.autoSaveSettings",
+		0x4c4d4ce:  "inputStickerSetEmojiGenericAnimations",
+		0x4c4e743f: "messageEntityCashtag",
+		0x4c9409f6: "account.declinePasswordReset",
+		0x4d22ff98: "webViewResultUrl",
+		0x4d392343: "help.getInviteText",
+		0x4d4bd46a: "profileTabGifts",
+		0x4d6deea5: "updateShortChatMessage",
+		0x4d712f2e: "updateBotCommands",
+		0x4d818d5d: "inputInvoiceStarGiftUpgrade",
+		0x4d8a0299: "botInfo",
+		0x4dafc503: "stickers.suggestShortName",
+		0x4dba4501: "account.takeout",
+		0x4dbe9226: "inputPrivacyKeySavedMusic",
+		0x4dc5085f: "messages.deleteSavedHistory",
+		0x4dd3a7f6: "account.verifyPhone",
+		0x4e4df4bb: "messageFwdHeader",
+		0x4e5f810d: "payments.paymentResult",
+		0x4e7085ea: "starGiftAttributePattern",
+		0x4e80a379: "updateStarsBalance",
+		0x4e90bfd6: "updateMessageID",
+		0x4e9963b2: "messages.getEmojiKeywordsLanguages",
+		0x4ea4c80f: "account.getConnectedBots",
+		0x4ea9b3bf: "payments.getStarsStatus",
+		0x4f11bae1: "userProfilePhotoEmpty",
+		0x4f1aaa9:  "messages.getFavedStickers",
+		0x4f1ebf24: "smsjobs.finishJob",
+		0x4f4456d3: "pageBlockPullquote",
+		0x4f47a016: "messages.setDefaultReaction",
+		0x4f607bef: "channelParticipantSelf",
+		0x4f96cb18: "inputPrivacyKeyStatusTimestamp",
+		0x4fa417f2: "inputBotInlineResultGame",
+		0x4facb138: "messages.hidePeerSettingsBar",
+		0x4fc81d6e: "account.savedMusicIdsNotModified",
+		0x4fcba9c8: "messages.archivedStickers",
+		0x4fdc5ea7: "payments.canPurchaseStore",
+		0x4fdd3430: "keyboardButtonStyle",
+		0x4fddbee7: "payments.updateStarGiftCollection",
+		0x4fe196fe: "contacts.importCard",
+		0x500377f9: "phone.leaveGroupCall",
+		0x50077589: "bots.checkDownloadFileParams",
+		0x500e6dfa: "privacyKeyChatInvite",
+		0x502f92f7: "messageActionInviteToGroupCall",
+		0x504aa18f: "updateSentPhoneCode",
+		0x5057c497: "messages.uploadEncryptedFile",
+		0x5060a3f4: "messageActionSetChatWallPaper",
+		0x509113f:  "attachMenuPeerTypeChat",
+		0x50a04e45: "account.privacyRules",
+		0x50a9839:  "requirementToContactEmpty",
+		0x50c7ac8:  "channelAdminLogEventActionChangeLinkedChat",
+		0x50ca4de1: "phoneCallDiscarded",
+		0x50cc03d3: "webPageAttributeStickerSet",
+		0x50cd067c: "stats.storyStats",
+		0x50f24105: "channels.toggleUsername",
+		0x50f5c392: "inputMessagesFilterVoice",
+		0x512fe446: "payments.uniqueStarGiftValueInfo",
+		0x514519e2: "dialogPeerFolder",
+		0x514e999d: "messages.getInlineBotResults",
+		0x517165a:  "bots.setBotCommands",
+		0x51846fd:  "botInlineMessageMediaGeo",
+		0x518ad0b7: "auth.initPasskeyLogin",
+		0x51e6ee4f: "storyItemDeleted",
+		0x51e842e1: "messages.editMessage",
+		0x52029342: "help.getCdnConfig",
+		0x522d5a7d: "help.getAppUpdate",
+		0x523da4eb: "reactionPaid",
+		0x527d22eb: "emailVerifyPurposeLoginChange",
+		0x528a0677: "storage.fileMp3",
+		0x52928bca: "chatReactionsAll",
+		0x52d8ccd9: "messageMediaDocument",
+		0x5334759c: "help.premiumPromo",
+		0x5353e5a7: "auth.sentCodeTypeCall",
+		0x53577479: "account.getNotifyExceptions",
+		0x535f779d: "statsGroupTopInviter",
+		0x53618bce: "messages.requestAppWebView",
+		0x5366c915: "phoneCallEmpty",
+		0x5367e5be: "inputSecureFile",
+		0x53909779: "channelAdminLogEventActionToggleSlowMode",
+		0x53b22baf: "messages.searchResultsPositions",
+		0x53bc0020: "account.deleteAutoSaveExceptions",
+		0x53ca973:  "bots.toggleUsername",
+		0x53e6f1ec: "updateDeleteQuickReply",
+		0x5416d58:  "starsSubscriptionPricing",
+		0x541a1d1a: "userStatusLastWeek",
+		0x54236209: "starsGiveawayWinnersOption",
+		0x545cd15a: "messages.sendMessage",
+		0x548a30f5: "account.getPassword",
+		0x5492a13:  "updateUserPhone",
+		0x5492e5ee: "account.resolveBusinessChatLink",
+		0x54ae308e: "messages.saveDraft",
+		0x54b56617: "webPageAttributeTheme",
+		0x54c01850: "updateChatDefaultBannedRights",
+		0x55188a2e: "channelAdminLogEventActionChangeAbout",
+		0x55451fa9: "phone.getCallConfig",
+		0x55555550: "messageActionUserJoined",
+		0x55555551: "messageActionUserUpdatedPhoto",
+		0x55555552: "messageActionTTLChange",
+		0x55555557: "messageActionCreatedBroadcastList",
+		0x555555f5: "messageActionLoginUnknownLocation",
+		0x5559481d: "messages.sendEncryptedFile",
+		0x55a5bb66: "messages.receivedQueue",
+		0x55b41fd6: "account.registerPasskey",
+		0x56022f4d: "updateLangPack",
+		0x560f8935: "messages.sentEncryptedMessage",
+		0x564edaeb: "stories.albumsNotModified",
+		0x564fe691: "updateLoginToken",
+		0x565251e2: "starGiftAttributeModel",
+		0x566decd0: "channels.editTitle",
+		0x566fe7cd: "updateDeleteQuickReplyMessages",
+		0x56730bcc: "null",
+		0x5680e342: "phone.startScheduledGroupCall",
+		0x56987bd5: "messages.deleteRevokedExportedChatInvites",
+		0x56d6a247: "channelAdminLogEventActionToggleGroupCallSetting",
+		0x56da0b3f: "account.getAutoDownloadSettings",
+		0x56e0d474: "messageMediaGeo",
+		0x56e34970: "reactionsNotifySettings",
+		0x56e59f9c: "auth.checkPaidAuth",
+		0x56e9f0e4: "inputMessagesFilterPhotoVideo",
+		0x570d6f6f: "messages.getWebPagePreview",
+		0x5719bacc: "inputPrivacyKeyProfilePhoto",
+		0x571d2742: "updateReadFeaturedStickers",
+		0x5725e40a: "cdnConfig",
+		0x575e1f8c: "phone.saveDefaultGroupCallJoinAs",
+		0x5774ca74: "stories.getStoriesByID",
+		0x5784d3e1: "messages.getMessagesViews",
+		0x5787686d: "mediaAreaStarGift",
+		0x5796e780: "channelAdminLogEventActionChangePeerColor",
+		0x57adc690: "phoneCallDiscardReasonHangup",
+		0x57bbd166: "stories.activateStealthMode",
+		0x57de635e: "messageActionSuggestProfilePhoto",
+		0x57e28221: "account.contentSettings",
+		0x57e2f66c: "inputMessagesFilterEmpty",
+		0x57f17692: "messages.getArchivedStickers",
+		0x5821a5dc: "stories.getPinnedStories",
+		0x5869a553: "payments.getConnectedStarRefBots",
+		0x58707d28: "channelAdminLogEventActionCreateTopic",
+		0x58747131: "poll",
+		0x5881323a: "inputReplyToStory",
+		0x58943ee2: "messages.setTyping",
+		0x589ee75:  "messages.editFactCheck",
+		0x58bbcb50: "messages.sendPaidReaction",
+		0x58d6b376: "account.toggleUsername",
+		0x58dbcab8: "inputReportReasonSpam",
+		0x58e63f6d: "channels.editLocation",
+		0x59511722: "peerUser",
+		0x598a92a:  "inputGroupCallStream",
+		0x59ae2b16: "messages.deleteScheduledMessages",
+		0x59d78fc5: "stories.storyViewsList",
+		0x59e65335: "messages.emojiGameUnavailable",
+		0x59ead627: "phone.setCallRating",
+		0x5a0a066d: "businessIntro",
+		0x5a17b5e5: "inputEncryptedFile",
+		0x5a4fcce5: "inputPrivacyValueAllowBots",
+		0x5a50fca4: "channelAdminLogEventActionExportedInviteDelete",
+		0x5a686d7c: "chatInviteAlready",
+		0x5a6d7395: "messages.reportMessagesDelivery",
+		0x5a954c0:  "messages.receivedMessages",
+		0x5af4c73a: "phone.getGroupCallStreamRtmpUrl",
+		0x5b0f15f5: "keyboardButtonRequestPeer",
+		0x5b11125a: "baseThemeArctic",
+		0x5b118126: "messages.readFeaturedStickers",
+		0x5b1ccb28: "inputPasskeyCredentialFirebasePNV",
+		0x5b934f9d: "inputChannelFromMessage",
+		0x5bb98608: "updatePinnedChannelMessages",
+		0x5bd0ee50: "messages.deleteChat",
+		0x5c003cef: "messages.editQuickReplyShortcut",
+		0x5c467992: "inputNotifyForumTopic",
+		0x5c9d3702: "chatInvite",
+		0x5c9ff4d6: "payments.getStarGiftAuctionState",
+		0x5cc761bd: "emojiKeywordsDifference",
+		0x5ce14175: "popularContact",
+		0x5cf09635: "messages.getSavedGifs",
+		0x5d75a138: "updates.differenceEmpty",
+		0x5d8d353b: "channelAdminLogEventActionPinTopic",
+		0x5da674b7: "botAppNotModified",
+		0x5dab1af4: "exportedMessageLink",
+		0x5dc60f03: "messages.checkHistoryImportPeer",
+		0x5dd69e12: "contacts.getContacts",
+		0x5dee78b0: "account.setMainProfileTab",
+		0x5e002502: "auth.sentCode",
+		0x5e0589f1: "starsGiftOption",
+		0x5e068047: "pageListOrderedItemText",
+		0x5e0fb7b9: "messages.historyImportParsed",
+		0x5e437ed9: "account.disablePeerConnectedBot",
+		0x5e477b25: "channelAdminLogEventActionChangeProfilePeerColor",
+		0x5e5259b6: "stories.updateAlbum",
+		0x5ec4be43: "inlineQueryPeerTypeMegagroup",
+		0x5f150144: "stats.getMessagePublicForwards",
+		0x5f206716: "messages.messagesSlice",
+		0x5f2178c3: "account.confirmPhone",
+		0x5f2d1df2: "premiumSubscriptionOption",
+		0x5f3b8a00: "requestPeerTypeUser",
+		0x5f5c95f1: "channelAdminLogEventActionTogglePreHistoryHidden",
+		0x5f91eb5b: "messages.quickRepliesNotModified",
+		0x5fb224d5: "chatAdminRights",
+		0x5ff58f20: "payments.launchPrepaidGiveaway",
+		0x60073674: "account.deleteBusinessChatLink",
+		0x6010c534: "messages.foundStickersNotModified",
+		0x60297dec: "messages.updateSavedReactionTag",
+		0x60331907: "messages.reorderQuickReplies",
+		0x60682812: "starsTransactionPeerAds",
+		0x6090d6d5: "storyReaction",
+		0x60a79c79: "channelAdminLogEventActionToggleSignatureProfiles",
+		0x60eaefa1: "payments.toggleChatStarGiftNotifications",
+		0x60f67660: "premium.getBoostsList",
+		0x61695cb0: "chatInvitePeek",
+		0x616f7fe8: "inputStorePaymentGiftPremium",
+		0x61e3f854: "help.getAppConfig",
+		0x61f0d4c7: "encryptedChat",
+		0x621d5fa0: "stats.loadAsyncGraph",
+		0x623a8fa0: "urlAuthResultAccepted",
+		0x628c9224: "missingInvitee",
+		0x628cbc6f: "sendMessageChooseContactAction",
+		0x629f1980: "auth.loginToken",
+		0x62ba04d9: "updateNewChannelMessage",
+		0x62d706b8: "users.users",
+		0x62dc8b48: "inputFileStoryDocument",
+		0x62dd747:  "messages.unpinAllMessages",
+		0x63183030: "messages.translateText",
+		0x6319d612: "documentAttributeSticker",
+		0x6334ee9a: "inlineQueryPeerTypeBroadcast",
+		0x635b4c09: "updateChannel",
+		0x635fe375: "phoneConnectionWebrtc",
+		0x637b7ed:  "topPeerCategoryCorrespondents",
+		0x63c3dd0a: "stories.stories",
+		0x63c66506: "messages.getMessages",
+		0x63cacf26: "account.autoDownloadSettings",
+		0x640f82b8: "messages.getMaskStickers",
+		0x6410a5d2: "stickerSetCovered",
+		0x64199744: "secureFileEmpty",
+		0x64407ea7: "monoForumDialog",
+		0x64600527: "inputDialogPeerFolder",
+		0x64642db3: "channelAdminLogEventActionParticipantSubExtend",
+		0x646e1097: "account.toggleConnectedBotPaused",
+		0x64780b14: "messages.getFeaturedStickers",
+		0x64bd0306: "inputEncryptedFileUploaded",
+		0x64e475c2: "messageEntityEmail",
+		0x64f36dfc: "channelAdminLogEventActionToggleAntiSpam",
+		0x64ff9fd5: "messages.chats",
+		0x652e4400: "account.createTheme",
+		0x653db63d: "chatlists.editExportedInvite",
+		0x65427b82: "privacyValueAllowAll",
+		0x656ac4b:  "channelParticipantsSearch",
+		0x65899777: "userStatusLastMonth",
+		0x658b7188: "messages.getDefaultHistoryTTL",
+		0x6592a1a7: "chatForbidden",
+		0x65a0fa4d: "pageBlockCollage",
+		0x65ad71dc: "account.getMultiWallPapers",
+		0x65f00ce3: "inputInvoiceStars",
+		0x661d4037: "chatReactionsSome",
+		0x6628562c: "account.updateStatus",
+		0x666220e9: "secureValueErrorFiles",
+		0x66a08c7e: "account.updateConnectedBot",
+		0x66afa166: "help.deepLinkInfoEmpty",
+		0x66b25953: "encryptedChatWaiting",
+		0x66b91b70: "help.editUserInfo",
+		0x66cdafc4: "account.updateBusinessGreetingMessage",
+		0x66e486fb: "chatlists.hideChatlistUpdates",
+		0x6724abc4: "textBold",
+		0x67753ac8: "groupCallParticipantVideo",
+		0x67a3ff2c: "auth.importBotAuthorization",
+		0x68013e72: "inputKeyboardButtonUrlAuth",
+		0x682d2594: "account.resetWebAuthorizations",
+		0x683b2c52: "updatePinnedForumTopic",
+		0x6847d0ab: "folders.editPeerFolders",
+		0x684d214e: "account.updateColor",
+		0x686c85a6: "updatePinnedSavedDialogs",
+		0x6880b94d: "messages.peerSettings",
+		0x688a30aa: "updateNewStickerSet",
+		0x68cb6283: "messageMediaStory",
+		0x68e9916:  "auth.loginTokenMigrateTo",
+		0x68f3e4eb: "channels.toggleAntiSpam",
+		0x6917560b: "messageReplyHeader",
+		0x691e9052: "updateInlineBotCallbackQuery",
+		0x69279795: "inputSavedStarGiftUser",
+		0x695150d7: "messageMediaPhoto",
+		0x695c9e7c: "updateReadChannelDiscussionOutbox",
+		0x697102b:  "quickReply",
+		0x697f414:  "privacyKeyVoiceMessages",
+		0x69d66c45: "inputReplyToMonoForum",
+		0x69da4557: "payments.getStarsTransactions",
+		0x69ec56a3: "privacyKeyForwards",
+		0x69f59d69: "messages.toggleBotInAttachMenu",
+		0x69f916f8: "messageActionSuggestedPostRefund",
+		0x6a0d3206: "account.unregisterDevice",
+		0x6a1dc4be: "inputPhoneContact",
+		0x6a3f8d65: "messages.getAllDrafts",
+		0x6a4afc38: "channelAdminLogEventActionChangeUsername",
+		0x6a4ee832: "help.deepLinkInfo",
+		0x6a596502: "langpack.getLanguage",
+		0x6a6e7854: "channels.toggleParticipantsHidden",
+		0x6a7e7366: "updatePeerSettings",
+		0x6aa3f6bd: "messages.getSearchResultsCalendar",
+		0x6b134e8e: "privacyValueAllowChatParticipants",
+		0x6b39f4ec: "payments.starGiftAuctionState",
+		0x6b7da746: "premium.applyBoost",
+		0x6ba2cbec: "payments.getStarGiftAuctionAcquiredGifts",
+		0x6c207376: "payments.starsRevenueStats",
+		0x6c37c15c: "documentAttributeImageSize",
+		0x6c3f19b9: "textFixed",
+		0x6c47ac9f: "langPackStringPluralized",
+		0x6c50051c: "messages.importChatInvite",
+		0x6c5a5b37: "account.saveWallPaper",
+		0x6c750de1: "messages.sendQuickReplyMessages",
+		0x6c8e1e06: "birthday",
+		0x6c9ce8ed: "payments.starsStatus",
+		0x6cef8ac7: "messageEntityBotCommand",
+		0x6d038b58: "payments.getStarGiftUpgradeAttributes",
+		0x6d5f77ee: "baseThemeTinted",
+		0x6dd654c:  "account.getReactionsNotifySettings",
+		0x6de6392:  "bots.toggleUserEmojiStatusPermission",
+		0x6df8014e: "channelParticipantBanned",
+		0x6dfa0622: "payments.botCancelStarsSubscription",
+		0x6e153f16: "messages.stickerSet",
+		0x6e2be050: "messages.getOnlines",
+		0x6e425c4:  "secureValueTypeDriverLicense",
+		0x6e6fe51c: "updateDialogPinned",
+		0x6e941a38: "channelAdminLogEventActionChangeHistoryTTL",
+		0x6ebdff91: "fragment.collectibleInfo",
+		0x6ed02538: "messageEntityUrl",
+		0x6ed998c:  "auth.codeTypeFragmentSms",
+		0x6efc5e81: "stories.allStories",
+		0x6f02f748: "help.saveAppLog",
+		0x6f09ac31: "reportResultAddComment",
+		0x6f0c34df: "notificationSoundNone",
+		0x6f635b0d: "messageEntityHashtag",
+		0x6f636302: "phone.getGroupCallStars",
+		0x6f6f9c96: "messages.getSavedDialogsByID",
+		0x6f70dde1: "account.getBusinessChatLinks",
+		0x6f747657: "pageCaption",
+		0x6f7863f4: "updateRecentReactions",
+		0x6f8b32aa: "inputBusinessRecipients",
+		0x6fb4ad87: "messages.emojiGroupsNotModified",
+		0x6fe1a881: "botCommandScopeChats",
+		0x702a40e0: "messages.getRecentLocations",
+		0x70322949: "messageMediaContact",
+		0x7063c3db: "updatePendingJoinRequests",
+		0x7084a7be: "updateContactsReset",
+		0x709b2405: "channelAdminLogEventActionEditMessage",
+		0x70abc3fd: "pageBlockTitle",
+		0x70b772a8: "contacts.topPeers",
+		0x70c32edb: "account.changePhone",
+		0x70c4fe03: "payments.paymentReceipt",
+		0x711d692d: "recentStory",
+		0x712e27fd: "storiesStealthMode",
+		0x7141dbf:  "inputEmojiStatusCollectible",
+		0x7184603b: "emojiStatusCollectible",
+		0x719c5c5e: "chatlists.deleteExportedInvite",
+		0x71bd134c: "dialogFolder",
+		0x71e094f3: "messages.dialogsSlice",
+		0x71f276c4: "disallowedGiftsSettings",
+		0x7206e458: "account.getThemes",
+		0x72091c80: "inputWallPaperSlug",
+		0x725afbbc: "contacts.resolveUsername",
+		0x725b04c3: "updatesCombined",
+		0x72a3158c: "auth.codeTypeSms",
+		0x72c64955: "profileTabMedia",
+		0x72f0eaae: "inputDocumentEmpty",
+		0x7307544f: "requestedPeerChat",
+		0x7311ca11: "webPageNotModified",
+		0x735787a8: "help.getCountriesList",
+		0x73665bc2: "account.getSecureValue",
+		0x73746f5c: "messages.getExportedChatInvite",
+		0x73783ffd: "messages.editChatTitle",
+		0x737fc2ec: "stories.sendStory",
+		0x73924be0: "messageEntityPre",
+		0x73a379eb: "highScore",
+		0x73ada76b: "messageActionStarGiftPurchaseOfferDeclined",
+		0x73bb643b: "messages.getPollResults",
+		0x741cd3e3: "auth.codeTypeCall",
+		0x744694e0: "textPlain",
+		0x74535f21: "messages.messagesNotModified",
+		0x7488ce5b: "messages.getEmojiGroups",
+		0x74ae4240: "updates",
+		0x74aee3e0: "starsTonAmount",
+		0x74bf076b: "payments.convertStarGift",
+		0x74cda504: "messagePeerVoteInputOption",
+		0x74d8be99: "updateSavedRingtones",
+		0x74fae13a: "chatlists.leaveChatlist",
+		0x751f08fa: "inputStorePaymentStarsGiveaway",
+		0x751f3146: "textWithEntities",
+		0x7533a588: "botMenuButtonDefault",
+		0x75588b3f: "inputClientProxy",
+		0x7573a4e9: "users.getSavedMusicByID",
+		0x75b3b798: "updateStory",
+		0x75c78e60: "photoSize",
+		0x761e6af4: "messageEntityBankCard",
+		0x764cf810: "botInlineMessageMediaAuto",
+		0x76768bed: "pageBlockDetails",
+		0x767d61eb: "help.peerColorProfileSet",
+		0x768e3aad: "messages.availableReactions",
+		0x76a6d327: "messageEntityTextUrl",
+		0x76a86270: "account.getBotBusinessConnection",
+		0x76f36233: "account.saveAutoDownloadSettings",
+		0x770416af: "mediaAreaChannelPost",
+		0x771a4e66: "starGiftAuctionState",
+		0x77216192: "messages.getAttachMenuBot",
+		0x7727a7d5: "account.getChannelDefaultEmojiStatuses",
+		0x774278d4: "messageActionStarGiftPurchaseOffer",
+		0x77608b83: "keyboardButtonRow",
+		0x7761198:  "updateChatParticipants",
+		0x77744d4a: "dialogFilterSuggested",
+		0x7780bcb4: "groupCallDiscarded",
+		0x778b5ab3: "bots.updateStarRefProgram",
+		0x778d902f: "smsjobs.getSmsJob",
+		0x779600f9: "inputMediaDocumentExternal",
+		0x77b0e372: "updateReadMonoForumInbox",
+		0x77b15d1c: "stickerSetNoCovered",
+		0x77cdc9f1: "inputPrivacyValueAllowPremium",
+		0x77ced9d0: "channels.getParticipants",
+		0x77d01c3b: "contacts.importedContacts",
+		0x780a0310: "help.termsOfService",
+		0x78337739: "messages.reorderStickerSets",
+		0x78499170: "stories.getPeerMaxIDs",
+		0x78515775: "account.updateProfile",
+		0x788464e1: "bots.setBotBroadcastDefaultAdminRights",
+		0x788d7fe3: "users.getSavedMusic",
+		0x78d4dec1: "updateShort",
+		0x78fbf3a8: "starGiftAttributeRarityEpic",
+		0x7903e3d9: "messageReportOption",
+		0x791451ed: "messages.setEncryptedTyping",
+		0x7967d36:  "account.getWallPapers",
+		0x7998c914: "payments.sendStarsForm",
+		0x79c059f7: "premiumGiftOption",
+		0x79f5d419: "reactionEmpty",
+		0x7a0d7f42: "messageActionGroupCall",
+		0x7a11d782: "keyboardButtonRequestPoll",
+		0x7a1e11d1: "emojiList",
+		0x7a5fa236: "payments.getResaleStarGifts",
+		0x7a700873: "secureValueErrorFile",
+		0x7a777135: "phone.discardGroupCall",
+		0x7a7c17a4: "inputMessagesFilterRoundVoice",
+		0x7a7f2a15: "account.resendPasswordEmail",
+		0x7a800e0a: "messageService",
+		0x7a9abda9: "emojiGroup",
+		0x7ab58308: "inputStarGiftAuctionSlug",
+		0x7adc669d: "contacts.getContactIDs",
+		0x7adf2420: "pollResults",
+		0x7b197dc8: "userStatusRecently",
+		0x7b393160: "phone.inviteToGroupCall",
+		0x7b560a0b: "starsTransactionPeerPlayMarket",
+		0x7b68920:  "updateChannelViewForumAsMessages",
+		0x7b74ed71: "help.timezonesList",
+		0x7b8def20: "stories.exportStoryLink",
+		0x7bf6b15c: "payments.paymentFormStars",
+		0x7bfbdefc: "attachMenuPeerTypeBroadcast",
+		0x7c2557c4: "stories.toggleAllStoriesHidden",
+		0x7c8fe7b6: "pageBlockVideo",
+		0x7cde641d: "help.appConfigNotModified",
+		0x7d0444bb: "phone.createConferenceCall",
+		0x7d09c27e: "secureFile",
+		0x7d170cff: "keyboardButton",
+		0x7d5bd1f0: "payments.starGiftAuctionAcquiredGifts",
+		0x7d5e07c7: "inputKeyboardButtonUserProfile",
+		0x7d6099dd: "securePlainPhone",
+		0x7d627683: "updateSentStoryReaction",
+		0x7d6be90e: "attachMenuPeerTypeSameBotPM",
+		0x7d748d04: "dataJSON",
+		0x7da07ec9: "inputPeerSelf",
+		0x7dbf8673: "sponsoredMessage",
+		0x7df587c:  "updateBotEditBusinessMessage",
+		0x7e58ee9c: "messages.clearAllDrafts",
+		0x7e6260d7: "textConcat",
+		0x7e960193: "auth.resetLoginEmail",
+		0x7ed094a1: "messages.getOldFeaturedStickers",
+		0x7ed23c57: "stories.getStoryViewsList",
+		0x7ed5348a: "payments.connectStarRefBot",
+		0x7ef0dd87: "inputMessagesFilterUrl",
+		0x7efe0e:   "storage.fileJpeg",
+		0x7f077ad9: "contacts.resolvedPeer",
+		0x7f18176a: "payments.transferStarGift",
+		0x7f1d072f: "messages.rateTranscribedAudio",
+		0x7f3b18ea: "inputPeerEmpty",
+		0x7f4b690a: "messages.readEncryptedHistory",
+		0x7f5defa6: "messages.invitedUsers",
+		0x7f648b67: "searchResultPosition",
+		0x7f891213: "updateWebPage",
+		0x7fcb13a8: "messageActionChatEditPhoto",
+		0x7fd736b2: "stories.sendReaction",
+		0x7fe7e815: "messages.hideChatJoinRequest",
+		0x7fe91c14: "stats.messageStats",
+		0x804361ea: "pageBlockAudio",
+		0x809ad9a6: "botInlineMessageMediaWebPage",
+		0x80c99768: "inputMessagesFilterPhoneCalls",
+		0x80d26cc7: "emojiGroupGreeting",
+		0x80e11a7f: "messageActionPhoneCall",
+		0x80eb48af: "groupCallStreamChannel",
+		0x80ed747d: "payments.assignAppStoreTransaction",
+		0x8107455c: "messages.toggleSuggestedPostApproval",
+		0x811f854f: "account.sentEmailCode",
+		0x81202c9:  "messages.setChatTheme",
+		0x812c2ae6: "messages.getStatsURL",
+		0x81602d47: "autoSaveException",
+		0x81b6b00a: "messages.chatInviteImporters",
+		0x81ccf4f:  "textImage",
+		0x82006484: "auth.sentCodeTypeMissedCall",
+		0x8216fba3: "updateTheme",
+		0x8235057e: "messages.clickSponsoredMessage",
+		0x82574ae5: "account.sendChangePhoneCode",
+		0x826f8b60: "messageEntityItalic",
+		0x829d99da: "secureRequiredType",
+		0x82c9e290: "messages.foundStickers",
+		0x82d1f706: "userProfilePhoto",
+		0x82f1e39f: "contacts.getSaved",
+		0x830b9ae4: "notificationSoundLocal",
+		0x8317c0c3: "updateBotWebhookJSON",
+		0x831a83a2: "account.uploadRingtone",
+		0x832175e0: "inputBusinessAwayMessage",
+		0x83268483: "inputChatThemeEmpty",
+		0x833c0fac: "inlineQueryPeerTypePM",
+		0x8341ecc0: "channels.getLeftChannels",
+		0x83487af0: "updateChatUserTyping",
+		0x83557dba: "messages.editInlineBotMessage",
+		0x83d60fc2: "messageReplies",
+		0x840649cf: "inputPrivacyValueAllowChatParticipants",
+		0x846f9e42: "channels.sponsoredMessageReportResultChooseOption",
+		0x8472478e: "chatlists.exportChatlistInvite",
+		0x84a02a0d: "messages.savedGifs",
+		0x84aa3a9c: "payments.starGiftWithdrawalUrl",
+		0x84b88578: "messageActionPaidMessagesPrice",
+		0x84be5b93: "account.updateNotifySettings",
+		0x84c1fd4e: "channels.deleteMessages",
+		0x84cd5a:   "updateTranscribedAudio",
+		0x84d19185: "messages.affectedMessages",
+		0x84f80814: "messages.getExtendedMedia",
+		0x8514bdda: "contacts.toggleTopPeers",
+		0x8525606f: "bots.editPreviewMedia",
+		0x8535fbd9: "stories.reorderAlbums",
+		0x857ebdb8: "messages.getPreparedInlineMessage",
+		0x85dd99d1: "replyKeyboardMarkup",
+		0x85e42301: "phoneCallDiscardReasonMissed",
+		0x85f0a9cd: "starGiftUnique",
+		0x85fea03f: "stickers.suggestedShortName",
+		0x861cc8a0: "inputStickerSetShortName",
+		0x86471d92: "securePasswordKdfAlgoSHA512",
+		0x864b2581: "messages.setChatAvailableReactions",
+		0x8653febe: "stickers.addStickerToSet",
+		0x86872538: "inputMessagePinned",
+		0x868a2aa5: "secureValueErrorReverseSide",
+		0x869d758f: "secureValueError",
+		0x869fbe10: "inputReplyToMessage",
+		0x86b40b08: "replyKeyboardForceReply",
+		0x86f8613c: "premium.boostsList",
+		0x86fccf85: "updateMoveStickerSetToTop",
+		0x871fb939: "updateGeoLiveViewed",
+		0x8736a09:  "channels.getFullChannel",
+		0x875f74be: "messages.getAllChats",
+		0x8763d3e1: "chatParticipantsForbidden",
+		0x87704394: "stickers.deleteStickerSet",
+		0x879537f1: "contacts.resetSaved",
+		0x87cf7f2f: "photos.deletePhotos",
+		0x87d0759e: "help.countriesList",
+		0x87e2f155: "messageActionGiveawayResults",
+		0x87e5dfe4: "inputChatThemeUniqueGift",
+		0x87fc5e7:  "bots.invokeWebViewCustomMethod",
+		0x881fb94b: "messages.emojiGroups",
+		0x8851e68e: "account.createBusinessChatLink",
+		0x88617090: "updateTranscribeAudio",
+		0x889b59ef: "messages.savedReactionTagsNotModified",
+		0x88bf9319: "inputBotInlineResult",
+		0x88d37c56: "messages.recentStickers",
+		0x88f27fbc: "sendMessageRecordRoundAction",
+		0x88f8f21b: "paymentFormMethod",
+		0x890c3d89: "inputBotInlineMessageID",
+		0x89137c0d: "secureValueTypeBankStatement",
+		0x8935fc73: "reactionCustomEmoji",
+		0x89419521: "chatlists.getChatlistUpdates",
+		0x8951abef: "updateNewAuthorization",
+		0x8953ad37: "inputChatPhoto",
+		0x8999602d: "messages.clearRecentStickers",
+		0x899fe31d: "account.saveSecureValue",
+		0x89c590f9: "keyboardButtonGame",
+		0x89fdd778: "inputMediaStory",
+		0x8a2932f3: "payments.starGiftCollections",
+		0x8a480e27: "postInteractionCountersStory",
+		0x8a4d87a:  "help.promoData",
+		0x8a53b014: "messageMediaToDo",
+		0x8a86659c: "botInlineMessageMediaVenue",
+		0x8ac32801: "inputPaymentCredentialsGooglePay",
+		0x8ae5c97a: "updateBotBusinessConnect",
+		0x8aeabec3: "secureData",
+		0x8af09dd2: "messages.foundStickerSets",
+		0x8af94344: "contacts.resolvePhone",
+		0x8b716587: "messages.reorderPinnedSavedDialogs",
+		0x8b725fce: "updatePaidReactionPrivacy",
+		0x8b73e763: "privacyValueDisallowAll",
+		0x8b883488: "secureValueTypeRentalAgreement",
+		0x8b89dfbd: "bots.setCustomVerification",
+		0x8b9b4dae: "account.getContentSettings",
+		0x8ba403e4: "requestedPeerChannel",
+		0x8bba90e6: "messages.getMessagesReactions",
+		0x8c05f1c9: "help.supportName",
+		0x8c10603f: "inputGroupCallInviteMessage",
+		0x8c3410af: "account.editBusinessChatLink",
+		0x8c4bfe5d: "messages.getOutboxReadDate",
+		0x8c5006f8: "messages.markDialogUnread",
+		0x8c5adfd9: "chatInviteImporter",
+		0x8c703f:   "userStatusOffline",
+		0x8c79b63c: "messagePeerReaction",
+		0x8c7f65e2: "botInlineMessageText",
+		0x8c88c923: "updateChannelUserTyping",
+		0x8c92b098: "businessWorkHours",
+		0x8c9a88ac: "messages.webPagePreview",
+		0x8ca60525: "phone.deleteConferenceCallParticipants",
+		0x8caa9a96: "updateBotPrecheckoutQuery",
+		0x8cbec07:  "messageMediaDice",
+		0x8d3456d0: "stories.deleteAlbum",
+		0x8d52a951: "auth.signIn",
+		0x8d595cd6: "storyViews",
+		0x8d9692a3: "messages.getWebPage",
+		0x8db33c4b: "reportResultReported",
+		0x8dca6aa5: "photos.photos",
+		0x8e1a1775: "nearestDc",
+		0x8e39261e: "auth.requestFirebaseSms",
+		0x8e3ca7ee: "secureValueTypeEmail",
+		0x8e48a188: "auth.dropTempAuthKeys",
+		0x8e51b4c1: "payments.checkGiftCode",
+		0x8e5e9873: "updateDcOptions",
+		0x8ea464b6: "statsGraph",
+		0x8ecf0511: "messages.botPreparedInlineMessage",
+		0x8ef3eab0: "account.initTakeoutSession",
+		0x8ef8ecc0: "messages.setGameScore",
+		0x8f079643: "channelAdminLogEventActionStopPoll",
+		0x8f34b2f5: "botBusinessConnection",
+		0x8f38cd1f: "channels.editCreator",
+		0x8fb53057: "phone.joinGroupCall",
+		0x8fb86b41: "payments.sendStarGiftOffer",
+		0x8fc711d:  "account.getAccountTTL",
+		0x8fd4c4d8: "document",
+		0x8fde504f: "inputThemeSettings",
+		0x8fdf1920: "account.confirmPasswordEmail",
+		0x8ffa9a1f: "pageBlockSubtitle",
+		0x8ffacae1: "messages.setChatWallPaper",
+		0x90110467: "inputPrivacyValueDisallowUsers",
+		0x9015e101: "updateShortSentMessage",
+		0x9021ab67: "stickers.createStickerSet",
+		0x904dd49c: "updateBotChatBoost",
+		0x9083670b: "storyViewPublicForward",
+		0x908c0407: "inputBotAppShortName",
+		0x909c3f94: "paymentRequestedInfo",
+		0x90a6ca84: "messageEmpty",
+		0x90c467d1: "account.emojiStatuses",
+		0x90c894b5: "users.setSecureValueErrors",
+		0x91006707: "channels.createChannel",
+		0x9156982a: "upload.getFileHashes",
+		0x915860ae: "account.getDefaultGroupPhotoEmojis",
+		0x91b2d060: "messages.sendBotRequestedPeer",
+		0x91cd32a8: "photos.getUserPhotos",
+		0x91dc3f31: "upload.getCdnFileHashes",
+		0x922e55a9: "emailVerificationCode",
+		0x922e6e10: "updateReadChannelInbox",
+		0x923d8d1:  "inputInvoiceStarGiftDropOriginalDetails",
+		0x925ec9ea: "bots.setBotGroupDefaultAdminRights",
+		0x92a72876: "messageActionGameScore",
+		0x92b4494c: "messages.searchEmojiStickerSets",
+		0x92ceddd4: "messages.createChat",
+		0x93037e20: "stats.publicForwards",
+		0x9308ce1b: "account.resetPassword",
+		0x9325705a: "storyAlbum",
+		0x9342ca07: "messages.getBotCallbackAnswer",
+		0x9375341e: "updateSavedGifs",
+		0x93b31848: "messageActionRequestedPeerSentMe",
+		0x93bcf34:  "emojiGroupPremium",
+		0x93bd878d: "chatlists.chatlistUpdates",
+		0x93bf667f: "attachMenuBotsBot",
+		0x93c3e27e: "availableEffect",
+		0x93cc1f32: "help.countriesListNotModified",
+		0x93fa0bf:  "smsjobs.updateSettings",
+		0x947a12df: "payments.resaleStarGifts",
+		0x9493ff32: "messages.sentEncryptedFile",
+		0x94a495c3: "messages.getQuickReplyMessages",
+		0x94bd38ed: "messageActionPinMessage",
+		0x94c65c76: "contacts.setBlocked",
+		0x94ce852a: "starsGiveawayOption",
+		0x94d42ee7: "channelMessagesFilterEmpty",
+		0x957b50fb: "account.password",
+		0x95ac5ce4: "auth.importLoginToken",
+		0x95d2ac92: "messageActionChannelCreate",
+		0x95ddcf69: "messageActionSuggestedPostSuccess",
+		0x95e3fbef: "messageActionChatDeletePhoto",
+		0x95f2bfe4: "starsTransactionPeerUnsupported",
+		0x95f389b1: "payments.savedStarGifts",
+		0x95fcd1d6: "botApp",
+		0x9609a51c: "inputMessagesFilterPhotos",
+		0x96151fed: "privacyKeyProfilePhoto",
+		0x96537bd7: "dialogFilterChatlist",
+		0x9664f57f: "inputMediaEmpty",
+		0x967a462e: "inputWallPaperNoFile",
+		0x96929a85: "inputBotInlineMessageMediaGeo",
+		0x96a0e00:  "contacts.deleteContacts",
+		0x96a18d5:  "upload.file",
+		0x96d074fd: "emailVerificationApple",
+		0x96e6cd81: "channels.editBanned",
+		0x96eaa5eb: "draftMessage",
+		0x970708cc: "help.timezonesListNotModified",
+		0x9709b1c2: "bots.reorderUsernames",
+		0x971fa843: "inputMediaGeoLive",
+		0x972dabbf: "starGiftAuctionStateFinished",
+		0x973478b6: "contacts.getTopPeers",
+		0x9738bb15: "channels.toggleViewForumAsMessages",
+		0x974392f2: "phone.toggleGroupCallSettings",
+		0x97e8bebe: "notificationSoundDefault",
+		0x9801d2f7: "documentAttributeHasStickers",
+		0x981b91dd: "payments.getStarGiftCollections",
+		0x9852f9c6: "documentAttributeAudio",
+		0x9857ad07: "auth.finishPasskeyLogin",
+		0x985d3abb: "updateChannelParticipant",
+		0x98613ebf: "passkey",
+		0x98657f0d: "page",
+		0x9880f658: "inputCheckPasswordEmpty",
+		0x98986c0d: "inputInvoicePremiumGiftCode",
+		0x9898ad73: "smsjobs.leave",
+		0x98d5ea1d: "payments.connectedStarRefBots",
+		0x98dd8936: "pageListOrderedItemBlocks",
+		0x98e037bb: "account.sendVerifyEmailCode",
+		0x98e0d697: "messageActionGeoProximityReached",
+		0x98f6ac75: "help.promoDataEmpty",
+		0x991399fc: "keyboardButtonSwitchInline",
+		0x99622c0c: "peerNotifySettings",
+		0x998ab009: "messages.getSavedHistory",
+		0x998d6636: "account.savedMusicIds",
+		0x99a48f23: "secureValueTypeInternalPassport",
+		0x99c1d49d: "jsonObject",
+		0x99e3806a: "secureValueTypePassportRegistration",
+		0x99ea331d: "starGiftUpgradePrice",
+		0x9a0b48b8: "inputInvoiceStarGiftPrepaidUpgrade",
+		0x9a23af21: "account.resolvedBusinessChatLinks",
+		0x9a35e999: "peerStories",
+		0x9a3bfd99: "messages.highScores",
+		0x9a3d8c6d: "account.themes",
+		0x9a422c20: "updateRecentStickers",
+		0x9a5c33e5: "account.passwordSettings",
+		0x9a75a1ef: "stories.togglePinned",
+		0x9a868f80: "contacts.getBlocked",
+		0x9a8ae1e1: "pageBlockOrderedList",
+		0x9a9d77e0: "prepaidStarsGiveaway",
+		0x9ab0feaf: "channels.channelParticipants",
+		0x9ae228e2: "premium.myBoosts",
+		0x9ae91519: "channels.restrictSponsoredMessages",
+		0x9b2754a8: "upload.reuploadCdnFile",
+		0x9b5ae7f9: "stories.getAllReadPeerStories",
+		0x9b69e34b: "messageEntityPhone",
+		0x9b89f93a: "inputReportReasonCopyright",
+		0x9b9240a6: "updateBotWebhookJSONQuery",
+		0x9baa9647: "channels.deleteHistory",
+		0x9bb2636d: "inputStorePaymentAuthCode",
+		0x9bed434d: "inputWebDocument",
+		0x9bf8bb95: "textStrike",
+		0x9c2dd95:  "messages.setBotPrecheckoutResults",
+		0x9c469cd:  "inputBusinessIntro",
+		0x9c4e7e8b: "messageEntityUnderline",
+		0x9c60eb28: "bots.getBotMenuButton",
+		0x9c7f2f10: "messages.getSearchResultsPositions",
+		0x9c9abcb1: "payments.getStarGiftUpgradePreview",
+		0x9cb490e9: "message",
+		0x9cb7759:  "updateBotMessageReactions",
+		0x9cc123c7: "phoneConnection",
+		0x9cd4eaf9: "account.getPasswordSettings",
+		0x9cd81144: "messages.chatsSlice",
+		0x9cdf08cd: "help.getSupport",
+		0x9d04af9b: "statsGroupTopPoster",
+		0x9d05049:  "userStatusEmpty",
+		0x9d1dbd26: "phone.groupCallStars",
+		0x9d2216e0: "updateGroupCall",
+		0x9d2a81e3: "secureValueTypePersonalDetails",
+		0x9d4104e2: "messages.summarizeText",
+		0x9d6b13b0: "starGiftCollection",
+		0x9d84f3db: "inputStickerSetThumb",
+		0x9da9403b: "messages.getRecentStickers",
+		0x9ddb347c: "updateBotNewBusinessMessage",
+		0x9de7a269: "inputStickerSetID",
+		0x9dfeefb4: "messages.clearRecentReactions",
+		0x9e6b131a: "account.updateBusinessLocation",
+		0x9e727aad: "phone.groupCall",
+		0x9e82039:  "photos.updateProfilePhoto",
+		0x9e84bc99: "updateReadHistoryInbox",
+		0x9e8fa6d3: "messages.favedStickersNotModified",
+		0x9eb51445: "messages.setDefaultHistoryTTL",
+		0x9ec44f93: "messages.readReactions",
+		0x9ec7863d: "inputReportReasonPersonalDetails",
+		0x9eddf188: "inputMessagesFilterDocument",
+		0x9f071957: "messages.availableReactionsNotModified",
+		0x9f07c728: "account.getContactSignUpNotification",
+		0x9f120418: "chatBannedRights",
+		0x9f2221c9: "inputWebFileGeoPointLocation",
+		0x9f2504e4: "starGiftAttributeBackdrop",
+		0x9f27d26e: "profileTabMusic",
+		0x9f812b08: "updateMonoForumNoPaidException",
+		0x9f84f49e: "messageMediaUnsupported",
+		0x9fab0d1a: "auth.resetAuthorizations",
+		0x9fbab604: "messageActionHistoryClear",
+		0x9fbbf1f7: "phoneCallDiscardReasonMigrateConferenceCall",
+		0x9fc00e65: "inputMessagesFilterVideo",
+		0x9fc55fde: "inputMediaTodo",
+		0x9fd40bd8: "notifyPeer",
+		0x9fd736:   "auth.sentCodeTypeFirebaseSms",
+		0xa0058751: "payments.paymentForm",
+		0xa00918af: "channels.getFutureCreatorAfterLeave",
+		0xa00e67d6: "theme",
+		0xa02a982e: "updateBotDeleteBusinessMessage",
+		0xa02bc13e: "userFull",
+		0xa03e5b85: "replyKeyboardHide",
+		0xa0624cf7: "businessBotRights",
+		0xa0933f5b: "chatParticipantAdmin",
+		0xa098d6af: "help.passportConfig",
+		0xa0ab6cc6: "channels.getParticipant",
+		0xa0ba4f17: "payments.starGiftCollectionsNotModified",
+		0xa0d0744b: "secureValueTypeIdentityCard",
+		0xa0f4cb4f: "messages.getDialogs",
+		0xa1144770: "secureValueErrorTranslationFile",
+		0xa1321f3:  "botCommandScopePeerUser",
+		0xa1405817: "messages.sendScreenshotNotification",
+		0xa187d66f: "sendMessageRecordVideoAction",
+		0xa1974d72: "payments.getUniqueStarGift",
+		0xa1b70815: "bots.getBotRecommendations",
+		0xa20db0e5: "updateDeleteMessages",
+		0xa2185cab: "messages.deleteChatUser",
+		0xa229dd06: "updateConfig",
+		0xa22cbd96: "chatInviteExported",
+		0xa245dd3:  "channels.deactivateAllUsernames",
+		0xa24de717: "messages.checkedHistoryImportPeer",
+		0xa26a7fa5: "account.updateBusinessAwayMessage",
+		0xa2875319: "messages.migrateChat",
+		0xa29cd42c: "messages.getSuggestedDialogFilters",
+		0xa2a5371e: "peerChannel",
+		0xa2a5594d: "bots.getPreviewMedias",
+		0xa2b5a3f6: "messages.getExportedChatInvites",
+		0xa2c0cf74: "account.deleteAccount",
+		0xa2c0f695: "profileTabGifs",
+		0xa2e214a4: "inputCollectiblePhone",
+		0xa319e569: "payments.getSavedStarGifts",
+		0xa339f0b:  "messageReactions",
+		0xa36396e5: "stories.createAlbum",
+		0xa384b779: "receivedNotifyMessage",
+		0xa388a368: "payments.starGiftsNotModified",
+		0xa3b54985: "channelParticipantsKicked",
+		0xa3d1cb80: "reactionCount",
+		0xa416ac81: "auth.sentCodeTypeSmsWord",
+		0xa4314f5:  "messages.sendWebViewResultMessage",
+		0xa437c3ed: "wallPaper",
+		0xa43f30cc: "messageActionChatDeleteUser",
+		0xa44f3ef6: "pageBlockMap",
+		0xa455de90: "messages.exportChatInvite",
+		0xa477288f: "updateGroupCallChainBlocks",
+		0xa486b761: "privacyKeyAbout",
+		0xa4a79376: "updateReadMonoForumOutbox",
+		0xa4bcc6fe: "updates.channelDifferenceTooLong",
+		0xa4dd4c08: "inputPrivacyKeyForwards",
+		0xa4f63c0:  "storage.filePng",
+		0xa5273abf: "phone.editGroupCallParticipant",
+		0xa5491dea: "auth.sentCodeTypeSetUpEmailRequired",
+		0xa556dac8: "stories.readStories",
+		0xa56a8b60: "stories.getChatsToSend",
+		0xa56c2a3e: "updates.state",
+		0xa575739d: "emojiURL",
+		0xa57a7dad: "auth.importAuthorization",
+		0xa584b019: "updateStarsRevenueStatus",
+		0xa5866b41: "messages.editChatDefaultBannedRights",
+		0xa59b102f: "account.updatePasswordSettings",
+		0xa5a356f9: "account.sendVerifyPhoneCode",
+		0xa5d0514d: "payments.getStarGiftActiveAuctions",
+		0xa5d72105: "updateDialogFilterOrder",
+		0xa60ab9ce: "account.getDefaultBackgroundEmojis",
+		0xa614d034: "account.updateBusinessIntro",
+		0xa6341782: "messages.discussionMessage",
+		0xa6437ef6: "stats.getStoryPublicForwards",
+		0xa6751e66: "inputStorePaymentPremiumSubscription",
+		0xa676a322: "inputMessageID",
+		0xa677244f: "auth.sendCode",
+		0xa6b1e39a: "chatlists.joinChatlistInvite",
+		0xa6edbffd: "inputBotInlineMessageMediaContact",
+		0xa6f8f452: "webAuthorization",
+		0xa731e257: "messages.toggleDialogPin",
+		0xa74ece2d: "smsjobs.join",
+		0xa76a5392: "stickers.setStickerSetThumb",
+		0xa7848924: "updateUserName",
+		0xa7f6bbb:  "channels.getChannels",
+		0xa8008cd8: "encryptedFile",
+		0xa80f51e4: "messageActionGiveawayLaunch",
+		0xa8406ca9: "topPeerCategoryForwardUsers",
+		0xa850a693: "channels.reportAntiSpamFalsePositive",
+		0xa85bd1c2: "messages.editChatAdmin",
+		0xa8718dc5: "pageBlockEmbed",
+		0xa8763ab5: "inputMediaDocument",
+		0xa87b0a1c: "inputPeerUserFromMessage",
+		0xa8852491: "messageMediaPaidMedia",
+		0xa8a3c699: "messageActionGiftTon",
+		0xa8ae3eb1: "updateBotSubscriptionExpire",
+		0xa8d864a7: "inputBotInlineResultPhoto",
+		0xa8eb2be:  "inputReportReasonIllegalDrugs",
+		0xa8fb1981: "updates.differenceSlice",
+		0xa920bd7a: "inputBotAppID",
+		0xa927fec5: "messages.inactiveChats",
+		0xa929597a: "account.getAuthorizationForm",
+		0xa99fca4f: "upload.cdnFile",
+		0xa9d6db1f: "urlAuthResultDefault",
+		0xaa021e5:  "starGiftAuctionRoundExtendable",
+		0xaa073beb: "messageMediaGiveaway",
+		0xaa0cd9e4: "sendMessageUploadDocumentAction",
+		0xaa1c39f:  "inputPaymentCredentialsApplePay",
+		0xaa2769ed: "bots.sendCustomRequest",
+		0xaa40f94d: "keyboardButtonRequestGeoLocation",
+		0xaa472651: "dialogFilter",
+		0xaa5f789c: "stories.storyReactionsList",
+		0xaa963b05: "storage.fileUnknown",
+		0xaac7b717: "auth.signUp",
+		0xaadf159b: "messages.webViewResult",
+		0xab03c6d9: "auth.sentCodeTypeFlashCall",
+		0xab0f6b1e: "updatePhoneCall",
+		0xab339c00: "profileTabFiles",
+		0xab42441a: "stats.getBroadcastStats",
+		0xab661b5b: "topPeerCategoryBotsPM",
+		0xab7ec0a0: "encryptedChatEmpty",
+		0xabcfa9fd: "help.getPeerProfileColors",
+		0xac072444: "updateStarGiftCraftFail",
+		0xac1f1fcd: "messageActionPaidMessagesRefunded",
+		0xac21d3ce: "updateBotMessageReaction",
+		0xac5c1af7: "businessLocation",
+		0xac806d61: "stories.getAlbumStories",
+		0xac81bbde: "messages.toggleSavedDialogPin",
+		0xaca1657b: "updateMessagePoll",
+		0xacfa1a7e: "inputMessageCallbackQuery",
+		0xad01d61d: "authorization",
+		0xad253d78: "codeSettings",
+		0xad2e1cd8: "account.authorizationForm",
+		0xad399cee: "channels.setBoostsToUnblockRestrictions",
+		0xad5648e8: "payments.deleteStarGiftCollection",
+		0xad628cc8: "messageExtendedMediaPreview",
+		0xad798849: "channels.sponsoredMessageReportResultReported",
+		0xad8c9a23: "channels.getMessages",
+		0xadcbbcda: "account.getAutoSaveSettings",
+		0xade1591:  "contacts.blocked",
+		0xadec6ebe: "help.peerColorOption",
+		0xadf44ee3: "inputReportReasonChildAbuse",
+		0xae168909: "channelAdminLogEventActionDeleteTopic",
+		0xae1e508d: "storage.filePdf",
+		0xae30253:  "messageRange",
+		0xae3f101d: "updatePeerWallpaper",
+		0xae59db5f: "stories.deleteStories",
+		0xaeaf9e74: "updateSavedDialogPinned",
+		0xaeb00b34: "messages.getFullChat",
+		0xaed0cbd9: "payments.exportedInvoice",
+		0xaed6dbb2: "maskCoords",
+		0xaed6e4f5: "payments.upgradeStarGift",
+		0xaee69d68: "inputPrivacyKeyVoiceMessages",
+		0xaef6abbc: "payments.starGiftActiveAuctions",
+		0xaf0a4a08: "messages.getForumTopicsByID",
+		0xafb6144a: "channelAdminLogEventActionParticipantJoinByRequest",
+		0xafe5623f: "phone.joinAsPeers",
+		0xaff56398: "starGiftBackground",
+		0xb00c47a2: "messageActionPrizeStars",
+		0xb05ac6b1: "sendMessageChooseStickerAction",
+		0xb06fdbdf: "messages.reactionsNotModified",
+		0xb0711d83: "bots.getAdminedBots",
+		0xb07ed085: "messageActionNewCreatorPending",
+		0xb08f922a: "messages.deleteHistory",
+		0xb0bdeac5: "storyView",
+		0xb0cd6617: "botVerifierSettings",
+		0xb0d13e47: "webPagePending",
+		0xb0d1865b: "channelParticipantsBots",
+		0xb0d81a83: "messages.prolongWebView",
+		0xb0f9684f: "messages.craftStarGift",
+		0xb11eafa2: "messages.toggleNoForwards",
+		0xb12c7125: "messages.acceptUrlAuth",
+		0xb17f890:  "messages.recentStickersNotModified",
+		0xb1c3caa7: "channelAdminLogEventActionChangeStickerSet",
+		0xb1d11410: "phone.sendGroupCallMessage",
+		0xb1db7c7e: "inputNotifyBroadcasts",
+		0xb1f2061f: "messages.getDocumentByHash",
+		0xb2028afb: "stories.incrementStoryViews",
+		0xb23fc698: "updateChannelAvailableMessages",
+		0xb2539d54: "prepaidGiveaway",
+		0xb26732a9: "account.saveMusic",
+		0xb282217f: "inputMediaAreaVenue",
+		0xb288bc7d: "account.getAllSecureValues",
+		0xb290c69:  "channels.convertToGigagroup",
+		0xb297e9b:  "stories.togglePinnedToTop",
+		0xb2a2f663: "geoPoint",
+		0xb2a7386b: "attachMenuBotIcon",
+		0xb2cbc1c0: "phone.discardCall",
+		0xb2da71d2: "recentMeUrlChat",
+		0xb304a621: "upload.saveFilePart",
+		0xb3134d9d: "contacts.found",
+		0xb320aadb: "secureValueTypePhone",
+		0xb37794af: "auth.sentCodeTypeSmsPhrase",
+		0xb390dc08: "pageRelatedArticle",
+		0xb3a07661: "messageActionGroupCallScheduled",
+		0xb3ba0635: "inputMediaPhoto",
+		0xb3cea0e4: "storage.fileMp4",
+		0xb3fb5361: "emojiLanguage",
+		0xb4073647: "username",
+		0xb425cfe1: "payments.paymentFormStarGift",
+		0xb434e2b8: "auth.exportedAuthorization",
+		0xb4352016: "stories.getStoriesArchive",
+		0xb43df344: "messages.startHistoryImport",
+		0xb455a106: "payments.getSavedStarGift",
+		0xb457b375: "starsTransactionPeerAppStore",
+		0xb45c69d1: "messages.affectedHistory",
+		0xb45ced1d: "channels.reorderUsernames",
+		0xb4608969: "channelParticipantsAdmins",
+		0xb4a2e88d: "updateEncryption",
+		0xb4ae666f: "businessChatLink",
+		0xb4afcfb0: "updatePeerLocated",
+		0xb4c38cb5: "messageActionWebViewDataSent",
+		0xb4c83b4c: "notifyUsers",
+		0xb4d5d859: "payments.suggestedStarRefBots",
+		0xb4f67e93: "requirementToContactPaidMessages",
+		0xb5052fea: "messages.toggleStickerSets",
+		0xb52c939d: "contacts.topPeersDisabled",
+		0xb549da53: "inputMessagesFilterRoundVideo",
+		0xb54b5acf: "peerColor",
+		0xb550d328: "contacts.unblock",
+		0xb57295d5: "inlineBotWebView",
+		0xb574b16b: "account.setContentSettings",
+		0xb583ba46: "stories.editStory",
+		0xb59cf977: "phone.checkGroupCall",
+		0xb5a1ce5a: "messageActionChatEditTitle",
+		0xb5aefd7d: "updateBotShippingQuery",
+		0xb60f5918: "users.getFullUser",
+		0xb6213cdf: "shippingOption",
+		0xb627f3aa: "bots.reorderPreviewMedias",
+		0xb637edaf: "statsDateRangeDays",
+		0xb658f23e: "updateDialogUnreadMark",
+		0xb665902e: "sendMessageEmojiInteractionSeen",
+		0xb69b72d7: "messages.chatAdminsWithInvites",
+		0xb6aef7b0: "messageActionEmpty",
+		0xb6c4f543: "messages.messageViews",
+		0xb6c8c393: "contacts.getSponsoredPeers",
+		0xb6c8f12b: "payments.validateRequestedInfo",
+		0xb6cc2d5c: "messagePeerVote",
+		0xb6d915d7: "inputBotInlineMessageID64",
+		0xb6e0a3f5: "stats.getMessageStats",
+		0xb71e767a: "jsonString",
+		0xb7263f6d: "account.savedRingtone",
+		0xb74ba9d2: "contacts.contactsNotModified",
+		0xb75f99a9: "updateReadChannelOutbox",
+		0xb783982:  "updateGroupCallConnection",
+		0xb7b31ea8: "baseThemeNight",
+		0xb7d998f0: "payments.getConnectedStarRefBot",
+		0xb7e085fe: "auth.exportLoginToken",
+		0xb80e5fe4: "messages.setHistoryTTL",
+		0xb81b93d4: "help.getPremiumPromo",
+		0xb81c7034: "sendAsPeer",
+		0xb826e150: "storyFwdHeader",
+		0xb86e380e: "messages.getPollVotes",
+		0xb880bc4b: "account.deleteSecureValue",
+		0xb88cf373: "businessBotRecipients",
+		0xb8905fb2: "privacyValueAllowUsers",
+		0xb89bfccf: "factCheck",
+		0xb8a0a1a8: "messages.getAllStickers",
+		0xb8bc5b0c: "inputNotifyPeer",
+		0xb8d0afdf: "accountDaysTTL",
+		0xb8ea86a9: "inputPeerColorCollectible",
+		0xb91bbd3a: "messageActionSetChatTheme",
+		0xb92c09e2: "recentMeUrlUser",
+		0xb92fb6cd: "pageListItemText",
+		0xb940c666: "messageMediaGeoLive",
+		0xb98886cf: "inputUserEmpty",
+		0xb98cd696: "profileTabPosts",
+		0xb9aa606a: "botCommandScopeChatAdmins",
+		0xb9b2881f: "stories.getStoryReactionsList",
+		0xb9c0639a: "peerColorCollectible",
+		0xb9cdc5ee: "messages.getFactCheck",
+		0xb9cfc48d: "updateBotCallbackQuery",
+		0xb9d9a38d: "account.toggleSponsoredMessages",
+		0xb9ffc55b: "messages.faveSticker",
+		0xba4a3b5b: "messages.readSavedHistory",
+		0xba52007:  "inputPrivacyValueDisallowContacts",
+		0xba6705f0: "contacts.editCloseFriends",
+		0xbaa57628: "autoDownloadSettings",
+		0xbaafe5e0: "pageBlockAuthorDate",
+		0xbac3a61a: "reactionNotificationsFromContacts",
+		0xbad07584: "inputDocumentFileLocation",
+		0xbad88395: "inputMessageReplyTo",
+		0xbb12a419: "messages.setInlineBotResults",
+		0xbb2d201:  "updateStickerSetsOrder",
+		0xbb3b9804: "account.resetWallPapers",
+		0xbb6ae88d: "channelParticipantsContacts",
+		0xbb8125ba: "messages.getTopReactions",
+		0xbb92ba95: "messageEntityUnknown",
+		0xbb9bb9a5: "updatePeerHistoryTTL",
+		0xbbab2643: "storyReactionPublicForward",
+		0xbbb6b4a3: "starsAmount",
+		0xbbf2dda0: "securePasswordKdfAlgoPBKDF2HMACSHA512iter100000",
+		0xbbf51685: "emailVerifyPurposePassport",
+		0xbc0a57dc: "recentMeUrlStickerSet",
+		0xbc2eab30: "privacyKeyStatusTimestamp",
+		0xbcc4af10: "keyboardButtonCopy",
+		0xbcf22685: "phone.inviteConferenceCallParticipant",
+		0xbd0415c4: "stories.togglePeerStoriesHidden",
+		0xbd17a14a: "topPeerCategoryGroups",
+		0xbd1efd3e: "payments.getStarsGiveawayOptions",
+		0xbd2a0840: "inputPeerChannelFromMessage",
+		0xbd38850a: "messages.sendScheduledMessages",
+		0xbd47cbad: "messageActionChatCreate",
+		0xbd610bc9: "messageEntityBold",
+		0xbd74cf49: "storyViewPublicRepost",
+		0xbd7f90ac: "messages.getUnreadReactions",
+		0xbd87cb6c: "savedDialog",
+		0xbd915c0:  "starsTopupOption",
+		0xbdbb0464: "messages.getScheduledMessages",
+		0xbdc597b4: "inputPrivacyKeyNoPaidMessages",
+		0xbdc62dcc: "messages.exportedChatInvites",
+		0xbdca2f75: "messages.editExportedChatInvite",
+		0xbdcdaec0: "inputChatUploadedPhoto",
+		0xbddb616e: "messages.availableEffects",
+		0xbddcc510: "inputBotInlineMessageMediaWebPage",
+		0xbdf93428: "messages.getDefaultTagReactions",
+		0xbdf9653b: "game",
+		0xbdfb0426: "inputPrivacyKeyChatInvite",
+		0xbe098173: "account.chatThemes",
+		0xbe1e85ba: "fragment.getCollectibleInfo",
+		0xbe382906: "messages.featuredStickers",
+		0xbe3dfa:   "secureValueErrorFrontSide",
+		0xbe4e0ef8: "channelAdminLogEventActionChangeAvailableReactions",
+		0xbe5335be: "upload.getFile",
+		0xbe77b4a:  "premium.getMyBoosts",
+		0xbe82db9c: "mediaAreaVenue",
+		0xbec268ef: "updateNotifySettings",
+		0xbedc9822: "statsGraphError",
+		0xbf0693d4: "messageEntityStrike",
+		0xbf4dea82: "pageBlockTable",
+		0xbf899aa0: "account.setAuthorizationTTL",
+		0xbfb5ad8b: "channelLocationEmpty",
+		0xbfb9f457: "help.passportConfigNotModified",
+		0xbfd064ec: "pageBlockHeader",
+		0xc000bba2: "auth.sentCodeTypeSms",
+		0xc007cec3: "notifyChats",
+		0xc00ec7d3: "payments.getStarsTopupOptions",
+		0xc0111fe3: "channels.deleteChannel",
+		0xc01f6fe8: "messages.savedDialogsNotModified",
+		0xc02d4007: "chatParticipant",
+		0xc070d93e: "pageBlockPreformatted",
+		0xc077ec01: "availableReaction",
+		0xc0944820: "messageActionTopicEdit",
+		0xc0977421: "help.getPromoData",
+		0xc0c4edc9: "payments.checkCanSendGift",
+		0xc0cf7646: "messages.sendInlineBotResult",
+		0xc0de1bd9: "jsonObjectValue",
+		0xc0e24635: "messages.dhConfigNotModified",
+		0xc0fd5d09: "keyboardButtonUserProfile",
+		0xc10eb2cf: "inputPaymentCredentialsSaved",
+		0xc12622c4: "textUnderline",
+		0xc13d1c11: "inputMediaVenue",
+		0xc13e3c50: "importedContact",
+		0xc1cbd5b6: "account.cancelPasswordEmail",
+		0xc1e4a2b1: "inputReportReasonOther",
+		0xc1e92cc5: "account.savedRingtones",
+		0xc1f8e69a: "inputMessagesFilterMyMentions",
+		0xc21b8849: "inputMediaWebPage",
+		0xc21f497e: "encryptedFileEmpty",
+		0xc23727c9: "account.passwordInputSettings",
+		0xc239d686: "inputWebFileLocation",
+		0xc2510192: "bots.getPopularAppBots",
+		0xc27ac8c7: "botCommand",
+		0xc31fc14a: "inputPasskeyResponseLogin",
+		0xc326caef: "inputInvoiceSlug",
+		0xc32af4cc: "payments.reorderStarGiftCollections",
+		0xc32bfa1a: "attachMenuPeerTypeBotPM",
+		0xc32d5b12: "updateDeleteChannelMessages",
+		0xc331e80a: "inputGameShortName",
+		0xc3878e23: "help.country",
+		0xc387c04e: "stories.canSendStoryCount",
+		0xc3987a3a: "stories.albums",
+		0xc39f5324: "inputInvoiceStarGiftResale",
+		0xc3a12462: "baseThemeClassic",
+		0xc3a2835f: "auth.loggedOut",
+		0xc3dffc04: "chatTheme",
+		0xc3f2f501: "businessAwayMessageScheduleOutsideWorkHours",
+		0xc4103386: "inputMediaPaidMedia",
+		0xc448415c: "myBoost",
+		0xc4563590: "payments.getStarGifts",
+		0xc45a6536: "help.noAppUpdate",
+		0xc4870a49: "updateBotStopped",
+		0xc4a353ee: "contacts.getStatuses",
+		0xc4b9f9bb: "error",
+		0xc4e57915: "inputPrivacyValueDisallowBots",
+		0xc4e5921e: "inputBusinessBotRecipients",
+		0xc4f9186b: "help.getConfig",
+		0xc516d679: "messageActionBotAllowed",
+		0xc517f77e: "channelAdminLogEventActionToggleAutotranslation",
+		0xc5181ac:  "exportedChatlistInvite",
+		0xc5226f17: "phoneCallWaiting",
+		0xc558d8ab: "phone.getGroupParticipants",
+		0xc563c1e4: "messages.updateDialogFiltersOrder",
+		0xc5b56859: "inputInvoiceMessage",
+		0xc5ba3d86: "account.reportPeer",
+		0xc624b16e: "messageActionPaymentSent",
+		0xc661ad08: "help.getPassportConfig",
+		0xc6701900: "phone.sendConferenceCallBroadcast",
+		0xc68d6695: "messages.quickReplies",
+		0xc69708d3: "sponsoredPeer",
+		0xc6dc0c66: "messages.featuredStickersNotModified",
+		0xc727bb3b: "account.installTheme",
+		0xc7345e6a: "jsonBool",
+		0xc776ba4e: "messages.channelMessages",
+		0xc7770878: "payments.changeStarsSubscription",
+		0xc78fe460: "messages.installStickerSet",
+		0xc7b57ce6: "botMenuButton",
+		0xc7edbc83: "messageActionTodoAppendTasks",
+		0xc7fb5e01: "textSuperscript",
+		0xc84834ce: "autoSaveSettings",
+		0xc88b3b02: "inputStickerSetPremiumGifts",
+		0xc8a0ec74: "messages.getStickerSet",
+		0xc8cf05f8: "messageEntityCustomEmoji",
+		0xc93de95c: "inputChatTheme",
+		0xc94511c:  "webViewMessageSent",
+		0xc957a766: "updateGroupCallEncryptedMessage",
+		0xc9662d05: "inputKeyboardButtonRequestPeer",
+		0xc982eaba: "cdnPublicKey",
+		0xc99b1950: "botAppSettings",
+		0xc9b0539f: "searchResultsCalendarPeriod",
+		0xc9b9e2b9: "businessAwayMessageScheduleAlways",
+		0xc9e01e7b: "messages.requestMainWebView",
+		0xc9e33d54: "channels.inviteToChannel",
+		0xc9f06e1b: "requestPeerTypeChat",
+		0xc9f81ce8: "account.setPrivacy",
+		0xca461b5d: "peerLocated",
+		0xca5cab89: "messageMediaVideoStream",
+		0xca71d64:  "bots.previewInfo",
+		0xca8ae8ba: "account.invalidateSignInCodes",
+		0xcacb6ae2: "inputPeerNotifySettings",
+		0xcad181f6: "langPackString",
+		0xcad5452d: "mediaAreaGeoPoint",
+		0xcae1aadf: "storage.fileGif",
+		0xcae47523: "auth.resendCode",
+		0xcae68768: "stories.peerStories",
+		0xcb296bf8: "labeledPrice",
+		0xcb2ac766: "channelAdminLogEventActionToggleNoForwards",
+		0xcb397619: "channelParticipant",
+		0xcb43acde: "statsAbsValueAndPrev",
+		0xcb6ff828: "savedReactionTag",
+		0xcb9deff6: "auth.reportMissingCode",
+		0xcba9a52f: "todoItem",
+		0xcbc6d107: "messages.addChatUser",
+		0xcbc7ee28: "inputSecureFileLocation",
+		0xcbce2fe0: "statsPercentValue",
+		0xcbe31e26: "secureValueTypeAddress",
+		0xcbea6bc4: "phone.joinGroupCallPresentation",
+		0xcc02aa6d: "messageActionBoostApply",
+		0xcc104937: "channels.readHistory",
+		0xcc1a241e: "config",
+		0xcc4d9ecc: "businessAwayMessageScheduleCustom",
+		0xcc5b67cc: "messages.getAttachedStickers",
+		0xcc5bebb3: "payments.fulfillStarsSubscription",
+		0xcc6e0c11: "account.updateBirthday",
+		0xcc7c5c89: "messageActionTodoCompletions",
+		0xccbbce30: "help.appUpdate",
+		0xccfddf96: "messages.saveDefaultSendAs",
+		0xcd64636c: "connectedBot",
+		0xcd77d957: "channelMessagesFilter",
+		0xcd984aa5: "langpack.getDifference",
+		0xcdbbcebb: "messages.allStickers",
+		0xcdc27a1f: "paymentSavedCredentialsCard",
+		0xcdc3858c: "account.wallPapers",
+		0xcdd42a05: "auth.bindTempAuthKey",
+		0xcde3739:  "inputStickerSetAnimatedEmojiAnimations",
+		0xcdff0eca: "forumTopic",
+		0xce03da83: "chatlists.getExportedInvites",
+		0xce0d37b0: "pageBlockAnchor",
+		0xceaa3ea1: "messageMediaGiveawayResults",
+		0xcecc1134: "messages.editForumTopic",
+		0xcef7e7a8: "starGiftAttributeRarityLegendary",
+		0xcf1592db: "messages.reportSpam",
+		0xcf6f6db8: "webPageAttributeUniqueStarGift",
+		0xcf7d64b1: "userStatusHidden",
+		0xcfb9d957: "messages.transcribedAudio",
+		0xcfc9e002: "mediaAreaCoordinates",
+		0xcfcd0f13: "storyReactionPublicRepost",
+		0xcff43f61: "account.setContactSignUpNotification",
+		0xd069ccde: "stories.startLive",
+		0xd06e93a8: "payments.getStarGiftWithdrawalUrl",
+		0xd072acb4: "restrictionReason",
+		0xd087663a: "updateChatParticipant",
+		0xd08ce645: "account.emojiStatusesNotModified",
+		0xd09e07b:  "inputPrivacyValueAllowContacts",
+		0xd0b5e1fc: "messages.getMyStickers",
+		0xd0e482b2: "phone.groupCallStreamChannels",
+		0xd1219bdd: "inputPrivacyKeyAddedByPhone",
+		0xd1451883: "payments.validatedRequestedInfo",
+		0xd1810907: "stories.searchPosts",
+		0xd18b4d16: "auth.checkPassword",
+		0xd19ae46d: "privacyKeyPhoneNumber",
+		0xd1d34a26: "sendMessageUploadPhotoAction",
+		0xd1d7efc5: "payments.getStarsRevenueAdsAccountUrl",
+		0xd1da940c: "messages.deleteFactCheck",
+		0xd1ed9a5b: "messages.availableEffectsNotModified",
+		0xd27ff082: "inputCheckPasswordSRP",
+		0xd2816f10: "messages.deleteTopicHistory",
+		0xd29a27f4: "updateChannelMessageForwards",
+		0xd2aaf7ec: "messages.updatePinnedMessage",
+		0xd30d78d4: "messages.sendReaction",
+		0xd31bc45d: "starGiftActiveAuctionState",
+		0xd33c8902: "channels.editAdmin",
+		0xd33f43f3: "inputMediaGame",
+		0xd348bc44: "contacts.getLocated",
+		0xd360e72c: "help.getSupportName",
+		0xd3656499: "profileTabLinks",
+		0xd36bf79:  "auth.checkRecoveryPassword",
+		0xd3bc4b7a: "userEmpty",
+		0xd3c96bc8: "payments.getStarsGiftOptions",
+		0xd3e03124: "messages.toggleTodoCompleted",
+		0xd3f924eb: "messages.stickerSetNotModified",
+		0xd41a5167: "webPageUrlPending",
+		0xd45ab096: "passwordKdfAlgoUnknown",
+		0xd464a42b: "messages.deleteExportedChatInvite",
+		0xd483f2a8: "messages.getQuickReplies",
+		0xd52f73f7: "sendMessageRecordAudioAction",
+		0xd54b65d:  "messages.foundStickerSetsNotModified",
+		0xd5676710: "channelAdminLogEventActionParticipantToggleAdmin",
+		0xd58a08c6: "dialog",
+		0xd58f130a: "messages.setBotCallbackAnswer",
+		0xd5a41724: "updateMessageExtendedMedia",
+		0xd5a5d3a1: "messages.getStickers",
+		0xd5b10c26: "messages.getEmojiURL",
+		0xd5b3b9f9: "emojiKeyword",
+		0xd5e58274: "payments.checkCanSendGiftResultFail",
+		0xd612e8ef: "notifyBroadcasts",
+		0xd61ad6ee: "auth.codeTypeMissedCall",
+		0xd62ff46a: "requestedPeerUser",
+		0xd638de89: "account.getChatThemes",
+		0xd63d94e0: "messages.getPinnedSavedDialogs",
+		0xd65a11cc: "inputPrivacyKeyBirthday",
+		0xd66b66c9: "inputPrivacyValueDisallowAll",
+		0xd6753386: "account.getDefaultEmojiStatuses",
+		0xd69b8361: "account.saveAutoSaveSettings",
+		0xd6b19546: "updateReadChannelDiscussionInbox",
+		0xd6b48f7:  "payments.getSuggestedStarRefBots",
+		0xd6b94df2: "messages.getPinnedDialogs",
+		0xd7584c87: "statsGroupTopAdmin",
+		0xd766c50a: "inlineQueryPeerTypeChat",
+		0xd7ca61a2: "updateChatParticipantAdmin",
+		0xd7e78225: "inputBotInlineMessageMediaInvoice",
+		0xd80c25ec: "keyboardButtonUrl",
+		0xd80da15d: "starsTransactionPeer",
+		0xd8214d41: "photoPathSize",
+		0xd8326f0d: "updateGroupCallMessage",
+		0xd83466f3: "inputPhotoLegacyFileLocation",
+		0xd83d70c1: "payments.clearSavedInfo",
+		0xd8411139: "payments.paymentVerificationNeeded",
+		0xd897bc66: "auth.requestPasswordRecovery",
+		0xd89a83a3: "users.getRequirementsToContact",
+		0xd8aa3671: "channels.updateColor",
+		0xd8aa840f: "inputGroupCall",
+		0xd90d8dfe: "attachMenuBot",
+		0xd912a59c: "textItalic",
+		0xd91a548:  "users.getUsers",
+		0xd91ffad6: "payments.getStarsRevenueStats",
+		0xd92c2285: "speakingInGroupCallAction",
+		0xd94305e0: "account.updatePersonalChannel",
+		0xd9565c39: "auth.sentCodeTypeFragmentSms",
+		0xd95c6154: "messageActionSecureValuesSent",
+		0xd95e73bb: "inputMessagesFilterPhotoVideoDocuments",
+		0xd999256:  "messageActionTopicCreate",
+		0xd9ab0f54: "messages.getCustomEmojiDocuments",
+		0xd9ba2e54: "contacts.addContact",
+		0xda082fe:  "videoSizeStickerMarkup",
+		0xda2ad647: "messages.emojiGameOutcome",
+		0xda80f42f: "help.getPeerColors",
+		0xdabab2ef: "inputInvoicePremiumGiftStars",
+		0xdabbf83a: "payments.paymentReceiptStars",
+		0xdadbc950: "account.getPrivacy",
+		0xdaeda864: "contacts.getBirthdays",
+		0xdb20b188: "pageBlockDivider",
+		0xdb21d0a7: "inputSecureValue",
+		0xdb33dad0: "payments.starGiftActiveAuctionsNotModified",
+		0xdb64fd34: "account.tmpPassword",
+		0xdb7e1747: "account.resetNotifySettings",
+		0xdb909ec2: "emailVerificationGoogle",
+		0xdb9d897d: "botCommandScopePeer",
+		0xdb9e70d2: "inputPrivacyKeyPhoneP2P",
+		0xdb9f9140: "channelAdminLogEventActionDiscardGroupCall",
+		0xdbce6389: "starGiftAttributeRarityUncommon",
+		0xdbd4feed: "inputReportReasonGeoIrrelevant",
+		0xdbda9246: "sendMessageHistoryImportAction",
+		0xdc0242c8: "messages.sendWebViewData",
+		0xdc3d824f: "textEmpty",
+		0xdc58f31e: "updateStarGiftAuctionUserState",
+		0xdc6cfcf0: "paidReactionPrivacyPeer",
+		0xdc7b1140: "messageEntityMentionName",
+		0xdc8b44cf: "smsjobs.eligibleToJoin",
+		0xdcb118b7: "groupCallParticipantVideoSourceGroup",
+		0xdcd914fd: "bots.getBotInfo",
+		0xdcdf8607: "stats.getMegagroupStats",
+		0xdd0c66f2: "starRefProgram",
+		0xdd18782e: "help.appConfig",
+		0xdd6a8f48: "sendMessageGamePlayAction",
+		0xdde8a54c: "inputPeerUser",
+		0xddf10c3b: "messageMediaWebPage",
+		0xde266ef5: "contacts.topPeersNotModified",
+		0xde33b094: "videoSize",
+		0xde3f3c79: "channelParticipantsRecent",
+		0xde4c5d93: "geoPointAddress",
+		0xde5a0dd6: "textEmail",
+		0xde7b673d: "upload.saveBigFilePart",
+		0xde9eed1d: "stories.storyViews",
+		0xdea20a39: "messages.getAvailableEffects",
+		0xdef143d0: "updatePinnedForumTopics",
+		0xdef60797: "messages.editChatAbout",
+		0xdf04dd4e: "messages.getChatInviteImporters",
+		0xdf77f3bc: "account.resetAuthorization",
+		0xdfb80317: "channels.channelParticipant",
+		0xdfdaabe1: "inputFileLocation",
+		0xdffd50d3: "payments.assignPlayMarketTransaction",
+		0xe011e1c4: "account.chatThemesNotModified",
+		0xe021f2f6: "messages.botResults",
+		0xe0310d7:  "help.recentMeUrls",
+		0xe04b5ceb: "channelParticipantsMentions",
+		0xe062db83: "inputMessagesFilterContacts",
+		0xe0804116: "wallPaperNoFile",
+		0xe085f4ea: "messages.hideAllChatJoinRequests",
+		0xe089f8f5: "chatlists.joinChatlistUpdates",
+		0xe0955a3c: "auth.sentCodePaymentRequired",
+		0xe095c1a0: "phoneCallDiscardReasonDisconnect",
+		0xe09d5faf: "account.getSavedMusicIds",
+		0xe0b0bc2e: "photoStrippedSize",
+		0xe0bff26c: "starGiftAttributeOriginalDetails",
+		0xe0c0c5e5: "pageTableRow",
+		0xe1037f92: "messageActionChatMigrateTo",
+		0xe105e910: "messages.deleteQuickReplyMessages",
+		0xe14c4a71: "photos.uploadContactProfilePhoto",
+		0xe15c4370: "keyboardButtonSimpleWebView",
+		0xe1664194: "contacts.blockedSlice",
+		0xe16b5ce1: "account.passkeyRegistrationOptions",
+		0xe1732341: "inputPrivacyKeyStarGiftsAutoSave",
+		0xe175e66f: "payments.giveawayInfoResults",
+		0xe17e23c:  "photoSizeEmpty",
+		0xe188503b: "messageActionChangeCreator",
+		0xe1902288: "account.getSavedRingtones",
+		0xe1bb0d61: "account.emailVerifiedLogin",
+		0xe2037789: "auth.passkeyLoginOptions",
+		0xe2750328: "account.getDefaultProfilePhotoEmojis",
+		0xe2de7737: "stories.foundStories",
+		0xe306d3a:  "messages.readHistory",
+		0xe317af7e: "updatesTooLong",
+		0xe31c34d8: "channelAdminLogEventActionParticipantInvite",
+		0xe320c158: "account.getAuthorizations",
+		0xe32f3d77: "updateChatParticipantDelete",
+		0xe3309f7f: "help.termsOfServiceUpdateEmpty",
+		0xe34c0dd6: "bots.getBotCommands",
+		0xe3779861: "account.resetPasswordFailedWait",
+		0xe3878aa4: "users.savedMusicNotModified",
+		0xe39460a9: "inputCollectibleUsername",
+		0xe39a8f03: "account.uploadWallPaper",
+		0xe3b2d0c:  "inlineQueryPeerTypeBotPM",
+		0xe40370a3: "updateEditMessage",
+		0xe40ca104: "messages.getCommonChats",
+		0xe41cd11d: "messages.setWebViewResult",
+		0xe42ce9c9: "account.getUniqueGiftChatThemes",
+		0xe4621141: "privacyValueDisallowUsers",
+		0xe46bcee4: "chatParticipantCreator",
+		0xe470bcfd: "messages.getPeerDialogs",
+		0xe477092e: "profileTabVoice",
+		0xe47cb579: "messages.togglePeerTranslations",
+		0xe4c123d6: "inputGeoPointEmpty",
+		0xe4cb9580: "channels.toggleJoinToSend",
+		0xe4e0b29d: "channelFull",
+		0xe4e88011: "pageBlockList",
+		0xe4fca4a3: "payments.editConnectedStarRefBot",
+		0xe511996d: "updateFavedStickers",
+		0xe519abab: "businessGreetingMessage",
+		0xe537ced6: "secureValueErrorSelfie",
+		0xe56dbf05: "dialogPeer",
+		0xe581e4e9: "requirementToContactPremium",
+		0xe58e95d2: "messages.deleteMessages",
+		0xe5af939:  "messageReplyStoryHeader",
+		0xe5afa56d: "phone.sendGroupCallEncryptedMessage",
+		0xe5bbfe1a: "inputMediaPhotoExternal",
+		0xe5bdf8de: "updateUserStatus",
+		0xe5bfffcd: "auth.exportAuthorization",
+		0xe5d7d19c: "messages.chatFull",
+		0xe5f672fa: "messages.setBotShippingResults",
+		0xe6213f4d: "bots.answerWebhookJSONQuery",
+		0xe62bc960: "keyboardButtonCallback",
+		0xe630b979: "inputWallPaper",
+		0xe63fadeb: "channels.exportMessageLink",
+		0xe64429c0: "channelAdminLogEventActionParticipantUnmute",
+		0xe66fbf7b: "inputMediaDice",
+		0xe67f520e: "inputStickerSetDice",
+		0xe6a1eeb8: "smsJob",
+		0xe6aa647f: "phone.exportGroupCallInvite",
+		0xe6b76ae:  "channelAdminLogEventActionChangeLocation",
+		0xe6c31522: "messageActionStarGiftUnique",
+		0xe6d83d7e: "channelAdminLogEventActionParticipantToggleBan",
+		0xe6df7378: "messages.startBot",
+		0xe6dfb825: "channelAdminLogEventActionChangeTitle",
+		0xe7026d0d: "inputMessagesFilterGeo",
+		0xe7058e7f: "postInteractionCountersMessage",
+		0xe7841f0:  "messages.reorderPinnedForumTopics",
+		0xe785a43f: "channels.getSendAs",
+		0xe7e82e12: "pendingSuggestion",
+		0xe7ff068a: "emojiStatus",
+		0xe8025ca2: "messages.savedGifsNotModified",
+		0xe822649d: "messages.getGameHighScores",
+		0xe844ebff: "messages.searchCounter",
+		0xe846b1a0: "keyboardButtonWebView",
+		0xe8625e92: "inputInvoiceStarGift",
+		0xe86602c3: "messages.allStickersNotModified",
+		0xe87acbc0: "foundStory",
+		0xe894ad4d: "auth.acceptLoginToken",
+		0xe89c45b2: "webPage",
+		0xe8a40bd9: "secureValueErrorData",
+		0xe8a775b0: "bots.botInfo",
+		0xe8e37e5:  "suggestedPost",
+		0xe8fd8014: "peerBlocked",
+		0xe90ebb59: "channelAdminLogEventActionExportedInviteEdit",
+		0xe926d63e: "account.resetPasswordOk",
+		0xe92fd902: "starsTransactionPeerFragment",
+		0xe94f0f86: "inputPrivacyValueDisallowChatParticipants",
+		0xe9763aec: "sendMessageUploadVideoAction",
+		0xe9baa668: "folderPeer",
+		0xe9ce781c: "payments.resolveStarGiftOffer",
+		0xe9e82c18: "channelAdminLogEventActionUpdatePinned",
+		0xe9effc7d: "account.resetPasswordRequestedWait",
+		0xea02c27e: "paymentCharge",
+		0xea02ec33: "secureValueTypeTemporaryRegistration",
+		0xea107ae4: "channelAdminLogEventsFilter",
+		0xea1f0c52: "account.getPasskeys",
+		0xea2c31d3: "messageActionStarGift",
+		0xea32b4b1: "contacts.sponsoredPeersEmpty",
+		0xea3948e9: "messageActionChannelMigrateFrom",
+		0xea8ca4f9: "channels.setStickers",
+		0xeab5dc38: "channels.readMessageContents",
+		0xeabbb94c: "channels.togglePreHistoryHidden",
+		0xeae87e42: "contacts.contacts",
+		0xeafc32bc: "chatReactionsNone",
+		0xeafdf716: "messages.reactions",
+		0xeb032884: "contacts.sponsoredPeers",
+		0xeb2b4cf6: "account.getGlobalPrivacySettings",
+		0xeb49081d: "recentMeUrlChatInvite",
+		0xeb50adf5: "messages.botApp",
+		0xeb983f8f: "payments.checkedGiftCode",
+		0xebbca3cb: "messageActionChatJoinedByRequest",
+		0xebe07752: "updatePeerBlocked",
+		0xebe46819: "updateServiceNotification",
+		0xec05b097: "updateAutoSaveSettings",
+		0xec22cfcd: "help.setBotUpdatesStatus",
+		0xec43a2d1: "account.businessChatLinks",
+		0xec82e140: "phone.phoneCall",
+		0xec86017a: "account.registerDevice",
+		0xece2a0e6: "channels.getMessageAuthor",
+		0xece9814b: "privacyValueAllowPremium",
+		0xecf6736:  "messages.getFeaturedEmojiStickers",
+		0xed107ab7: "chatInvitePublicJoinRequests",
+		0xed18c118: "encryptedMessage",
+		0xed1ecdb0: "secureValueHash",
+		0xed56c9fc: "account.webAuthorizations",
+		0xed6a8504: "textSubscript",
+		0xed85eab5: "updatePinnedMessages",
+		0xed8af74d: "channels.adminLogResults",
+		0xed9f30c5: "bots.updateUserEmojiStatus",
+		0xedb93949: "userStatusOnline",
+		0xedbe6ccb: "payments.updateStarGiftPrice",
+		0xedc39d0:  "smsjobs.isEligibleToJoin",
+		0xedcdc05b: "topPeer",
+		0xedd4882a: "updates.getState",
+		0xedd49ef0: "channels.toggleSlowMode",
+		0xedf164f1: "storyItem",
+		0xedf3add0: "publicForwardStory",
+		0xedfc111e: "updateDraftMessage",
+		0xee3b272a: "updatePrivacy",
+		0xee430c85: "groupCallDonor",
+		0xee479c64: "messageExtendedMedia",
+		0xee72f79a: "help.acceptTermsOfService",
+		0xee7a1596: "messageActionSuggestedPostApproval",
+		0xee8c1e86: "inputChannelEmpty",
+		0xee9f88a6: "phone.getGroupCallChainBlocks",
+		0xeea8e46e: "upload.cdnFileReuploadNeeded",
+		0xeeb0d625: "stories.getAllStories",
+		0xeeca5ce3: "langPackLanguage",
+		0xef156a5c: "businessAwayMessage",
+		0xef1751b5: "pageBlockChannel",
+		0xef500eab: "account.reorderUsernames",
+		0xef7c213a: "phone.getGroupCallJoinAs",
+		0xef7ff916: "stats.megagroupStats",
+		0xef8d3e6c: "messages.affectedFoundMessages",
+		0xefb2b617: "groupCall",
+		0xefd48c89: "messages.getDialogFilters",
+		0xefd9a6a2: "messages.getPeerSettings",
+		0xefea3803: "langpack.getStrings",
+		0xf0173fe9: "channels.channelParticipantsNotModified",
+		0xf041e250: "chatOnlines",
+		0xf04fb3a9: "channelAdminLogEventActionChangeUsernames",
+		0xf06fe208: "channelAdminLogEventActionEditTopic",
+		0xf08d516b: "starGiftAttributeRarityRare",
+		0xf0d3e6a8: "channels.updateEmojiStatus",
+		0xf0e3e596: "messages.dialogsNotModified",
+		0xf0e4e0b6: "reportResultChooseOption",
+		0xf101aa7f: "inputSavedStarGiftChat",
+		0xf107e790: "messages.getUnreadMentions",
+		0xf10ece2f: "chatlists.chatlistInvite",
+		0xf128c708: "phone.toggleGroupCallRecord",
+		0xf12bb6e1: "pageBlockSubheader",
+		0xf12e57c9: "channels.editPhoto",
+		0xf132e3ef: "bots.allowSendMessage",
+		0xf141b5e1: "inputEncryptedChat",
+		0xf146d31f: "attachMenuPeerTypePM",
+		0xf16269d4: "updateSmsJob",
+		0xf1749a22: "messages.stickersNotModified",
+		0xf18cda44: "upload.fileCdnRedirect",
+		0xf1ccaaac: "messageEntityBlockquote",
+		0xf1d0fbd3: "messages.checkQuickReplyShortcut",
+		0xf1d88a5c: "attachMenuBotsNotModified",
+		0xf21158c6: "inputUser",
+		0xf21f7f2f: "messages.savePreparedInlineMessage",
+		0xf226ac08: "updateChannelMessageViews",
+		0xf257106c: "account.saveTheme",
+		0xf259a80b: "pageBlockEmbedPost",
+		0xf2a71983: "updateDeleteScheduledMessages",
+		0xf2c4f24d: "channels.searchPosts",
+		0xf2ebdb4e: "updateGroupCallParticipants",
+		0xf2ecef23: "chatAdminWithInvites",
+		0xf2f2330a: "langpack.getLangPack",
+		0xf351d7ab: "sendMessageUploadAudioAction",
+		0xf35aec28: "inputChannel",
+		0xf385c1f6: "langPackDifference",
+		0xf393aea0: "messages.discardEncryption",
+		0xf39b035c: "fileHash",
+		0xf3a9244a: "inputMediaStakeDice",
+		0xf3ae2eed: "help.userInfoEmpty",
+		0xf3e0da33: "inputChatlistDialogFilter",
+		0xf3ed4c73: "account.acceptAuthorization",
+		0xf3f25f76: "messageActionContactSignUp",
+		0xf41eb622: "account.themesNotModified",
+		0xf4239425: "payments.getGiveawayInfo",
+		0xf44a8315: "channels.reportSpam",
+		0xf450f59b: "auth.sentCodeTypeEmailCode",
+		0xf46fe924: "inputWebFileAudioAlbumThumbLocation",
+		0xf47741f7: "peerSettings",
+		0xf47751b6: "phone.groupParticipants",
+		0xf496b0c6: "channels.sendAsPeers",
+		0xf4997e42: "inputInvoiceBusinessBotTransferStars",
+		0xf49ca0:   "updates.difference",
+		0xf50dbaa1: "help.dismissSuggestion",
+		0xf51006f9: "keyboardButtonUrlAuth",
+		0xf516760b: "messages.getScheduledHistory",
+		0xf5235d55: "inputEncryptedFileLocation",
+		0xf52ff27f: "inputFile",
+		0xf53da717: "updateNewQuickReply",
+		0xf5537ebc: "stickers.changeSticker",
+		0xf568028a: "bankCardOpenUrl",
+		0xf578105:  "account.getRecentEmojiStatuses",
+		0xf5890df1: "inputThemeSlug",
+		0xf5b5563f: "account.deletePasskey",
+		0xf5dad378: "channels.getGroupsForDiscussion",
+		0xf5ddd6e7: "inputReportReasonFake",
+		0xf635e1b:  "messages.getInlineGameHighScores",
+		0xf64daf43: "messages.requestEncryption",
+		0xf64f54f7: "phone.deleteGroupCallMessages",
+		0xf6a548d3: "messageMediaInvoice",
+		0xf6a5f82f: "privacyValueDisallowBots",
+		0xf6e26854: "payments.applyGiftCode",
+		0xf731a9f4: "messages.readDiscussion",
+		0xf7444763: "jsonArray",
+		0xf74e932b: "updateReadStories",
+		0xf7760f51: "stickers.removeStickerFromSet",
+		0xf7c1b13f: "inputUserSelf",
+		0xf7e8d89b: "privacyValueAllowCloseFriends",
+		0xf8227181: "updateReadMessagesContents",
+		0xf831a20f: "contacts.acceptContact",
+		0xf836aa95: "channels.leaveChannel",
+		0xf83ae221: "messages.savedDialogs",
+		0xf85c413c: "videoSizeEmojiMarkup",
+		0xf8654027: "contacts.exportContactToken",
+		0xf888fa1a: "privacyValueDisallowContacts",
+		0xf89777f2: "channelAdminLogEventActionParticipantLeave",
+		0xf89a6a4e: "updateChat",
+		0xf8ab7dfb: "inputMediaContact",
+		0xf8b036af: "channels.getAdminedPublicChannels",
+		0xf8e0aa1c: "account.passkeys",
+		0xf8ec284b: "peerSelfLocated",
+		0xf8ed08:   "help.peerColors",
+		0xf91b065:  "payments.exportInvoice",
+		0xf92424d2: "channelAdminLogEventActionParticipantMute",
+		0xf93cd45c: "botVerification",
+		0xf9470ab2: "updateQuickReplies",
+		0xf94e5f1:  "inputMediaPoll",
+		0xf9677aad: "starsTransactionPeerAPI",
+		0xf96e55de: "messages.uninstallStickerSet",
+		0xf9a2a6cb: "inputStorePaymentStarsTopup",
+		0xf9c44144: "inputMediaGeoPoint",
+		0xf9c8bcc6: "webDocumentNoProxy",
+		0xf9cbe409: "messages.deletePhoneCallHistory",
+		0xfa04579d: "messageEntityMention",
+		0xfa0f3ca2: "updatePinnedDialogs",
+		0xfa3efb95: "photoSizeProgressive",
+		0xfa4f0bb5: "inputFileBig",
+		0xfa58b6d4: "themeSettings",
+		0xfa87f659: "chatlists.chatlistInviteAlready",
+		0xfa8cc6f5: "account.reportProfilePhoto",
+		0xfabadc5f: "inputPrivacyKeyPhoneCall",
+		0xfae69f56: "messageActionCustomAction",
+		0xfaf7e8c9: "phoneCallDiscardReasonBusy",
+		0xfaff629d: "messages.myStickers",
+		0xfb197a65: "photo",
+		0xfb4c496c: "updateReadFeaturedEmojiStickers",
+		0xfb790393: "inputStorePaymentPremiumGiftCode",
+		0xfb7e8ca7: "messages.getEmojiGameInfo",
+		0xfb834291: "topPeerCategoryPeers",
+		0xfb8fe43c: "payments.savedInfo",
+		0xfb9c547a: "updateEmojiGameInfo",
+		0xfbd2c296: "inputFolderPeer",
+		0xfbd3de6b: "account.updateEmojiStatus",
+		0xfbd81688: "baseThemeDay",
+		0xfbeec0f0: "topPeerCategoryForwardChats",
+		0xfbf6e8b1: "account.savedRingtonesNotModified",
+		0xfbfca18f: "messages.getEmojiStickers",
+		0xfc36954e: "secureValueTypeUtilityBill",
+		0xfc533372: "users.suggestBirthday",
+		0xfc78af9b: "messages.report",
+		0xfc878fc8: "phoneCallProtocol",
+		0xfc8ddbea: "account.getWallPaper",
+		0xfcaafeb7: "inputDialogPeer",
+		0xfcfeb29c: "stickerKeyword",
+		0xfd05dd00: "messages.getCraftStarGifts",
+		0xfd149899: "documentAttributeCustomEmoji",
+		0xfd2dda49: "messages.toggleDialogFilterTags",
+		0xfd5e12bd: "messages.webPage",
+		0xfd5ec8f5: "sendMessageCancelAction",
+		0xfd9e7bec: "topPeerCategoryBotsApp",
+		0xfda68d36: "messages.getMessageEditData",
+		0xfdb19008: "messageMediaGame",
+		0xfdbcd714: "chatlists.getLeaveChatlistSuggestions",
+		0xfe06823f: "inputGroupCallSlug",
+		0xfe2eda76: "account.toggleNoPaidMessagesException",
+		0xfe333952: "starGiftAuctionStateNotModified",
+		0xfe41b34f: "globalPrivacySettings",
+		0xfe69018d: "channelAdminLogEventActionChangeTheme",
+		0xfe9fc158: "channelAdminLogEventActionParticipantJoinByInvite",
+		0xfebe5491: "starsRevenueStatus",
+		0xfeed5769: "account.installWallPaper",
+		0xff16e2ca: "pollAnswer",
+		0xff544e65: "folder",
+		0xff57708d: "messages.preparedInlineMessage",
+		0xff6c8049: "notificationSoundRingtone",
+		0xff7a571b: "privacyKeySavedMusic",
+		0xff7a9383: "phone.sendSignalingData",
+		0xff9289f5: "timezone",
+		0xffa00ccc: "messageActionPaymentSentMe",
+		0xffadc913: "storyItemSkipped",
+		0xffb62b95: "inputStickerSetEmpty",
+		0xffb6d4ca: "stickers.changeStickerPosition",
+		0xffc86587: "inputMessagesFilterGif",
+		0xffda656d: "messages.sponsoredMessages",
+		0xfff8fdc4: "inputBotInlineResultDocument",
+		0xfffe1bac: "privacyValueAllowContacts",
+	})
 }
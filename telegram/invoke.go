@@ -0,0 +1,154 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+)
+
+// InvokeRaw calls a method by its schema name, for methods picked at runtime
+// that have no Go helper to call. Params are named like the fields of the
+// method's Params struct or like the schema parameters, and are coerced to the
+// field types: values may be TL objects, their JSON form (maps with a "_"
+// constructor name, see MarshalJSON) or plain numbers and strings. Peer,
+// user and channel params also take usernames and ids, which are resolved.
+// A random_id left out is generated.
+//
+//	res, err := c.InvokeRaw(ctx, "messages.sendMessage", map[string]any{
+//		"peer":    "@username",
+//		"message": "Hello",
+//	})
+func (c *Client) InvokeRaw(ctx context.Context, method string, params map[string]any) (any, error) {
+	obj, err := newRawMethod(method)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err = c.resolveRawPeers(reflect.TypeOf(obj).Elem(), params)
+	if err != nil {
+		return nil, fmt.Errorf("invoking %s: %w", method, err)
+	}
+	data, err := tl.MarshalJSON(params)
+	if err != nil {
+		return nil, fmt.Errorf("invoking %s: encoding params: %w", method, err)
+	}
+	if err := tl.UnmarshalJSON(data, obj); err != nil {
+		return nil, fmt.Errorf("invoking %s: decoding params: %w", method, err)
+	}
+	if id := reflect.ValueOf(obj).Elem().FieldByName("RandomID"); id.IsValid() && id.Kind() == reflect.Int64 && id.Int() == 0 {
+		id.SetInt(GenRandInt())
+	}
+
+	res, err := c.MakeRequestCtx(ctx, obj)
+	if err != nil {
+		return nil, fmt.Errorf("invoking %s: %w", method, err)
+	}
+	return res, nil
+}
+
+// InvokeRawJSON is InvokeRaw with params given as a JSON object and the result
+// returned in the JSON form of MarshalJSON.
+func (c *Client) InvokeRawJSON(ctx context.Context, method string, params []byte) ([]byte, error) {
+	var args map[string]any
+	if len(bytes.TrimSpace(params)) > 0 {
+		dec := json.NewDecoder(bytes.NewReader(params))
+		dec.UseNumber()
+		if err := dec.Decode(&args); err != nil {
+			return nil, fmt.Errorf("invoking %s: decoding params: %w", method, err)
+		}
+	}
+
+	res, err := c.InvokeRaw(ctx, method, args)
+	if err != nil {
+		return nil, err
+	}
+	return tl.MarshalJSON(res)
+}
+
+// newRawMethod returns the empty Params struct of the method named method.
+func newRawMethod(method string) (tl.Object, error) {
+	obj, ok := tl.NewObject(method)
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownMethod, method)
+	}
+	if typ := reflect.TypeOf(obj); typ.Kind() != reflect.Pointer || !strings.HasSuffix(typ.Elem().Name(), "Params") {
+		return nil, fmt.Errorf("%w %q: it is a constructor", ErrUnknownMethod, method)
+	}
+	return obj, nil
+}
+
+// ErrUnknownMethod is returned by InvokeRaw for names that are not methods of the schema.
+var ErrUnknownMethod = errors.New("unknown method")
+
+var (
+	inputPeerType    = reflect.TypeFor[InputPeer]()
+	inputUserType    = reflect.TypeFor[InputUser]()
+	inputChannelType = reflect.TypeFor[InputChannel]()
+)
+
+// resolveRawPeers returns params with the usernames and ids given for peer,
+// user and channel fields of typ resolved. params itself is left untouched.
+func (c *Client) resolveRawPeers(typ reflect.Type, params map[string]any) (map[string]any, error) {
+	fields := make(map[string]reflect.Type, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		fields[strings.ToLower(typ.Field(i).Name)] = typ.Field(i).Type
+	}
+
+	var resolved map[string]any
+	for key, value := range params {
+		ft := fields[strings.ToLower(strings.ReplaceAll(key, "_", ""))]
+		if ft != inputPeerType && ft != inputUserType && ft != inputChannelType {
+			continue
+		}
+
+		switch v := value.(type) {
+		case nil, map[string]any:
+			continue
+		case json.Number:
+			n, err := v.Int64()
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			value = n
+		case float64:
+			value = int64(v)
+		case tl.Object:
+			if reflect.TypeOf(v).AssignableTo(ft) {
+				continue
+			}
+		}
+
+		var peer any
+		var err error
+		switch ft {
+		case inputPeerType:
+			peer, err = c.ResolvePeer(value)
+		case inputUserType:
+			peer, err = c.GetSendableUser(value)
+		case inputChannelType:
+			peer, err = c.GetSendableChannel(value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("resolving %s: %w", key, err)
+		}
+
+		if resolved == nil {
+			resolved = maps.Clone(params)
+		}
+		resolved[key] = peer
+	}
+
+	if resolved == nil {
+		return params, nil
+	}
+	return resolved, nil
+}
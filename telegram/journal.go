@@ -0,0 +1,34 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import (
+	"io"
+
+	mtproto "github.com/amarnathcjd/gogram"
+)
+
+type (
+	// Recorder journals the RPC traffic and updates of a client, see ClientConfig.Recorder.
+	Recorder = mtproto.Recorder
+	// JournalEntry is one recorded call or update.
+	JournalEntry = mtproto.JournalEntry
+	// JournalError is the RPC error a recorded call failed with.
+	JournalError = mtproto.JournalError
+)
+
+// Kinds of journal entries.
+const (
+	JournalCall   = mtproto.JournalCall
+	JournalUpdate = mtproto.JournalUpdate
+)
+
+// NewRecorder returns a recorder writing a journal of newline-delimited JSON to w.
+func NewRecorder(w io.Writer) *Recorder {
+	return mtproto.NewRecorder(w)
+}
+
+// ReadJournal reads all entries of a journal written by a Recorder.
+func ReadJournal(r io.Reader) ([]JournalEntry, error) {
+	return mtproto.ReadJournal(r)
+}
@@ -827,6 +827,13 @@ func (mb *Destination) Close() error {
 	return nil
 }
 
+// DownloadMediaCtx is like DownloadMedia, but the download is canceled when ctx is done.
+func (c *Client) DownloadMediaCtx(ctx context.Context, file any, Opts ...*DownloadOptions) (string, error) {
+	opts := *getVariadic(Opts, &DownloadOptions{})
+	opts.Ctx = ctx
+	return c.DownloadMedia(file, &opts)
+}
+
 func (c *Client) DownloadMedia(file any, Opts ...*DownloadOptions) (string, error) {
 	opts := getVariadic(Opts, &DownloadOptions{})
 
@@ -1179,7 +1186,7 @@ func initializeWorkers(numWorkers int, dc int32, c *Client, w *WorkerPool) error
 	}
 
 	var authParams = &AuthExportedAuthorization{}
-	if dc != int32(c.GetDC()) {
+	if dc != int32(c.GetDC()) && !c.MTProto.HasStoredAuth(int(dc)) {
 		if c.exportedKeys == nil {
 			c.exportedKeys = make(map[int]*AuthExportedAuthorization)
 		}
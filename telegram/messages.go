@@ -45,6 +45,7 @@ type SendOptions struct {
 	PaidFloodSkip        bool                     // Skip flood wait using paid priority
 	SuggestedPost        *SuggestedPost           // Channel post suggestion configuration
 	QuickReplyShortcut   *InputQuickReplyShortcut // Quick reply shortcut binding
+	Ctx                  context.Context          // Context for cancellation and deadlines
 }
 
 // SendMessage sends a message to a specified peer using the Telegram API method messages.sendMessage.
@@ -116,6 +117,13 @@ func (c *Client) SendMessage(peerID, message any, opts ...*SendOptions) (*NewMes
 	return c.sendMessage(senderPeer, textMessage, entities, sendAs, opt)
 }
 
+// SendMessageCtx is like SendMessage, but the request is bound to ctx.
+func (c *Client) SendMessageCtx(ctx context.Context, peerID, message any, opts ...*SendOptions) (*NewMessage, error) {
+	opt := *getVariadic(opts, &SendOptions{})
+	opt.Ctx = ctx
+	return c.SendMessage(peerID, message, &opt)
+}
+
 func (c *Client) sendMessage(Peer InputPeer, Message string, entities []MessageEntity, sendAs InputPeer, opt *SendOptions) (*NewMessage, error) {
 	var replyTo *InputReplyToMessage = &InputReplyToMessage{ReplyToMsgID: opt.ReplyID}
 	if opt.ReplyTo != nil {
@@ -159,7 +167,7 @@ func (c *Client) sendMessage(Peer InputPeer, Message string, entities []MessageE
 		opts.QuickReplyShortcut = *opt.QuickReplyShortcut
 	}
 
-	updateResp, err := c.MessagesSendMessage(opts)
+	updateResp, err := c.MessagesSendMessageCtx(getValue(opt.Ctx, context.Background()), opts)
 	if err != nil {
 		return nil, err
 	}
@@ -221,6 +229,13 @@ func (c *Client) EditMessage(peerID any, id int32, message any, opts ...*SendOpt
 	return c.editMessage(senderPeer, id, textMessage, entities, media, opt)
 }
 
+// EditMessageCtx is like EditMessage, but the request is bound to ctx.
+func (c *Client) EditMessageCtx(ctx context.Context, peerID any, id int32, message any, opts ...*SendOptions) (*NewMessage, error) {
+	opt := *getVariadic(opts, &SendOptions{})
+	opt.Ctx = ctx
+	return c.EditMessage(peerID, id, message, &opt)
+}
+
 func (c *Client) editMessage(Peer InputPeer, id int32, Message string, entities []MessageEntity, Media any, options *SendOptions) (*NewMessage, error) {
 	var (
 		media InputMedia
@@ -243,7 +258,7 @@ func (c *Client) editMessage(Peer InputPeer, id int32, Message string, entities
 		}
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(getValue(options.Ctx, context.Background()), 5*time.Second)
 	defer cancel()
 
 	if Message == "" {
@@ -395,6 +410,7 @@ type MediaOptions struct {
 	PaidFloodSkip        bool                     // Skip flood wait using paid priority
 	SuggestedPost        *SuggestedPost           // Channel post suggestion configuration
 	QuickReplyShortcut   *InputQuickReplyShortcut // Quick reply shortcut binding
+	Ctx                  context.Context          // Context for cancellation and deadlines
 }
 
 type MediaMetadata struct {
@@ -478,6 +494,13 @@ func (c *Client) SendMedia(peerID, Media any, opts ...*MediaOptions) (*NewMessag
 	return c.sendMedia(senderPeer, sendMedia, textMessage, entities, sendAs, opt)
 }
 
+// SendMediaCtx is like SendMedia, but the request is bound to ctx.
+func (c *Client) SendMediaCtx(ctx context.Context, peerID, Media any, opts ...*MediaOptions) (*NewMessage, error) {
+	opt := *getVariadic(opts, &MediaOptions{})
+	opt.Ctx = ctx
+	return c.SendMedia(peerID, Media, &opt)
+}
+
 func (c *Client) sendMedia(Peer InputPeer, Media InputMedia, Caption string, entities []MessageEntity, sendAs InputPeer, opt *MediaOptions) (*NewMessage, error) {
 	var replyTo *InputReplyToMessage = &InputReplyToMessage{ReplyToMsgID: opt.ReplyID}
 	if opt.ReplyTo != nil {
@@ -520,7 +543,7 @@ func (c *Client) sendMedia(Peer InputPeer, Media InputMedia, Caption string, ent
 		params.QuickReplyShortcut = *opt.QuickReplyShortcut
 	}
 
-	result, err := c.MessagesSendMedia(params)
+	result, err := c.MessagesSendMediaCtx(getValue(opt.Ctx, context.Background()), params)
 	if err != nil {
 		return nil, err
 	}
@@ -1098,6 +1121,7 @@ func (c *Client) GetMessages(PeerID any, Opts ...*SearchOption) ([]NewMessage, e
 		return nil, err
 	}
 
+	ctx := getValue(opt.Context, context.Background())
 	var (
 		messages []NewMessage
 		inputIDs []InputMessage
@@ -1141,9 +1165,9 @@ func (c *Client) GetMessages(PeerID any, Opts ...*SearchOption) ([]NewMessage, e
 		for _, ids := range chunkedIds {
 			switch peer := peer.(type) {
 			case *InputPeerChannel:
-				result, err = c.ChannelsGetMessages(&InputChannelObj{ChannelID: peer.ChannelID, AccessHash: peer.AccessHash}, ids)
+				result, err = c.ChannelsGetMessagesCtx(ctx, &InputChannelObj{ChannelID: peer.ChannelID, AccessHash: peer.AccessHash}, ids)
 			case *InputPeerChat, *InputPeerUser, *InputPeerSelf:
-				result, err = c.MessagesGetMessages(ids)
+				result, err = c.MessagesGetMessagesCtx(ctx, ids)
 			default:
 				return nil, errors.New("invalid peer type to get messages")
 			}
@@ -1205,9 +1229,9 @@ func (c *Client) GetMessages(PeerID any, Opts ...*SearchOption) ([]NewMessage, e
 			perReqLimit := min(int32(remaining), 100)
 			params.Limit = perReqLimit
 
-			result, err = c.MessagesSearch(params)
+			result, err = c.MessagesSearchCtx(ctx, params)
 			if err != nil {
-				if handleIfFlood(err, c) {
+				if ctx.Err() == nil && handleIfFlood(err, c) {
 					continue
 				}
 				return nil, err
@@ -1255,6 +1279,16 @@ func (c *Client) GetMessages(PeerID any, Opts ...*SearchOption) ([]NewMessage, e
 	return messages, nil
 }
 
+// GetMessagesCtx is like GetMessages, but every request it makes is bound to ctx.
+func (c *Client) GetMessagesCtx(ctx context.Context, PeerID any, Opts ...*SearchOption) ([]NewMessage, error) {
+	opt := *getVariadic(Opts, &SearchOption{
+		Filter:           &InputMessagesFilterEmpty{},
+		SleepThresholdMs: 20,
+	})
+	opt.Context = ctx
+	return c.GetMessages(PeerID, &opt)
+}
+
 var ErrStopIteration = errors.New("stop iteration")
 
 func (c *Client) IterMessages(PeerID any, callback func(*NewMessage) error, Opts ...*SearchOption) error {
@@ -1323,9 +1357,9 @@ func (c *Client) IterMessages(PeerID any, callback func(*NewMessage) error, Opts
 
 			switch peer := peer.(type) {
 			case *InputPeerChannel:
-				result, err = c.ChannelsGetMessages(&InputChannelObj{ChannelID: peer.ChannelID, AccessHash: peer.AccessHash}, ids)
+				result, err = c.ChannelsGetMessagesCtx(ctx, &InputChannelObj{ChannelID: peer.ChannelID, AccessHash: peer.AccessHash}, ids)
 			case *InputPeerChat, *InputPeerUser, *InputPeerSelf:
-				result, err = c.MessagesGetMessages(ids)
+				result, err = c.MessagesGetMessagesCtx(ctx, ids)
 			default:
 				return errors.New("invalid peer type to get messages")
 			}
@@ -1412,9 +1446,9 @@ func (c *Client) IterMessages(PeerID any, callback func(*NewMessage) error, Opts
 			}
 			params.Limit = perReqLimit
 
-			result, err = c.MessagesSearch(params)
+			result, err = c.MessagesSearchCtx(ctx, params)
 			if err != nil {
-				if handleIfFlood(err, c) {
+				if ctx.Err() == nil && handleIfFlood(err, c) {
 					continue
 				}
 				return err
@@ -1868,6 +1902,7 @@ func convertOption(s *SendOptions) *MediaOptions {
 		Upload:        s.Upload,
 		FileName:      s.FileName,
 		Attributes:    s.Attributes,
+		Ctx:           s.Ctx,
 	}
 }
 
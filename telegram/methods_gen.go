@@ -3,6 +3,7 @@
 package telegram
 
 import (
+	"context"
 	"fmt"
 	tl "github.com/amarnathcjd/gogram/internal/encoding/tl"
 	"reflect"
@@ -22,7 +23,12 @@ func (*AccountAcceptAuthorizationParams) CRC() uint32 {
 
 // Sends a Telegram Passport authorization form, effectively sharing data with the service
 func (c *Client) AccountAcceptAuthorization(botID int64, scope, publicKey string, valueHashes []*SecureValueHash, credentials *SecureCredentialsEncrypted) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountAcceptAuthorizationParams{
+	return c.AccountAcceptAuthorizationCtx(context.Background(), botID, scope, publicKey, valueHashes, credentials)
+}
+
+// AccountAcceptAuthorizationCtx is the context-aware variant of AccountAcceptAuthorization.
+func (c *Client) AccountAcceptAuthorizationCtx(ctx context.Context, botID int64, scope, publicKey string, valueHashes []*SecureValueHash, credentials *SecureCredentialsEncrypted) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountAcceptAuthorizationParams{
 		BotID:       botID,
 		Credentials: credentials,
 		PublicKey:   publicKey,
@@ -48,7 +54,12 @@ func (*AccountCancelPasswordEmailParams) CRC() uint32 {
 
 // Cancel the code that was sent to verify an email to use as 2FA recovery method.
 func (c *Client) AccountCancelPasswordEmail() (bool, error) {
-	responseData, err := c.MakeRequest(&AccountCancelPasswordEmailParams{})
+	return c.AccountCancelPasswordEmailCtx(context.Background())
+}
+
+// AccountCancelPasswordEmailCtx is the context-aware variant of AccountCancelPasswordEmail.
+func (c *Client) AccountCancelPasswordEmailCtx(ctx context.Context) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountCancelPasswordEmailParams{})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountCancelPasswordEmail: %w", err)
 	}
@@ -77,7 +88,12 @@ func (*AccountChangeAuthorizationSettingsParams) FlagIndex() int {
 
 // Change settings related to a session.
 func (c *Client) AccountChangeAuthorizationSettings(confirmed bool, hash int64, encryptedRequestsDisabled, callRequestsDisabled bool) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountChangeAuthorizationSettingsParams{
+	return c.AccountChangeAuthorizationSettingsCtx(context.Background(), confirmed, hash, encryptedRequestsDisabled, callRequestsDisabled)
+}
+
+// AccountChangeAuthorizationSettingsCtx is the context-aware variant of AccountChangeAuthorizationSettings.
+func (c *Client) AccountChangeAuthorizationSettingsCtx(ctx context.Context, confirmed bool, hash int64, encryptedRequestsDisabled, callRequestsDisabled bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountChangeAuthorizationSettingsParams{
 		CallRequestsDisabled:      callRequestsDisabled,
 		Confirmed:                 confirmed,
 		EncryptedRequestsDisabled: encryptedRequestsDisabled,
@@ -106,7 +122,12 @@ func (*AccountChangePhoneParams) CRC() uint32 {
 
 // Change the phone number of the current account
 func (c *Client) AccountChangePhone(phoneNumber, phoneCodeHash, phoneCode string) (User, error) {
-	responseData, err := c.MakeRequest(&AccountChangePhoneParams{
+	return c.AccountChangePhoneCtx(context.Background(), phoneNumber, phoneCodeHash, phoneCode)
+}
+
+// AccountChangePhoneCtx is the context-aware variant of AccountChangePhone.
+func (c *Client) AccountChangePhoneCtx(ctx context.Context, phoneNumber, phoneCodeHash, phoneCode string) (User, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountChangePhoneParams{
 		PhoneCode:     phoneCode,
 		PhoneCodeHash: phoneCodeHash,
 		PhoneNumber:   phoneNumber,
@@ -132,7 +153,12 @@ func (*AccountCheckUsernameParams) CRC() uint32 {
 
 // Validates a username and checks availability.
 func (c *Client) AccountCheckUsername(username string) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountCheckUsernameParams{Username: username})
+	return c.AccountCheckUsernameCtx(context.Background(), username)
+}
+
+// AccountCheckUsernameCtx is the context-aware variant of AccountCheckUsername.
+func (c *Client) AccountCheckUsernameCtx(ctx context.Context, username string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountCheckUsernameParams{Username: username})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountCheckUsername: %w", err)
 	}
@@ -152,7 +178,12 @@ func (*AccountClearRecentEmojiStatusesParams) CRC() uint32 {
 
 // Clears list of recently used emoji statuses
 func (c *Client) AccountClearRecentEmojiStatuses() (bool, error) {
-	responseData, err := c.MakeRequest(&AccountClearRecentEmojiStatusesParams{})
+	return c.AccountClearRecentEmojiStatusesCtx(context.Background())
+}
+
+// AccountClearRecentEmojiStatusesCtx is the context-aware variant of AccountClearRecentEmojiStatuses.
+func (c *Client) AccountClearRecentEmojiStatusesCtx(ctx context.Context) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountClearRecentEmojiStatusesParams{})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountClearRecentEmojiStatuses: %w", err)
 	}
@@ -174,7 +205,12 @@ func (*AccountConfirmPasswordEmailParams) CRC() uint32 {
 
 // Verify an email to use as 2FA recovery method.
 func (c *Client) AccountConfirmPasswordEmail(code string) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountConfirmPasswordEmailParams{Code: code})
+	return c.AccountConfirmPasswordEmailCtx(context.Background(), code)
+}
+
+// AccountConfirmPasswordEmailCtx is the context-aware variant of AccountConfirmPasswordEmail.
+func (c *Client) AccountConfirmPasswordEmailCtx(ctx context.Context, code string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountConfirmPasswordEmailParams{Code: code})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountConfirmPasswordEmail: %w", err)
 	}
@@ -197,7 +233,12 @@ func (*AccountConfirmPhoneParams) CRC() uint32 {
 
 // Confirm a phone number to cancel account deletion, for more info click here
 func (c *Client) AccountConfirmPhone(phoneCodeHash, phoneCode string) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountConfirmPhoneParams{
+	return c.AccountConfirmPhoneCtx(context.Background(), phoneCodeHash, phoneCode)
+}
+
+// AccountConfirmPhoneCtx is the context-aware variant of AccountConfirmPhone.
+func (c *Client) AccountConfirmPhoneCtx(ctx context.Context, phoneCodeHash, phoneCode string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountConfirmPhoneParams{
 		PhoneCode:     phoneCode,
 		PhoneCodeHash: phoneCodeHash,
 	})
@@ -222,7 +263,12 @@ func (*AccountCreateBusinessChatLinkParams) CRC() uint32 {
 
 // Create a business chat deep link.
 func (c *Client) AccountCreateBusinessChatLink(link *InputBusinessChatLink) (*BusinessChatLink, error) {
-	responseData, err := c.MakeRequest(&AccountCreateBusinessChatLinkParams{Link: link})
+	return c.AccountCreateBusinessChatLinkCtx(context.Background(), link)
+}
+
+// AccountCreateBusinessChatLinkCtx is the context-aware variant of AccountCreateBusinessChatLink.
+func (c *Client) AccountCreateBusinessChatLinkCtx(ctx context.Context, link *InputBusinessChatLink) (*BusinessChatLink, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountCreateBusinessChatLinkParams{Link: link})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountCreateBusinessChatLink: %w", err)
 	}
@@ -251,7 +297,12 @@ func (*AccountCreateThemeParams) FlagIndex() int {
 
 // Create a theme
 func (c *Client) AccountCreateTheme(slug, title string, document InputDocument, settings []*InputThemeSettings) (*Theme, error) {
-	responseData, err := c.MakeRequest(&AccountCreateThemeParams{
+	return c.AccountCreateThemeCtx(context.Background(), slug, title, document, settings)
+}
+
+// AccountCreateThemeCtx is the context-aware variant of AccountCreateTheme.
+func (c *Client) AccountCreateThemeCtx(ctx context.Context, slug, title string, document InputDocument, settings []*InputThemeSettings) (*Theme, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountCreateThemeParams{
 		Document: document,
 		Settings: settings,
 		Slug:     slug,
@@ -276,7 +327,12 @@ func (*AccountDeclinePasswordResetParams) CRC() uint32 {
 
 // Abort a pending 2FA password reset
 func (c *Client) AccountDeclinePasswordReset() (bool, error) {
-	responseData, err := c.MakeRequest(&AccountDeclinePasswordResetParams{})
+	return c.AccountDeclinePasswordResetCtx(context.Background())
+}
+
+// AccountDeclinePasswordResetCtx is the context-aware variant of AccountDeclinePasswordReset.
+func (c *Client) AccountDeclinePasswordResetCtx(ctx context.Context) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountDeclinePasswordResetParams{})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountDeclinePasswordReset: %w", err)
 	}
@@ -303,7 +359,12 @@ func (*AccountDeleteAccountParams) FlagIndex() int {
 
 // Delete the user's account from the telegram servers.
 func (c *Client) AccountDeleteAccount(reason string, password InputCheckPasswordSRP) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountDeleteAccountParams{
+	return c.AccountDeleteAccountCtx(context.Background(), reason, password)
+}
+
+// AccountDeleteAccountCtx is the context-aware variant of AccountDeleteAccount.
+func (c *Client) AccountDeleteAccountCtx(ctx context.Context, reason string, password InputCheckPasswordSRP) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountDeleteAccountParams{
 		Password: password,
 		Reason:   reason,
 	})
@@ -326,7 +387,12 @@ func (*AccountDeleteAutoSaveExceptionsParams) CRC() uint32 {
 
 // Clear all peer-specific autosave settings.
 func (c *Client) AccountDeleteAutoSaveExceptions() (bool, error) {
-	responseData, err := c.MakeRequest(&AccountDeleteAutoSaveExceptionsParams{})
+	return c.AccountDeleteAutoSaveExceptionsCtx(context.Background())
+}
+
+// AccountDeleteAutoSaveExceptionsCtx is the context-aware variant of AccountDeleteAutoSaveExceptions.
+func (c *Client) AccountDeleteAutoSaveExceptionsCtx(ctx context.Context) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountDeleteAutoSaveExceptionsParams{})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountDeleteAutoSaveExceptions: %w", err)
 	}
@@ -348,7 +414,12 @@ func (*AccountDeleteBusinessChatLinkParams) CRC() uint32 {
 
 // Delete a business chat deep link.
 func (c *Client) AccountDeleteBusinessChatLink(slug string) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountDeleteBusinessChatLinkParams{Slug: slug})
+	return c.AccountDeleteBusinessChatLinkCtx(context.Background(), slug)
+}
+
+// AccountDeleteBusinessChatLinkCtx is the context-aware variant of AccountDeleteBusinessChatLink.
+func (c *Client) AccountDeleteBusinessChatLinkCtx(ctx context.Context, slug string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountDeleteBusinessChatLinkParams{Slug: slug})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountDeleteBusinessChatLink: %w", err)
 	}
@@ -369,7 +440,12 @@ func (*AccountDeletePasskeyParams) CRC() uint32 {
 }
 
 func (c *Client) AccountDeletePasskey(id string) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountDeletePasskeyParams{ID: id})
+	return c.AccountDeletePasskeyCtx(context.Background(), id)
+}
+
+// AccountDeletePasskeyCtx is the context-aware variant of AccountDeletePasskey.
+func (c *Client) AccountDeletePasskeyCtx(ctx context.Context, id string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountDeletePasskeyParams{ID: id})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountDeletePasskey: %w", err)
 	}
@@ -391,7 +467,12 @@ func (*AccountDeleteSecureValueParams) CRC() uint32 {
 
 // Delete stored Telegram Passport documents, for more info see the passport docs
 func (c *Client) AccountDeleteSecureValue(types []SecureValueType) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountDeleteSecureValueParams{Types: types})
+	return c.AccountDeleteSecureValueCtx(context.Background(), types)
+}
+
+// AccountDeleteSecureValueCtx is the context-aware variant of AccountDeleteSecureValue.
+func (c *Client) AccountDeleteSecureValueCtx(ctx context.Context, types []SecureValueType) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountDeleteSecureValueParams{Types: types})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountDeleteSecureValue: %w", err)
 	}
@@ -413,7 +494,12 @@ func (*AccountDisablePeerConnectedBotParams) CRC() uint32 {
 
 // Permanently disconnect a specific chat from all business bots (equivalent to specifying it in `recipients.exclude_users` during initial configuration with account.updateConnectedBot ); to reconnect of a chat disconnected using this method the user must reconnect the entire bot by invoking account.updateConnectedBot.
 func (c *Client) AccountDisablePeerConnectedBot(peer InputPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountDisablePeerConnectedBotParams{Peer: peer})
+	return c.AccountDisablePeerConnectedBotCtx(context.Background(), peer)
+}
+
+// AccountDisablePeerConnectedBotCtx is the context-aware variant of AccountDisablePeerConnectedBot.
+func (c *Client) AccountDisablePeerConnectedBotCtx(ctx context.Context, peer InputPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountDisablePeerConnectedBotParams{Peer: peer})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountDisablePeerConnectedBot: %w", err)
 	}
@@ -436,7 +522,12 @@ func (*AccountEditBusinessChatLinkParams) CRC() uint32 {
 
 // Edit a created business chat deep link.
 func (c *Client) AccountEditBusinessChatLink(slug string, link *InputBusinessChatLink) (*BusinessChatLink, error) {
-	responseData, err := c.MakeRequest(&AccountEditBusinessChatLinkParams{
+	return c.AccountEditBusinessChatLinkCtx(context.Background(), slug, link)
+}
+
+// AccountEditBusinessChatLinkCtx is the context-aware variant of AccountEditBusinessChatLink.
+func (c *Client) AccountEditBusinessChatLinkCtx(ctx context.Context, slug string, link *InputBusinessChatLink) (*BusinessChatLink, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountEditBusinessChatLinkParams{
 		Link: link,
 		Slug: slug,
 	})
@@ -465,7 +556,12 @@ func (*AccountFinishTakeoutSessionParams) FlagIndex() int {
 
 // Terminate a takeout session
 func (c *Client) AccountFinishTakeoutSession(success bool) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountFinishTakeoutSessionParams{Success: success})
+	return c.AccountFinishTakeoutSessionCtx(context.Background(), success)
+}
+
+// AccountFinishTakeoutSessionCtx is the context-aware variant of AccountFinishTakeoutSession.
+func (c *Client) AccountFinishTakeoutSessionCtx(ctx context.Context, success bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountFinishTakeoutSessionParams{Success: success})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountFinishTakeoutSession: %w", err)
 	}
@@ -485,7 +581,12 @@ func (*AccountGetAccountTtlParams) CRC() uint32 {
 
 // Get days to live of account
 func (c *Client) AccountGetAccountTtl() (*AccountDaysTtl, error) {
-	responseData, err := c.MakeRequest(&AccountGetAccountTtlParams{})
+	return c.AccountGetAccountTtlCtx(context.Background())
+}
+
+// AccountGetAccountTtlCtx is the context-aware variant of AccountGetAccountTtl.
+func (c *Client) AccountGetAccountTtlCtx(ctx context.Context) (*AccountDaysTtl, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetAccountTtlParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetAccountTtl: %w", err)
 	}
@@ -505,7 +606,12 @@ func (*AccountGetAllSecureValuesParams) CRC() uint32 {
 
 // Get all saved Telegram Passport documents, for more info see the passport docs
 func (c *Client) AccountGetAllSecureValues() ([]*SecureValue, error) {
-	responseData, err := c.MakeRequest(&AccountGetAllSecureValuesParams{})
+	return c.AccountGetAllSecureValuesCtx(context.Background())
+}
+
+// AccountGetAllSecureValuesCtx is the context-aware variant of AccountGetAllSecureValues.
+func (c *Client) AccountGetAllSecureValuesCtx(ctx context.Context) ([]*SecureValue, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetAllSecureValuesParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetAllSecureValues: %w", err)
 	}
@@ -529,7 +635,12 @@ func (*AccountGetAuthorizationFormParams) CRC() uint32 {
 
 // Returns a Telegram Passport authorization form for sharing data with a service
 func (c *Client) AccountGetAuthorizationForm(botID int64, scope, publicKey string) (*AccountAuthorizationForm, error) {
-	responseData, err := c.MakeRequest(&AccountGetAuthorizationFormParams{
+	return c.AccountGetAuthorizationFormCtx(context.Background(), botID, scope, publicKey)
+}
+
+// AccountGetAuthorizationFormCtx is the context-aware variant of AccountGetAuthorizationForm.
+func (c *Client) AccountGetAuthorizationFormCtx(ctx context.Context, botID int64, scope, publicKey string) (*AccountAuthorizationForm, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetAuthorizationFormParams{
 		BotID:     botID,
 		PublicKey: publicKey,
 		Scope:     scope,
@@ -553,7 +664,12 @@ func (*AccountGetAuthorizationsParams) CRC() uint32 {
 
 // Get logged-in sessions
 func (c *Client) AccountGetAuthorizations() (*AccountAuthorizations, error) {
-	responseData, err := c.MakeRequest(&AccountGetAuthorizationsParams{})
+	return c.AccountGetAuthorizationsCtx(context.Background())
+}
+
+// AccountGetAuthorizationsCtx is the context-aware variant of AccountGetAuthorizations.
+func (c *Client) AccountGetAuthorizationsCtx(ctx context.Context) (*AccountAuthorizations, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetAuthorizationsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetAuthorizations: %w", err)
 	}
@@ -573,7 +689,12 @@ func (*AccountGetAutoDownloadSettingsParams) CRC() uint32 {
 
 // Get media autodownload settings
 func (c *Client) AccountGetAutoDownloadSettings() (*AccountAutoDownloadSettings, error) {
-	responseData, err := c.MakeRequest(&AccountGetAutoDownloadSettingsParams{})
+	return c.AccountGetAutoDownloadSettingsCtx(context.Background())
+}
+
+// AccountGetAutoDownloadSettingsCtx is the context-aware variant of AccountGetAutoDownloadSettings.
+func (c *Client) AccountGetAutoDownloadSettingsCtx(ctx context.Context) (*AccountAutoDownloadSettings, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetAutoDownloadSettingsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetAutoDownloadSettings: %w", err)
 	}
@@ -593,7 +714,12 @@ func (*AccountGetAutoSaveSettingsParams) CRC() uint32 {
 
 // Get autosave settings
 func (c *Client) AccountGetAutoSaveSettings() (*AccountAutoSaveSettings, error) {
-	responseData, err := c.MakeRequest(&AccountGetAutoSaveSettingsParams{})
+	return c.AccountGetAutoSaveSettingsCtx(context.Background())
+}
+
+// AccountGetAutoSaveSettingsCtx is the context-aware variant of AccountGetAutoSaveSettings.
+func (c *Client) AccountGetAutoSaveSettingsCtx(ctx context.Context) (*AccountAutoSaveSettings, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetAutoSaveSettingsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetAutoSaveSettings: %w", err)
 	}
@@ -615,7 +741,12 @@ func (*AccountGetBotBusinessConnectionParams) CRC() uint32 {
 
 // Bots may invoke this method to re-fetch the updateBotBusinessConnect constructor associated with a specific business `connection_id` This is needed for example for freshly logged in bots that are receiving some updateBotNewBusinessMessage, etc. updates because some users have already connected to the bot before it could login. In this case, the bot is receiving messages from the business connection, but it hasn't cached the associated updateBotBusinessConnect with info about the connection (can it reply to messages? etc.) yet, and cannot receive the old ones because they were sent when the bot wasn't logged into the session yet. This method can be used to fetch info about a not-yet-cached business connection, and should not be invoked if the info is already cached or to fetch changes, as eventual changes will automatically be sent as new updateBotBusinessConnect updates to the bot using the usual update delivery methods.
 func (c *Client) AccountGetBotBusinessConnection(connectionID string) (Updates, error) {
-	responseData, err := c.MakeRequest(&AccountGetBotBusinessConnectionParams{ConnectionID: connectionID})
+	return c.AccountGetBotBusinessConnectionCtx(context.Background(), connectionID)
+}
+
+// AccountGetBotBusinessConnectionCtx is the context-aware variant of AccountGetBotBusinessConnection.
+func (c *Client) AccountGetBotBusinessConnectionCtx(ctx context.Context, connectionID string) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetBotBusinessConnectionParams{ConnectionID: connectionID})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetBotBusinessConnection: %w", err)
 	}
@@ -635,7 +766,12 @@ func (*AccountGetBusinessChatLinksParams) CRC() uint32 {
 
 // List all created business chat deep links.
 func (c *Client) AccountGetBusinessChatLinks() (*AccountBusinessChatLinks, error) {
-	responseData, err := c.MakeRequest(&AccountGetBusinessChatLinksParams{})
+	return c.AccountGetBusinessChatLinksCtx(context.Background())
+}
+
+// AccountGetBusinessChatLinksCtx is the context-aware variant of AccountGetBusinessChatLinks.
+func (c *Client) AccountGetBusinessChatLinksCtx(ctx context.Context) (*AccountBusinessChatLinks, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetBusinessChatLinksParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetBusinessChatLinks: %w", err)
 	}
@@ -657,7 +793,12 @@ func (*AccountGetChannelDefaultEmojiStatusesParams) CRC() uint32 {
 
 // Get a list of default suggested channel emoji statuses.
 func (c *Client) AccountGetChannelDefaultEmojiStatuses(hash int64) (AccountEmojiStatuses, error) {
-	responseData, err := c.MakeRequest(&AccountGetChannelDefaultEmojiStatusesParams{Hash: hash})
+	return c.AccountGetChannelDefaultEmojiStatusesCtx(context.Background(), hash)
+}
+
+// AccountGetChannelDefaultEmojiStatusesCtx is the context-aware variant of AccountGetChannelDefaultEmojiStatuses.
+func (c *Client) AccountGetChannelDefaultEmojiStatusesCtx(ctx context.Context, hash int64) (AccountEmojiStatuses, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetChannelDefaultEmojiStatusesParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetChannelDefaultEmojiStatuses: %w", err)
 	}
@@ -679,7 +820,12 @@ func (*AccountGetChannelRestrictedStatusEmojisParams) CRC() uint32 {
 
 // Returns fetch the full list of custom emoji IDs that cannot be used in channel emoji statuses.
 func (c *Client) AccountGetChannelRestrictedStatusEmojis(hash int64) (EmojiList, error) {
-	responseData, err := c.MakeRequest(&AccountGetChannelRestrictedStatusEmojisParams{Hash: hash})
+	return c.AccountGetChannelRestrictedStatusEmojisCtx(context.Background(), hash)
+}
+
+// AccountGetChannelRestrictedStatusEmojisCtx is the context-aware variant of AccountGetChannelRestrictedStatusEmojis.
+func (c *Client) AccountGetChannelRestrictedStatusEmojisCtx(ctx context.Context, hash int64) (EmojiList, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetChannelRestrictedStatusEmojisParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetChannelRestrictedStatusEmojis: %w", err)
 	}
@@ -701,7 +847,12 @@ func (*AccountGetChatThemesParams) CRC() uint32 {
 
 // Get all available chat themes.
 func (c *Client) AccountGetChatThemes(hash int64) (AccountThemes, error) {
-	responseData, err := c.MakeRequest(&AccountGetChatThemesParams{Hash: hash})
+	return c.AccountGetChatThemesCtx(context.Background(), hash)
+}
+
+// AccountGetChatThemesCtx is the context-aware variant of AccountGetChatThemes.
+func (c *Client) AccountGetChatThemesCtx(ctx context.Context, hash int64) (AccountThemes, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetChatThemesParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetChatThemes: %w", err)
 	}
@@ -723,7 +874,12 @@ func (*AccountGetCollectibleEmojiStatusesParams) CRC() uint32 {
 
 // Obtain a list of emoji statuses for owned collectible gifts.
 func (c *Client) AccountGetCollectibleEmojiStatuses(hash int64) (AccountEmojiStatuses, error) {
-	responseData, err := c.MakeRequest(&AccountGetCollectibleEmojiStatusesParams{Hash: hash})
+	return c.AccountGetCollectibleEmojiStatusesCtx(context.Background(), hash)
+}
+
+// AccountGetCollectibleEmojiStatusesCtx is the context-aware variant of AccountGetCollectibleEmojiStatuses.
+func (c *Client) AccountGetCollectibleEmojiStatusesCtx(ctx context.Context, hash int64) (AccountEmojiStatuses, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetCollectibleEmojiStatusesParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetCollectibleEmojiStatuses: %w", err)
 	}
@@ -743,7 +899,12 @@ func (*AccountGetConnectedBotsParams) CRC() uint32 {
 
 // List all currently connected business bots
 func (c *Client) AccountGetConnectedBots() (*AccountConnectedBots, error) {
-	responseData, err := c.MakeRequest(&AccountGetConnectedBotsParams{})
+	return c.AccountGetConnectedBotsCtx(context.Background())
+}
+
+// AccountGetConnectedBotsCtx is the context-aware variant of AccountGetConnectedBots.
+func (c *Client) AccountGetConnectedBotsCtx(ctx context.Context) (*AccountConnectedBots, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetConnectedBotsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetConnectedBots: %w", err)
 	}
@@ -763,7 +924,12 @@ func (*AccountGetContactSignUpNotificationParams) CRC() uint32 {
 
 // Whether the user will receive notifications when contacts sign up
 func (c *Client) AccountGetContactSignUpNotification() (bool, error) {
-	responseData, err := c.MakeRequest(&AccountGetContactSignUpNotificationParams{})
+	return c.AccountGetContactSignUpNotificationCtx(context.Background())
+}
+
+// AccountGetContactSignUpNotificationCtx is the context-aware variant of AccountGetContactSignUpNotification.
+func (c *Client) AccountGetContactSignUpNotificationCtx(ctx context.Context) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetContactSignUpNotificationParams{})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountGetContactSignUpNotification: %w", err)
 	}
@@ -783,7 +949,12 @@ func (*AccountGetContentSettingsParams) CRC() uint32 {
 
 // Get sensitive content settings
 func (c *Client) AccountGetContentSettings() (*AccountContentSettings, error) {
-	responseData, err := c.MakeRequest(&AccountGetContentSettingsParams{})
+	return c.AccountGetContentSettingsCtx(context.Background())
+}
+
+// AccountGetContentSettingsCtx is the context-aware variant of AccountGetContentSettings.
+func (c *Client) AccountGetContentSettingsCtx(ctx context.Context) (*AccountContentSettings, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetContentSettingsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetContentSettings: %w", err)
 	}
@@ -805,7 +976,12 @@ func (*AccountGetDefaultBackgroundEmojisParams) CRC() uint32 {
 
 // Get a set of suggested custom emoji stickers that can be used in an accent color pattern.
 func (c *Client) AccountGetDefaultBackgroundEmojis(hash int64) (EmojiList, error) {
-	responseData, err := c.MakeRequest(&AccountGetDefaultBackgroundEmojisParams{Hash: hash})
+	return c.AccountGetDefaultBackgroundEmojisCtx(context.Background(), hash)
+}
+
+// AccountGetDefaultBackgroundEmojisCtx is the context-aware variant of AccountGetDefaultBackgroundEmojis.
+func (c *Client) AccountGetDefaultBackgroundEmojisCtx(ctx context.Context, hash int64) (EmojiList, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetDefaultBackgroundEmojisParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetDefaultBackgroundEmojis: %w", err)
 	}
@@ -827,7 +1003,12 @@ func (*AccountGetDefaultEmojiStatusesParams) CRC() uint32 {
 
 // Get a list of default suggested emoji statuses
 func (c *Client) AccountGetDefaultEmojiStatuses(hash int64) (AccountEmojiStatuses, error) {
-	responseData, err := c.MakeRequest(&AccountGetDefaultEmojiStatusesParams{Hash: hash})
+	return c.AccountGetDefaultEmojiStatusesCtx(context.Background(), hash)
+}
+
+// AccountGetDefaultEmojiStatusesCtx is the context-aware variant of AccountGetDefaultEmojiStatuses.
+func (c *Client) AccountGetDefaultEmojiStatusesCtx(ctx context.Context, hash int64) (AccountEmojiStatuses, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetDefaultEmojiStatusesParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetDefaultEmojiStatuses: %w", err)
 	}
@@ -849,7 +1030,12 @@ func (*AccountGetDefaultGroupPhotoEmojisParams) CRC() uint32 {
 
 // Get a set of suggested custom emoji stickers that can be used as group picture
 func (c *Client) AccountGetDefaultGroupPhotoEmojis(hash int64) (EmojiList, error) {
-	responseData, err := c.MakeRequest(&AccountGetDefaultGroupPhotoEmojisParams{Hash: hash})
+	return c.AccountGetDefaultGroupPhotoEmojisCtx(context.Background(), hash)
+}
+
+// AccountGetDefaultGroupPhotoEmojisCtx is the context-aware variant of AccountGetDefaultGroupPhotoEmojis.
+func (c *Client) AccountGetDefaultGroupPhotoEmojisCtx(ctx context.Context, hash int64) (EmojiList, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetDefaultGroupPhotoEmojisParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetDefaultGroupPhotoEmojis: %w", err)
 	}
@@ -871,7 +1057,12 @@ func (*AccountGetDefaultProfilePhotoEmojisParams) CRC() uint32 {
 
 // Get a set of suggested custom emoji stickers that can be used as profile picture
 func (c *Client) AccountGetDefaultProfilePhotoEmojis(hash int64) (EmojiList, error) {
-	responseData, err := c.MakeRequest(&AccountGetDefaultProfilePhotoEmojisParams{Hash: hash})
+	return c.AccountGetDefaultProfilePhotoEmojisCtx(context.Background(), hash)
+}
+
+// AccountGetDefaultProfilePhotoEmojisCtx is the context-aware variant of AccountGetDefaultProfilePhotoEmojis.
+func (c *Client) AccountGetDefaultProfilePhotoEmojisCtx(ctx context.Context, hash int64) (EmojiList, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetDefaultProfilePhotoEmojisParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetDefaultProfilePhotoEmojis: %w", err)
 	}
@@ -891,7 +1082,12 @@ func (*AccountGetGlobalPrivacySettingsParams) CRC() uint32 {
 
 // Get global privacy settings
 func (c *Client) AccountGetGlobalPrivacySettings() (*GlobalPrivacySettings, error) {
-	responseData, err := c.MakeRequest(&AccountGetGlobalPrivacySettingsParams{})
+	return c.AccountGetGlobalPrivacySettingsCtx(context.Background())
+}
+
+// AccountGetGlobalPrivacySettingsCtx is the context-aware variant of AccountGetGlobalPrivacySettings.
+func (c *Client) AccountGetGlobalPrivacySettingsCtx(ctx context.Context) (*GlobalPrivacySettings, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetGlobalPrivacySettingsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetGlobalPrivacySettings: %w", err)
 	}
@@ -913,7 +1109,12 @@ func (*AccountGetMultiWallPapersParams) CRC() uint32 {
 
 // Get info about multiple wallpapers
 func (c *Client) AccountGetMultiWallPapers(wallpapers []InputWallPaper) ([]WallPaper, error) {
-	responseData, err := c.MakeRequest(&AccountGetMultiWallPapersParams{Wallpapers: wallpapers})
+	return c.AccountGetMultiWallPapersCtx(context.Background(), wallpapers)
+}
+
+// AccountGetMultiWallPapersCtx is the context-aware variant of AccountGetMultiWallPapers.
+func (c *Client) AccountGetMultiWallPapersCtx(ctx context.Context, wallpapers []InputWallPaper) ([]WallPaper, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetMultiWallPapersParams{Wallpapers: wallpapers})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetMultiWallPapers: %w", err)
 	}
@@ -941,7 +1142,12 @@ func (*AccountGetNotifyExceptionsParams) FlagIndex() int {
 
 // Returns list of chats with non-default notification settings
 func (c *Client) AccountGetNotifyExceptions(compareSound, compareStories bool, peer InputNotifyPeer) (Updates, error) {
-	responseData, err := c.MakeRequest(&AccountGetNotifyExceptionsParams{
+	return c.AccountGetNotifyExceptionsCtx(context.Background(), compareSound, compareStories, peer)
+}
+
+// AccountGetNotifyExceptionsCtx is the context-aware variant of AccountGetNotifyExceptions.
+func (c *Client) AccountGetNotifyExceptionsCtx(ctx context.Context, compareSound, compareStories bool, peer InputNotifyPeer) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetNotifyExceptionsParams{
 		CompareSound:   compareSound,
 		CompareStories: compareStories,
 		Peer:           peer,
@@ -967,7 +1173,12 @@ func (*AccountGetNotifySettingsParams) CRC() uint32 {
 
 // Gets current notification settings for a given user/group, from all users/all groups.
 func (c *Client) AccountGetNotifySettings(peer InputNotifyPeer) (*PeerNotifySettings, error) {
-	responseData, err := c.MakeRequest(&AccountGetNotifySettingsParams{Peer: peer})
+	return c.AccountGetNotifySettingsCtx(context.Background(), peer)
+}
+
+// AccountGetNotifySettingsCtx is the context-aware variant of AccountGetNotifySettings.
+func (c *Client) AccountGetNotifySettingsCtx(ctx context.Context, peer InputNotifyPeer) (*PeerNotifySettings, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetNotifySettingsParams{Peer: peer})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetNotifySettings: %w", err)
 	}
@@ -994,7 +1205,12 @@ func (*AccountGetPaidMessagesRevenueParams) FlagIndex() int {
 
 // Get the number of stars we have received from the specified user thanks to paid messages ; the received amount will be equal to the sent amount multiplied by stars_paid_message_commission_permille divided by 1000.
 func (c *Client) AccountGetPaidMessagesRevenue(parentPeer InputPeer, userID InputUser) (*AccountPaidMessagesRevenue, error) {
-	responseData, err := c.MakeRequest(&AccountGetPaidMessagesRevenueParams{
+	return c.AccountGetPaidMessagesRevenueCtx(context.Background(), parentPeer, userID)
+}
+
+// AccountGetPaidMessagesRevenueCtx is the context-aware variant of AccountGetPaidMessagesRevenue.
+func (c *Client) AccountGetPaidMessagesRevenueCtx(ctx context.Context, parentPeer InputPeer, userID InputUser) (*AccountPaidMessagesRevenue, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetPaidMessagesRevenueParams{
 		ParentPeer: parentPeer,
 		UserID:     userID,
 	})
@@ -1016,7 +1232,12 @@ func (*AccountGetPasskeysParams) CRC() uint32 {
 }
 
 func (c *Client) AccountGetPasskeys() (*AccountPasskeys, error) {
-	responseData, err := c.MakeRequest(&AccountGetPasskeysParams{})
+	return c.AccountGetPasskeysCtx(context.Background())
+}
+
+// AccountGetPasskeysCtx is the context-aware variant of AccountGetPasskeys.
+func (c *Client) AccountGetPasskeysCtx(ctx context.Context) (*AccountPasskeys, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetPasskeysParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetPasskeys: %w", err)
 	}
@@ -1036,7 +1257,12 @@ func (*AccountGetPasswordParams) CRC() uint32 {
 
 // Obtain configuration for two-factor authorization with password
 func (c *Client) AccountGetPassword() (*AccountPassword, error) {
-	responseData, err := c.MakeRequest(&AccountGetPasswordParams{})
+	return c.AccountGetPasswordCtx(context.Background())
+}
+
+// AccountGetPasswordCtx is the context-aware variant of AccountGetPassword.
+func (c *Client) AccountGetPasswordCtx(ctx context.Context) (*AccountPassword, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetPasswordParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetPassword: %w", err)
 	}
@@ -1058,7 +1284,12 @@ func (*AccountGetPasswordSettingsParams) CRC() uint32 {
 
 // Get private info associated to the password info (recovery email, telegram passport info & so on)
 func (c *Client) AccountGetPasswordSettings(password InputCheckPasswordSRP) (*AccountPasswordSettings, error) {
-	responseData, err := c.MakeRequest(&AccountGetPasswordSettingsParams{Password: password})
+	return c.AccountGetPasswordSettingsCtx(context.Background(), password)
+}
+
+// AccountGetPasswordSettingsCtx is the context-aware variant of AccountGetPasswordSettings.
+func (c *Client) AccountGetPasswordSettingsCtx(ctx context.Context, password InputCheckPasswordSRP) (*AccountPasswordSettings, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetPasswordSettingsParams{Password: password})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetPasswordSettings: %w", err)
 	}
@@ -1080,7 +1311,12 @@ func (*AccountGetPrivacyParams) CRC() uint32 {
 
 // Get privacy settings of current account
 func (c *Client) AccountGetPrivacy(key InputPrivacyKey) (*AccountPrivacyRules, error) {
-	responseData, err := c.MakeRequest(&AccountGetPrivacyParams{Key: key})
+	return c.AccountGetPrivacyCtx(context.Background(), key)
+}
+
+// AccountGetPrivacyCtx is the context-aware variant of AccountGetPrivacy.
+func (c *Client) AccountGetPrivacyCtx(ctx context.Context, key InputPrivacyKey) (*AccountPrivacyRules, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetPrivacyParams{Key: key})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetPrivacy: %w", err)
 	}
@@ -1100,7 +1336,12 @@ func (*AccountGetReactionsNotifySettingsParams) CRC() uint32 {
 
 // Get the current reaction notification settings.
 func (c *Client) AccountGetReactionsNotifySettings() (*ReactionsNotifySettings, error) {
-	responseData, err := c.MakeRequest(&AccountGetReactionsNotifySettingsParams{})
+	return c.AccountGetReactionsNotifySettingsCtx(context.Background())
+}
+
+// AccountGetReactionsNotifySettingsCtx is the context-aware variant of AccountGetReactionsNotifySettings.
+func (c *Client) AccountGetReactionsNotifySettingsCtx(ctx context.Context) (*ReactionsNotifySettings, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetReactionsNotifySettingsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetReactionsNotifySettings: %w", err)
 	}
@@ -1122,7 +1363,12 @@ func (*AccountGetRecentEmojiStatusesParams) CRC() uint32 {
 
 // Get recently used emoji statuses
 func (c *Client) AccountGetRecentEmojiStatuses(hash int64) (AccountEmojiStatuses, error) {
-	responseData, err := c.MakeRequest(&AccountGetRecentEmojiStatusesParams{Hash: hash})
+	return c.AccountGetRecentEmojiStatusesCtx(context.Background(), hash)
+}
+
+// AccountGetRecentEmojiStatusesCtx is the context-aware variant of AccountGetRecentEmojiStatuses.
+func (c *Client) AccountGetRecentEmojiStatusesCtx(ctx context.Context, hash int64) (AccountEmojiStatuses, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetRecentEmojiStatusesParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetRecentEmojiStatuses: %w", err)
 	}
@@ -1144,7 +1390,12 @@ func (*AccountGetSavedMusicIdsParams) CRC() uint32 {
 
 // Fetch the full list of only the IDs of songs currently added to the profile
 func (c *Client) AccountGetSavedMusicIds(hash int64) (AccountSavedMusicIds, error) {
-	responseData, err := c.MakeRequest(&AccountGetSavedMusicIdsParams{Hash: hash})
+	return c.AccountGetSavedMusicIdsCtx(context.Background(), hash)
+}
+
+// AccountGetSavedMusicIdsCtx is the context-aware variant of AccountGetSavedMusicIds.
+func (c *Client) AccountGetSavedMusicIdsCtx(ctx context.Context, hash int64) (AccountSavedMusicIds, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetSavedMusicIdsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetSavedMusicIds: %w", err)
 	}
@@ -1166,7 +1417,12 @@ func (*AccountGetSavedRingtonesParams) CRC() uint32 {
 
 // Fetch saved notification sounds
 func (c *Client) AccountGetSavedRingtones(hash int64) (AccountSavedRingtones, error) {
-	responseData, err := c.MakeRequest(&AccountGetSavedRingtonesParams{Hash: hash})
+	return c.AccountGetSavedRingtonesCtx(context.Background(), hash)
+}
+
+// AccountGetSavedRingtonesCtx is the context-aware variant of AccountGetSavedRingtones.
+func (c *Client) AccountGetSavedRingtonesCtx(ctx context.Context, hash int64) (AccountSavedRingtones, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetSavedRingtonesParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetSavedRingtones: %w", err)
 	}
@@ -1188,7 +1444,12 @@ func (*AccountGetSecureValueParams) CRC() uint32 {
 
 // Get saved Telegram Passport document, for more info see the passport docs
 func (c *Client) AccountGetSecureValue(types []SecureValueType) ([]*SecureValue, error) {
-	responseData, err := c.MakeRequest(&AccountGetSecureValueParams{Types: types})
+	return c.AccountGetSecureValueCtx(context.Background(), types)
+}
+
+// AccountGetSecureValueCtx is the context-aware variant of AccountGetSecureValue.
+func (c *Client) AccountGetSecureValueCtx(ctx context.Context, types []SecureValueType) ([]*SecureValue, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetSecureValueParams{Types: types})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetSecureValue: %w", err)
 	}
@@ -1211,7 +1472,12 @@ func (*AccountGetThemeParams) CRC() uint32 {
 
 // Get theme information
 func (c *Client) AccountGetTheme(format string, theme InputTheme) (*Theme, error) {
-	responseData, err := c.MakeRequest(&AccountGetThemeParams{
+	return c.AccountGetThemeCtx(context.Background(), format, theme)
+}
+
+// AccountGetThemeCtx is the context-aware variant of AccountGetTheme.
+func (c *Client) AccountGetThemeCtx(ctx context.Context, format string, theme InputTheme) (*Theme, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetThemeParams{
 		Format: format,
 		Theme:  theme,
 	})
@@ -1237,7 +1503,12 @@ func (*AccountGetThemesParams) CRC() uint32 {
 
 // Get installed themes
 func (c *Client) AccountGetThemes(format string, hash int64) (AccountThemes, error) {
-	responseData, err := c.MakeRequest(&AccountGetThemesParams{
+	return c.AccountGetThemesCtx(context.Background(), format, hash)
+}
+
+// AccountGetThemesCtx is the context-aware variant of AccountGetThemes.
+func (c *Client) AccountGetThemesCtx(ctx context.Context, format string, hash int64) (AccountThemes, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetThemesParams{
 		Format: format,
 		Hash:   hash,
 	})
@@ -1263,7 +1534,12 @@ func (*AccountGetTmpPasswordParams) CRC() uint32 {
 
 // Get temporary payment password
 func (c *Client) AccountGetTmpPassword(password InputCheckPasswordSRP, period int32) (*AccountTmpPassword, error) {
-	responseData, err := c.MakeRequest(&AccountGetTmpPasswordParams{
+	return c.AccountGetTmpPasswordCtx(context.Background(), password, period)
+}
+
+// AccountGetTmpPasswordCtx is the context-aware variant of AccountGetTmpPassword.
+func (c *Client) AccountGetTmpPasswordCtx(ctx context.Context, password InputCheckPasswordSRP, period int32) (*AccountTmpPassword, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetTmpPasswordParams{
 		Password: password,
 		Period:   period,
 	})
@@ -1290,7 +1566,12 @@ func (*AccountGetUniqueGiftChatThemesParams) CRC() uint32 {
 
 // Obtain all chat themes associated to owned collectible gifts.
 func (c *Client) AccountGetUniqueGiftChatThemes(offset string, limit int32, hash int64) (AccountChatThemes, error) {
-	responseData, err := c.MakeRequest(&AccountGetUniqueGiftChatThemesParams{
+	return c.AccountGetUniqueGiftChatThemesCtx(context.Background(), offset, limit, hash)
+}
+
+// AccountGetUniqueGiftChatThemesCtx is the context-aware variant of AccountGetUniqueGiftChatThemes.
+func (c *Client) AccountGetUniqueGiftChatThemesCtx(ctx context.Context, offset string, limit int32, hash int64) (AccountChatThemes, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetUniqueGiftChatThemesParams{
 		Hash:   hash,
 		Limit:  limit,
 		Offset: offset,
@@ -1316,7 +1597,12 @@ func (*AccountGetWallPaperParams) CRC() uint32 {
 
 // Get info about a certain wallpaper
 func (c *Client) AccountGetWallPaper(wallpaper InputWallPaper) (WallPaper, error) {
-	responseData, err := c.MakeRequest(&AccountGetWallPaperParams{Wallpaper: wallpaper})
+	return c.AccountGetWallPaperCtx(context.Background(), wallpaper)
+}
+
+// AccountGetWallPaperCtx is the context-aware variant of AccountGetWallPaper.
+func (c *Client) AccountGetWallPaperCtx(ctx context.Context, wallpaper InputWallPaper) (WallPaper, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetWallPaperParams{Wallpaper: wallpaper})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetWallPaper: %w", err)
 	}
@@ -1338,7 +1624,12 @@ func (*AccountGetWallPapersParams) CRC() uint32 {
 
 // Returns a list of available wallpapers.
 func (c *Client) AccountGetWallPapers(hash int64) (AccountWallPapers, error) {
-	responseData, err := c.MakeRequest(&AccountGetWallPapersParams{Hash: hash})
+	return c.AccountGetWallPapersCtx(context.Background(), hash)
+}
+
+// AccountGetWallPapersCtx is the context-aware variant of AccountGetWallPapers.
+func (c *Client) AccountGetWallPapersCtx(ctx context.Context, hash int64) (AccountWallPapers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetWallPapersParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetWallPapers: %w", err)
 	}
@@ -1358,7 +1649,12 @@ func (*AccountGetWebAuthorizationsParams) CRC() uint32 {
 
 // Get web login widget authorizations
 func (c *Client) AccountGetWebAuthorizations() (*AccountWebAuthorizations, error) {
-	responseData, err := c.MakeRequest(&AccountGetWebAuthorizationsParams{})
+	return c.AccountGetWebAuthorizationsCtx(context.Background())
+}
+
+// AccountGetWebAuthorizationsCtx is the context-aware variant of AccountGetWebAuthorizations.
+func (c *Client) AccountGetWebAuthorizationsCtx(ctx context.Context) (*AccountWebAuthorizations, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountGetWebAuthorizationsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountGetWebAuthorizations: %w", err)
 	}
@@ -1377,7 +1673,12 @@ func (*AccountInitPasskeyRegistrationParams) CRC() uint32 {
 }
 
 func (c *Client) AccountInitPasskeyRegistration() (*AccountPasskeyRegistrationOptions, error) {
-	responseData, err := c.MakeRequest(&AccountInitPasskeyRegistrationParams{})
+	return c.AccountInitPasskeyRegistrationCtx(context.Background())
+}
+
+// AccountInitPasskeyRegistrationCtx is the context-aware variant of AccountInitPasskeyRegistration.
+func (c *Client) AccountInitPasskeyRegistrationCtx(ctx context.Context) (*AccountPasskeyRegistrationOptions, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountInitPasskeyRegistrationParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountInitPasskeyRegistration: %w", err)
 	}
@@ -1409,7 +1710,12 @@ func (*AccountInitTakeoutSessionParams) FlagIndex() int {
 
 // Initialize a takeout session
 func (c *Client) AccountInitTakeoutSession(params *AccountInitTakeoutSessionParams) (*AccountTakeout, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.AccountInitTakeoutSessionCtx(context.Background(), params)
+}
+
+// AccountInitTakeoutSessionCtx is the context-aware variant of AccountInitTakeoutSession.
+func (c *Client) AccountInitTakeoutSessionCtx(ctx context.Context, params *AccountInitTakeoutSessionParams) (*AccountTakeout, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountInitTakeoutSession: %w", err)
 	}
@@ -1438,7 +1744,12 @@ func (*AccountInstallThemeParams) FlagIndex() int {
 
 // Install a theme
 func (c *Client) AccountInstallTheme(dark bool, theme InputTheme, format string, baseTheme BaseTheme) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountInstallThemeParams{
+	return c.AccountInstallThemeCtx(context.Background(), dark, theme, format, baseTheme)
+}
+
+// AccountInstallThemeCtx is the context-aware variant of AccountInstallTheme.
+func (c *Client) AccountInstallThemeCtx(ctx context.Context, dark bool, theme InputTheme, format string, baseTheme BaseTheme) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountInstallThemeParams{
 		BaseTheme: baseTheme,
 		Dark:      dark,
 		Format:    format,
@@ -1466,7 +1777,12 @@ func (*AccountInstallWallPaperParams) CRC() uint32 {
 
 // Install wallpaper
 func (c *Client) AccountInstallWallPaper(wallpaper InputWallPaper, settings *WallPaperSettings) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountInstallWallPaperParams{
+	return c.AccountInstallWallPaperCtx(context.Background(), wallpaper, settings)
+}
+
+// AccountInstallWallPaperCtx is the context-aware variant of AccountInstallWallPaper.
+func (c *Client) AccountInstallWallPaperCtx(ctx context.Context, wallpaper InputWallPaper, settings *WallPaperSettings) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountInstallWallPaperParams{
 		Settings:  settings,
 		Wallpaper: wallpaper,
 	})
@@ -1491,7 +1807,12 @@ func (*AccountInvalidateSignInCodesParams) CRC() uint32 {
 
 // Invalidate the specified login codes
 func (c *Client) AccountInvalidateSignInCodes(codes []string) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountInvalidateSignInCodesParams{Codes: codes})
+	return c.AccountInvalidateSignInCodesCtx(context.Background(), codes)
+}
+
+// AccountInvalidateSignInCodesCtx is the context-aware variant of AccountInvalidateSignInCodes.
+func (c *Client) AccountInvalidateSignInCodesCtx(ctx context.Context, codes []string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountInvalidateSignInCodesParams{Codes: codes})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountInvalidateSignInCodes: %w", err)
 	}
@@ -1522,7 +1843,12 @@ func (*AccountRegisterDeviceParams) FlagIndex() int {
 
 // Register device to receive PUSH notifications
 func (c *Client) AccountRegisterDevice(params *AccountRegisterDeviceParams) (bool, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.AccountRegisterDeviceCtx(context.Background(), params)
+}
+
+// AccountRegisterDeviceCtx is the context-aware variant of AccountRegisterDevice.
+func (c *Client) AccountRegisterDeviceCtx(ctx context.Context, params *AccountRegisterDeviceParams) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return false, fmt.Errorf("sending AccountRegisterDevice: %w", err)
 	}
@@ -1543,7 +1869,12 @@ func (*AccountRegisterPasskeyParams) CRC() uint32 {
 }
 
 func (c *Client) AccountRegisterPasskey(credential InputPasskeyCredential) (*Passkey, error) {
-	responseData, err := c.MakeRequest(&AccountRegisterPasskeyParams{Credential: credential})
+	return c.AccountRegisterPasskeyCtx(context.Background(), credential)
+}
+
+// AccountRegisterPasskeyCtx is the context-aware variant of AccountRegisterPasskey.
+func (c *Client) AccountRegisterPasskeyCtx(ctx context.Context, credential InputPasskeyCredential) (*Passkey, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountRegisterPasskeyParams{Credential: credential})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountRegisterPasskey: %w", err)
 	}
@@ -1565,7 +1896,12 @@ func (*AccountReorderUsernamesParams) CRC() uint32 {
 
 // Reorder usernames associated with the currently logged-in user.
 func (c *Client) AccountReorderUsernames(order []string) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountReorderUsernamesParams{Order: order})
+	return c.AccountReorderUsernamesCtx(context.Background(), order)
+}
+
+// AccountReorderUsernamesCtx is the context-aware variant of AccountReorderUsernames.
+func (c *Client) AccountReorderUsernamesCtx(ctx context.Context, order []string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountReorderUsernamesParams{Order: order})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountReorderUsernames: %w", err)
 	}
@@ -1589,7 +1925,12 @@ func (*AccountReportPeerParams) CRC() uint32 {
 
 // Report a peer for violation of telegram's Terms of Service
 func (c *Client) AccountReportPeer(peer InputPeer, reason ReportReason, message string) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountReportPeerParams{
+	return c.AccountReportPeerCtx(context.Background(), peer, reason, message)
+}
+
+// AccountReportPeerCtx is the context-aware variant of AccountReportPeer.
+func (c *Client) AccountReportPeerCtx(ctx context.Context, peer InputPeer, reason ReportReason, message string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountReportPeerParams{
 		Message: message,
 		Peer:    peer,
 		Reason:  reason,
@@ -1618,7 +1959,12 @@ func (*AccountReportProfilePhotoParams) CRC() uint32 {
 
 // Report a profile photo of a dialog
 func (c *Client) AccountReportProfilePhoto(peer InputPeer, photoID InputPhoto, reason ReportReason, message string) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountReportProfilePhotoParams{
+	return c.AccountReportProfilePhotoCtx(context.Background(), peer, photoID, reason, message)
+}
+
+// AccountReportProfilePhotoCtx is the context-aware variant of AccountReportProfilePhoto.
+func (c *Client) AccountReportProfilePhotoCtx(ctx context.Context, peer InputPeer, photoID InputPhoto, reason ReportReason, message string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountReportProfilePhotoParams{
 		Message: message,
 		Peer:    peer,
 		PhotoID: photoID,
@@ -1643,7 +1989,12 @@ func (*AccountResendPasswordEmailParams) CRC() uint32 {
 
 // Resend the code to verify an email to use as 2FA recovery method.
 func (c *Client) AccountResendPasswordEmail() (bool, error) {
-	responseData, err := c.MakeRequest(&AccountResendPasswordEmailParams{})
+	return c.AccountResendPasswordEmailCtx(context.Background())
+}
+
+// AccountResendPasswordEmailCtx is the context-aware variant of AccountResendPasswordEmail.
+func (c *Client) AccountResendPasswordEmailCtx(ctx context.Context) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountResendPasswordEmailParams{})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountResendPasswordEmail: %w", err)
 	}
@@ -1665,7 +2016,12 @@ func (*AccountResetAuthorizationParams) CRC() uint32 {
 
 // Log out an active authorized session by its hash
 func (c *Client) AccountResetAuthorization(hash int64) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountResetAuthorizationParams{Hash: hash})
+	return c.AccountResetAuthorizationCtx(context.Background(), hash)
+}
+
+// AccountResetAuthorizationCtx is the context-aware variant of AccountResetAuthorization.
+func (c *Client) AccountResetAuthorizationCtx(ctx context.Context, hash int64) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountResetAuthorizationParams{Hash: hash})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountResetAuthorization: %w", err)
 	}
@@ -1685,7 +2041,12 @@ func (*AccountResetNotifySettingsParams) CRC() uint32 {
 
 // Resets all notification settings from users and groups.
 func (c *Client) AccountResetNotifySettings() (bool, error) {
-	responseData, err := c.MakeRequest(&AccountResetNotifySettingsParams{})
+	return c.AccountResetNotifySettingsCtx(context.Background())
+}
+
+// AccountResetNotifySettingsCtx is the context-aware variant of AccountResetNotifySettings.
+func (c *Client) AccountResetNotifySettingsCtx(ctx context.Context) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountResetNotifySettingsParams{})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountResetNotifySettings: %w", err)
 	}
@@ -1705,7 +2066,12 @@ func (*AccountResetPasswordParams) CRC() uint32 {
 
 // Initiate a 2FA password reset: can only be used if the user is already logged-in
 func (c *Client) AccountResetPassword() (AccountResetPasswordResult, error) {
-	responseData, err := c.MakeRequest(&AccountResetPasswordParams{})
+	return c.AccountResetPasswordCtx(context.Background())
+}
+
+// AccountResetPasswordCtx is the context-aware variant of AccountResetPassword.
+func (c *Client) AccountResetPasswordCtx(ctx context.Context) (AccountResetPasswordResult, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountResetPasswordParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountResetPassword: %w", err)
 	}
@@ -1725,7 +2091,12 @@ func (*AccountResetWallPapersParams) CRC() uint32 {
 
 // Delete all installed wallpapers, reverting to the default wallpaper set.
 func (c *Client) AccountResetWallPapers() (bool, error) {
-	responseData, err := c.MakeRequest(&AccountResetWallPapersParams{})
+	return c.AccountResetWallPapersCtx(context.Background())
+}
+
+// AccountResetWallPapersCtx is the context-aware variant of AccountResetWallPapers.
+func (c *Client) AccountResetWallPapersCtx(ctx context.Context) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountResetWallPapersParams{})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountResetWallPapers: %w", err)
 	}
@@ -1747,7 +2118,12 @@ func (*AccountResetWebAuthorizationParams) CRC() uint32 {
 
 // Log out an active web telegram login session
 func (c *Client) AccountResetWebAuthorization(hash int64) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountResetWebAuthorizationParams{Hash: hash})
+	return c.AccountResetWebAuthorizationCtx(context.Background(), hash)
+}
+
+// AccountResetWebAuthorizationCtx is the context-aware variant of AccountResetWebAuthorization.
+func (c *Client) AccountResetWebAuthorizationCtx(ctx context.Context, hash int64) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountResetWebAuthorizationParams{Hash: hash})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountResetWebAuthorization: %w", err)
 	}
@@ -1767,7 +2143,12 @@ func (*AccountResetWebAuthorizationsParams) CRC() uint32 {
 
 // Reset all active web telegram login sessions
 func (c *Client) AccountResetWebAuthorizations() (bool, error) {
-	responseData, err := c.MakeRequest(&AccountResetWebAuthorizationsParams{})
+	return c.AccountResetWebAuthorizationsCtx(context.Background())
+}
+
+// AccountResetWebAuthorizationsCtx is the context-aware variant of AccountResetWebAuthorizations.
+func (c *Client) AccountResetWebAuthorizationsCtx(ctx context.Context) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountResetWebAuthorizationsParams{})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountResetWebAuthorizations: %w", err)
 	}
@@ -1789,7 +2170,12 @@ func (*AccountResolveBusinessChatLinkParams) CRC() uint32 {
 
 // Resolve a business chat deep link.
 func (c *Client) AccountResolveBusinessChatLink(slug string) (*AccountResolvedBusinessChatLinks, error) {
-	responseData, err := c.MakeRequest(&AccountResolveBusinessChatLinkParams{Slug: slug})
+	return c.AccountResolveBusinessChatLinkCtx(context.Background(), slug)
+}
+
+// AccountResolveBusinessChatLinkCtx is the context-aware variant of AccountResolveBusinessChatLink.
+func (c *Client) AccountResolveBusinessChatLinkCtx(ctx context.Context, slug string) (*AccountResolvedBusinessChatLinks, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountResolveBusinessChatLinkParams{Slug: slug})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountResolveBusinessChatLink: %w", err)
 	}
@@ -1817,7 +2203,12 @@ func (*AccountSaveAutoDownloadSettingsParams) FlagIndex() int {
 
 // Change media autodownload settings
 func (c *Client) AccountSaveAutoDownloadSettings(low, high bool, settings *AutoDownloadSettings) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountSaveAutoDownloadSettingsParams{
+	return c.AccountSaveAutoDownloadSettingsCtx(context.Background(), low, high, settings)
+}
+
+// AccountSaveAutoDownloadSettingsCtx is the context-aware variant of AccountSaveAutoDownloadSettings.
+func (c *Client) AccountSaveAutoDownloadSettingsCtx(ctx context.Context, low, high bool, settings *AutoDownloadSettings) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSaveAutoDownloadSettingsParams{
 		High:     high,
 		Low:      low,
 		Settings: settings,
@@ -1851,7 +2242,12 @@ func (*AccountSaveAutoSaveSettingsParams) FlagIndex() int {
 
 // Modify autosave settings
 func (c *Client) AccountSaveAutoSaveSettings(params *AccountSaveAutoSaveSettingsParams) (bool, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.AccountSaveAutoSaveSettingsCtx(context.Background(), params)
+}
+
+// AccountSaveAutoSaveSettingsCtx is the context-aware variant of AccountSaveAutoSaveSettings.
+func (c *Client) AccountSaveAutoSaveSettingsCtx(ctx context.Context, params *AccountSaveAutoSaveSettingsParams) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return false, fmt.Errorf("sending AccountSaveAutoSaveSettings: %w", err)
 	}
@@ -1879,7 +2275,12 @@ func (*AccountSaveMusicParams) FlagIndex() int {
 
 // Adds or removes a song from the current user's profile
 func (c *Client) AccountSaveMusic(unsave bool, id, afterID InputDocument) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountSaveMusicParams{
+	return c.AccountSaveMusicCtx(context.Background(), unsave, id, afterID)
+}
+
+// AccountSaveMusicCtx is the context-aware variant of AccountSaveMusic.
+func (c *Client) AccountSaveMusicCtx(ctx context.Context, unsave bool, id, afterID InputDocument) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSaveMusicParams{
 		AfterID: afterID,
 		ID:      id,
 		Unsave:  unsave,
@@ -1906,7 +2307,12 @@ func (*AccountSaveRingtoneParams) CRC() uint32 {
 
 // Save or remove saved notification sound.
 func (c *Client) AccountSaveRingtone(id InputDocument, unsave bool) (AccountSavedRingtone, error) {
-	responseData, err := c.MakeRequest(&AccountSaveRingtoneParams{
+	return c.AccountSaveRingtoneCtx(context.Background(), id, unsave)
+}
+
+// AccountSaveRingtoneCtx is the context-aware variant of AccountSaveRingtone.
+func (c *Client) AccountSaveRingtoneCtx(ctx context.Context, id InputDocument, unsave bool) (AccountSavedRingtone, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSaveRingtoneParams{
 		ID:     id,
 		Unsave: unsave,
 	})
@@ -1932,7 +2338,12 @@ func (*AccountSaveSecureValueParams) CRC() uint32 {
 
 // Securely save Telegram Passport document, for more info see the passport docs
 func (c *Client) AccountSaveSecureValue(value *InputSecureValue, secureSecretID int64) (*SecureValue, error) {
-	responseData, err := c.MakeRequest(&AccountSaveSecureValueParams{
+	return c.AccountSaveSecureValueCtx(context.Background(), value, secureSecretID)
+}
+
+// AccountSaveSecureValueCtx is the context-aware variant of AccountSaveSecureValue.
+func (c *Client) AccountSaveSecureValueCtx(ctx context.Context, value *InputSecureValue, secureSecretID int64) (*SecureValue, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSaveSecureValueParams{
 		SecureSecretID: secureSecretID,
 		Value:          value,
 	})
@@ -1958,7 +2369,12 @@ func (*AccountSaveThemeParams) CRC() uint32 {
 
 // Save a theme
 func (c *Client) AccountSaveTheme(theme InputTheme, unsave bool) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountSaveThemeParams{
+	return c.AccountSaveThemeCtx(context.Background(), theme, unsave)
+}
+
+// AccountSaveThemeCtx is the context-aware variant of AccountSaveTheme.
+func (c *Client) AccountSaveThemeCtx(ctx context.Context, theme InputTheme, unsave bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSaveThemeParams{
 		Theme:  theme,
 		Unsave: unsave,
 	})
@@ -1985,7 +2401,12 @@ func (*AccountSaveWallPaperParams) CRC() uint32 {
 
 // Install/uninstall wallpaper
 func (c *Client) AccountSaveWallPaper(wallpaper InputWallPaper, unsave bool, settings *WallPaperSettings) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountSaveWallPaperParams{
+	return c.AccountSaveWallPaperCtx(context.Background(), wallpaper, unsave, settings)
+}
+
+// AccountSaveWallPaperCtx is the context-aware variant of AccountSaveWallPaper.
+func (c *Client) AccountSaveWallPaperCtx(ctx context.Context, wallpaper InputWallPaper, unsave bool, settings *WallPaperSettings) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSaveWallPaperParams{
 		Settings:  settings,
 		Unsave:    unsave,
 		Wallpaper: wallpaper,
@@ -2012,7 +2433,12 @@ func (*AccountSendChangePhoneCodeParams) CRC() uint32 {
 
 // Verify a new phone number to associate to the current account
 func (c *Client) AccountSendChangePhoneCode(phoneNumber string, settings *CodeSettings) (AuthSentCode, error) {
-	responseData, err := c.MakeRequest(&AccountSendChangePhoneCodeParams{
+	return c.AccountSendChangePhoneCodeCtx(context.Background(), phoneNumber, settings)
+}
+
+// AccountSendChangePhoneCodeCtx is the context-aware variant of AccountSendChangePhoneCode.
+func (c *Client) AccountSendChangePhoneCodeCtx(ctx context.Context, phoneNumber string, settings *CodeSettings) (AuthSentCode, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSendChangePhoneCodeParams{
 		PhoneNumber: phoneNumber,
 		Settings:    settings,
 	})
@@ -2038,7 +2464,12 @@ func (*AccountSendConfirmPhoneCodeParams) CRC() uint32 {
 
 // Send confirmation code to cancel account deletion, for more info click here
 func (c *Client) AccountSendConfirmPhoneCode(hash string, settings *CodeSettings) (AuthSentCode, error) {
-	responseData, err := c.MakeRequest(&AccountSendConfirmPhoneCodeParams{
+	return c.AccountSendConfirmPhoneCodeCtx(context.Background(), hash, settings)
+}
+
+// AccountSendConfirmPhoneCodeCtx is the context-aware variant of AccountSendConfirmPhoneCode.
+func (c *Client) AccountSendConfirmPhoneCodeCtx(ctx context.Context, hash string, settings *CodeSettings) (AuthSentCode, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSendConfirmPhoneCodeParams{
 		Hash:     hash,
 		Settings: settings,
 	})
@@ -2064,7 +2495,12 @@ func (*AccountSendVerifyEmailCodeParams) CRC() uint32 {
 
 // Send an email verification code.
 func (c *Client) AccountSendVerifyEmailCode(purpose EmailVerifyPurpose, email string) (*AccountSentEmailCode, error) {
-	responseData, err := c.MakeRequest(&AccountSendVerifyEmailCodeParams{
+	return c.AccountSendVerifyEmailCodeCtx(context.Background(), purpose, email)
+}
+
+// AccountSendVerifyEmailCodeCtx is the context-aware variant of AccountSendVerifyEmailCode.
+func (c *Client) AccountSendVerifyEmailCodeCtx(ctx context.Context, purpose EmailVerifyPurpose, email string) (*AccountSentEmailCode, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSendVerifyEmailCodeParams{
 		Email:   email,
 		Purpose: purpose,
 	})
@@ -2090,7 +2526,12 @@ func (*AccountSendVerifyPhoneCodeParams) CRC() uint32 {
 
 // Send the verification phone code for telegram passport.
 func (c *Client) AccountSendVerifyPhoneCode(phoneNumber string, settings *CodeSettings) (AuthSentCode, error) {
-	responseData, err := c.MakeRequest(&AccountSendVerifyPhoneCodeParams{
+	return c.AccountSendVerifyPhoneCodeCtx(context.Background(), phoneNumber, settings)
+}
+
+// AccountSendVerifyPhoneCodeCtx is the context-aware variant of AccountSendVerifyPhoneCode.
+func (c *Client) AccountSendVerifyPhoneCodeCtx(ctx context.Context, phoneNumber string, settings *CodeSettings) (AuthSentCode, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSendVerifyPhoneCodeParams{
 		PhoneNumber: phoneNumber,
 		Settings:    settings,
 	})
@@ -2115,7 +2556,12 @@ func (*AccountSetAccountTtlParams) CRC() uint32 {
 
 // Set account self-destruction period
 func (c *Client) AccountSetAccountTtl(ttl *AccountDaysTtl) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountSetAccountTtlParams{Ttl: ttl})
+	return c.AccountSetAccountTtlCtx(context.Background(), ttl)
+}
+
+// AccountSetAccountTtlCtx is the context-aware variant of AccountSetAccountTtl.
+func (c *Client) AccountSetAccountTtlCtx(ctx context.Context, ttl *AccountDaysTtl) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSetAccountTtlParams{Ttl: ttl})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountSetAccountTtl: %w", err)
 	}
@@ -2137,7 +2583,12 @@ func (*AccountSetAuthorizationTtlParams) CRC() uint32 {
 
 // Set time-to-live of current session
 func (c *Client) AccountSetAuthorizationTtl(authorizationTtlDays int32) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountSetAuthorizationTtlParams{AuthorizationTtlDays: authorizationTtlDays})
+	return c.AccountSetAuthorizationTtlCtx(context.Background(), authorizationTtlDays)
+}
+
+// AccountSetAuthorizationTtlCtx is the context-aware variant of AccountSetAuthorizationTtl.
+func (c *Client) AccountSetAuthorizationTtlCtx(ctx context.Context, authorizationTtlDays int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSetAuthorizationTtlParams{AuthorizationTtlDays: authorizationTtlDays})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountSetAuthorizationTtl: %w", err)
 	}
@@ -2159,7 +2610,12 @@ func (*AccountSetContactSignUpNotificationParams) CRC() uint32 {
 
 // Toggle contact sign up notifications
 func (c *Client) AccountSetContactSignUpNotification(silent bool) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountSetContactSignUpNotificationParams{Silent: silent})
+	return c.AccountSetContactSignUpNotificationCtx(context.Background(), silent)
+}
+
+// AccountSetContactSignUpNotificationCtx is the context-aware variant of AccountSetContactSignUpNotification.
+func (c *Client) AccountSetContactSignUpNotificationCtx(ctx context.Context, silent bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSetContactSignUpNotificationParams{Silent: silent})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountSetContactSignUpNotification: %w", err)
 	}
@@ -2185,7 +2641,12 @@ func (*AccountSetContentSettingsParams) FlagIndex() int {
 
 // Set sensitive content settings (for viewing or hiding NSFW content)
 func (c *Client) AccountSetContentSettings(sensitiveEnabled bool) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountSetContentSettingsParams{SensitiveEnabled: sensitiveEnabled})
+	return c.AccountSetContentSettingsCtx(context.Background(), sensitiveEnabled)
+}
+
+// AccountSetContentSettingsCtx is the context-aware variant of AccountSetContentSettings.
+func (c *Client) AccountSetContentSettingsCtx(ctx context.Context, sensitiveEnabled bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSetContentSettingsParams{SensitiveEnabled: sensitiveEnabled})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountSetContentSettings: %w", err)
 	}
@@ -2207,7 +2668,12 @@ func (*AccountSetGlobalPrivacySettingsParams) CRC() uint32 {
 
 // Set global privacy settings
 func (c *Client) AccountSetGlobalPrivacySettings(settings *GlobalPrivacySettings) (*GlobalPrivacySettings, error) {
-	responseData, err := c.MakeRequest(&AccountSetGlobalPrivacySettingsParams{Settings: settings})
+	return c.AccountSetGlobalPrivacySettingsCtx(context.Background(), settings)
+}
+
+// AccountSetGlobalPrivacySettingsCtx is the context-aware variant of AccountSetGlobalPrivacySettings.
+func (c *Client) AccountSetGlobalPrivacySettingsCtx(ctx context.Context, settings *GlobalPrivacySettings) (*GlobalPrivacySettings, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSetGlobalPrivacySettingsParams{Settings: settings})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountSetGlobalPrivacySettings: %w", err)
 	}
@@ -2229,7 +2695,12 @@ func (*AccountSetMainProfileTabParams) CRC() uint32 {
 
 // Changes the main profile tab of the current user
 func (c *Client) AccountSetMainProfileTab(tab ProfileTab) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountSetMainProfileTabParams{Tab: tab})
+	return c.AccountSetMainProfileTabCtx(context.Background(), tab)
+}
+
+// AccountSetMainProfileTabCtx is the context-aware variant of AccountSetMainProfileTab.
+func (c *Client) AccountSetMainProfileTabCtx(ctx context.Context, tab ProfileTab) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSetMainProfileTabParams{Tab: tab})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountSetMainProfileTab: %w", err)
 	}
@@ -2252,7 +2723,12 @@ func (*AccountSetPrivacyParams) CRC() uint32 {
 
 // Change privacy settings of current account
 func (c *Client) AccountSetPrivacy(key InputPrivacyKey, rules []InputPrivacyRule) (*AccountPrivacyRules, error) {
-	responseData, err := c.MakeRequest(&AccountSetPrivacyParams{
+	return c.AccountSetPrivacyCtx(context.Background(), key, rules)
+}
+
+// AccountSetPrivacyCtx is the context-aware variant of AccountSetPrivacy.
+func (c *Client) AccountSetPrivacyCtx(ctx context.Context, key InputPrivacyKey, rules []InputPrivacyRule) (*AccountPrivacyRules, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSetPrivacyParams{
 		Key:   key,
 		Rules: rules,
 	})
@@ -2277,7 +2753,12 @@ func (*AccountSetReactionsNotifySettingsParams) CRC() uint32 {
 
 // Change the reaction notification settings.
 func (c *Client) AccountSetReactionsNotifySettings(settings *ReactionsNotifySettings) (*ReactionsNotifySettings, error) {
-	responseData, err := c.MakeRequest(&AccountSetReactionsNotifySettingsParams{Settings: settings})
+	return c.AccountSetReactionsNotifySettingsCtx(context.Background(), settings)
+}
+
+// AccountSetReactionsNotifySettingsCtx is the context-aware variant of AccountSetReactionsNotifySettings.
+func (c *Client) AccountSetReactionsNotifySettingsCtx(ctx context.Context, settings *ReactionsNotifySettings) (*ReactionsNotifySettings, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountSetReactionsNotifySettingsParams{Settings: settings})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountSetReactionsNotifySettings: %w", err)
 	}
@@ -2300,7 +2781,12 @@ func (*AccountToggleConnectedBotPausedParams) CRC() uint32 {
 
 // Pause or unpause a specific chat, temporarily disconnecting it from all business bots.
 func (c *Client) AccountToggleConnectedBotPaused(peer InputPeer, paused bool) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountToggleConnectedBotPausedParams{
+	return c.AccountToggleConnectedBotPausedCtx(context.Background(), peer, paused)
+}
+
+// AccountToggleConnectedBotPausedCtx is the context-aware variant of AccountToggleConnectedBotPaused.
+func (c *Client) AccountToggleConnectedBotPausedCtx(ctx context.Context, peer InputPeer, paused bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountToggleConnectedBotPausedParams{
 		Paused: paused,
 		Peer:   peer,
 	})
@@ -2332,7 +2818,12 @@ func (*AccountToggleNoPaidMessagesExceptionParams) FlagIndex() int {
 
 // Allow a user to send us messages without paying if paid messages are enabled.
 func (c *Client) AccountToggleNoPaidMessagesException(refundCharged, requirePayment bool, parentPeer InputPeer, userID InputUser) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountToggleNoPaidMessagesExceptionParams{
+	return c.AccountToggleNoPaidMessagesExceptionCtx(context.Background(), refundCharged, requirePayment, parentPeer, userID)
+}
+
+// AccountToggleNoPaidMessagesExceptionCtx is the context-aware variant of AccountToggleNoPaidMessagesException.
+func (c *Client) AccountToggleNoPaidMessagesExceptionCtx(ctx context.Context, refundCharged, requirePayment bool, parentPeer InputPeer, userID InputUser) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountToggleNoPaidMessagesExceptionParams{
 		ParentPeer:     parentPeer,
 		RefundCharged:  refundCharged,
 		RequirePayment: requirePayment,
@@ -2359,7 +2850,12 @@ func (*AccountToggleSponsoredMessagesParams) CRC() uint32 {
 
 // Disable or re-enable Telegram ads for the current Premium account.
 func (c *Client) AccountToggleSponsoredMessages(enabled bool) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountToggleSponsoredMessagesParams{Enabled: enabled})
+	return c.AccountToggleSponsoredMessagesCtx(context.Background(), enabled)
+}
+
+// AccountToggleSponsoredMessagesCtx is the context-aware variant of AccountToggleSponsoredMessages.
+func (c *Client) AccountToggleSponsoredMessagesCtx(ctx context.Context, enabled bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountToggleSponsoredMessagesParams{Enabled: enabled})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountToggleSponsoredMessages: %w", err)
 	}
@@ -2382,7 +2878,12 @@ func (*AccountToggleUsernameParams) CRC() uint32 {
 
 // Activate or deactivate a purchased fragment.com username associated to the currently logged-in user.
 func (c *Client) AccountToggleUsername(username string, active bool) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountToggleUsernameParams{
+	return c.AccountToggleUsernameCtx(context.Background(), username, active)
+}
+
+// AccountToggleUsernameCtx is the context-aware variant of AccountToggleUsername.
+func (c *Client) AccountToggleUsernameCtx(ctx context.Context, username string, active bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountToggleUsernameParams{
 		Active:   active,
 		Username: username,
 	})
@@ -2409,7 +2910,12 @@ func (*AccountUnregisterDeviceParams) CRC() uint32 {
 
 // Deletes a device by its token, stops sending PUSH-notifications to it.
 func (c *Client) AccountUnregisterDevice(tokenType int32, token string, otherUids []int64) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountUnregisterDeviceParams{
+	return c.AccountUnregisterDeviceCtx(context.Background(), tokenType, token, otherUids)
+}
+
+// AccountUnregisterDeviceCtx is the context-aware variant of AccountUnregisterDevice.
+func (c *Client) AccountUnregisterDeviceCtx(ctx context.Context, tokenType int32, token string, otherUids []int64) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUnregisterDeviceParams{
 		OtherUids: otherUids,
 		Token:     token,
 		TokenType: tokenType,
@@ -2439,7 +2945,12 @@ func (*AccountUpdateBirthdayParams) FlagIndex() int {
 
 // Update our birthday
 func (c *Client) AccountUpdateBirthday(birthday *Birthday) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountUpdateBirthdayParams{Birthday: birthday})
+	return c.AccountUpdateBirthdayCtx(context.Background(), birthday)
+}
+
+// AccountUpdateBirthdayCtx is the context-aware variant of AccountUpdateBirthday.
+func (c *Client) AccountUpdateBirthdayCtx(ctx context.Context, birthday *Birthday) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdateBirthdayParams{Birthday: birthday})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountUpdateBirthday: %w", err)
 	}
@@ -2465,7 +2976,12 @@ func (*AccountUpdateBusinessAwayMessageParams) FlagIndex() int {
 
 // Set a list of Telegram Business away messages.
 func (c *Client) AccountUpdateBusinessAwayMessage(message *InputBusinessAwayMessage) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountUpdateBusinessAwayMessageParams{Message: message})
+	return c.AccountUpdateBusinessAwayMessageCtx(context.Background(), message)
+}
+
+// AccountUpdateBusinessAwayMessageCtx is the context-aware variant of AccountUpdateBusinessAwayMessage.
+func (c *Client) AccountUpdateBusinessAwayMessageCtx(ctx context.Context, message *InputBusinessAwayMessage) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdateBusinessAwayMessageParams{Message: message})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountUpdateBusinessAwayMessage: %w", err)
 	}
@@ -2491,7 +3007,12 @@ func (*AccountUpdateBusinessGreetingMessageParams) FlagIndex() int {
 
 // Set a list of Telegram Business greeting messages.
 func (c *Client) AccountUpdateBusinessGreetingMessage(message *InputBusinessGreetingMessage) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountUpdateBusinessGreetingMessageParams{Message: message})
+	return c.AccountUpdateBusinessGreetingMessageCtx(context.Background(), message)
+}
+
+// AccountUpdateBusinessGreetingMessageCtx is the context-aware variant of AccountUpdateBusinessGreetingMessage.
+func (c *Client) AccountUpdateBusinessGreetingMessageCtx(ctx context.Context, message *InputBusinessGreetingMessage) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdateBusinessGreetingMessageParams{Message: message})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountUpdateBusinessGreetingMessage: %w", err)
 	}
@@ -2517,7 +3038,12 @@ func (*AccountUpdateBusinessIntroParams) FlagIndex() int {
 
 // Set or remove the Telegram Business introduction.
 func (c *Client) AccountUpdateBusinessIntro(intro *InputBusinessIntro) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountUpdateBusinessIntroParams{Intro: intro})
+	return c.AccountUpdateBusinessIntroCtx(context.Background(), intro)
+}
+
+// AccountUpdateBusinessIntroCtx is the context-aware variant of AccountUpdateBusinessIntro.
+func (c *Client) AccountUpdateBusinessIntroCtx(ctx context.Context, intro *InputBusinessIntro) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdateBusinessIntroParams{Intro: intro})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountUpdateBusinessIntro: %w", err)
 	}
@@ -2544,7 +3070,12 @@ func (*AccountUpdateBusinessLocationParams) FlagIndex() int {
 
 // Businesses may advertise their location using this method
 func (c *Client) AccountUpdateBusinessLocation(geoPoint InputGeoPoint, address string) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountUpdateBusinessLocationParams{
+	return c.AccountUpdateBusinessLocationCtx(context.Background(), geoPoint, address)
+}
+
+// AccountUpdateBusinessLocationCtx is the context-aware variant of AccountUpdateBusinessLocation.
+func (c *Client) AccountUpdateBusinessLocationCtx(ctx context.Context, geoPoint InputGeoPoint, address string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdateBusinessLocationParams{
 		Address:  address,
 		GeoPoint: geoPoint,
 	})
@@ -2573,7 +3104,12 @@ func (*AccountUpdateBusinessWorkHoursParams) FlagIndex() int {
 
 // Specify a set of Telegram Business opening hours. This info will be contained in userFull.`business_work_hours`.
 func (c *Client) AccountUpdateBusinessWorkHours(businessWorkHours *BusinessWorkHours) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountUpdateBusinessWorkHoursParams{BusinessWorkHours: businessWorkHours})
+	return c.AccountUpdateBusinessWorkHoursCtx(context.Background(), businessWorkHours)
+}
+
+// AccountUpdateBusinessWorkHoursCtx is the context-aware variant of AccountUpdateBusinessWorkHours.
+func (c *Client) AccountUpdateBusinessWorkHoursCtx(ctx context.Context, businessWorkHours *BusinessWorkHours) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdateBusinessWorkHoursParams{BusinessWorkHours: businessWorkHours})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountUpdateBusinessWorkHours: %w", err)
 	}
@@ -2600,7 +3136,12 @@ func (*AccountUpdateColorParams) FlagIndex() int {
 
 // Update the accent color and background custom emoji of the current account.
 func (c *Client) AccountUpdateColor(forProfile bool, color PeerColor) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountUpdateColorParams{
+	return c.AccountUpdateColorCtx(context.Background(), forProfile, color)
+}
+
+// AccountUpdateColorCtx is the context-aware variant of AccountUpdateColor.
+func (c *Client) AccountUpdateColorCtx(ctx context.Context, forProfile bool, color PeerColor) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdateColorParams{
 		Color:      color,
 		ForProfile: forProfile,
 	})
@@ -2632,7 +3173,12 @@ func (*AccountUpdateConnectedBotParams) FlagIndex() int {
 
 // Connect a business bot to the current account, or to change the current connection settings.
 func (c *Client) AccountUpdateConnectedBot(deleted bool, rights *BusinessBotRights, bot InputUser, recipients *InputBusinessBotRecipients) (Updates, error) {
-	responseData, err := c.MakeRequest(&AccountUpdateConnectedBotParams{
+	return c.AccountUpdateConnectedBotCtx(context.Background(), deleted, rights, bot, recipients)
+}
+
+// AccountUpdateConnectedBotCtx is the context-aware variant of AccountUpdateConnectedBot.
+func (c *Client) AccountUpdateConnectedBotCtx(ctx context.Context, deleted bool, rights *BusinessBotRights, bot InputUser, recipients *InputBusinessBotRecipients) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdateConnectedBotParams{
 		Bot:        bot,
 		Deleted:    deleted,
 		Recipients: recipients,
@@ -2659,7 +3205,12 @@ func (*AccountUpdateDeviceLockedParams) CRC() uint32 {
 
 // When client-side passcode lock feature is enabled, will not show message texts in incoming PUSH notifications.
 func (c *Client) AccountUpdateDeviceLocked(period int32) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountUpdateDeviceLockedParams{Period: period})
+	return c.AccountUpdateDeviceLockedCtx(context.Background(), period)
+}
+
+// AccountUpdateDeviceLockedCtx is the context-aware variant of AccountUpdateDeviceLocked.
+func (c *Client) AccountUpdateDeviceLockedCtx(ctx context.Context, period int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdateDeviceLockedParams{Period: period})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountUpdateDeviceLocked: %w", err)
 	}
@@ -2681,7 +3232,12 @@ func (*AccountUpdateEmojiStatusParams) CRC() uint32 {
 
 // Set an emoji status
 func (c *Client) AccountUpdateEmojiStatus(emojiStatus EmojiStatus) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountUpdateEmojiStatusParams{EmojiStatus: emojiStatus})
+	return c.AccountUpdateEmojiStatusCtx(context.Background(), emojiStatus)
+}
+
+// AccountUpdateEmojiStatusCtx is the context-aware variant of AccountUpdateEmojiStatus.
+func (c *Client) AccountUpdateEmojiStatusCtx(ctx context.Context, emojiStatus EmojiStatus) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdateEmojiStatusParams{EmojiStatus: emojiStatus})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountUpdateEmojiStatus: %w", err)
 	}
@@ -2704,7 +3260,12 @@ func (*AccountUpdateNotifySettingsParams) CRC() uint32 {
 
 // Edits notification settings from a given user/group, from all users/all groups.
 func (c *Client) AccountUpdateNotifySettings(peer InputNotifyPeer, settings *InputPeerNotifySettings) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountUpdateNotifySettingsParams{
+	return c.AccountUpdateNotifySettingsCtx(context.Background(), peer, settings)
+}
+
+// AccountUpdateNotifySettingsCtx is the context-aware variant of AccountUpdateNotifySettings.
+func (c *Client) AccountUpdateNotifySettingsCtx(ctx context.Context, peer InputNotifyPeer, settings *InputPeerNotifySettings) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdateNotifySettingsParams{
 		Peer:     peer,
 		Settings: settings,
 	})
@@ -2730,7 +3291,12 @@ func (*AccountUpdatePasswordSettingsParams) CRC() uint32 {
 
 // Set a new 2FA password
 func (c *Client) AccountUpdatePasswordSettings(password InputCheckPasswordSRP, newSettings *AccountPasswordInputSettings) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountUpdatePasswordSettingsParams{
+	return c.AccountUpdatePasswordSettingsCtx(context.Background(), password, newSettings)
+}
+
+// AccountUpdatePasswordSettingsCtx is the context-aware variant of AccountUpdatePasswordSettings.
+func (c *Client) AccountUpdatePasswordSettingsCtx(ctx context.Context, password InputCheckPasswordSRP, newSettings *AccountPasswordInputSettings) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdatePasswordSettingsParams{
 		NewSettings: newSettings,
 		Password:    password,
 	})
@@ -2755,7 +3321,12 @@ func (*AccountUpdatePersonalChannelParams) CRC() uint32 {
 
 // Associate (or remove) a personal channel, that will be listed on our personal profile page.
 func (c *Client) AccountUpdatePersonalChannel(channel InputChannel) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountUpdatePersonalChannelParams{Channel: channel})
+	return c.AccountUpdatePersonalChannelCtx(context.Background(), channel)
+}
+
+// AccountUpdatePersonalChannelCtx is the context-aware variant of AccountUpdatePersonalChannel.
+func (c *Client) AccountUpdatePersonalChannelCtx(ctx context.Context, channel InputChannel) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdatePersonalChannelParams{Channel: channel})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountUpdatePersonalChannel: %w", err)
 	}
@@ -2783,7 +3354,12 @@ func (*AccountUpdateProfileParams) FlagIndex() int {
 
 // Updates user profile.
 func (c *Client) AccountUpdateProfile(firstName, lastName, about string) (User, error) {
-	responseData, err := c.MakeRequest(&AccountUpdateProfileParams{
+	return c.AccountUpdateProfileCtx(context.Background(), firstName, lastName, about)
+}
+
+// AccountUpdateProfileCtx is the context-aware variant of AccountUpdateProfile.
+func (c *Client) AccountUpdateProfileCtx(ctx context.Context, firstName, lastName, about string) (User, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdateProfileParams{
 		About:     about,
 		FirstName: firstName,
 		LastName:  lastName,
@@ -2809,7 +3385,12 @@ func (*AccountUpdateStatusParams) CRC() uint32 {
 
 // Updates online user status.
 func (c *Client) AccountUpdateStatus(offline bool) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountUpdateStatusParams{Offline: offline})
+	return c.AccountUpdateStatusCtx(context.Background(), offline)
+}
+
+// AccountUpdateStatusCtx is the context-aware variant of AccountUpdateStatus.
+func (c *Client) AccountUpdateStatusCtx(ctx context.Context, offline bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdateStatusParams{Offline: offline})
 	if err != nil {
 		return false, fmt.Errorf("sending AccountUpdateStatus: %w", err)
 	}
@@ -2840,7 +3421,12 @@ func (*AccountUpdateThemeParams) FlagIndex() int {
 
 // Update theme
 func (c *Client) AccountUpdateTheme(params *AccountUpdateThemeParams) (*Theme, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.AccountUpdateThemeCtx(context.Background(), params)
+}
+
+// AccountUpdateThemeCtx is the context-aware variant of AccountUpdateTheme.
+func (c *Client) AccountUpdateThemeCtx(ctx context.Context, params *AccountUpdateThemeParams) (*Theme, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountUpdateTheme: %w", err)
 	}
@@ -2862,7 +3448,12 @@ func (*AccountUpdateUsernameParams) CRC() uint32 {
 
 // Changes username for the current user.
 func (c *Client) AccountUpdateUsername(username string) (User, error) {
-	responseData, err := c.MakeRequest(&AccountUpdateUsernameParams{Username: username})
+	return c.AccountUpdateUsernameCtx(context.Background(), username)
+}
+
+// AccountUpdateUsernameCtx is the context-aware variant of AccountUpdateUsername.
+func (c *Client) AccountUpdateUsernameCtx(ctx context.Context, username string) (User, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUpdateUsernameParams{Username: username})
 	if err != nil {
 		return nil, fmt.Errorf("sending AccountUpdateUsername: %w", err)
 	}
@@ -2886,7 +3477,12 @@ func (*AccountUploadRingtoneParams) CRC() uint32 {
 
 // Upload notification sound, use account.saveRingtone to convert it and add it to the list of saved notification sounds.
 func (c *Client) AccountUploadRingtone(file InputFile, fileName, mimeType string) (Document, error) {
-	responseData, err := c.MakeRequest(&AccountUploadRingtoneParams{
+	return c.AccountUploadRingtoneCtx(context.Background(), file, fileName, mimeType)
+}
+
+// AccountUploadRingtoneCtx is the context-aware variant of AccountUploadRingtone.
+func (c *Client) AccountUploadRingtoneCtx(ctx context.Context, file InputFile, fileName, mimeType string) (Document, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUploadRingtoneParams{
 		File:     file,
 		FileName: fileName,
 		MimeType: mimeType,
@@ -2919,7 +3515,12 @@ func (*AccountUploadThemeParams) FlagIndex() int {
 
 // Upload theme
 func (c *Client) AccountUploadTheme(file, thumb InputFile, fileName, mimeType string) (Document, error) {
-	responseData, err := c.MakeRequest(&AccountUploadThemeParams{
+	return c.AccountUploadThemeCtx(context.Background(), file, thumb, fileName, mimeType)
+}
+
+// AccountUploadThemeCtx is the context-aware variant of AccountUploadTheme.
+func (c *Client) AccountUploadThemeCtx(ctx context.Context, file, thumb InputFile, fileName, mimeType string) (Document, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUploadThemeParams{
 		File:     file,
 		FileName: fileName,
 		MimeType: mimeType,
@@ -2953,7 +3554,12 @@ func (*AccountUploadWallPaperParams) FlagIndex() int {
 
 // Create and upload a new wallpaper
 func (c *Client) AccountUploadWallPaper(forChat bool, file InputFile, mimeType string, settings *WallPaperSettings) (WallPaper, error) {
-	responseData, err := c.MakeRequest(&AccountUploadWallPaperParams{
+	return c.AccountUploadWallPaperCtx(context.Background(), forChat, file, mimeType, settings)
+}
+
+// AccountUploadWallPaperCtx is the context-aware variant of AccountUploadWallPaper.
+func (c *Client) AccountUploadWallPaperCtx(ctx context.Context, forChat bool, file InputFile, mimeType string, settings *WallPaperSettings) (WallPaper, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountUploadWallPaperParams{
 		File:     file,
 		ForChat:  forChat,
 		MimeType: mimeType,
@@ -2981,7 +3587,12 @@ func (*AccountVerifyEmailParams) CRC() uint32 {
 
 // Verify an email address.
 func (c *Client) AccountVerifyEmail(purpose EmailVerifyPurpose, verification EmailVerification) (AccountEmailVerified, error) {
-	responseData, err := c.MakeRequest(&AccountVerifyEmailParams{
+	return c.AccountVerifyEmailCtx(context.Background(), purpose, verification)
+}
+
+// AccountVerifyEmailCtx is the context-aware variant of AccountVerifyEmail.
+func (c *Client) AccountVerifyEmailCtx(ctx context.Context, purpose EmailVerifyPurpose, verification EmailVerification) (AccountEmailVerified, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountVerifyEmailParams{
 		Purpose:      purpose,
 		Verification: verification,
 	})
@@ -3008,7 +3619,12 @@ func (*AccountVerifyPhoneParams) CRC() uint32 {
 
 // Verify a phone number for telegram passport.
 func (c *Client) AccountVerifyPhone(phoneNumber, phoneCodeHash, phoneCode string) (bool, error) {
-	responseData, err := c.MakeRequest(&AccountVerifyPhoneParams{
+	return c.AccountVerifyPhoneCtx(context.Background(), phoneNumber, phoneCodeHash, phoneCode)
+}
+
+// AccountVerifyPhoneCtx is the context-aware variant of AccountVerifyPhone.
+func (c *Client) AccountVerifyPhoneCtx(ctx context.Context, phoneNumber, phoneCodeHash, phoneCode string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AccountVerifyPhoneParams{
 		PhoneCode:     phoneCode,
 		PhoneCodeHash: phoneCodeHash,
 		PhoneNumber:   phoneNumber,
@@ -3034,7 +3650,12 @@ func (*AuthAcceptLoginTokenParams) CRC() uint32 {
 
 // Accept QR code login token, logging in the app that generated it.
 func (c *Client) AuthAcceptLoginToken(token []byte) (*Authorization, error) {
-	responseData, err := c.MakeRequest(&AuthAcceptLoginTokenParams{Token: token})
+	return c.AuthAcceptLoginTokenCtx(context.Background(), token)
+}
+
+// AuthAcceptLoginTokenCtx is the context-aware variant of AuthAcceptLoginToken.
+func (c *Client) AuthAcceptLoginTokenCtx(ctx context.Context, token []byte) (*Authorization, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthAcceptLoginTokenParams{Token: token})
 	if err != nil {
 		return nil, fmt.Errorf("sending AuthAcceptLoginToken: %w", err)
 	}
@@ -3059,7 +3680,12 @@ func (*AuthBindTempAuthKeyParams) CRC() uint32 {
 
 // Binds a temporary authorization key `temp_auth_key_id` to the permanent authorization key `perm_auth_key_id`. Each permanent key may only be bound to one temporary key at a time, binding a new temporary key overwrites the previous one.
 func (c *Client) AuthBindTempAuthKey(permAuthKeyID, nonce int64, expiresAt int32, encryptedMessage []byte) (bool, error) {
-	responseData, err := c.MakeRequest(&AuthBindTempAuthKeyParams{
+	return c.AuthBindTempAuthKeyCtx(context.Background(), permAuthKeyID, nonce, expiresAt, encryptedMessage)
+}
+
+// AuthBindTempAuthKeyCtx is the context-aware variant of AuthBindTempAuthKey.
+func (c *Client) AuthBindTempAuthKeyCtx(ctx context.Context, permAuthKeyID, nonce int64, expiresAt int32, encryptedMessage []byte) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthBindTempAuthKeyParams{
 		EncryptedMessage: encryptedMessage,
 		ExpiresAt:        expiresAt,
 		Nonce:            nonce,
@@ -3087,7 +3713,12 @@ func (*AuthCancelCodeParams) CRC() uint32 {
 
 // Cancel the login verification code
 func (c *Client) AuthCancelCode(phoneNumber, phoneCodeHash string) (bool, error) {
-	responseData, err := c.MakeRequest(&AuthCancelCodeParams{
+	return c.AuthCancelCodeCtx(context.Background(), phoneNumber, phoneCodeHash)
+}
+
+// AuthCancelCodeCtx is the context-aware variant of AuthCancelCode.
+func (c *Client) AuthCancelCodeCtx(ctx context.Context, phoneNumber, phoneCodeHash string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthCancelCodeParams{
 		PhoneCodeHash: phoneCodeHash,
 		PhoneNumber:   phoneNumber,
 	})
@@ -3113,7 +3744,12 @@ func (*AuthCheckPaidAuthParams) CRC() uint32 {
 }
 
 func (c *Client) AuthCheckPaidAuth(phoneNumber, phoneCodeHash string, formID int64) (AuthSentCode, error) {
-	responseData, err := c.MakeRequest(&AuthCheckPaidAuthParams{
+	return c.AuthCheckPaidAuthCtx(context.Background(), phoneNumber, phoneCodeHash, formID)
+}
+
+// AuthCheckPaidAuthCtx is the context-aware variant of AuthCheckPaidAuth.
+func (c *Client) AuthCheckPaidAuthCtx(ctx context.Context, phoneNumber, phoneCodeHash string, formID int64) (AuthSentCode, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthCheckPaidAuthParams{
 		FormID:        formID,
 		PhoneCodeHash: phoneCodeHash,
 		PhoneNumber:   phoneNumber,
@@ -3139,7 +3775,12 @@ func (*AuthCheckPasswordParams) CRC() uint32 {
 
 // Try logging to an account protected by a 2FA password.
 func (c *Client) AuthCheckPassword(password InputCheckPasswordSRP) (AuthAuthorization, error) {
-	responseData, err := c.MakeRequest(&AuthCheckPasswordParams{Password: password})
+	return c.AuthCheckPasswordCtx(context.Background(), password)
+}
+
+// AuthCheckPasswordCtx is the context-aware variant of AuthCheckPassword.
+func (c *Client) AuthCheckPasswordCtx(ctx context.Context, password InputCheckPasswordSRP) (AuthAuthorization, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthCheckPasswordParams{Password: password})
 	if err != nil {
 		return nil, fmt.Errorf("sending AuthCheckPassword: %w", err)
 	}
@@ -3161,7 +3802,12 @@ func (*AuthCheckRecoveryPasswordParams) CRC() uint32 {
 
 // Check if the 2FA recovery code sent using auth.requestPasswordRecovery is valid, before passing it to auth.recoverPassword.
 func (c *Client) AuthCheckRecoveryPassword(code string) (bool, error) {
-	responseData, err := c.MakeRequest(&AuthCheckRecoveryPasswordParams{Code: code})
+	return c.AuthCheckRecoveryPasswordCtx(context.Background(), code)
+}
+
+// AuthCheckRecoveryPasswordCtx is the context-aware variant of AuthCheckRecoveryPassword.
+func (c *Client) AuthCheckRecoveryPasswordCtx(ctx context.Context, code string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthCheckRecoveryPasswordParams{Code: code})
 	if err != nil {
 		return false, fmt.Errorf("sending AuthCheckRecoveryPassword: %w", err)
 	}
@@ -3183,7 +3829,12 @@ func (*AuthDropTempAuthKeysParams) CRC() uint32 {
 
 // Delete all temporary authorization keys except for the ones specified
 func (c *Client) AuthDropTempAuthKeys(exceptAuthKeys []int64) (bool, error) {
-	responseData, err := c.MakeRequest(&AuthDropTempAuthKeysParams{ExceptAuthKeys: exceptAuthKeys})
+	return c.AuthDropTempAuthKeysCtx(context.Background(), exceptAuthKeys)
+}
+
+// AuthDropTempAuthKeysCtx is the context-aware variant of AuthDropTempAuthKeys.
+func (c *Client) AuthDropTempAuthKeysCtx(ctx context.Context, exceptAuthKeys []int64) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthDropTempAuthKeysParams{ExceptAuthKeys: exceptAuthKeys})
 	if err != nil {
 		return false, fmt.Errorf("sending AuthDropTempAuthKeys: %w", err)
 	}
@@ -3205,7 +3856,12 @@ func (*AuthExportAuthorizationParams) CRC() uint32 {
 
 // Returns data for copying authorization to another data-center.
 func (c *Client) AuthExportAuthorization(dcID int32) (*AuthExportedAuthorization, error) {
-	responseData, err := c.MakeRequest(&AuthExportAuthorizationParams{DcID: dcID})
+	return c.AuthExportAuthorizationCtx(context.Background(), dcID)
+}
+
+// AuthExportAuthorizationCtx is the context-aware variant of AuthExportAuthorization.
+func (c *Client) AuthExportAuthorizationCtx(ctx context.Context, dcID int32) (*AuthExportedAuthorization, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthExportAuthorizationParams{DcID: dcID})
 	if err != nil {
 		return nil, fmt.Errorf("sending AuthExportAuthorization: %w", err)
 	}
@@ -3229,7 +3885,12 @@ func (*AuthExportLoginTokenParams) CRC() uint32 {
 
 // Generate a login token, for login via QR code. The generated login token should be encoded using base64url, then shown as a `tg://login?token=base64encodedtoken` deep link in the QR code.
 func (c *Client) AuthExportLoginToken(apiID int32, apiHash string, exceptIds []int64) (AuthLoginToken, error) {
-	responseData, err := c.MakeRequest(&AuthExportLoginTokenParams{
+	return c.AuthExportLoginTokenCtx(context.Background(), apiID, apiHash, exceptIds)
+}
+
+// AuthExportLoginTokenCtx is the context-aware variant of AuthExportLoginToken.
+func (c *Client) AuthExportLoginTokenCtx(ctx context.Context, apiID int32, apiHash string, exceptIds []int64) (AuthLoginToken, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthExportLoginTokenParams{
 		APIHash:   apiHash,
 		APIID:     apiID,
 		ExceptIds: exceptIds,
@@ -3260,7 +3921,12 @@ func (*AuthFinishPasskeyLoginParams) FlagIndex() int {
 }
 
 func (c *Client) AuthFinishPasskeyLogin(credential InputPasskeyCredential, fromDcID int32, fromAuthKeyID int64) (AuthAuthorization, error) {
-	responseData, err := c.MakeRequest(&AuthFinishPasskeyLoginParams{
+	return c.AuthFinishPasskeyLoginCtx(context.Background(), credential, fromDcID, fromAuthKeyID)
+}
+
+// AuthFinishPasskeyLoginCtx is the context-aware variant of AuthFinishPasskeyLogin.
+func (c *Client) AuthFinishPasskeyLoginCtx(ctx context.Context, credential InputPasskeyCredential, fromDcID int32, fromAuthKeyID int64) (AuthAuthorization, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthFinishPasskeyLoginParams{
 		Credential:    credential,
 		FromAuthKeyID: fromAuthKeyID,
 		FromDcID:      fromDcID,
@@ -3287,7 +3953,12 @@ func (*AuthImportAuthorizationParams) CRC() uint32 {
 
 // Logs in a user using a key transmitted from his native data-center.
 func (c *Client) AuthImportAuthorization(id int64, bytes []byte) (AuthAuthorization, error) {
-	responseData, err := c.MakeRequest(&AuthImportAuthorizationParams{
+	return c.AuthImportAuthorizationCtx(context.Background(), id, bytes)
+}
+
+// AuthImportAuthorizationCtx is the context-aware variant of AuthImportAuthorization.
+func (c *Client) AuthImportAuthorizationCtx(ctx context.Context, id int64, bytes []byte) (AuthAuthorization, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthImportAuthorizationParams{
 		Bytes: bytes,
 		ID:    id,
 	})
@@ -3315,7 +3986,12 @@ func (*AuthImportBotAuthorizationParams) CRC() uint32 {
 
 // Login as a bot
 func (c *Client) AuthImportBotAuthorization(flags, apiID int32, apiHash, botAuthToken string) (AuthAuthorization, error) {
-	responseData, err := c.MakeRequest(&AuthImportBotAuthorizationParams{
+	return c.AuthImportBotAuthorizationCtx(context.Background(), flags, apiID, apiHash, botAuthToken)
+}
+
+// AuthImportBotAuthorizationCtx is the context-aware variant of AuthImportBotAuthorization.
+func (c *Client) AuthImportBotAuthorizationCtx(ctx context.Context, flags, apiID int32, apiHash, botAuthToken string) (AuthAuthorization, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthImportBotAuthorizationParams{
 		APIHash:      apiHash,
 		APIID:        apiID,
 		BotAuthToken: botAuthToken,
@@ -3342,7 +4018,12 @@ func (*AuthImportLoginTokenParams) CRC() uint32 {
 
 // Login using a redirected login token, generated in case of DC mismatch during QR code login.
 func (c *Client) AuthImportLoginToken(token []byte) (AuthLoginToken, error) {
-	responseData, err := c.MakeRequest(&AuthImportLoginTokenParams{Token: token})
+	return c.AuthImportLoginTokenCtx(context.Background(), token)
+}
+
+// AuthImportLoginTokenCtx is the context-aware variant of AuthImportLoginToken.
+func (c *Client) AuthImportLoginTokenCtx(ctx context.Context, token []byte) (AuthLoginToken, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthImportLoginTokenParams{Token: token})
 	if err != nil {
 		return nil, fmt.Errorf("sending AuthImportLoginToken: %w", err)
 	}
@@ -3366,7 +4047,12 @@ func (*AuthImportWebTokenAuthorizationParams) CRC() uint32 {
 
 // Login by importing an authorization token
 func (c *Client) AuthImportWebTokenAuthorization(apiID int32, apiHash, webAuthToken string) (AuthAuthorization, error) {
-	responseData, err := c.MakeRequest(&AuthImportWebTokenAuthorizationParams{
+	return c.AuthImportWebTokenAuthorizationCtx(context.Background(), apiID, apiHash, webAuthToken)
+}
+
+// AuthImportWebTokenAuthorizationCtx is the context-aware variant of AuthImportWebTokenAuthorization.
+func (c *Client) AuthImportWebTokenAuthorizationCtx(ctx context.Context, apiID int32, apiHash, webAuthToken string) (AuthAuthorization, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthImportWebTokenAuthorizationParams{
 		APIHash:      apiHash,
 		APIID:        apiID,
 		WebAuthToken: webAuthToken,
@@ -3392,7 +4078,12 @@ func (*AuthInitPasskeyLoginParams) CRC() uint32 {
 }
 
 func (c *Client) AuthInitPasskeyLogin(apiID int32, apiHash string) (*AuthPasskeyLoginOptions, error) {
-	responseData, err := c.MakeRequest(&AuthInitPasskeyLoginParams{
+	return c.AuthInitPasskeyLoginCtx(context.Background(), apiID, apiHash)
+}
+
+// AuthInitPasskeyLoginCtx is the context-aware variant of AuthInitPasskeyLogin.
+func (c *Client) AuthInitPasskeyLoginCtx(ctx context.Context, apiID int32, apiHash string) (*AuthPasskeyLoginOptions, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthInitPasskeyLoginParams{
 		APIHash: apiHash,
 		APIID:   apiID,
 	})
@@ -3415,7 +4106,12 @@ func (*AuthLogOutParams) CRC() uint32 {
 
 // Logs out the user.
 func (c *Client) AuthLogOut() (*AuthLoggedOut, error) {
-	responseData, err := c.MakeRequest(&AuthLogOutParams{})
+	return c.AuthLogOutCtx(context.Background())
+}
+
+// AuthLogOutCtx is the context-aware variant of AuthLogOut.
+func (c *Client) AuthLogOutCtx(ctx context.Context) (*AuthLoggedOut, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthLogOutParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AuthLogOut: %w", err)
 	}
@@ -3442,7 +4138,12 @@ func (*AuthRecoverPasswordParams) FlagIndex() int {
 
 // Reset the 2FA password using the recovery code sent using auth.requestPasswordRecovery.
 func (c *Client) AuthRecoverPassword(code string, newSettings *AccountPasswordInputSettings) (AuthAuthorization, error) {
-	responseData, err := c.MakeRequest(&AuthRecoverPasswordParams{
+	return c.AuthRecoverPasswordCtx(context.Background(), code, newSettings)
+}
+
+// AuthRecoverPasswordCtx is the context-aware variant of AuthRecoverPassword.
+func (c *Client) AuthRecoverPasswordCtx(ctx context.Context, code string, newSettings *AccountPasswordInputSettings) (AuthAuthorization, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthRecoverPasswordParams{
 		Code:        code,
 		NewSettings: newSettings,
 	})
@@ -3469,7 +4170,12 @@ func (*AuthReportMissingCodeParams) CRC() uint32 {
 
 // Official apps only, reports that the SMS authentication code wasn't delivered.
 func (c *Client) AuthReportMissingCode(phoneNumber, phoneCodeHash, mnc string) (bool, error) {
-	responseData, err := c.MakeRequest(&AuthReportMissingCodeParams{
+	return c.AuthReportMissingCodeCtx(context.Background(), phoneNumber, phoneCodeHash, mnc)
+}
+
+// AuthReportMissingCodeCtx is the context-aware variant of AuthReportMissingCode.
+func (c *Client) AuthReportMissingCodeCtx(ctx context.Context, phoneNumber, phoneCodeHash, mnc string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthReportMissingCodeParams{
 		Mnc:           mnc,
 		PhoneCodeHash: phoneCodeHash,
 		PhoneNumber:   phoneNumber,
@@ -3503,7 +4209,12 @@ func (*AuthRequestFirebaseSmsParams) FlagIndex() int {
 
 // Request an SMS code via Firebase.
 func (c *Client) AuthRequestFirebaseSms(params *AuthRequestFirebaseSmsParams) (bool, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.AuthRequestFirebaseSmsCtx(context.Background(), params)
+}
+
+// AuthRequestFirebaseSmsCtx is the context-aware variant of AuthRequestFirebaseSms.
+func (c *Client) AuthRequestFirebaseSmsCtx(ctx context.Context, params *AuthRequestFirebaseSmsParams) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return false, fmt.Errorf("sending AuthRequestFirebaseSms: %w", err)
 	}
@@ -3523,7 +4234,12 @@ func (*AuthRequestPasswordRecoveryParams) CRC() uint32 {
 
 // Request recovery code of a 2FA password, only for accounts with a recovery email configured.
 func (c *Client) AuthRequestPasswordRecovery() (*AuthPasswordRecovery, error) {
-	responseData, err := c.MakeRequest(&AuthRequestPasswordRecoveryParams{})
+	return c.AuthRequestPasswordRecoveryCtx(context.Background())
+}
+
+// AuthRequestPasswordRecoveryCtx is the context-aware variant of AuthRequestPasswordRecovery.
+func (c *Client) AuthRequestPasswordRecoveryCtx(ctx context.Context) (*AuthPasswordRecovery, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthRequestPasswordRecoveryParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending AuthRequestPasswordRecovery: %w", err)
 	}
@@ -3551,7 +4267,12 @@ func (*AuthResendCodeParams) FlagIndex() int {
 
 // Resend the login code via another medium, the phone code type is determined by the return value of the previous auth.sendCode/auth.resendCode: see login for more info.
 func (c *Client) AuthResendCode(phoneNumber, phoneCodeHash, reason string) (AuthSentCode, error) {
-	responseData, err := c.MakeRequest(&AuthResendCodeParams{
+	return c.AuthResendCodeCtx(context.Background(), phoneNumber, phoneCodeHash, reason)
+}
+
+// AuthResendCodeCtx is the context-aware variant of AuthResendCode.
+func (c *Client) AuthResendCodeCtx(ctx context.Context, phoneNumber, phoneCodeHash, reason string) (AuthSentCode, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthResendCodeParams{
 		PhoneCodeHash: phoneCodeHash,
 		PhoneNumber:   phoneNumber,
 		Reason:        reason,
@@ -3575,7 +4296,12 @@ func (*AuthResetAuthorizationsParams) CRC() uint32 {
 
 // Terminates all user's authorized sessions except for the current one.
 func (c *Client) AuthResetAuthorizations() (bool, error) {
-	responseData, err := c.MakeRequest(&AuthResetAuthorizationsParams{})
+	return c.AuthResetAuthorizationsCtx(context.Background())
+}
+
+// AuthResetAuthorizationsCtx is the context-aware variant of AuthResetAuthorizations.
+func (c *Client) AuthResetAuthorizationsCtx(ctx context.Context) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthResetAuthorizationsParams{})
 	if err != nil {
 		return false, fmt.Errorf("sending AuthResetAuthorizations: %w", err)
 	}
@@ -3598,7 +4324,12 @@ func (*AuthResetLoginEmailParams) CRC() uint32 {
 
 // Reset the login email.
 func (c *Client) AuthResetLoginEmail(phoneNumber, phoneCodeHash string) (AuthSentCode, error) {
-	responseData, err := c.MakeRequest(&AuthResetLoginEmailParams{
+	return c.AuthResetLoginEmailCtx(context.Background(), phoneNumber, phoneCodeHash)
+}
+
+// AuthResetLoginEmailCtx is the context-aware variant of AuthResetLoginEmail.
+func (c *Client) AuthResetLoginEmailCtx(ctx context.Context, phoneNumber, phoneCodeHash string) (AuthSentCode, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthResetLoginEmailParams{
 		PhoneCodeHash: phoneCodeHash,
 		PhoneNumber:   phoneNumber,
 	})
@@ -3626,7 +4357,12 @@ func (*AuthSendCodeParams) CRC() uint32 {
 
 // Send the verification code for login
 func (c *Client) AuthSendCode(phoneNumber string, apiID int32, apiHash string, settings *CodeSettings) (AuthSentCode, error) {
-	responseData, err := c.MakeRequest(&AuthSendCodeParams{
+	return c.AuthSendCodeCtx(context.Background(), phoneNumber, apiID, apiHash, settings)
+}
+
+// AuthSendCodeCtx is the context-aware variant of AuthSendCode.
+func (c *Client) AuthSendCodeCtx(ctx context.Context, phoneNumber string, apiID int32, apiHash string, settings *CodeSettings) (AuthSentCode, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthSendCodeParams{
 		APIHash:     apiHash,
 		APIID:       apiID,
 		PhoneNumber: phoneNumber,
@@ -3660,7 +4396,12 @@ func (*AuthSignInParams) FlagIndex() int {
 
 // Signs in a user with a validated phone number.
 func (c *Client) AuthSignIn(phoneNumber, phoneCodeHash, phoneCode string, emailVerification EmailVerification) (AuthAuthorization, error) {
-	responseData, err := c.MakeRequest(&AuthSignInParams{
+	return c.AuthSignInCtx(context.Background(), phoneNumber, phoneCodeHash, phoneCode, emailVerification)
+}
+
+// AuthSignInCtx is the context-aware variant of AuthSignIn.
+func (c *Client) AuthSignInCtx(ctx context.Context, phoneNumber, phoneCodeHash, phoneCode string, emailVerification EmailVerification) (AuthAuthorization, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &AuthSignInParams{
 		EmailVerification: emailVerification,
 		PhoneCode:         phoneCode,
 		PhoneCodeHash:     phoneCodeHash,
@@ -3695,7 +4436,12 @@ func (*AuthSignUpParams) FlagIndex() int {
 
 // Registers a validated phone number in the system.
 func (c *Client) AuthSignUp(params *AuthSignUpParams) (AuthAuthorization, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.AuthSignUpCtx(context.Background(), params)
+}
+
+// AuthSignUpCtx is the context-aware variant of AuthSignUp.
+func (c *Client) AuthSignUpCtx(ctx context.Context, params *AuthSignUpParams) (AuthAuthorization, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending AuthSignUp: %w", err)
 	}
@@ -3719,7 +4465,12 @@ func (*BotsAddPreviewMediaParams) CRC() uint32 {
 
 // Add a main mini app preview
 func (c *Client) BotsAddPreviewMedia(bot InputUser, langCode string, media InputMedia) (*BotPreviewMedia, error) {
-	responseData, err := c.MakeRequest(&BotsAddPreviewMediaParams{
+	return c.BotsAddPreviewMediaCtx(context.Background(), bot, langCode, media)
+}
+
+// BotsAddPreviewMediaCtx is the context-aware variant of BotsAddPreviewMedia.
+func (c *Client) BotsAddPreviewMediaCtx(ctx context.Context, bot InputUser, langCode string, media InputMedia) (*BotPreviewMedia, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsAddPreviewMediaParams{
 		Bot:      bot,
 		LangCode: langCode,
 		Media:    media,
@@ -3745,7 +4496,12 @@ func (*BotsAllowSendMessageParams) CRC() uint32 {
 
 // Allow the specified bot to send us messages
 func (c *Client) BotsAllowSendMessage(bot InputUser) (Updates, error) {
-	responseData, err := c.MakeRequest(&BotsAllowSendMessageParams{Bot: bot})
+	return c.BotsAllowSendMessageCtx(context.Background(), bot)
+}
+
+// BotsAllowSendMessageCtx is the context-aware variant of BotsAllowSendMessage.
+func (c *Client) BotsAllowSendMessageCtx(ctx context.Context, bot InputUser) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsAllowSendMessageParams{Bot: bot})
 	if err != nil {
 		return nil, fmt.Errorf("sending BotsAllowSendMessage: %w", err)
 	}
@@ -3768,7 +4524,12 @@ func (*BotsAnswerWebhookJsonQueryParams) CRC() uint32 {
 
 // Answers a custom query; for bots only
 func (c *Client) BotsAnswerWebhookJsonQuery(queryID int64, data *DataJson) (bool, error) {
-	responseData, err := c.MakeRequest(&BotsAnswerWebhookJsonQueryParams{
+	return c.BotsAnswerWebhookJsonQueryCtx(context.Background(), queryID, data)
+}
+
+// BotsAnswerWebhookJsonQueryCtx is the context-aware variant of BotsAnswerWebhookJsonQuery.
+func (c *Client) BotsAnswerWebhookJsonQueryCtx(ctx context.Context, queryID int64, data *DataJson) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsAnswerWebhookJsonQueryParams{
 		Data:    data,
 		QueryID: queryID,
 	})
@@ -3793,7 +4554,12 @@ func (*BotsCanSendMessageParams) CRC() uint32 {
 
 // Check whether the specified bot can send us messages
 func (c *Client) BotsCanSendMessage(bot InputUser) (bool, error) {
-	responseData, err := c.MakeRequest(&BotsCanSendMessageParams{Bot: bot})
+	return c.BotsCanSendMessageCtx(context.Background(), bot)
+}
+
+// BotsCanSendMessageCtx is the context-aware variant of BotsCanSendMessage.
+func (c *Client) BotsCanSendMessageCtx(ctx context.Context, bot InputUser) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsCanSendMessageParams{Bot: bot})
 	if err != nil {
 		return false, fmt.Errorf("sending BotsCanSendMessage: %w", err)
 	}
@@ -3817,7 +4583,12 @@ func (*BotsCheckDownloadFileParamsParams) CRC() uint32 {
 
 // Check if a mini app can request the download of a specific file: called when handling web_app_request_file_download events
 func (c *Client) BotsCheckDownloadFileParams(bot InputUser, fileName, url string) (bool, error) {
-	responseData, err := c.MakeRequest(&BotsCheckDownloadFileParamsParams{
+	return c.BotsCheckDownloadFileParamsCtx(context.Background(), bot, fileName, url)
+}
+
+// BotsCheckDownloadFileParamsCtx is the context-aware variant of BotsCheckDownloadFileParams.
+func (c *Client) BotsCheckDownloadFileParamsCtx(ctx context.Context, bot InputUser, fileName, url string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsCheckDownloadFileParamsParams{
 		Bot:      bot,
 		FileName: fileName,
 		URL:      url,
@@ -3845,7 +4616,12 @@ func (*BotsDeletePreviewMediaParams) CRC() uint32 {
 
 // Delete a main mini app preview
 func (c *Client) BotsDeletePreviewMedia(bot InputUser, langCode string, media []InputMedia) (bool, error) {
-	responseData, err := c.MakeRequest(&BotsDeletePreviewMediaParams{
+	return c.BotsDeletePreviewMediaCtx(context.Background(), bot, langCode, media)
+}
+
+// BotsDeletePreviewMediaCtx is the context-aware variant of BotsDeletePreviewMedia.
+func (c *Client) BotsDeletePreviewMediaCtx(ctx context.Context, bot InputUser, langCode string, media []InputMedia) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsDeletePreviewMediaParams{
 		Bot:      bot,
 		LangCode: langCode,
 		Media:    media,
@@ -3874,7 +4650,12 @@ func (*BotsEditPreviewMediaParams) CRC() uint32 {
 
 // Edit a main mini app preview
 func (c *Client) BotsEditPreviewMedia(bot InputUser, langCode string, media, newMedia InputMedia) (*BotPreviewMedia, error) {
-	responseData, err := c.MakeRequest(&BotsEditPreviewMediaParams{
+	return c.BotsEditPreviewMediaCtx(context.Background(), bot, langCode, media, newMedia)
+}
+
+// BotsEditPreviewMediaCtx is the context-aware variant of BotsEditPreviewMedia.
+func (c *Client) BotsEditPreviewMediaCtx(ctx context.Context, bot InputUser, langCode string, media, newMedia InputMedia) (*BotPreviewMedia, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsEditPreviewMediaParams{
 		Bot:      bot,
 		LangCode: langCode,
 		Media:    media,
@@ -3899,7 +4680,12 @@ func (*BotsGetAdminedBotsParams) CRC() uint32 {
 
 // Get a list of bots owned by the current user
 func (c *Client) BotsGetAdminedBots() ([]User, error) {
-	responseData, err := c.MakeRequest(&BotsGetAdminedBotsParams{})
+	return c.BotsGetAdminedBotsCtx(context.Background())
+}
+
+// BotsGetAdminedBotsCtx is the context-aware variant of BotsGetAdminedBots.
+func (c *Client) BotsGetAdminedBotsCtx(ctx context.Context) ([]User, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsGetAdminedBotsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending BotsGetAdminedBots: %w", err)
 	}
@@ -3922,7 +4708,12 @@ func (*BotsGetBotCommandsParams) CRC() uint32 {
 
 // Obtain a list of bot commands for the specified bot scope and language code
 func (c *Client) BotsGetBotCommands(scope BotCommandScope, langCode string) ([]*BotCommand, error) {
-	responseData, err := c.MakeRequest(&BotsGetBotCommandsParams{
+	return c.BotsGetBotCommandsCtx(context.Background(), scope, langCode)
+}
+
+// BotsGetBotCommandsCtx is the context-aware variant of BotsGetBotCommands.
+func (c *Client) BotsGetBotCommandsCtx(ctx context.Context, scope BotCommandScope, langCode string) ([]*BotCommand, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsGetBotCommandsParams{
 		LangCode: langCode,
 		Scope:    scope,
 	})
@@ -3952,7 +4743,12 @@ func (*BotsGetBotInfoParams) FlagIndex() int {
 
 // Get localized name, about text and description of a bot (or of the current account, if called by a bot).
 func (c *Client) BotsGetBotInfo(bot InputUser, langCode string) (*BotsBotInfo, error) {
-	responseData, err := c.MakeRequest(&BotsGetBotInfoParams{
+	return c.BotsGetBotInfoCtx(context.Background(), bot, langCode)
+}
+
+// BotsGetBotInfoCtx is the context-aware variant of BotsGetBotInfo.
+func (c *Client) BotsGetBotInfoCtx(ctx context.Context, bot InputUser, langCode string) (*BotsBotInfo, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsGetBotInfoParams{
 		Bot:      bot,
 		LangCode: langCode,
 	})
@@ -3977,7 +4773,12 @@ func (*BotsGetBotMenuButtonParams) CRC() uint32 {
 
 // Gets the menu button action for a given user or for all users, previously set using bots.setBotMenuButton; users can see this information in the botInfo constructor.
 func (c *Client) BotsGetBotMenuButton(userID InputUser) (BotMenuButton, error) {
-	responseData, err := c.MakeRequest(&BotsGetBotMenuButtonParams{UserID: userID})
+	return c.BotsGetBotMenuButtonCtx(context.Background(), userID)
+}
+
+// BotsGetBotMenuButtonCtx is the context-aware variant of BotsGetBotMenuButton.
+func (c *Client) BotsGetBotMenuButtonCtx(ctx context.Context, userID InputUser) (BotMenuButton, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsGetBotMenuButtonParams{UserID: userID})
 	if err != nil {
 		return nil, fmt.Errorf("sending BotsGetBotMenuButton: %w", err)
 	}
@@ -3999,7 +4800,12 @@ func (*BotsGetBotRecommendationsParams) CRC() uint32 {
 
 // Obtain a list of similarly themed bots, selected based on similarities in their subscriber bases
 func (c *Client) BotsGetBotRecommendations(bot InputUser) (UsersUsers, error) {
-	responseData, err := c.MakeRequest(&BotsGetBotRecommendationsParams{Bot: bot})
+	return c.BotsGetBotRecommendationsCtx(context.Background(), bot)
+}
+
+// BotsGetBotRecommendationsCtx is the context-aware variant of BotsGetBotRecommendations.
+func (c *Client) BotsGetBotRecommendationsCtx(ctx context.Context, bot InputUser) (UsersUsers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsGetBotRecommendationsParams{Bot: bot})
 	if err != nil {
 		return nil, fmt.Errorf("sending BotsGetBotRecommendations: %w", err)
 	}
@@ -4022,7 +4828,12 @@ func (*BotsGetPopularAppBotsParams) CRC() uint32 {
 
 // Fetch popular Main Mini Apps, to be used in the apps tab of global search.
 func (c *Client) BotsGetPopularAppBots(offset string, limit int32) (*BotsPopularAppBots, error) {
-	responseData, err := c.MakeRequest(&BotsGetPopularAppBotsParams{
+	return c.BotsGetPopularAppBotsCtx(context.Background(), offset, limit)
+}
+
+// BotsGetPopularAppBotsCtx is the context-aware variant of BotsGetPopularAppBots.
+func (c *Client) BotsGetPopularAppBotsCtx(ctx context.Context, offset string, limit int32) (*BotsPopularAppBots, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsGetPopularAppBotsParams{
 		Limit:  limit,
 		Offset: offset,
 	})
@@ -4048,7 +4859,12 @@ func (*BotsGetPreviewInfoParams) CRC() uint32 {
 
 // Bot owners only, fetch main mini app preview information
 func (c *Client) BotsGetPreviewInfo(bot InputUser, langCode string) (*BotsPreviewInfo, error) {
-	responseData, err := c.MakeRequest(&BotsGetPreviewInfoParams{
+	return c.BotsGetPreviewInfoCtx(context.Background(), bot, langCode)
+}
+
+// BotsGetPreviewInfoCtx is the context-aware variant of BotsGetPreviewInfo.
+func (c *Client) BotsGetPreviewInfoCtx(ctx context.Context, bot InputUser, langCode string) (*BotsPreviewInfo, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsGetPreviewInfoParams{
 		Bot:      bot,
 		LangCode: langCode,
 	})
@@ -4073,7 +4889,12 @@ func (*BotsGetPreviewMediasParams) CRC() uint32 {
 
 // Fetch main mini app previews
 func (c *Client) BotsGetPreviewMedias(bot InputUser) ([]*BotPreviewMedia, error) {
-	responseData, err := c.MakeRequest(&BotsGetPreviewMediasParams{Bot: bot})
+	return c.BotsGetPreviewMediasCtx(context.Background(), bot)
+}
+
+// BotsGetPreviewMediasCtx is the context-aware variant of BotsGetPreviewMedias.
+func (c *Client) BotsGetPreviewMediasCtx(ctx context.Context, bot InputUser) ([]*BotPreviewMedia, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsGetPreviewMediasParams{Bot: bot})
 	if err != nil {
 		return nil, fmt.Errorf("sending BotsGetPreviewMedias: %w", err)
 	}
@@ -4097,7 +4918,12 @@ func (*BotsInvokeWebViewCustomMethodParams) CRC() uint32 {
 
 // Send a custom request from a mini bot app, triggered by a web_app_invoke_custom_method event.
 func (c *Client) BotsInvokeWebViewCustomMethod(bot InputUser, customMethod string, params *DataJson) (*DataJson, error) {
-	responseData, err := c.MakeRequest(&BotsInvokeWebViewCustomMethodParams{
+	return c.BotsInvokeWebViewCustomMethodCtx(context.Background(), bot, customMethod, params)
+}
+
+// BotsInvokeWebViewCustomMethodCtx is the context-aware variant of BotsInvokeWebViewCustomMethod.
+func (c *Client) BotsInvokeWebViewCustomMethodCtx(ctx context.Context, bot InputUser, customMethod string, params *DataJson) (*DataJson, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsInvokeWebViewCustomMethodParams{
 		Bot:          bot,
 		CustomMethod: customMethod,
 		Params:       params,
@@ -4125,7 +4951,12 @@ func (*BotsReorderPreviewMediasParams) CRC() uint32 {
 
 // Reorder a main mini app previews
 func (c *Client) BotsReorderPreviewMedias(bot InputUser, langCode string, order []InputMedia) (bool, error) {
-	responseData, err := c.MakeRequest(&BotsReorderPreviewMediasParams{
+	return c.BotsReorderPreviewMediasCtx(context.Background(), bot, langCode, order)
+}
+
+// BotsReorderPreviewMediasCtx is the context-aware variant of BotsReorderPreviewMedias.
+func (c *Client) BotsReorderPreviewMediasCtx(ctx context.Context, bot InputUser, langCode string, order []InputMedia) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsReorderPreviewMediasParams{
 		Bot:      bot,
 		LangCode: langCode,
 		Order:    order,
@@ -4152,7 +4983,12 @@ func (*BotsReorderUsernamesParams) CRC() uint32 {
 
 // Reorder usernames associated to a bot we own.
 func (c *Client) BotsReorderUsernames(bot InputUser, order []string) (bool, error) {
-	responseData, err := c.MakeRequest(&BotsReorderUsernamesParams{
+	return c.BotsReorderUsernamesCtx(context.Background(), bot, order)
+}
+
+// BotsReorderUsernamesCtx is the context-aware variant of BotsReorderUsernames.
+func (c *Client) BotsReorderUsernamesCtx(ctx context.Context, bot InputUser, order []string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsReorderUsernamesParams{
 		Bot:   bot,
 		Order: order,
 	})
@@ -4178,7 +5014,12 @@ func (*BotsResetBotCommandsParams) CRC() uint32 {
 
 // Clear bot commands for the specified bot scope and language code
 func (c *Client) BotsResetBotCommands(scope BotCommandScope, langCode string) (bool, error) {
-	responseData, err := c.MakeRequest(&BotsResetBotCommandsParams{
+	return c.BotsResetBotCommandsCtx(context.Background(), scope, langCode)
+}
+
+// BotsResetBotCommandsCtx is the context-aware variant of BotsResetBotCommands.
+func (c *Client) BotsResetBotCommandsCtx(ctx context.Context, scope BotCommandScope, langCode string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsResetBotCommandsParams{
 		LangCode: langCode,
 		Scope:    scope,
 	})
@@ -4204,7 +5045,12 @@ func (*BotsSendCustomRequestParams) CRC() uint32 {
 
 // Sends a custom request; for bots only
 func (c *Client) BotsSendCustomRequest(customMethod string, params *DataJson) (*DataJson, error) {
-	responseData, err := c.MakeRequest(&BotsSendCustomRequestParams{
+	return c.BotsSendCustomRequestCtx(context.Background(), customMethod, params)
+}
+
+// BotsSendCustomRequestCtx is the context-aware variant of BotsSendCustomRequest.
+func (c *Client) BotsSendCustomRequestCtx(ctx context.Context, customMethod string, params *DataJson) (*DataJson, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsSendCustomRequestParams{
 		CustomMethod: customMethod,
 		Params:       params,
 	})
@@ -4229,7 +5075,12 @@ func (*BotsSetBotBroadcastDefaultAdminRightsParams) CRC() uint32 {
 
 // Set the default suggested admin rights for bots being added as admins to channels
 func (c *Client) BotsSetBotBroadcastDefaultAdminRights(adminRights *ChatAdminRights) (bool, error) {
-	responseData, err := c.MakeRequest(&BotsSetBotBroadcastDefaultAdminRightsParams{AdminRights: adminRights})
+	return c.BotsSetBotBroadcastDefaultAdminRightsCtx(context.Background(), adminRights)
+}
+
+// BotsSetBotBroadcastDefaultAdminRightsCtx is the context-aware variant of BotsSetBotBroadcastDefaultAdminRights.
+func (c *Client) BotsSetBotBroadcastDefaultAdminRightsCtx(ctx context.Context, adminRights *ChatAdminRights) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsSetBotBroadcastDefaultAdminRightsParams{AdminRights: adminRights})
 	if err != nil {
 		return false, fmt.Errorf("sending BotsSetBotBroadcastDefaultAdminRights: %w", err)
 	}
@@ -4253,7 +5104,12 @@ func (*BotsSetBotCommandsParams) CRC() uint32 {
 
 // Set bot command list
 func (c *Client) BotsSetBotCommands(scope BotCommandScope, langCode string, commands []*BotCommand) (bool, error) {
-	responseData, err := c.MakeRequest(&BotsSetBotCommandsParams{
+	return c.BotsSetBotCommandsCtx(context.Background(), scope, langCode, commands)
+}
+
+// BotsSetBotCommandsCtx is the context-aware variant of BotsSetBotCommands.
+func (c *Client) BotsSetBotCommandsCtx(ctx context.Context, scope BotCommandScope, langCode string, commands []*BotCommand) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsSetBotCommandsParams{
 		Commands: commands,
 		LangCode: langCode,
 		Scope:    scope,
@@ -4279,7 +5135,12 @@ func (*BotsSetBotGroupDefaultAdminRightsParams) CRC() uint32 {
 
 // Set the default suggested admin rights for bots being added as admins to groups
 func (c *Client) BotsSetBotGroupDefaultAdminRights(adminRights *ChatAdminRights) (bool, error) {
-	responseData, err := c.MakeRequest(&BotsSetBotGroupDefaultAdminRightsParams{AdminRights: adminRights})
+	return c.BotsSetBotGroupDefaultAdminRightsCtx(context.Background(), adminRights)
+}
+
+// BotsSetBotGroupDefaultAdminRightsCtx is the context-aware variant of BotsSetBotGroupDefaultAdminRights.
+func (c *Client) BotsSetBotGroupDefaultAdminRightsCtx(ctx context.Context, adminRights *ChatAdminRights) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsSetBotGroupDefaultAdminRightsParams{AdminRights: adminRights})
 	if err != nil {
 		return false, fmt.Errorf("sending BotsSetBotGroupDefaultAdminRights: %w", err)
 	}
@@ -4309,7 +5170,12 @@ func (*BotsSetBotInfoParams) FlagIndex() int {
 
 // Set localized name, about text and description of a bot (or of the current account, if called by a bot).
 func (c *Client) BotsSetBotInfo(params *BotsSetBotInfoParams) (bool, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.BotsSetBotInfoCtx(context.Background(), params)
+}
+
+// BotsSetBotInfoCtx is the context-aware variant of BotsSetBotInfo.
+func (c *Client) BotsSetBotInfoCtx(ctx context.Context, params *BotsSetBotInfoParams) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return false, fmt.Errorf("sending BotsSetBotInfo: %w", err)
 	}
@@ -4332,7 +5198,12 @@ func (*BotsSetBotMenuButtonParams) CRC() uint32 {
 
 // Sets the menu button action for a given user or for all users
 func (c *Client) BotsSetBotMenuButton(userID InputUser, button BotMenuButton) (bool, error) {
-	responseData, err := c.MakeRequest(&BotsSetBotMenuButtonParams{
+	return c.BotsSetBotMenuButtonCtx(context.Background(), userID, button)
+}
+
+// BotsSetBotMenuButtonCtx is the context-aware variant of BotsSetBotMenuButton.
+func (c *Client) BotsSetBotMenuButtonCtx(ctx context.Context, userID InputUser, button BotMenuButton) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsSetBotMenuButtonParams{
 		Button: button,
 		UserID: userID,
 	})
@@ -4364,7 +5235,12 @@ func (*BotsSetCustomVerificationParams) FlagIndex() int {
 
 // Verify a user or chat on behalf of an organization.
 func (c *Client) BotsSetCustomVerification(enabled bool, bot InputUser, peer InputPeer, customDescription string) (bool, error) {
-	responseData, err := c.MakeRequest(&BotsSetCustomVerificationParams{
+	return c.BotsSetCustomVerificationCtx(context.Background(), enabled, bot, peer, customDescription)
+}
+
+// BotsSetCustomVerificationCtx is the context-aware variant of BotsSetCustomVerification.
+func (c *Client) BotsSetCustomVerificationCtx(ctx context.Context, enabled bool, bot InputUser, peer InputPeer, customDescription string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsSetCustomVerificationParams{
 		Bot:               bot,
 		CustomDescription: customDescription,
 		Enabled:           enabled,
@@ -4392,7 +5268,12 @@ func (*BotsToggleUserEmojiStatusPermissionParams) CRC() uint32 {
 
 // Allow or prevent a bot from changing our emoji status
 func (c *Client) BotsToggleUserEmojiStatusPermission(bot InputUser, enabled bool) (bool, error) {
-	responseData, err := c.MakeRequest(&BotsToggleUserEmojiStatusPermissionParams{
+	return c.BotsToggleUserEmojiStatusPermissionCtx(context.Background(), bot, enabled)
+}
+
+// BotsToggleUserEmojiStatusPermissionCtx is the context-aware variant of BotsToggleUserEmojiStatusPermission.
+func (c *Client) BotsToggleUserEmojiStatusPermissionCtx(ctx context.Context, bot InputUser, enabled bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsToggleUserEmojiStatusPermissionParams{
 		Bot:     bot,
 		Enabled: enabled,
 	})
@@ -4419,7 +5300,12 @@ func (*BotsToggleUsernameParams) CRC() uint32 {
 
 // Activate or deactivate a purchased fragment.com username associated to a bot we own.
 func (c *Client) BotsToggleUsername(bot InputUser, username string, active bool) (bool, error) {
-	responseData, err := c.MakeRequest(&BotsToggleUsernameParams{
+	return c.BotsToggleUsernameCtx(context.Background(), bot, username, active)
+}
+
+// BotsToggleUsernameCtx is the context-aware variant of BotsToggleUsername.
+func (c *Client) BotsToggleUsernameCtx(ctx context.Context, bot InputUser, username string, active bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsToggleUsernameParams{
 		Active:   active,
 		Bot:      bot,
 		Username: username,
@@ -4451,7 +5337,12 @@ func (*BotsUpdateStarRefProgramParams) FlagIndex() int {
 
 // Create, edit or delete the affiliate program of a bot we own
 func (c *Client) BotsUpdateStarRefProgram(bot InputUser, commissionPermille, durationMonths int32) (*StarRefProgram, error) {
-	responseData, err := c.MakeRequest(&BotsUpdateStarRefProgramParams{
+	return c.BotsUpdateStarRefProgramCtx(context.Background(), bot, commissionPermille, durationMonths)
+}
+
+// BotsUpdateStarRefProgramCtx is the context-aware variant of BotsUpdateStarRefProgram.
+func (c *Client) BotsUpdateStarRefProgramCtx(ctx context.Context, bot InputUser, commissionPermille, durationMonths int32) (*StarRefProgram, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsUpdateStarRefProgramParams{
 		Bot:                bot,
 		CommissionPermille: commissionPermille,
 		DurationMonths:     durationMonths,
@@ -4478,7 +5369,12 @@ func (*BotsUpdateUserEmojiStatusParams) CRC() uint32 {
 
 // Change the emoji status of a user (invoked by bots
 func (c *Client) BotsUpdateUserEmojiStatus(userID InputUser, emojiStatus EmojiStatus) (bool, error) {
-	responseData, err := c.MakeRequest(&BotsUpdateUserEmojiStatusParams{
+	return c.BotsUpdateUserEmojiStatusCtx(context.Background(), userID, emojiStatus)
+}
+
+// BotsUpdateUserEmojiStatusCtx is the context-aware variant of BotsUpdateUserEmojiStatus.
+func (c *Client) BotsUpdateUserEmojiStatusCtx(ctx context.Context, userID InputUser, emojiStatus EmojiStatus) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &BotsUpdateUserEmojiStatusParams{
 		EmojiStatus: emojiStatus,
 		UserID:      userID,
 	})
@@ -4507,7 +5403,12 @@ func (*ChannelsCheckSearchPostsFloodParams) FlagIndex() int {
 
 // Check if the specified global post search requires payment.
 func (c *Client) ChannelsCheckSearchPostsFlood(query string) (*SearchPostsFlood, error) {
-	responseData, err := c.MakeRequest(&ChannelsCheckSearchPostsFloodParams{Query: query})
+	return c.ChannelsCheckSearchPostsFloodCtx(context.Background(), query)
+}
+
+// ChannelsCheckSearchPostsFloodCtx is the context-aware variant of ChannelsCheckSearchPostsFlood.
+func (c *Client) ChannelsCheckSearchPostsFloodCtx(ctx context.Context, query string) (*SearchPostsFlood, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsCheckSearchPostsFloodParams{Query: query})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChannelsCheckSearchPostsFlood: %w", err)
 	}
@@ -4530,7 +5431,12 @@ func (*ChannelsCheckUsernameParams) CRC() uint32 {
 
 // Check if a username is free and can be assigned to a channel/supergroup
 func (c *Client) ChannelsCheckUsername(channel InputChannel, username string) (bool, error) {
-	responseData, err := c.MakeRequest(&ChannelsCheckUsernameParams{
+	return c.ChannelsCheckUsernameCtx(context.Background(), channel, username)
+}
+
+// ChannelsCheckUsernameCtx is the context-aware variant of ChannelsCheckUsername.
+func (c *Client) ChannelsCheckUsernameCtx(ctx context.Context, channel InputChannel, username string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsCheckUsernameParams{
 		Channel:  channel,
 		Username: username,
 	})
@@ -4555,7 +5461,12 @@ func (*ChannelsConvertToGigagroupParams) CRC() uint32 {
 
 // Convert a supergroup to a gigagroup, when requested by channel suggestions.
 func (c *Client) ChannelsConvertToGigagroup(channel InputChannel) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsConvertToGigagroupParams{Channel: channel})
+	return c.ChannelsConvertToGigagroupCtx(context.Background(), channel)
+}
+
+// ChannelsConvertToGigagroupCtx is the context-aware variant of ChannelsConvertToGigagroup.
+func (c *Client) ChannelsConvertToGigagroupCtx(ctx context.Context, channel InputChannel) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsConvertToGigagroupParams{Channel: channel})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChannelsConvertToGigagroup: %w", err)
 	}
@@ -4589,7 +5500,12 @@ func (*ChannelsCreateChannelParams) FlagIndex() int {
 
 // Create a supergroup/channel.
 func (c *Client) ChannelsCreateChannel(params *ChannelsCreateChannelParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.ChannelsCreateChannelCtx(context.Background(), params)
+}
+
+// ChannelsCreateChannelCtx is the context-aware variant of ChannelsCreateChannel.
+func (c *Client) ChannelsCreateChannelCtx(ctx context.Context, params *ChannelsCreateChannelParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending ChannelsCreateChannel: %w", err)
 	}
@@ -4611,7 +5527,12 @@ func (*ChannelsDeactivateAllUsernamesParams) CRC() uint32 {
 
 // Disable all purchased usernames of a supergroup or channel
 func (c *Client) ChannelsDeactivateAllUsernames(channel InputChannel) (bool, error) {
-	responseData, err := c.MakeRequest(&ChannelsDeactivateAllUsernamesParams{Channel: channel})
+	return c.ChannelsDeactivateAllUsernamesCtx(context.Background(), channel)
+}
+
+// ChannelsDeactivateAllUsernamesCtx is the context-aware variant of ChannelsDeactivateAllUsernames.
+func (c *Client) ChannelsDeactivateAllUsernamesCtx(ctx context.Context, channel InputChannel) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsDeactivateAllUsernamesParams{Channel: channel})
 	if err != nil {
 		return false, fmt.Errorf("sending ChannelsDeactivateAllUsernames: %w", err)
 	}
@@ -4633,7 +5554,12 @@ func (*ChannelsDeleteChannelParams) CRC() uint32 {
 
 // Delete a channel/supergroup
 func (c *Client) ChannelsDeleteChannel(channel InputChannel) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsDeleteChannelParams{Channel: channel})
+	return c.ChannelsDeleteChannelCtx(context.Background(), channel)
+}
+
+// ChannelsDeleteChannelCtx is the context-aware variant of ChannelsDeleteChannel.
+func (c *Client) ChannelsDeleteChannelCtx(ctx context.Context, channel InputChannel) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsDeleteChannelParams{Channel: channel})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChannelsDeleteChannel: %w", err)
 	}
@@ -4661,7 +5587,12 @@ func (*ChannelsDeleteHistoryParams) FlagIndex() int {
 
 // Delete the history of a supergroup
 func (c *Client) ChannelsDeleteHistory(forEveryone bool, channel InputChannel, maxID int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsDeleteHistoryParams{
+	return c.ChannelsDeleteHistoryCtx(context.Background(), forEveryone, channel, maxID)
+}
+
+// ChannelsDeleteHistoryCtx is the context-aware variant of ChannelsDeleteHistory.
+func (c *Client) ChannelsDeleteHistoryCtx(ctx context.Context, forEveryone bool, channel InputChannel, maxID int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsDeleteHistoryParams{
 		Channel:     channel,
 		ForEveryone: forEveryone,
 		MaxID:       maxID,
@@ -4688,7 +5619,12 @@ func (*ChannelsDeleteMessagesParams) CRC() uint32 {
 
 // Delete messages in a channel/supergroup
 func (c *Client) ChannelsDeleteMessages(channel InputChannel, id []int32) (*MessagesAffectedMessages, error) {
-	responseData, err := c.MakeRequest(&ChannelsDeleteMessagesParams{
+	return c.ChannelsDeleteMessagesCtx(context.Background(), channel, id)
+}
+
+// ChannelsDeleteMessagesCtx is the context-aware variant of ChannelsDeleteMessages.
+func (c *Client) ChannelsDeleteMessagesCtx(ctx context.Context, channel InputChannel, id []int32) (*MessagesAffectedMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsDeleteMessagesParams{
 		Channel: channel,
 		ID:      id,
 	})
@@ -4714,7 +5650,12 @@ func (*ChannelsDeleteParticipantHistoryParams) CRC() uint32 {
 
 // Delete all messages sent by a specific participant of a given supergroup
 func (c *Client) ChannelsDeleteParticipantHistory(channel InputChannel, participant InputPeer) (*MessagesAffectedHistory, error) {
-	responseData, err := c.MakeRequest(&ChannelsDeleteParticipantHistoryParams{
+	return c.ChannelsDeleteParticipantHistoryCtx(context.Background(), channel, participant)
+}
+
+// ChannelsDeleteParticipantHistoryCtx is the context-aware variant of ChannelsDeleteParticipantHistory.
+func (c *Client) ChannelsDeleteParticipantHistoryCtx(ctx context.Context, channel InputChannel, participant InputPeer) (*MessagesAffectedHistory, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsDeleteParticipantHistoryParams{
 		Channel:     channel,
 		Participant: participant,
 	})
@@ -4742,7 +5683,12 @@ func (*ChannelsEditAdminParams) CRC() uint32 {
 
 // Modify the admin rights of a user in a supergroup/channel.
 func (c *Client) ChannelsEditAdmin(channel InputChannel, userID InputUser, adminRights *ChatAdminRights, rank string) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsEditAdminParams{
+	return c.ChannelsEditAdminCtx(context.Background(), channel, userID, adminRights, rank)
+}
+
+// ChannelsEditAdminCtx is the context-aware variant of ChannelsEditAdmin.
+func (c *Client) ChannelsEditAdminCtx(ctx context.Context, channel InputChannel, userID InputUser, adminRights *ChatAdminRights, rank string) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsEditAdminParams{
 		AdminRights: adminRights,
 		Channel:     channel,
 		Rank:        rank,
@@ -4771,7 +5717,12 @@ func (*ChannelsEditBannedParams) CRC() uint32 {
 
 // Ban/unban/kick a user in a supergroup/channel.
 func (c *Client) ChannelsEditBanned(channel InputChannel, participant InputPeer, bannedRights *ChatBannedRights) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsEditBannedParams{
+	return c.ChannelsEditBannedCtx(context.Background(), channel, participant, bannedRights)
+}
+
+// ChannelsEditBannedCtx is the context-aware variant of ChannelsEditBanned.
+func (c *Client) ChannelsEditBannedCtx(ctx context.Context, channel InputChannel, participant InputPeer, bannedRights *ChatBannedRights) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsEditBannedParams{
 		BannedRights: bannedRights,
 		Channel:      channel,
 		Participant:  participant,
@@ -4799,7 +5750,12 @@ func (*ChannelsEditCreatorParams) CRC() uint32 {
 
 // Transfer channel ownership
 func (c *Client) ChannelsEditCreator(channel InputChannel, userID InputUser, password InputCheckPasswordSRP) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsEditCreatorParams{
+	return c.ChannelsEditCreatorCtx(context.Background(), channel, userID, password)
+}
+
+// ChannelsEditCreatorCtx is the context-aware variant of ChannelsEditCreator.
+func (c *Client) ChannelsEditCreatorCtx(ctx context.Context, channel InputChannel, userID InputUser, password InputCheckPasswordSRP) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsEditCreatorParams{
 		Channel:  channel,
 		Password: password,
 		UserID:   userID,
@@ -4827,7 +5783,12 @@ func (*ChannelsEditLocationParams) CRC() uint32 {
 
 // Edit location of geogroup
 func (c *Client) ChannelsEditLocation(channel InputChannel, geoPoint InputGeoPoint, address string) (bool, error) {
-	responseData, err := c.MakeRequest(&ChannelsEditLocationParams{
+	return c.ChannelsEditLocationCtx(context.Background(), channel, geoPoint, address)
+}
+
+// ChannelsEditLocationCtx is the context-aware variant of ChannelsEditLocation.
+func (c *Client) ChannelsEditLocationCtx(ctx context.Context, channel InputChannel, geoPoint InputGeoPoint, address string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsEditLocationParams{
 		Address:  address,
 		Channel:  channel,
 		GeoPoint: geoPoint,
@@ -4854,7 +5815,12 @@ func (*ChannelsEditPhotoParams) CRC() uint32 {
 
 // Change the photo of a channel/supergroup
 func (c *Client) ChannelsEditPhoto(channel InputChannel, photo InputChatPhoto) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsEditPhotoParams{
+	return c.ChannelsEditPhotoCtx(context.Background(), channel, photo)
+}
+
+// ChannelsEditPhotoCtx is the context-aware variant of ChannelsEditPhoto.
+func (c *Client) ChannelsEditPhotoCtx(ctx context.Context, channel InputChannel, photo InputChatPhoto) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsEditPhotoParams{
 		Channel: channel,
 		Photo:   photo,
 	})
@@ -4880,7 +5846,12 @@ func (*ChannelsEditTitleParams) CRC() uint32 {
 
 // Edit the name of a channel/supergroup
 func (c *Client) ChannelsEditTitle(channel InputChannel, title string) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsEditTitleParams{
+	return c.ChannelsEditTitleCtx(context.Background(), channel, title)
+}
+
+// ChannelsEditTitleCtx is the context-aware variant of ChannelsEditTitle.
+func (c *Client) ChannelsEditTitleCtx(ctx context.Context, channel InputChannel, title string) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsEditTitleParams{
 		Channel: channel,
 		Title:   title,
 	})
@@ -4912,7 +5883,12 @@ func (*ChannelsExportMessageLinkParams) FlagIndex() int {
 
 // Get link and embed info of a message in a channel/supergroup
 func (c *Client) ChannelsExportMessageLink(grouped, thread bool, channel InputChannel, id int32) (*ExportedMessageLink, error) {
-	responseData, err := c.MakeRequest(&ChannelsExportMessageLinkParams{
+	return c.ChannelsExportMessageLinkCtx(context.Background(), grouped, thread, channel, id)
+}
+
+// ChannelsExportMessageLinkCtx is the context-aware variant of ChannelsExportMessageLink.
+func (c *Client) ChannelsExportMessageLinkCtx(ctx context.Context, grouped, thread bool, channel InputChannel, id int32) (*ExportedMessageLink, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsExportMessageLinkParams{
 		Channel: channel,
 		Grouped: grouped,
 		ID:      id,
@@ -4949,7 +5925,12 @@ func (*ChannelsGetAdminLogParams) FlagIndex() int {
 
 // Get the admin log of a channel/supergroup
 func (c *Client) ChannelsGetAdminLog(params *ChannelsGetAdminLogParams) (*ChannelsAdminLogResults, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.ChannelsGetAdminLogCtx(context.Background(), params)
+}
+
+// ChannelsGetAdminLogCtx is the context-aware variant of ChannelsGetAdminLog.
+func (c *Client) ChannelsGetAdminLogCtx(ctx context.Context, params *ChannelsGetAdminLogParams) (*ChannelsAdminLogResults, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending ChannelsGetAdminLog: %w", err)
 	}
@@ -4977,7 +5958,12 @@ func (*ChannelsGetAdminedPublicChannelsParams) FlagIndex() int {
 
 // Get channels/supergroups/geogroups we're admin in. Usually called when the user exceeds the limit for owned public channels/supergroups/geogroups, and the user is given the choice to remove one of his channels/supergroups/geogroups.
 func (c *Client) ChannelsGetAdminedPublicChannels(byLocation, checkLimit, forPersonal bool) (MessagesChats, error) {
-	responseData, err := c.MakeRequest(&ChannelsGetAdminedPublicChannelsParams{
+	return c.ChannelsGetAdminedPublicChannelsCtx(context.Background(), byLocation, checkLimit, forPersonal)
+}
+
+// ChannelsGetAdminedPublicChannelsCtx is the context-aware variant of ChannelsGetAdminedPublicChannels.
+func (c *Client) ChannelsGetAdminedPublicChannelsCtx(ctx context.Context, byLocation, checkLimit, forPersonal bool) (MessagesChats, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsGetAdminedPublicChannelsParams{
 		ByLocation:  byLocation,
 		CheckLimit:  checkLimit,
 		ForPersonal: forPersonal,
@@ -5007,7 +5993,12 @@ func (*ChannelsGetChannelRecommendationsParams) FlagIndex() int {
 
 // Obtain a list of similarly themed public channels, selected based on similarities in their subscriber bases.
 func (c *Client) ChannelsGetChannelRecommendations(channel InputChannel) (MessagesChats, error) {
-	responseData, err := c.MakeRequest(&ChannelsGetChannelRecommendationsParams{Channel: channel})
+	return c.ChannelsGetChannelRecommendationsCtx(context.Background(), channel)
+}
+
+// ChannelsGetChannelRecommendationsCtx is the context-aware variant of ChannelsGetChannelRecommendations.
+func (c *Client) ChannelsGetChannelRecommendationsCtx(ctx context.Context, channel InputChannel) (MessagesChats, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsGetChannelRecommendationsParams{Channel: channel})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChannelsGetChannelRecommendations: %w", err)
 	}
@@ -5029,7 +6020,12 @@ func (*ChannelsGetChannelsParams) CRC() uint32 {
 
 // Get info about channels/supergroups
 func (c *Client) ChannelsGetChannels(id []InputChannel) (MessagesChats, error) {
-	responseData, err := c.MakeRequest(&ChannelsGetChannelsParams{ID: id})
+	return c.ChannelsGetChannelsCtx(context.Background(), id)
+}
+
+// ChannelsGetChannelsCtx is the context-aware variant of ChannelsGetChannels.
+func (c *Client) ChannelsGetChannelsCtx(ctx context.Context, id []InputChannel) (MessagesChats, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsGetChannelsParams{ID: id})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChannelsGetChannels: %w", err)
 	}
@@ -5051,7 +6047,12 @@ func (*ChannelsGetFullChannelParams) CRC() uint32 {
 
 // Get full info about a supergroup, gigagroup or channel
 func (c *Client) ChannelsGetFullChannel(channel InputChannel) (*MessagesChatFull, error) {
-	responseData, err := c.MakeRequest(&ChannelsGetFullChannelParams{Channel: channel})
+	return c.ChannelsGetFullChannelCtx(context.Background(), channel)
+}
+
+// ChannelsGetFullChannelCtx is the context-aware variant of ChannelsGetFullChannel.
+func (c *Client) ChannelsGetFullChannelCtx(ctx context.Context, channel InputChannel) (*MessagesChatFull, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsGetFullChannelParams{Channel: channel})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChannelsGetFullChannel: %w", err)
 	}
@@ -5072,7 +6073,12 @@ func (*ChannelsGetFutureCreatorAfterLeaveParams) CRC() uint32 {
 }
 
 func (c *Client) ChannelsGetFutureCreatorAfterLeave(channel InputChannel) (User, error) {
-	responseData, err := c.MakeRequest(&ChannelsGetFutureCreatorAfterLeaveParams{Channel: channel})
+	return c.ChannelsGetFutureCreatorAfterLeaveCtx(context.Background(), channel)
+}
+
+// ChannelsGetFutureCreatorAfterLeaveCtx is the context-aware variant of ChannelsGetFutureCreatorAfterLeave.
+func (c *Client) ChannelsGetFutureCreatorAfterLeaveCtx(ctx context.Context, channel InputChannel) (User, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsGetFutureCreatorAfterLeaveParams{Channel: channel})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChannelsGetFutureCreatorAfterLeave: %w", err)
 	}
@@ -5092,7 +6098,12 @@ func (*ChannelsGetGroupsForDiscussionParams) CRC() uint32 {
 
 // Get all groups that can be used as discussion groups.
 func (c *Client) ChannelsGetGroupsForDiscussion() (MessagesChats, error) {
-	responseData, err := c.MakeRequest(&ChannelsGetGroupsForDiscussionParams{})
+	return c.ChannelsGetGroupsForDiscussionCtx(context.Background())
+}
+
+// ChannelsGetGroupsForDiscussionCtx is the context-aware variant of ChannelsGetGroupsForDiscussion.
+func (c *Client) ChannelsGetGroupsForDiscussionCtx(ctx context.Context) (MessagesChats, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsGetGroupsForDiscussionParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChannelsGetGroupsForDiscussion: %w", err)
 	}
@@ -5112,7 +6123,12 @@ func (*ChannelsGetInactiveChannelsParams) CRC() uint32 {
 
 // Get inactive channels and supergroups
 func (c *Client) ChannelsGetInactiveChannels() (*MessagesInactiveChats, error) {
-	responseData, err := c.MakeRequest(&ChannelsGetInactiveChannelsParams{})
+	return c.ChannelsGetInactiveChannelsCtx(context.Background())
+}
+
+// ChannelsGetInactiveChannelsCtx is the context-aware variant of ChannelsGetInactiveChannels.
+func (c *Client) ChannelsGetInactiveChannelsCtx(ctx context.Context) (*MessagesInactiveChats, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsGetInactiveChannelsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChannelsGetInactiveChannels: %w", err)
 	}
@@ -5134,7 +6150,12 @@ func (*ChannelsGetLeftChannelsParams) CRC() uint32 {
 
 // Get a list of channels/supergroups we left, requires a takeout session
 func (c *Client) ChannelsGetLeftChannels(offset int32) (MessagesChats, error) {
-	responseData, err := c.MakeRequest(&ChannelsGetLeftChannelsParams{Offset: offset})
+	return c.ChannelsGetLeftChannelsCtx(context.Background(), offset)
+}
+
+// ChannelsGetLeftChannelsCtx is the context-aware variant of ChannelsGetLeftChannels.
+func (c *Client) ChannelsGetLeftChannelsCtx(ctx context.Context, offset int32) (MessagesChats, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsGetLeftChannelsParams{Offset: offset})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChannelsGetLeftChannels: %w", err)
 	}
@@ -5157,7 +6178,12 @@ func (*ChannelsGetMessageAuthorParams) CRC() uint32 {
 
 // Can only be invoked by non-bot admins of a monoforum, obtains the original sender of a message sent by other monoforum admins to the monoforum, on behalf of the channel associated to the monoforum.
 func (c *Client) ChannelsGetMessageAuthor(channel InputChannel, id int32) (User, error) {
-	responseData, err := c.MakeRequest(&ChannelsGetMessageAuthorParams{
+	return c.ChannelsGetMessageAuthorCtx(context.Background(), channel, id)
+}
+
+// ChannelsGetMessageAuthorCtx is the context-aware variant of ChannelsGetMessageAuthor.
+func (c *Client) ChannelsGetMessageAuthorCtx(ctx context.Context, channel InputChannel, id int32) (User, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsGetMessageAuthorParams{
 		Channel: channel,
 		ID:      id,
 	})
@@ -5183,7 +6209,12 @@ func (*ChannelsGetMessagesParams) CRC() uint32 {
 
 // Get channel/supergroup messages
 func (c *Client) ChannelsGetMessages(channel InputChannel, id []InputMessage) (MessagesMessages, error) {
-	responseData, err := c.MakeRequest(&ChannelsGetMessagesParams{
+	return c.ChannelsGetMessagesCtx(context.Background(), channel, id)
+}
+
+// ChannelsGetMessagesCtx is the context-aware variant of ChannelsGetMessages.
+func (c *Client) ChannelsGetMessagesCtx(ctx context.Context, channel InputChannel, id []InputMessage) (MessagesMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsGetMessagesParams{
 		Channel: channel,
 		ID:      id,
 	})
@@ -5209,7 +6240,12 @@ func (*ChannelsGetParticipantParams) CRC() uint32 {
 
 // Get info about a channel/supergroup participant
 func (c *Client) ChannelsGetParticipant(channel InputChannel, participant InputPeer) (*ChannelsChannelParticipant, error) {
-	responseData, err := c.MakeRequest(&ChannelsGetParticipantParams{
+	return c.ChannelsGetParticipantCtx(context.Background(), channel, participant)
+}
+
+// ChannelsGetParticipantCtx is the context-aware variant of ChannelsGetParticipant.
+func (c *Client) ChannelsGetParticipantCtx(ctx context.Context, channel InputChannel, participant InputPeer) (*ChannelsChannelParticipant, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsGetParticipantParams{
 		Channel:     channel,
 		Participant: participant,
 	})
@@ -5238,7 +6274,12 @@ func (*ChannelsGetParticipantsParams) CRC() uint32 {
 
 // Get the participants of a supergroup/channel
 func (c *Client) ChannelsGetParticipants(channel InputChannel, filter ChannelParticipantsFilter, offset, limit int32, hash int64) (ChannelsChannelParticipants, error) {
-	responseData, err := c.MakeRequest(&ChannelsGetParticipantsParams{
+	return c.ChannelsGetParticipantsCtx(context.Background(), channel, filter, offset, limit, hash)
+}
+
+// ChannelsGetParticipantsCtx is the context-aware variant of ChannelsGetParticipants.
+func (c *Client) ChannelsGetParticipantsCtx(ctx context.Context, channel InputChannel, filter ChannelParticipantsFilter, offset, limit int32, hash int64) (ChannelsChannelParticipants, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsGetParticipantsParams{
 		Channel: channel,
 		Filter:  filter,
 		Hash:    hash,
@@ -5272,7 +6313,12 @@ func (*ChannelsGetSendAsParams) FlagIndex() int {
 
 // Obtains a list of peers that can be used to send messages in a specific group
 func (c *Client) ChannelsGetSendAs(forPaidReactions, forLiveStories bool, peer InputPeer) (*ChannelsSendAsPeers, error) {
-	responseData, err := c.MakeRequest(&ChannelsGetSendAsParams{
+	return c.ChannelsGetSendAsCtx(context.Background(), forPaidReactions, forLiveStories, peer)
+}
+
+// ChannelsGetSendAsCtx is the context-aware variant of ChannelsGetSendAs.
+func (c *Client) ChannelsGetSendAsCtx(ctx context.Context, forPaidReactions, forLiveStories bool, peer InputPeer) (*ChannelsSendAsPeers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsGetSendAsParams{
 		ForLiveStories:   forLiveStories,
 		ForPaidReactions: forPaidReactions,
 		Peer:             peer,
@@ -5299,7 +6345,12 @@ func (*ChannelsInviteToChannelParams) CRC() uint32 {
 
 // Invite users to a channel/supergroup
 func (c *Client) ChannelsInviteToChannel(channel InputChannel, users []InputUser) (*MessagesInvitedUsers, error) {
-	responseData, err := c.MakeRequest(&ChannelsInviteToChannelParams{
+	return c.ChannelsInviteToChannelCtx(context.Background(), channel, users)
+}
+
+// ChannelsInviteToChannelCtx is the context-aware variant of ChannelsInviteToChannel.
+func (c *Client) ChannelsInviteToChannelCtx(ctx context.Context, channel InputChannel, users []InputUser) (*MessagesInvitedUsers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsInviteToChannelParams{
 		Channel: channel,
 		Users:   users,
 	})
@@ -5324,7 +6375,12 @@ func (*ChannelsJoinChannelParams) CRC() uint32 {
 
 // Join a channel/supergroup
 func (c *Client) ChannelsJoinChannel(channel InputChannel) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsJoinChannelParams{Channel: channel})
+	return c.ChannelsJoinChannelCtx(context.Background(), channel)
+}
+
+// ChannelsJoinChannelCtx is the context-aware variant of ChannelsJoinChannel.
+func (c *Client) ChannelsJoinChannelCtx(ctx context.Context, channel InputChannel) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsJoinChannelParams{Channel: channel})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChannelsJoinChannel: %w", err)
 	}
@@ -5346,7 +6402,12 @@ func (*ChannelsLeaveChannelParams) CRC() uint32 {
 
 // Leave a channel/supergroup
 func (c *Client) ChannelsLeaveChannel(channel InputChannel) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsLeaveChannelParams{Channel: channel})
+	return c.ChannelsLeaveChannelCtx(context.Background(), channel)
+}
+
+// ChannelsLeaveChannelCtx is the context-aware variant of ChannelsLeaveChannel.
+func (c *Client) ChannelsLeaveChannelCtx(ctx context.Context, channel InputChannel) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsLeaveChannelParams{Channel: channel})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChannelsLeaveChannel: %w", err)
 	}
@@ -5369,7 +6430,12 @@ func (*ChannelsReadHistoryParams) CRC() uint32 {
 
 // Mark channel/supergroup history as read
 func (c *Client) ChannelsReadHistory(channel InputChannel, maxID int32) (bool, error) {
-	responseData, err := c.MakeRequest(&ChannelsReadHistoryParams{
+	return c.ChannelsReadHistoryCtx(context.Background(), channel, maxID)
+}
+
+// ChannelsReadHistoryCtx is the context-aware variant of ChannelsReadHistory.
+func (c *Client) ChannelsReadHistoryCtx(ctx context.Context, channel InputChannel, maxID int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsReadHistoryParams{
 		Channel: channel,
 		MaxID:   maxID,
 	})
@@ -5395,7 +6461,12 @@ func (*ChannelsReadMessageContentsParams) CRC() uint32 {
 
 // Mark channel/supergroup message contents as read, emitting an updateChannelReadMessagesContents.
 func (c *Client) ChannelsReadMessageContents(channel InputChannel, id []int32) (bool, error) {
-	responseData, err := c.MakeRequest(&ChannelsReadMessageContentsParams{
+	return c.ChannelsReadMessageContentsCtx(context.Background(), channel, id)
+}
+
+// ChannelsReadMessageContentsCtx is the context-aware variant of ChannelsReadMessageContents.
+func (c *Client) ChannelsReadMessageContentsCtx(ctx context.Context, channel InputChannel, id []int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsReadMessageContentsParams{
 		Channel: channel,
 		ID:      id,
 	})
@@ -5421,7 +6492,12 @@ func (*ChannelsReorderUsernamesParams) CRC() uint32 {
 
 // Reorder active usernames
 func (c *Client) ChannelsReorderUsernames(channel InputChannel, order []string) (bool, error) {
-	responseData, err := c.MakeRequest(&ChannelsReorderUsernamesParams{
+	return c.ChannelsReorderUsernamesCtx(context.Background(), channel, order)
+}
+
+// ChannelsReorderUsernamesCtx is the context-aware variant of ChannelsReorderUsernames.
+func (c *Client) ChannelsReorderUsernamesCtx(ctx context.Context, channel InputChannel, order []string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsReorderUsernamesParams{
 		Channel: channel,
 		Order:   order,
 	})
@@ -5447,7 +6523,12 @@ func (*ChannelsReportAntiSpamFalsePositiveParams) CRC() uint32 {
 
 // Report a native antispam false positive
 func (c *Client) ChannelsReportAntiSpamFalsePositive(channel InputChannel, msgID int32) (bool, error) {
-	responseData, err := c.MakeRequest(&ChannelsReportAntiSpamFalsePositiveParams{
+	return c.ChannelsReportAntiSpamFalsePositiveCtx(context.Background(), channel, msgID)
+}
+
+// ChannelsReportAntiSpamFalsePositiveCtx is the context-aware variant of ChannelsReportAntiSpamFalsePositive.
+func (c *Client) ChannelsReportAntiSpamFalsePositiveCtx(ctx context.Context, channel InputChannel, msgID int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsReportAntiSpamFalsePositiveParams{
 		Channel: channel,
 		MsgID:   msgID,
 	})
@@ -5474,7 +6555,12 @@ func (*ChannelsReportSpamParams) CRC() uint32 {
 
 // Reports some messages from a user in a supergroup as spam; requires administrator rights in the supergroup
 func (c *Client) ChannelsReportSpam(channel InputChannel, participant InputPeer, id []int32) (bool, error) {
-	responseData, err := c.MakeRequest(&ChannelsReportSpamParams{
+	return c.ChannelsReportSpamCtx(context.Background(), channel, participant, id)
+}
+
+// ChannelsReportSpamCtx is the context-aware variant of ChannelsReportSpam.
+func (c *Client) ChannelsReportSpamCtx(ctx context.Context, channel InputChannel, participant InputPeer, id []int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsReportSpamParams{
 		Channel:     channel,
 		ID:          id,
 		Participant: participant,
@@ -5501,7 +6587,12 @@ func (*ChannelsRestrictSponsoredMessagesParams) CRC() uint32 {
 
 // Disable ads on the specified channel, for all users.
 func (c *Client) ChannelsRestrictSponsoredMessages(channel InputChannel, restricted bool) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsRestrictSponsoredMessagesParams{
+	return c.ChannelsRestrictSponsoredMessagesCtx(context.Background(), channel, restricted)
+}
+
+// ChannelsRestrictSponsoredMessagesCtx is the context-aware variant of ChannelsRestrictSponsoredMessages.
+func (c *Client) ChannelsRestrictSponsoredMessagesCtx(ctx context.Context, channel InputChannel, restricted bool) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsRestrictSponsoredMessagesParams{
 		Channel:    channel,
 		Restricted: restricted,
 	})
@@ -5536,7 +6627,12 @@ func (*ChannelsSearchPostsParams) FlagIndex() int {
 
 // Globally search for posts from public channels (<em>including</em> those we aren't a member of) containing either a specific hashtag, <em>or</em> a full text query.
 func (c *Client) ChannelsSearchPosts(params *ChannelsSearchPostsParams) (MessagesMessages, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.ChannelsSearchPostsCtx(context.Background(), params)
+}
+
+// ChannelsSearchPostsCtx is the context-aware variant of ChannelsSearchPosts.
+func (c *Client) ChannelsSearchPostsCtx(ctx context.Context, params *ChannelsSearchPostsParams) (MessagesMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending ChannelsSearchPosts: %w", err)
 	}
@@ -5559,7 +6655,12 @@ func (*ChannelsSetBoostsToUnblockRestrictionsParams) CRC() uint32 {
 
 // Admins with ban_users admin rights may allow users that apply a certain number of booosts to the group to bypass slow mode and other supergroup restrictions
 func (c *Client) ChannelsSetBoostsToUnblockRestrictions(channel InputChannel, boosts int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsSetBoostsToUnblockRestrictionsParams{
+	return c.ChannelsSetBoostsToUnblockRestrictionsCtx(context.Background(), channel, boosts)
+}
+
+// ChannelsSetBoostsToUnblockRestrictionsCtx is the context-aware variant of ChannelsSetBoostsToUnblockRestrictions.
+func (c *Client) ChannelsSetBoostsToUnblockRestrictionsCtx(ctx context.Context, channel InputChannel, boosts int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsSetBoostsToUnblockRestrictionsParams{
 		Boosts:  boosts,
 		Channel: channel,
 	})
@@ -5585,7 +6686,12 @@ func (*ChannelsSetDiscussionGroupParams) CRC() uint32 {
 
 // Associate a group to a channel as discussion group for that channel
 func (c *Client) ChannelsSetDiscussionGroup(broadcast, group InputChannel) (bool, error) {
-	responseData, err := c.MakeRequest(&ChannelsSetDiscussionGroupParams{
+	return c.ChannelsSetDiscussionGroupCtx(context.Background(), broadcast, group)
+}
+
+// ChannelsSetDiscussionGroupCtx is the context-aware variant of ChannelsSetDiscussionGroup.
+func (c *Client) ChannelsSetDiscussionGroupCtx(ctx context.Context, broadcast, group InputChannel) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsSetDiscussionGroupParams{
 		Broadcast: broadcast,
 		Group:     group,
 	})
@@ -5611,7 +6717,12 @@ func (*ChannelsSetEmojiStickersParams) CRC() uint32 {
 
 // Set a custom emoji stickerset for supergroups. Only usable after reaching at least the boost level specified in the `group_emoji_stickers_level_min` config parameter.
 func (c *Client) ChannelsSetEmojiStickers(channel InputChannel, stickerset InputStickerSet) (bool, error) {
-	responseData, err := c.MakeRequest(&ChannelsSetEmojiStickersParams{
+	return c.ChannelsSetEmojiStickersCtx(context.Background(), channel, stickerset)
+}
+
+// ChannelsSetEmojiStickersCtx is the context-aware variant of ChannelsSetEmojiStickers.
+func (c *Client) ChannelsSetEmojiStickersCtx(ctx context.Context, channel InputChannel, stickerset InputStickerSet) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsSetEmojiStickersParams{
 		Channel:    channel,
 		Stickerset: stickerset,
 	})
@@ -5637,7 +6748,12 @@ func (*ChannelsSetMainProfileTabParams) CRC() uint32 {
 
 // Changes the main profile tab of a channel
 func (c *Client) ChannelsSetMainProfileTab(channel InputChannel, tab ProfileTab) (bool, error) {
-	responseData, err := c.MakeRequest(&ChannelsSetMainProfileTabParams{
+	return c.ChannelsSetMainProfileTabCtx(context.Background(), channel, tab)
+}
+
+// ChannelsSetMainProfileTabCtx is the context-aware variant of ChannelsSetMainProfileTab.
+func (c *Client) ChannelsSetMainProfileTabCtx(ctx context.Context, channel InputChannel, tab ProfileTab) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsSetMainProfileTabParams{
 		Channel: channel,
 		Tab:     tab,
 	})
@@ -5663,7 +6779,12 @@ func (*ChannelsSetStickersParams) CRC() uint32 {
 
 // Associate a stickerset to the supergroup
 func (c *Client) ChannelsSetStickers(channel InputChannel, stickerset InputStickerSet) (bool, error) {
-	responseData, err := c.MakeRequest(&ChannelsSetStickersParams{
+	return c.ChannelsSetStickersCtx(context.Background(), channel, stickerset)
+}
+
+// ChannelsSetStickersCtx is the context-aware variant of ChannelsSetStickers.
+func (c *Client) ChannelsSetStickersCtx(ctx context.Context, channel InputChannel, stickerset InputStickerSet) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsSetStickersParams{
 		Channel:    channel,
 		Stickerset: stickerset,
 	})
@@ -5689,7 +6810,12 @@ func (*ChannelsToggleAntiSpamParams) CRC() uint32 {
 
 // Enable or disable the native antispam system.
 func (c *Client) ChannelsToggleAntiSpam(channel InputChannel, enabled bool) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsToggleAntiSpamParams{
+	return c.ChannelsToggleAntiSpamCtx(context.Background(), channel, enabled)
+}
+
+// ChannelsToggleAntiSpamCtx is the context-aware variant of ChannelsToggleAntiSpam.
+func (c *Client) ChannelsToggleAntiSpamCtx(ctx context.Context, channel InputChannel, enabled bool) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsToggleAntiSpamParams{
 		Channel: channel,
 		Enabled: enabled,
 	})
@@ -5715,7 +6841,12 @@ func (*ChannelsToggleAutotranslationParams) CRC() uint32 {
 
 // Toggle autotranslation in a channel, for all users:
 func (c *Client) ChannelsToggleAutotranslation(channel InputChannel, enabled bool) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsToggleAutotranslationParams{
+	return c.ChannelsToggleAutotranslationCtx(context.Background(), channel, enabled)
+}
+
+// ChannelsToggleAutotranslationCtx is the context-aware variant of ChannelsToggleAutotranslation.
+func (c *Client) ChannelsToggleAutotranslationCtx(ctx context.Context, channel InputChannel, enabled bool) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsToggleAutotranslationParams{
 		Channel: channel,
 		Enabled: enabled,
 	})
@@ -5742,7 +6873,12 @@ func (*ChannelsToggleForumParams) CRC() uint32 {
 
 // Enable or disable forum functionality in a supergroup.
 func (c *Client) ChannelsToggleForum(channel InputChannel, enabled, tabs bool) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsToggleForumParams{
+	return c.ChannelsToggleForumCtx(context.Background(), channel, enabled, tabs)
+}
+
+// ChannelsToggleForumCtx is the context-aware variant of ChannelsToggleForum.
+func (c *Client) ChannelsToggleForumCtx(ctx context.Context, channel InputChannel, enabled, tabs bool) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsToggleForumParams{
 		Channel: channel,
 		Enabled: enabled,
 		Tabs:    tabs,
@@ -5769,7 +6905,12 @@ func (*ChannelsToggleJoinRequestParams) CRC() uint32 {
 
 // Set whether all users should request admin approval to join the group.
 func (c *Client) ChannelsToggleJoinRequest(channel InputChannel, enabled bool) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsToggleJoinRequestParams{
+	return c.ChannelsToggleJoinRequestCtx(context.Background(), channel, enabled)
+}
+
+// ChannelsToggleJoinRequestCtx is the context-aware variant of ChannelsToggleJoinRequest.
+func (c *Client) ChannelsToggleJoinRequestCtx(ctx context.Context, channel InputChannel, enabled bool) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsToggleJoinRequestParams{
 		Channel: channel,
 		Enabled: enabled,
 	})
@@ -5795,7 +6936,12 @@ func (*ChannelsToggleJoinToSendParams) CRC() uint32 {
 
 // Set whether all users should join a discussion group in order to comment on a post
 func (c *Client) ChannelsToggleJoinToSend(channel InputChannel, enabled bool) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsToggleJoinToSendParams{
+	return c.ChannelsToggleJoinToSendCtx(context.Background(), channel, enabled)
+}
+
+// ChannelsToggleJoinToSendCtx is the context-aware variant of ChannelsToggleJoinToSend.
+func (c *Client) ChannelsToggleJoinToSendCtx(ctx context.Context, channel InputChannel, enabled bool) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsToggleJoinToSendParams{
 		Channel: channel,
 		Enabled: enabled,
 	})
@@ -5821,7 +6967,12 @@ func (*ChannelsToggleParticipantsHiddenParams) CRC() uint32 {
 
 // Hide or display the participants list in a supergroup.
 func (c *Client) ChannelsToggleParticipantsHidden(channel InputChannel, enabled bool) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsToggleParticipantsHiddenParams{
+	return c.ChannelsToggleParticipantsHiddenCtx(context.Background(), channel, enabled)
+}
+
+// ChannelsToggleParticipantsHiddenCtx is the context-aware variant of ChannelsToggleParticipantsHidden.
+func (c *Client) ChannelsToggleParticipantsHiddenCtx(ctx context.Context, channel InputChannel, enabled bool) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsToggleParticipantsHiddenParams{
 		Channel: channel,
 		Enabled: enabled,
 	})
@@ -5847,7 +6998,12 @@ func (*ChannelsTogglePreHistoryHiddenParams) CRC() uint32 {
 
 // Hide/unhide message history for new channel/supergroup users
 func (c *Client) ChannelsTogglePreHistoryHidden(channel InputChannel, enabled bool) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsTogglePreHistoryHiddenParams{
+	return c.ChannelsTogglePreHistoryHiddenCtx(context.Background(), channel, enabled)
+}
+
+// ChannelsTogglePreHistoryHiddenCtx is the context-aware variant of ChannelsTogglePreHistoryHidden.
+func (c *Client) ChannelsTogglePreHistoryHiddenCtx(ctx context.Context, channel InputChannel, enabled bool) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsTogglePreHistoryHiddenParams{
 		Channel: channel,
 		Enabled: enabled,
 	})
@@ -5878,7 +7034,12 @@ func (*ChannelsToggleSignaturesParams) FlagIndex() int {
 
 // Enable/disable message signatures in channels
 func (c *Client) ChannelsToggleSignatures(signaturesEnabled, profilesEnabled bool, channel InputChannel) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsToggleSignaturesParams{
+	return c.ChannelsToggleSignaturesCtx(context.Background(), signaturesEnabled, profilesEnabled, channel)
+}
+
+// ChannelsToggleSignaturesCtx is the context-aware variant of ChannelsToggleSignatures.
+func (c *Client) ChannelsToggleSignaturesCtx(ctx context.Context, signaturesEnabled, profilesEnabled bool, channel InputChannel) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsToggleSignaturesParams{
 		Channel:           channel,
 		ProfilesEnabled:   profilesEnabled,
 		SignaturesEnabled: signaturesEnabled,
@@ -5905,7 +7066,12 @@ func (*ChannelsToggleSlowModeParams) CRC() uint32 {
 
 // Toggle supergroup slow mode: if enabled, users will only be able to send one message every `seconds` seconds
 func (c *Client) ChannelsToggleSlowMode(channel InputChannel, seconds int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsToggleSlowModeParams{
+	return c.ChannelsToggleSlowModeCtx(context.Background(), channel, seconds)
+}
+
+// ChannelsToggleSlowModeCtx is the context-aware variant of ChannelsToggleSlowMode.
+func (c *Client) ChannelsToggleSlowModeCtx(ctx context.Context, channel InputChannel, seconds int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsToggleSlowModeParams{
 		Channel: channel,
 		Seconds: seconds,
 	})
@@ -5932,7 +7098,12 @@ func (*ChannelsToggleUsernameParams) CRC() uint32 {
 
 // Activate or deactivate a purchased fragment.com username associated to a supergroup or channel we own.
 func (c *Client) ChannelsToggleUsername(channel InputChannel, username string, active bool) (bool, error) {
-	responseData, err := c.MakeRequest(&ChannelsToggleUsernameParams{
+	return c.ChannelsToggleUsernameCtx(context.Background(), channel, username, active)
+}
+
+// ChannelsToggleUsernameCtx is the context-aware variant of ChannelsToggleUsername.
+func (c *Client) ChannelsToggleUsernameCtx(ctx context.Context, channel InputChannel, username string, active bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsToggleUsernameParams{
 		Active:   active,
 		Channel:  channel,
 		Username: username,
@@ -5959,7 +7130,12 @@ func (*ChannelsToggleViewForumAsMessagesParams) CRC() uint32 {
 
 // Users may also choose to display messages from all topics of a forum as if they were sent to a normal group, using a "View as messages" setting in the local client: this setting only affects the current account, and is synced to other logged in sessions using this method.
 func (c *Client) ChannelsToggleViewForumAsMessages(channel InputChannel, enabled bool) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsToggleViewForumAsMessagesParams{
+	return c.ChannelsToggleViewForumAsMessagesCtx(context.Background(), channel, enabled)
+}
+
+// ChannelsToggleViewForumAsMessagesCtx is the context-aware variant of ChannelsToggleViewForumAsMessages.
+func (c *Client) ChannelsToggleViewForumAsMessagesCtx(ctx context.Context, channel InputChannel, enabled bool) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsToggleViewForumAsMessagesParams{
 		Channel: channel,
 		Enabled: enabled,
 	})
@@ -5991,7 +7167,12 @@ func (*ChannelsUpdateColorParams) FlagIndex() int {
 
 // Update the accent color and background custom emoji of a channel.
 func (c *Client) ChannelsUpdateColor(forProfile bool, channel InputChannel, color int32, backgroundEmojiID int64) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsUpdateColorParams{
+	return c.ChannelsUpdateColorCtx(context.Background(), forProfile, channel, color, backgroundEmojiID)
+}
+
+// ChannelsUpdateColorCtx is the context-aware variant of ChannelsUpdateColor.
+func (c *Client) ChannelsUpdateColorCtx(ctx context.Context, forProfile bool, channel InputChannel, color int32, backgroundEmojiID int64) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsUpdateColorParams{
 		BackgroundEmojiID: backgroundEmojiID,
 		Channel:           channel,
 		Color:             color,
@@ -6019,7 +7200,12 @@ func (*ChannelsUpdateEmojiStatusParams) CRC() uint32 {
 
 // Set an emoji status for a channel or supergroup.
 func (c *Client) ChannelsUpdateEmojiStatus(channel InputChannel, emojiStatus EmojiStatus) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsUpdateEmojiStatusParams{
+	return c.ChannelsUpdateEmojiStatusCtx(context.Background(), channel, emojiStatus)
+}
+
+// ChannelsUpdateEmojiStatusCtx is the context-aware variant of ChannelsUpdateEmojiStatus.
+func (c *Client) ChannelsUpdateEmojiStatusCtx(ctx context.Context, channel InputChannel, emojiStatus EmojiStatus) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsUpdateEmojiStatusParams{
 		Channel:     channel,
 		EmojiStatus: emojiStatus,
 	})
@@ -6050,7 +7236,12 @@ func (*ChannelsUpdatePaidMessagesPriceParams) FlagIndex() int {
 
 // Enable or disable paid messages in this supergroup or monoforum.
 func (c *Client) ChannelsUpdatePaidMessagesPrice(broadcastMessagesAllowed bool, channel InputChannel, sendPaidMessagesStars int64) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChannelsUpdatePaidMessagesPriceParams{
+	return c.ChannelsUpdatePaidMessagesPriceCtx(context.Background(), broadcastMessagesAllowed, channel, sendPaidMessagesStars)
+}
+
+// ChannelsUpdatePaidMessagesPriceCtx is the context-aware variant of ChannelsUpdatePaidMessagesPrice.
+func (c *Client) ChannelsUpdatePaidMessagesPriceCtx(ctx context.Context, broadcastMessagesAllowed bool, channel InputChannel, sendPaidMessagesStars int64) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsUpdatePaidMessagesPriceParams{
 		BroadcastMessagesAllowed: broadcastMessagesAllowed,
 		Channel:                  channel,
 		SendPaidMessagesStars:    sendPaidMessagesStars,
@@ -6077,7 +7268,12 @@ func (*ChannelsUpdateUsernameParams) CRC() uint32 {
 
 // Change or remove the username of a supergroup/channel
 func (c *Client) ChannelsUpdateUsername(channel InputChannel, username string) (bool, error) {
-	responseData, err := c.MakeRequest(&ChannelsUpdateUsernameParams{
+	return c.ChannelsUpdateUsernameCtx(context.Background(), channel, username)
+}
+
+// ChannelsUpdateUsernameCtx is the context-aware variant of ChannelsUpdateUsername.
+func (c *Client) ChannelsUpdateUsernameCtx(ctx context.Context, channel InputChannel, username string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChannelsUpdateUsernameParams{
 		Channel:  channel,
 		Username: username,
 	})
@@ -6102,7 +7298,12 @@ func (*ChatlistsCheckChatlistInviteParams) CRC() uint32 {
 
 // Obtain information about a chat folder deep link.
 func (c *Client) ChatlistsCheckChatlistInvite(slug string) (ChatlistsChatlistInvite, error) {
-	responseData, err := c.MakeRequest(&ChatlistsCheckChatlistInviteParams{Slug: slug})
+	return c.ChatlistsCheckChatlistInviteCtx(context.Background(), slug)
+}
+
+// ChatlistsCheckChatlistInviteCtx is the context-aware variant of ChatlistsCheckChatlistInvite.
+func (c *Client) ChatlistsCheckChatlistInviteCtx(ctx context.Context, slug string) (ChatlistsChatlistInvite, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChatlistsCheckChatlistInviteParams{Slug: slug})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChatlistsCheckChatlistInvite: %w", err)
 	}
@@ -6125,7 +7326,12 @@ func (*ChatlistsDeleteExportedInviteParams) CRC() uint32 {
 
 // Delete a previously created chat folder deep link.
 func (c *Client) ChatlistsDeleteExportedInvite(chatlist *InputChatlistDialogFilter, slug string) (bool, error) {
-	responseData, err := c.MakeRequest(&ChatlistsDeleteExportedInviteParams{
+	return c.ChatlistsDeleteExportedInviteCtx(context.Background(), chatlist, slug)
+}
+
+// ChatlistsDeleteExportedInviteCtx is the context-aware variant of ChatlistsDeleteExportedInvite.
+func (c *Client) ChatlistsDeleteExportedInviteCtx(ctx context.Context, chatlist *InputChatlistDialogFilter, slug string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChatlistsDeleteExportedInviteParams{
 		Chatlist: chatlist,
 		Slug:     slug,
 	})
@@ -6158,7 +7364,12 @@ func (*ChatlistsEditExportedInviteParams) FlagIndex() int {
 
 // Edit a chat folder deep link.
 func (c *Client) ChatlistsEditExportedInvite(params *ChatlistsEditExportedInviteParams) (*ExportedChatlistInvite, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.ChatlistsEditExportedInviteCtx(context.Background(), params)
+}
+
+// ChatlistsEditExportedInviteCtx is the context-aware variant of ChatlistsEditExportedInvite.
+func (c *Client) ChatlistsEditExportedInviteCtx(ctx context.Context, params *ChatlistsEditExportedInviteParams) (*ExportedChatlistInvite, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending ChatlistsEditExportedInvite: %w", err)
 	}
@@ -6182,7 +7393,12 @@ func (*ChatlistsExportChatlistInviteParams) CRC() uint32 {
 
 // Export a folder, creating a chat folder deep link.
 func (c *Client) ChatlistsExportChatlistInvite(chatlist *InputChatlistDialogFilter, title string, peers []InputPeer) (*ChatlistsExportedChatlistInvite, error) {
-	responseData, err := c.MakeRequest(&ChatlistsExportChatlistInviteParams{
+	return c.ChatlistsExportChatlistInviteCtx(context.Background(), chatlist, title, peers)
+}
+
+// ChatlistsExportChatlistInviteCtx is the context-aware variant of ChatlistsExportChatlistInvite.
+func (c *Client) ChatlistsExportChatlistInviteCtx(ctx context.Context, chatlist *InputChatlistDialogFilter, title string, peers []InputPeer) (*ChatlistsExportedChatlistInvite, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChatlistsExportChatlistInviteParams{
 		Chatlist: chatlist,
 		Peers:    peers,
 		Title:    title,
@@ -6208,7 +7424,12 @@ func (*ChatlistsGetChatlistUpdatesParams) CRC() uint32 {
 
 // Fetch new chats associated with an imported chat folder deep link. Must be invoked at most every `chatlist_update_period` seconds (as per the related client configuration parameter ).
 func (c *Client) ChatlistsGetChatlistUpdates(chatlist *InputChatlistDialogFilter) (*ChatlistsChatlistUpdates, error) {
-	responseData, err := c.MakeRequest(&ChatlistsGetChatlistUpdatesParams{Chatlist: chatlist})
+	return c.ChatlistsGetChatlistUpdatesCtx(context.Background(), chatlist)
+}
+
+// ChatlistsGetChatlistUpdatesCtx is the context-aware variant of ChatlistsGetChatlistUpdates.
+func (c *Client) ChatlistsGetChatlistUpdatesCtx(ctx context.Context, chatlist *InputChatlistDialogFilter) (*ChatlistsChatlistUpdates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChatlistsGetChatlistUpdatesParams{Chatlist: chatlist})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChatlistsGetChatlistUpdates: %w", err)
 	}
@@ -6230,7 +7451,12 @@ func (*ChatlistsGetExportedInvitesParams) CRC() uint32 {
 
 // List all chat folder deep links associated to a folder
 func (c *Client) ChatlistsGetExportedInvites(chatlist *InputChatlistDialogFilter) (*ChatlistsExportedInvites, error) {
-	responseData, err := c.MakeRequest(&ChatlistsGetExportedInvitesParams{Chatlist: chatlist})
+	return c.ChatlistsGetExportedInvitesCtx(context.Background(), chatlist)
+}
+
+// ChatlistsGetExportedInvitesCtx is the context-aware variant of ChatlistsGetExportedInvites.
+func (c *Client) ChatlistsGetExportedInvitesCtx(ctx context.Context, chatlist *InputChatlistDialogFilter) (*ChatlistsExportedInvites, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChatlistsGetExportedInvitesParams{Chatlist: chatlist})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChatlistsGetExportedInvites: %w", err)
 	}
@@ -6252,7 +7478,12 @@ func (*ChatlistsGetLeaveChatlistSuggestionsParams) CRC() uint32 {
 
 // Returns identifiers of pinned or always included chats from a chat folder imported using a chat folder deep link, which are suggested to be left when the chat folder is deleted.
 func (c *Client) ChatlistsGetLeaveChatlistSuggestions(chatlist *InputChatlistDialogFilter) ([]Peer, error) {
-	responseData, err := c.MakeRequest(&ChatlistsGetLeaveChatlistSuggestionsParams{Chatlist: chatlist})
+	return c.ChatlistsGetLeaveChatlistSuggestionsCtx(context.Background(), chatlist)
+}
+
+// ChatlistsGetLeaveChatlistSuggestionsCtx is the context-aware variant of ChatlistsGetLeaveChatlistSuggestions.
+func (c *Client) ChatlistsGetLeaveChatlistSuggestionsCtx(ctx context.Context, chatlist *InputChatlistDialogFilter) ([]Peer, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChatlistsGetLeaveChatlistSuggestionsParams{Chatlist: chatlist})
 	if err != nil {
 		return nil, fmt.Errorf("sending ChatlistsGetLeaveChatlistSuggestions: %w", err)
 	}
@@ -6274,7 +7505,12 @@ func (*ChatlistsHideChatlistUpdatesParams) CRC() uint32 {
 
 // Dismiss new pending peers recently added to a chat folder deep link.
 func (c *Client) ChatlistsHideChatlistUpdates(chatlist *InputChatlistDialogFilter) (bool, error) {
-	responseData, err := c.MakeRequest(&ChatlistsHideChatlistUpdatesParams{Chatlist: chatlist})
+	return c.ChatlistsHideChatlistUpdatesCtx(context.Background(), chatlist)
+}
+
+// ChatlistsHideChatlistUpdatesCtx is the context-aware variant of ChatlistsHideChatlistUpdates.
+func (c *Client) ChatlistsHideChatlistUpdatesCtx(ctx context.Context, chatlist *InputChatlistDialogFilter) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChatlistsHideChatlistUpdatesParams{Chatlist: chatlist})
 	if err != nil {
 		return false, fmt.Errorf("sending ChatlistsHideChatlistUpdates: %w", err)
 	}
@@ -6297,7 +7533,12 @@ func (*ChatlistsJoinChatlistInviteParams) CRC() uint32 {
 
 // Import a chat folder deep link, joining some or all the chats in the folder.
 func (c *Client) ChatlistsJoinChatlistInvite(slug string, peers []InputPeer) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChatlistsJoinChatlistInviteParams{
+	return c.ChatlistsJoinChatlistInviteCtx(context.Background(), slug, peers)
+}
+
+// ChatlistsJoinChatlistInviteCtx is the context-aware variant of ChatlistsJoinChatlistInvite.
+func (c *Client) ChatlistsJoinChatlistInviteCtx(ctx context.Context, slug string, peers []InputPeer) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChatlistsJoinChatlistInviteParams{
 		Peers: peers,
 		Slug:  slug,
 	})
@@ -6323,7 +7564,12 @@ func (*ChatlistsJoinChatlistUpdatesParams) CRC() uint32 {
 
 // Join channels and supergroups recently added to a chat folder deep link.
 func (c *Client) ChatlistsJoinChatlistUpdates(chatlist *InputChatlistDialogFilter, peers []InputPeer) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChatlistsJoinChatlistUpdatesParams{
+	return c.ChatlistsJoinChatlistUpdatesCtx(context.Background(), chatlist, peers)
+}
+
+// ChatlistsJoinChatlistUpdatesCtx is the context-aware variant of ChatlistsJoinChatlistUpdates.
+func (c *Client) ChatlistsJoinChatlistUpdatesCtx(ctx context.Context, chatlist *InputChatlistDialogFilter, peers []InputPeer) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChatlistsJoinChatlistUpdatesParams{
 		Chatlist: chatlist,
 		Peers:    peers,
 	})
@@ -6349,7 +7595,12 @@ func (*ChatlistsLeaveChatlistParams) CRC() uint32 {
 
 // Delete a folder imported using a chat folder deep link
 func (c *Client) ChatlistsLeaveChatlist(chatlist *InputChatlistDialogFilter, peers []InputPeer) (Updates, error) {
-	responseData, err := c.MakeRequest(&ChatlistsLeaveChatlistParams{
+	return c.ChatlistsLeaveChatlistCtx(context.Background(), chatlist, peers)
+}
+
+// ChatlistsLeaveChatlistCtx is the context-aware variant of ChatlistsLeaveChatlist.
+func (c *Client) ChatlistsLeaveChatlistCtx(ctx context.Context, chatlist *InputChatlistDialogFilter, peers []InputPeer) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ChatlistsLeaveChatlistParams{
 		Chatlist: chatlist,
 		Peers:    peers,
 	})
@@ -6374,7 +7625,12 @@ func (*ContactsAcceptContactParams) CRC() uint32 {
 
 // If the add contact action bar is active, add that user as contact
 func (c *Client) ContactsAcceptContact(id InputUser) (Updates, error) {
-	responseData, err := c.MakeRequest(&ContactsAcceptContactParams{ID: id})
+	return c.ContactsAcceptContactCtx(context.Background(), id)
+}
+
+// ContactsAcceptContactCtx is the context-aware variant of ContactsAcceptContact.
+func (c *Client) ContactsAcceptContactCtx(ctx context.Context, id InputUser) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsAcceptContactParams{ID: id})
 	if err != nil {
 		return nil, fmt.Errorf("sending ContactsAcceptContact: %w", err)
 	}
@@ -6405,7 +7661,12 @@ func (*ContactsAddContactParams) FlagIndex() int {
 
 // Add an existing telegram user as contact.
 func (c *Client) ContactsAddContact(params *ContactsAddContactParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.ContactsAddContactCtx(context.Background(), params)
+}
+
+// ContactsAddContactCtx is the context-aware variant of ContactsAddContact.
+func (c *Client) ContactsAddContactCtx(ctx context.Context, params *ContactsAddContactParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending ContactsAddContact: %w", err)
 	}
@@ -6432,7 +7693,12 @@ func (*ContactsBlockParams) FlagIndex() int {
 
 // Adds a peer to a blocklist
 func (c *Client) ContactsBlock(myStoriesFrom bool, id InputPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&ContactsBlockParams{
+	return c.ContactsBlockCtx(context.Background(), myStoriesFrom, id)
+}
+
+// ContactsBlockCtx is the context-aware variant of ContactsBlock.
+func (c *Client) ContactsBlockCtx(ctx context.Context, myStoriesFrom bool, id InputPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsBlockParams{
 		ID:            id,
 		MyStoriesFrom: myStoriesFrom,
 	})
@@ -6464,7 +7730,12 @@ func (*ContactsBlockFromRepliesParams) FlagIndex() int {
 
 // Stop getting notifications about discussion replies of a certain user in `@replies`
 func (c *Client) ContactsBlockFromReplies(deleteMessage, deleteHistory, reportSpam bool, msgID int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&ContactsBlockFromRepliesParams{
+	return c.ContactsBlockFromRepliesCtx(context.Background(), deleteMessage, deleteHistory, reportSpam, msgID)
+}
+
+// ContactsBlockFromRepliesCtx is the context-aware variant of ContactsBlockFromReplies.
+func (c *Client) ContactsBlockFromRepliesCtx(ctx context.Context, deleteMessage, deleteHistory, reportSpam bool, msgID int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsBlockFromRepliesParams{
 		DeleteHistory: deleteHistory,
 		DeleteMessage: deleteMessage,
 		MsgID:         msgID,
@@ -6491,7 +7762,12 @@ func (*ContactsDeleteByPhonesParams) CRC() uint32 {
 
 // Delete contacts by phone number
 func (c *Client) ContactsDeleteByPhones(phones []string) (bool, error) {
-	responseData, err := c.MakeRequest(&ContactsDeleteByPhonesParams{Phones: phones})
+	return c.ContactsDeleteByPhonesCtx(context.Background(), phones)
+}
+
+// ContactsDeleteByPhonesCtx is the context-aware variant of ContactsDeleteByPhones.
+func (c *Client) ContactsDeleteByPhonesCtx(ctx context.Context, phones []string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsDeleteByPhonesParams{Phones: phones})
 	if err != nil {
 		return false, fmt.Errorf("sending ContactsDeleteByPhones: %w", err)
 	}
@@ -6513,7 +7789,12 @@ func (*ContactsDeleteContactsParams) CRC() uint32 {
 
 // Deletes several contacts from the list.
 func (c *Client) ContactsDeleteContacts(id []InputUser) (Updates, error) {
-	responseData, err := c.MakeRequest(&ContactsDeleteContactsParams{ID: id})
+	return c.ContactsDeleteContactsCtx(context.Background(), id)
+}
+
+// ContactsDeleteContactsCtx is the context-aware variant of ContactsDeleteContacts.
+func (c *Client) ContactsDeleteContactsCtx(ctx context.Context, id []InputUser) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsDeleteContactsParams{ID: id})
 	if err != nil {
 		return nil, fmt.Errorf("sending ContactsDeleteContacts: %w", err)
 	}
@@ -6535,7 +7816,12 @@ func (*ContactsEditCloseFriendsParams) CRC() uint32 {
 
 // Edit the close friends list
 func (c *Client) ContactsEditCloseFriends(id []int64) (bool, error) {
-	responseData, err := c.MakeRequest(&ContactsEditCloseFriendsParams{ID: id})
+	return c.ContactsEditCloseFriendsCtx(context.Background(), id)
+}
+
+// ContactsEditCloseFriendsCtx is the context-aware variant of ContactsEditCloseFriends.
+func (c *Client) ContactsEditCloseFriendsCtx(ctx context.Context, id []int64) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsEditCloseFriendsParams{ID: id})
 	if err != nil {
 		return false, fmt.Errorf("sending ContactsEditCloseFriends: %w", err)
 	}
@@ -6555,7 +7841,12 @@ func (*ContactsExportContactTokenParams) CRC() uint32 {
 
 // Generates a temporary profile link for the currently logged-in user.
 func (c *Client) ContactsExportContactToken() (*ExportedContactToken, error) {
-	responseData, err := c.MakeRequest(&ContactsExportContactTokenParams{})
+	return c.ContactsExportContactTokenCtx(context.Background())
+}
+
+// ContactsExportContactTokenCtx is the context-aware variant of ContactsExportContactToken.
+func (c *Client) ContactsExportContactTokenCtx(ctx context.Context) (*ExportedContactToken, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsExportContactTokenParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending ContactsExportContactToken: %w", err)
 	}
@@ -6575,7 +7866,12 @@ func (*ContactsGetBirthdaysParams) CRC() uint32 {
 
 // Fetch all users with birthdays that fall within +1/-1 days, relative to the current day: this method should be invoked by clients every 6-8 hours, and if the result is non-empty, it should be used to appropriately update locally cached birthday information in user.`birthday`.
 func (c *Client) ContactsGetBirthdays() (*ContactsContactBirthdays, error) {
-	responseData, err := c.MakeRequest(&ContactsGetBirthdaysParams{})
+	return c.ContactsGetBirthdaysCtx(context.Background())
+}
+
+// ContactsGetBirthdaysCtx is the context-aware variant of ContactsGetBirthdays.
+func (c *Client) ContactsGetBirthdaysCtx(ctx context.Context) (*ContactsContactBirthdays, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsGetBirthdaysParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending ContactsGetBirthdays: %w", err)
 	}
@@ -6603,7 +7899,12 @@ func (*ContactsGetBlockedParams) FlagIndex() int {
 
 // Returns the list of blocked users.
 func (c *Client) ContactsGetBlocked(myStoriesFrom bool, offset, limit int32) (ContactsBlocked, error) {
-	responseData, err := c.MakeRequest(&ContactsGetBlockedParams{
+	return c.ContactsGetBlockedCtx(context.Background(), myStoriesFrom, offset, limit)
+}
+
+// ContactsGetBlockedCtx is the context-aware variant of ContactsGetBlocked.
+func (c *Client) ContactsGetBlockedCtx(ctx context.Context, myStoriesFrom bool, offset, limit int32) (ContactsBlocked, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsGetBlockedParams{
 		Limit:         limit,
 		MyStoriesFrom: myStoriesFrom,
 		Offset:        offset,
@@ -6629,7 +7930,12 @@ func (*ContactsGetContactIDsParams) CRC() uint32 {
 
 // Get the telegram IDs of all contacts. Returns an array of Telegram user IDs for all contacts (0 if a contact does not have an associated Telegram account or have hidden their account using privacy settings).
 func (c *Client) ContactsGetContactIDs(hash int64) ([]int32, error) {
-	responseData, err := c.MakeRequest(&ContactsGetContactIDsParams{Hash: hash})
+	return c.ContactsGetContactIDsCtx(context.Background(), hash)
+}
+
+// ContactsGetContactIDsCtx is the context-aware variant of ContactsGetContactIDs.
+func (c *Client) ContactsGetContactIDsCtx(ctx context.Context, hash int64) ([]int32, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsGetContactIDsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending ContactsGetContactIDs: %w", err)
 	}
@@ -6651,7 +7957,12 @@ func (*ContactsGetContactsParams) CRC() uint32 {
 
 // Returns the current user's contact list.
 func (c *Client) ContactsGetContacts(hash int64) (ContactsContacts, error) {
-	responseData, err := c.MakeRequest(&ContactsGetContactsParams{Hash: hash})
+	return c.ContactsGetContactsCtx(context.Background(), hash)
+}
+
+// ContactsGetContactsCtx is the context-aware variant of ContactsGetContacts.
+func (c *Client) ContactsGetContactsCtx(ctx context.Context, hash int64) (ContactsContacts, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsGetContactsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending ContactsGetContacts: %w", err)
 	}
@@ -6679,7 +7990,12 @@ func (*ContactsGetLocatedParams) FlagIndex() int {
 
 // Get users and geochats near you
 func (c *Client) ContactsGetLocated(background bool, geoPoint InputGeoPoint, selfExpires int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&ContactsGetLocatedParams{
+	return c.ContactsGetLocatedCtx(context.Background(), background, geoPoint, selfExpires)
+}
+
+// ContactsGetLocatedCtx is the context-aware variant of ContactsGetLocated.
+func (c *Client) ContactsGetLocatedCtx(ctx context.Context, background bool, geoPoint InputGeoPoint, selfExpires int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsGetLocatedParams{
 		Background:  background,
 		GeoPoint:    geoPoint,
 		SelfExpires: selfExpires,
@@ -6703,7 +8019,12 @@ func (*ContactsGetSavedParams) CRC() uint32 {
 
 // Get all contacts, requires a takeout session
 func (c *Client) ContactsGetSaved() ([]*SavedPhoneContact, error) {
-	responseData, err := c.MakeRequest(&ContactsGetSavedParams{})
+	return c.ContactsGetSavedCtx(context.Background())
+}
+
+// ContactsGetSavedCtx is the context-aware variant of ContactsGetSaved.
+func (c *Client) ContactsGetSavedCtx(ctx context.Context) ([]*SavedPhoneContact, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsGetSavedParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending ContactsGetSaved: %w", err)
 	}
@@ -6725,7 +8046,12 @@ func (*ContactsGetSponsoredPeersParams) CRC() uint32 {
 
 // Obtain a list of sponsored peer search results for a given query
 func (c *Client) ContactsGetSponsoredPeers(q string) (ContactsSponsoredPeers, error) {
-	responseData, err := c.MakeRequest(&ContactsGetSponsoredPeersParams{Q: q})
+	return c.ContactsGetSponsoredPeersCtx(context.Background(), q)
+}
+
+// ContactsGetSponsoredPeersCtx is the context-aware variant of ContactsGetSponsoredPeers.
+func (c *Client) ContactsGetSponsoredPeersCtx(ctx context.Context, q string) (ContactsSponsoredPeers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsGetSponsoredPeersParams{Q: q})
 	if err != nil {
 		return nil, fmt.Errorf("sending ContactsGetSponsoredPeers: %w", err)
 	}
@@ -6745,7 +8071,12 @@ func (*ContactsGetStatusesParams) CRC() uint32 {
 
 // Use this method to obtain the online statuses of all contacts with an accessible Telegram account.
 func (c *Client) ContactsGetStatuses() ([]*ContactStatus, error) {
-	responseData, err := c.MakeRequest(&ContactsGetStatusesParams{})
+	return c.ContactsGetStatusesCtx(context.Background())
+}
+
+// ContactsGetStatusesCtx is the context-aware variant of ContactsGetStatuses.
+func (c *Client) ContactsGetStatusesCtx(ctx context.Context) ([]*ContactStatus, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsGetStatusesParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending ContactsGetStatuses: %w", err)
 	}
@@ -6782,7 +8113,12 @@ func (*ContactsGetTopPeersParams) FlagIndex() int {
 
 // Get most used peers
 func (c *Client) ContactsGetTopPeers(params *ContactsGetTopPeersParams) (ContactsTopPeers, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.ContactsGetTopPeersCtx(context.Background(), params)
+}
+
+// ContactsGetTopPeersCtx is the context-aware variant of ContactsGetTopPeers.
+func (c *Client) ContactsGetTopPeersCtx(ctx context.Context, params *ContactsGetTopPeersParams) (ContactsTopPeers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending ContactsGetTopPeers: %w", err)
 	}
@@ -6804,7 +8140,12 @@ func (*ContactsImportCardParams) CRC() uint32 {
 
 // Returns general information on a user using his previously exported card as input. The app may use it to open a conversation without knowing the user's phone number.
 func (c *Client) ContactsImportCard(exportCard []int32) (User, error) {
-	responseData, err := c.MakeRequest(&ContactsImportCardParams{ExportCard: exportCard})
+	return c.ContactsImportCardCtx(context.Background(), exportCard)
+}
+
+// ContactsImportCardCtx is the context-aware variant of ContactsImportCard.
+func (c *Client) ContactsImportCardCtx(ctx context.Context, exportCard []int32) (User, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsImportCardParams{ExportCard: exportCard})
 	if err != nil {
 		return nil, fmt.Errorf("sending ContactsImportCard: %w", err)
 	}
@@ -6826,7 +8167,12 @@ func (*ContactsImportContactTokenParams) CRC() uint32 {
 
 // Obtain user info from a temporary profile link.
 func (c *Client) ContactsImportContactToken(token string) (User, error) {
-	responseData, err := c.MakeRequest(&ContactsImportContactTokenParams{Token: token})
+	return c.ContactsImportContactTokenCtx(context.Background(), token)
+}
+
+// ContactsImportContactTokenCtx is the context-aware variant of ContactsImportContactToken.
+func (c *Client) ContactsImportContactTokenCtx(ctx context.Context, token string) (User, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsImportContactTokenParams{Token: token})
 	if err != nil {
 		return nil, fmt.Errorf("sending ContactsImportContactToken: %w", err)
 	}
@@ -6848,7 +8194,12 @@ func (*ContactsImportContactsParams) CRC() uint32 {
 
 // Imports contacts: saves a full list on the server, adds already registered contacts to the contact list, returns added contacts and their info.
 func (c *Client) ContactsImportContacts(contacts []*InputPhoneContact) (*ContactsImportedContacts, error) {
-	responseData, err := c.MakeRequest(&ContactsImportContactsParams{Contacts: contacts})
+	return c.ContactsImportContactsCtx(context.Background(), contacts)
+}
+
+// ContactsImportContactsCtx is the context-aware variant of ContactsImportContacts.
+func (c *Client) ContactsImportContactsCtx(ctx context.Context, contacts []*InputPhoneContact) (*ContactsImportedContacts, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsImportContactsParams{Contacts: contacts})
 	if err != nil {
 		return nil, fmt.Errorf("sending ContactsImportContacts: %w", err)
 	}
@@ -6868,7 +8219,12 @@ func (*ContactsResetSavedParams) CRC() uint32 {
 
 // Removes all contacts without an associated Telegram account.
 func (c *Client) ContactsResetSaved() (bool, error) {
-	responseData, err := c.MakeRequest(&ContactsResetSavedParams{})
+	return c.ContactsResetSavedCtx(context.Background())
+}
+
+// ContactsResetSavedCtx is the context-aware variant of ContactsResetSaved.
+func (c *Client) ContactsResetSavedCtx(ctx context.Context) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsResetSavedParams{})
 	if err != nil {
 		return false, fmt.Errorf("sending ContactsResetSaved: %w", err)
 	}
@@ -6891,7 +8247,12 @@ func (*ContactsResetTopPeerRatingParams) CRC() uint32 {
 
 // Reset rating of top peer
 func (c *Client) ContactsResetTopPeerRating(category TopPeerCategory, peer InputPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&ContactsResetTopPeerRatingParams{
+	return c.ContactsResetTopPeerRatingCtx(context.Background(), category, peer)
+}
+
+// ContactsResetTopPeerRatingCtx is the context-aware variant of ContactsResetTopPeerRating.
+func (c *Client) ContactsResetTopPeerRatingCtx(ctx context.Context, category TopPeerCategory, peer InputPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsResetTopPeerRatingParams{
 		Category: category,
 		Peer:     peer,
 	})
@@ -6916,7 +8277,12 @@ func (*ContactsResolvePhoneParams) CRC() uint32 {
 
 // Resolve a phone number to get user info, if their privacy settings allow it.
 func (c *Client) ContactsResolvePhone(phone string) (*ContactsResolvedPeer, error) {
-	responseData, err := c.MakeRequest(&ContactsResolvePhoneParams{Phone: phone})
+	return c.ContactsResolvePhoneCtx(context.Background(), phone)
+}
+
+// ContactsResolvePhoneCtx is the context-aware variant of ContactsResolvePhone.
+func (c *Client) ContactsResolvePhoneCtx(ctx context.Context, phone string) (*ContactsResolvedPeer, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsResolvePhoneParams{Phone: phone})
 	if err != nil {
 		return nil, fmt.Errorf("sending ContactsResolvePhone: %w", err)
 	}
@@ -6943,7 +8309,12 @@ func (*ContactsResolveUsernameParams) FlagIndex() int {
 
 // Resolve a @username to get peer info
 func (c *Client) ContactsResolveUsername(username, referer string) (*ContactsResolvedPeer, error) {
-	responseData, err := c.MakeRequest(&ContactsResolveUsernameParams{
+	return c.ContactsResolveUsernameCtx(context.Background(), username, referer)
+}
+
+// ContactsResolveUsernameCtx is the context-aware variant of ContactsResolveUsername.
+func (c *Client) ContactsResolveUsernameCtx(ctx context.Context, username, referer string) (*ContactsResolvedPeer, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsResolveUsernameParams{
 		Referer:  referer,
 		Username: username,
 	})
@@ -6969,7 +8340,12 @@ func (*ContactsSearchParams) CRC() uint32 {
 
 // Returns users found by username substring.
 func (c *Client) ContactsSearch(q string, limit int32) (*ContactsFound, error) {
-	responseData, err := c.MakeRequest(&ContactsSearchParams{
+	return c.ContactsSearchCtx(context.Background(), q, limit)
+}
+
+// ContactsSearchCtx is the context-aware variant of ContactsSearch.
+func (c *Client) ContactsSearchCtx(ctx context.Context, q string, limit int32) (*ContactsFound, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsSearchParams{
 		Limit: limit,
 		Q:     q,
 	})
@@ -7000,7 +8376,12 @@ func (*ContactsSetBlockedParams) FlagIndex() int {
 
 // Replace the contents of an entire blocklist
 func (c *Client) ContactsSetBlocked(myStoriesFrom bool, id []InputPeer, limit int32) (bool, error) {
-	responseData, err := c.MakeRequest(&ContactsSetBlockedParams{
+	return c.ContactsSetBlockedCtx(context.Background(), myStoriesFrom, id, limit)
+}
+
+// ContactsSetBlockedCtx is the context-aware variant of ContactsSetBlocked.
+func (c *Client) ContactsSetBlockedCtx(ctx context.Context, myStoriesFrom bool, id []InputPeer, limit int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsSetBlockedParams{
 		ID:            id,
 		Limit:         limit,
 		MyStoriesFrom: myStoriesFrom,
@@ -7026,7 +8407,12 @@ func (*ContactsToggleTopPeersParams) CRC() uint32 {
 
 // Enable/disable top peers
 func (c *Client) ContactsToggleTopPeers(enabled bool) (bool, error) {
-	responseData, err := c.MakeRequest(&ContactsToggleTopPeersParams{Enabled: enabled})
+	return c.ContactsToggleTopPeersCtx(context.Background(), enabled)
+}
+
+// ContactsToggleTopPeersCtx is the context-aware variant of ContactsToggleTopPeers.
+func (c *Client) ContactsToggleTopPeersCtx(ctx context.Context, enabled bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsToggleTopPeersParams{Enabled: enabled})
 	if err != nil {
 		return false, fmt.Errorf("sending ContactsToggleTopPeers: %w", err)
 	}
@@ -7053,7 +8439,12 @@ func (*ContactsUnblockParams) FlagIndex() int {
 
 // Deletes a peer from a blocklist
 func (c *Client) ContactsUnblock(myStoriesFrom bool, id InputPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&ContactsUnblockParams{
+	return c.ContactsUnblockCtx(context.Background(), myStoriesFrom, id)
+}
+
+// ContactsUnblockCtx is the context-aware variant of ContactsUnblock.
+func (c *Client) ContactsUnblockCtx(ctx context.Context, myStoriesFrom bool, id InputPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsUnblockParams{
 		ID:            id,
 		MyStoriesFrom: myStoriesFrom,
 	})
@@ -7078,7 +8469,12 @@ func (*ContactsUpdateContactNoteParams) CRC() uint32 {
 }
 
 func (c *Client) ContactsUpdateContactNote(id InputUser, note *TextWithEntities) (bool, error) {
-	responseData, err := c.MakeRequest(&ContactsUpdateContactNoteParams{
+	return c.ContactsUpdateContactNoteCtx(context.Background(), id, note)
+}
+
+// ContactsUpdateContactNoteCtx is the context-aware variant of ContactsUpdateContactNote.
+func (c *Client) ContactsUpdateContactNoteCtx(ctx context.Context, id InputUser, note *TextWithEntities) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &ContactsUpdateContactNoteParams{
 		ID:   id,
 		Note: note,
 	})
@@ -7103,7 +8499,12 @@ func (*FoldersDeleteFolderParams) CRC() uint32 {
 
 // Delete a peer folder
 func (c *Client) FoldersDeleteFolder(folderID int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&FoldersDeleteFolderParams{FolderID: folderID})
+	return c.FoldersDeleteFolderCtx(context.Background(), folderID)
+}
+
+// FoldersDeleteFolderCtx is the context-aware variant of FoldersDeleteFolder.
+func (c *Client) FoldersDeleteFolderCtx(ctx context.Context, folderID int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &FoldersDeleteFolderParams{FolderID: folderID})
 	if err != nil {
 		return nil, fmt.Errorf("sending FoldersDeleteFolder: %w", err)
 	}
@@ -7125,7 +8526,12 @@ func (*FoldersEditPeerFoldersParams) CRC() uint32 {
 
 // Edit peers in peer folder
 func (c *Client) FoldersEditPeerFolders(folderPeers []*InputFolderPeer) (Updates, error) {
-	responseData, err := c.MakeRequest(&FoldersEditPeerFoldersParams{FolderPeers: folderPeers})
+	return c.FoldersEditPeerFoldersCtx(context.Background(), folderPeers)
+}
+
+// FoldersEditPeerFoldersCtx is the context-aware variant of FoldersEditPeerFolders.
+func (c *Client) FoldersEditPeerFoldersCtx(ctx context.Context, folderPeers []*InputFolderPeer) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &FoldersEditPeerFoldersParams{FolderPeers: folderPeers})
 	if err != nil {
 		return nil, fmt.Errorf("sending FoldersEditPeerFolders: %w", err)
 	}
@@ -7147,7 +8553,12 @@ func (*FragmentGetCollectibleInfoParams) CRC() uint32 {
 
 // Fetch information about a fragment collectible
 func (c *Client) FragmentGetCollectibleInfo(collectible InputCollectible) (*FragmentCollectibleInfo, error) {
-	responseData, err := c.MakeRequest(&FragmentGetCollectibleInfoParams{Collectible: collectible})
+	return c.FragmentGetCollectibleInfoCtx(context.Background(), collectible)
+}
+
+// FragmentGetCollectibleInfoCtx is the context-aware variant of FragmentGetCollectibleInfo.
+func (c *Client) FragmentGetCollectibleInfoCtx(ctx context.Context, collectible InputCollectible) (*FragmentCollectibleInfo, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &FragmentGetCollectibleInfoParams{Collectible: collectible})
 	if err != nil {
 		return nil, fmt.Errorf("sending FragmentGetCollectibleInfo: %w", err)
 	}
@@ -7169,7 +8580,12 @@ func (*HelpAcceptTermsOfServiceParams) CRC() uint32 {
 
 // Accept the new terms of service
 func (c *Client) HelpAcceptTermsOfService(id *DataJson) (bool, error) {
-	responseData, err := c.MakeRequest(&HelpAcceptTermsOfServiceParams{ID: id})
+	return c.HelpAcceptTermsOfServiceCtx(context.Background(), id)
+}
+
+// HelpAcceptTermsOfServiceCtx is the context-aware variant of HelpAcceptTermsOfService.
+func (c *Client) HelpAcceptTermsOfServiceCtx(ctx context.Context, id *DataJson) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpAcceptTermsOfServiceParams{ID: id})
 	if err != nil {
 		return false, fmt.Errorf("sending HelpAcceptTermsOfService: %w", err)
 	}
@@ -7192,7 +8608,12 @@ func (*HelpDismissSuggestionParams) CRC() uint32 {
 
 // Dismiss a suggestion
 func (c *Client) HelpDismissSuggestion(peer InputPeer, suggestion string) (bool, error) {
-	responseData, err := c.MakeRequest(&HelpDismissSuggestionParams{
+	return c.HelpDismissSuggestionCtx(context.Background(), peer, suggestion)
+}
+
+// HelpDismissSuggestionCtx is the context-aware variant of HelpDismissSuggestion.
+func (c *Client) HelpDismissSuggestionCtx(ctx context.Context, peer InputPeer, suggestion string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpDismissSuggestionParams{
 		Peer:       peer,
 		Suggestion: suggestion,
 	})
@@ -7219,7 +8640,12 @@ func (*HelpEditUserInfoParams) CRC() uint32 {
 
 // Internal use
 func (c *Client) HelpEditUserInfo(userID InputUser, message string, entities []MessageEntity) (HelpUserInfo, error) {
-	responseData, err := c.MakeRequest(&HelpEditUserInfoParams{
+	return c.HelpEditUserInfoCtx(context.Background(), userID, message, entities)
+}
+
+// HelpEditUserInfoCtx is the context-aware variant of HelpEditUserInfo.
+func (c *Client) HelpEditUserInfoCtx(ctx context.Context, userID InputUser, message string, entities []MessageEntity) (HelpUserInfo, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpEditUserInfoParams{
 		Entities: entities,
 		Message:  message,
 		UserID:   userID,
@@ -7245,7 +8671,12 @@ func (*HelpGetAppConfigParams) CRC() uint32 {
 
 // Get app-specific configuration, see client configuration for more info on the result.
 func (c *Client) HelpGetAppConfig(hash int32) (HelpAppConfig, error) {
-	responseData, err := c.MakeRequest(&HelpGetAppConfigParams{Hash: hash})
+	return c.HelpGetAppConfigCtx(context.Background(), hash)
+}
+
+// HelpGetAppConfigCtx is the context-aware variant of HelpGetAppConfig.
+func (c *Client) HelpGetAppConfigCtx(ctx context.Context, hash int32) (HelpAppConfig, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetAppConfigParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetAppConfig: %w", err)
 	}
@@ -7267,7 +8698,12 @@ func (*HelpGetAppUpdateParams) CRC() uint32 {
 
 // Returns information on update availability for the current application.
 func (c *Client) HelpGetAppUpdate(source string) (HelpAppUpdate, error) {
-	responseData, err := c.MakeRequest(&HelpGetAppUpdateParams{Source: source})
+	return c.HelpGetAppUpdateCtx(context.Background(), source)
+}
+
+// HelpGetAppUpdateCtx is the context-aware variant of HelpGetAppUpdate.
+func (c *Client) HelpGetAppUpdateCtx(ctx context.Context, source string) (HelpAppUpdate, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetAppUpdateParams{Source: source})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetAppUpdate: %w", err)
 	}
@@ -7287,7 +8723,12 @@ func (*HelpGetCdnConfigParams) CRC() uint32 {
 
 // Get configuration for CDN file downloads.
 func (c *Client) HelpGetCdnConfig() (*CdnConfig, error) {
-	responseData, err := c.MakeRequest(&HelpGetCdnConfigParams{})
+	return c.HelpGetCdnConfigCtx(context.Background())
+}
+
+// HelpGetCdnConfigCtx is the context-aware variant of HelpGetCdnConfig.
+func (c *Client) HelpGetCdnConfigCtx(ctx context.Context) (*CdnConfig, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetCdnConfigParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetCdnConfig: %w", err)
 	}
@@ -7307,7 +8748,12 @@ func (*HelpGetConfigParams) CRC() uint32 {
 
 // Returns current configuration, including data center configuration.
 func (c *Client) HelpGetConfig() (*Config, error) {
-	responseData, err := c.MakeRequest(&HelpGetConfigParams{})
+	return c.HelpGetConfigCtx(context.Background())
+}
+
+// HelpGetConfigCtx is the context-aware variant of HelpGetConfig.
+func (c *Client) HelpGetConfigCtx(ctx context.Context) (*Config, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetConfigParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetConfig: %w", err)
 	}
@@ -7330,7 +8776,12 @@ func (*HelpGetCountriesListParams) CRC() uint32 {
 
 // Get name, ISO code, localized name and phone codes/patterns of all available countries
 func (c *Client) HelpGetCountriesList(langCode string, hash int32) (HelpCountriesList, error) {
-	responseData, err := c.MakeRequest(&HelpGetCountriesListParams{
+	return c.HelpGetCountriesListCtx(context.Background(), langCode, hash)
+}
+
+// HelpGetCountriesListCtx is the context-aware variant of HelpGetCountriesList.
+func (c *Client) HelpGetCountriesListCtx(ctx context.Context, langCode string, hash int32) (HelpCountriesList, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetCountriesListParams{
 		Hash:     hash,
 		LangCode: langCode,
 	})
@@ -7355,7 +8806,12 @@ func (*HelpGetDeepLinkInfoParams) CRC() uint32 {
 
 // Get info about an unsupported deep link
 func (c *Client) HelpGetDeepLinkInfo(path string) (HelpDeepLinkInfo, error) {
-	responseData, err := c.MakeRequest(&HelpGetDeepLinkInfoParams{Path: path})
+	return c.HelpGetDeepLinkInfoCtx(context.Background(), path)
+}
+
+// HelpGetDeepLinkInfoCtx is the context-aware variant of HelpGetDeepLinkInfo.
+func (c *Client) HelpGetDeepLinkInfoCtx(ctx context.Context, path string) (HelpDeepLinkInfo, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetDeepLinkInfoParams{Path: path})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetDeepLinkInfo: %w", err)
 	}
@@ -7375,7 +8831,12 @@ func (*HelpGetInviteTextParams) CRC() uint32 {
 
 // Returns localized text of a text message with an invitation.
 func (c *Client) HelpGetInviteText() (*HelpInviteText, error) {
-	responseData, err := c.MakeRequest(&HelpGetInviteTextParams{})
+	return c.HelpGetInviteTextCtx(context.Background())
+}
+
+// HelpGetInviteTextCtx is the context-aware variant of HelpGetInviteText.
+func (c *Client) HelpGetInviteTextCtx(ctx context.Context) (*HelpInviteText, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetInviteTextParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetInviteText: %w", err)
 	}
@@ -7395,7 +8856,12 @@ func (*HelpGetNearestDcParams) CRC() uint32 {
 
 // Returns info on data center nearest to the user.
 func (c *Client) HelpGetNearestDc() (*NearestDc, error) {
-	responseData, err := c.MakeRequest(&HelpGetNearestDcParams{})
+	return c.HelpGetNearestDcCtx(context.Background())
+}
+
+// HelpGetNearestDcCtx is the context-aware variant of HelpGetNearestDc.
+func (c *Client) HelpGetNearestDcCtx(ctx context.Context) (*NearestDc, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetNearestDcParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetNearestDc: %w", err)
 	}
@@ -7417,7 +8883,12 @@ func (*HelpGetPassportConfigParams) CRC() uint32 {
 
 // Get passport configuration
 func (c *Client) HelpGetPassportConfig(hash int32) (HelpPassportConfig, error) {
-	responseData, err := c.MakeRequest(&HelpGetPassportConfigParams{Hash: hash})
+	return c.HelpGetPassportConfigCtx(context.Background(), hash)
+}
+
+// HelpGetPassportConfigCtx is the context-aware variant of HelpGetPassportConfig.
+func (c *Client) HelpGetPassportConfigCtx(ctx context.Context, hash int32) (HelpPassportConfig, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetPassportConfigParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetPassportConfig: %w", err)
 	}
@@ -7439,7 +8910,12 @@ func (*HelpGetPeerColorsParams) CRC() uint32 {
 
 // Get the set of accent color palettes that can be used for message accents.
 func (c *Client) HelpGetPeerColors(hash int32) (HelpPeerColors, error) {
-	responseData, err := c.MakeRequest(&HelpGetPeerColorsParams{Hash: hash})
+	return c.HelpGetPeerColorsCtx(context.Background(), hash)
+}
+
+// HelpGetPeerColorsCtx is the context-aware variant of HelpGetPeerColors.
+func (c *Client) HelpGetPeerColorsCtx(ctx context.Context, hash int32) (HelpPeerColors, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetPeerColorsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetPeerColors: %w", err)
 	}
@@ -7461,7 +8937,12 @@ func (*HelpGetPeerProfileColorsParams) CRC() uint32 {
 
 // Get the set of accent color palettes that can be used in profile page backgrounds.
 func (c *Client) HelpGetPeerProfileColors(hash int32) (HelpPeerColors, error) {
-	responseData, err := c.MakeRequest(&HelpGetPeerProfileColorsParams{Hash: hash})
+	return c.HelpGetPeerProfileColorsCtx(context.Background(), hash)
+}
+
+// HelpGetPeerProfileColorsCtx is the context-aware variant of HelpGetPeerProfileColors.
+func (c *Client) HelpGetPeerProfileColorsCtx(ctx context.Context, hash int32) (HelpPeerColors, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetPeerProfileColorsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetPeerProfileColors: %w", err)
 	}
@@ -7481,7 +8962,12 @@ func (*HelpGetPremiumPromoParams) CRC() uint32 {
 
 // Get Telegram Premium promotion information
 func (c *Client) HelpGetPremiumPromo() (*HelpPremiumPromo, error) {
-	responseData, err := c.MakeRequest(&HelpGetPremiumPromoParams{})
+	return c.HelpGetPremiumPromoCtx(context.Background())
+}
+
+// HelpGetPremiumPromoCtx is the context-aware variant of HelpGetPremiumPromo.
+func (c *Client) HelpGetPremiumPromoCtx(ctx context.Context) (*HelpPremiumPromo, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetPremiumPromoParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetPremiumPromo: %w", err)
 	}
@@ -7501,7 +8987,12 @@ func (*HelpGetPromoDataParams) CRC() uint32 {
 
 // Returns a set of useful suggestions and PSA/MTProxy sponsored peers
 func (c *Client) HelpGetPromoData() (HelpPromoData, error) {
-	responseData, err := c.MakeRequest(&HelpGetPromoDataParams{})
+	return c.HelpGetPromoDataCtx(context.Background())
+}
+
+// HelpGetPromoDataCtx is the context-aware variant of HelpGetPromoData.
+func (c *Client) HelpGetPromoDataCtx(ctx context.Context) (HelpPromoData, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetPromoDataParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetPromoData: %w", err)
 	}
@@ -7523,7 +9014,12 @@ func (*HelpGetRecentMeUrlsParams) CRC() uint32 {
 
 // Get recently used `t.me` links.
 func (c *Client) HelpGetRecentMeUrls(referer string) (*HelpRecentMeUrls, error) {
-	responseData, err := c.MakeRequest(&HelpGetRecentMeUrlsParams{Referer: referer})
+	return c.HelpGetRecentMeUrlsCtx(context.Background(), referer)
+}
+
+// HelpGetRecentMeUrlsCtx is the context-aware variant of HelpGetRecentMeUrls.
+func (c *Client) HelpGetRecentMeUrlsCtx(ctx context.Context, referer string) (*HelpRecentMeUrls, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetRecentMeUrlsParams{Referer: referer})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetRecentMeUrls: %w", err)
 	}
@@ -7543,7 +9039,12 @@ func (*HelpGetSupportParams) CRC() uint32 {
 
 // Returns the support user for the "ask a question" feature.
 func (c *Client) HelpGetSupport() (*HelpSupport, error) {
-	responseData, err := c.MakeRequest(&HelpGetSupportParams{})
+	return c.HelpGetSupportCtx(context.Background())
+}
+
+// HelpGetSupportCtx is the context-aware variant of HelpGetSupport.
+func (c *Client) HelpGetSupportCtx(ctx context.Context) (*HelpSupport, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetSupportParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetSupport: %w", err)
 	}
@@ -7563,7 +9064,12 @@ func (*HelpGetSupportNameParams) CRC() uint32 {
 
 // Get localized name of the telegram support user
 func (c *Client) HelpGetSupportName() (*HelpSupportName, error) {
-	responseData, err := c.MakeRequest(&HelpGetSupportNameParams{})
+	return c.HelpGetSupportNameCtx(context.Background())
+}
+
+// HelpGetSupportNameCtx is the context-aware variant of HelpGetSupportName.
+func (c *Client) HelpGetSupportNameCtx(ctx context.Context) (*HelpSupportName, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetSupportNameParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetSupportName: %w", err)
 	}
@@ -7583,7 +9089,12 @@ func (*HelpGetTermsOfServiceUpdateParams) CRC() uint32 {
 
 // Look for updates of telegram's terms of service
 func (c *Client) HelpGetTermsOfServiceUpdate() (HelpTermsOfServiceUpdate, error) {
-	responseData, err := c.MakeRequest(&HelpGetTermsOfServiceUpdateParams{})
+	return c.HelpGetTermsOfServiceUpdateCtx(context.Background())
+}
+
+// HelpGetTermsOfServiceUpdateCtx is the context-aware variant of HelpGetTermsOfServiceUpdate.
+func (c *Client) HelpGetTermsOfServiceUpdateCtx(ctx context.Context) (HelpTermsOfServiceUpdate, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetTermsOfServiceUpdateParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetTermsOfServiceUpdate: %w", err)
 	}
@@ -7605,7 +9116,12 @@ func (*HelpGetTimezonesListParams) CRC() uint32 {
 
 // Returns timezone information that may be used elsewhere in the API, such as to set Telegram Business opening hours.
 func (c *Client) HelpGetTimezonesList(hash int32) (HelpTimezonesList, error) {
-	responseData, err := c.MakeRequest(&HelpGetTimezonesListParams{Hash: hash})
+	return c.HelpGetTimezonesListCtx(context.Background(), hash)
+}
+
+// HelpGetTimezonesListCtx is the context-aware variant of HelpGetTimezonesList.
+func (c *Client) HelpGetTimezonesListCtx(ctx context.Context, hash int32) (HelpTimezonesList, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetTimezonesListParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetTimezonesList: %w", err)
 	}
@@ -7627,7 +9143,12 @@ func (*HelpGetUserInfoParams) CRC() uint32 {
 
 // Can only be used by TSF members to obtain internal information.
 func (c *Client) HelpGetUserInfo(userID InputUser) (HelpUserInfo, error) {
-	responseData, err := c.MakeRequest(&HelpGetUserInfoParams{UserID: userID})
+	return c.HelpGetUserInfoCtx(context.Background(), userID)
+}
+
+// HelpGetUserInfoCtx is the context-aware variant of HelpGetUserInfo.
+func (c *Client) HelpGetUserInfoCtx(ctx context.Context, userID InputUser) (HelpUserInfo, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpGetUserInfoParams{UserID: userID})
 	if err != nil {
 		return nil, fmt.Errorf("sending HelpGetUserInfo: %w", err)
 	}
@@ -7649,7 +9170,12 @@ func (*HelpHidePromoDataParams) CRC() uint32 {
 
 // Hide MTProxy/Public Service Announcement information
 func (c *Client) HelpHidePromoData(peer InputPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&HelpHidePromoDataParams{Peer: peer})
+	return c.HelpHidePromoDataCtx(context.Background(), peer)
+}
+
+// HelpHidePromoDataCtx is the context-aware variant of HelpHidePromoData.
+func (c *Client) HelpHidePromoDataCtx(ctx context.Context, peer InputPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpHidePromoDataParams{Peer: peer})
 	if err != nil {
 		return false, fmt.Errorf("sending HelpHidePromoData: %w", err)
 	}
@@ -7671,7 +9197,12 @@ func (*HelpSaveAppLogParams) CRC() uint32 {
 
 // Saves logs of application on the server.
 func (c *Client) HelpSaveAppLog(events []*InputAppEvent) (bool, error) {
-	responseData, err := c.MakeRequest(&HelpSaveAppLogParams{Events: events})
+	return c.HelpSaveAppLogCtx(context.Background(), events)
+}
+
+// HelpSaveAppLogCtx is the context-aware variant of HelpSaveAppLog.
+func (c *Client) HelpSaveAppLogCtx(ctx context.Context, events []*InputAppEvent) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpSaveAppLogParams{Events: events})
 	if err != nil {
 		return false, fmt.Errorf("sending HelpSaveAppLog: %w", err)
 	}
@@ -7694,7 +9225,12 @@ func (*HelpSetBotUpdatesStatusParams) CRC() uint32 {
 
 // Informs the server about the number of pending bot updates if they haven't been processed for a long time; for bots only
 func (c *Client) HelpSetBotUpdatesStatus(pendingUpdatesCount int32, message string) (bool, error) {
-	responseData, err := c.MakeRequest(&HelpSetBotUpdatesStatusParams{
+	return c.HelpSetBotUpdatesStatusCtx(context.Background(), pendingUpdatesCount, message)
+}
+
+// HelpSetBotUpdatesStatusCtx is the context-aware variant of HelpSetBotUpdatesStatus.
+func (c *Client) HelpSetBotUpdatesStatusCtx(ctx context.Context, pendingUpdatesCount int32, message string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &HelpSetBotUpdatesStatusParams{
 		Message:             message,
 		PendingUpdatesCount: pendingUpdatesCount,
 	})
@@ -7721,7 +9257,12 @@ func (*LangpackGetDifferenceParams) CRC() uint32 {
 
 // Get new strings in language pack
 func (c *Client) LangpackGetDifference(langPack, langCode string, fromVersion int32) (*LangPackDifference, error) {
-	responseData, err := c.MakeRequest(&LangpackGetDifferenceParams{
+	return c.LangpackGetDifferenceCtx(context.Background(), langPack, langCode, fromVersion)
+}
+
+// LangpackGetDifferenceCtx is the context-aware variant of LangpackGetDifference.
+func (c *Client) LangpackGetDifferenceCtx(ctx context.Context, langPack, langCode string, fromVersion int32) (*LangPackDifference, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &LangpackGetDifferenceParams{
 		FromVersion: fromVersion,
 		LangCode:    langCode,
 		LangPack:    langPack,
@@ -7748,7 +9289,12 @@ func (*LangpackGetLangPackParams) CRC() uint32 {
 
 // Get localization pack strings
 func (c *Client) LangpackGetLangPack(langPack, langCode string) (*LangPackDifference, error) {
-	responseData, err := c.MakeRequest(&LangpackGetLangPackParams{
+	return c.LangpackGetLangPackCtx(context.Background(), langPack, langCode)
+}
+
+// LangpackGetLangPackCtx is the context-aware variant of LangpackGetLangPack.
+func (c *Client) LangpackGetLangPackCtx(ctx context.Context, langPack, langCode string) (*LangPackDifference, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &LangpackGetLangPackParams{
 		LangCode: langCode,
 		LangPack: langPack,
 	})
@@ -7774,7 +9320,12 @@ func (*LangpackGetLanguageParams) CRC() uint32 {
 
 // Get information about a language in a localization pack
 func (c *Client) LangpackGetLanguage(langPack, langCode string) (*LangPackLanguage, error) {
-	responseData, err := c.MakeRequest(&LangpackGetLanguageParams{
+	return c.LangpackGetLanguageCtx(context.Background(), langPack, langCode)
+}
+
+// LangpackGetLanguageCtx is the context-aware variant of LangpackGetLanguage.
+func (c *Client) LangpackGetLanguageCtx(ctx context.Context, langPack, langCode string) (*LangPackLanguage, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &LangpackGetLanguageParams{
 		LangCode: langCode,
 		LangPack: langPack,
 	})
@@ -7799,7 +9350,12 @@ func (*LangpackGetLanguagesParams) CRC() uint32 {
 
 // Get information about all languages in a localization pack
 func (c *Client) LangpackGetLanguages(langPack string) ([]*LangPackLanguage, error) {
-	responseData, err := c.MakeRequest(&LangpackGetLanguagesParams{LangPack: langPack})
+	return c.LangpackGetLanguagesCtx(context.Background(), langPack)
+}
+
+// LangpackGetLanguagesCtx is the context-aware variant of LangpackGetLanguages.
+func (c *Client) LangpackGetLanguagesCtx(ctx context.Context, langPack string) ([]*LangPackLanguage, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &LangpackGetLanguagesParams{LangPack: langPack})
 	if err != nil {
 		return nil, fmt.Errorf("sending LangpackGetLanguages: %w", err)
 	}
@@ -7823,7 +9379,12 @@ func (*LangpackGetStringsParams) CRC() uint32 {
 
 // Get strings from a language pack
 func (c *Client) LangpackGetStrings(langPack, langCode string, keys []string) ([]LangPackString, error) {
-	responseData, err := c.MakeRequest(&LangpackGetStringsParams{
+	return c.LangpackGetStringsCtx(context.Background(), langPack, langCode, keys)
+}
+
+// LangpackGetStringsCtx is the context-aware variant of LangpackGetStrings.
+func (c *Client) LangpackGetStringsCtx(ctx context.Context, langPack, langCode string, keys []string) ([]LangPackString, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &LangpackGetStringsParams{
 		Keys:     keys,
 		LangCode: langCode,
 		LangPack: langPack,
@@ -7851,7 +9412,12 @@ func (*MessagesAcceptEncryptionParams) CRC() uint32 {
 
 // Confirms creation of a secret chat
 func (c *Client) MessagesAcceptEncryption(peer *InputEncryptedChat, gB []byte, keyFingerprint int64) (EncryptedChat, error) {
-	responseData, err := c.MakeRequest(&MessagesAcceptEncryptionParams{
+	return c.MessagesAcceptEncryptionCtx(context.Background(), peer, gB, keyFingerprint)
+}
+
+// MessagesAcceptEncryptionCtx is the context-aware variant of MessagesAcceptEncryption.
+func (c *Client) MessagesAcceptEncryptionCtx(ctx context.Context, peer *InputEncryptedChat, gB []byte, keyFingerprint int64) (EncryptedChat, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesAcceptEncryptionParams{
 		GB:             gB,
 		KeyFingerprint: keyFingerprint,
 		Peer:           peer,
@@ -7886,7 +9452,12 @@ func (*MessagesAcceptURLAuthParams) FlagIndex() int {
 
 // Use this to accept a Seamless Telegram Login authorization request, for more info click here
 func (c *Client) MessagesAcceptURLAuth(params *MessagesAcceptURLAuthParams) (URLAuthResult, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesAcceptURLAuthCtx(context.Background(), params)
+}
+
+// MessagesAcceptURLAuthCtx is the context-aware variant of MessagesAcceptURLAuth.
+func (c *Client) MessagesAcceptURLAuthCtx(ctx context.Context, params *MessagesAcceptURLAuthParams) (URLAuthResult, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesAcceptURLAuth: %w", err)
 	}
@@ -7910,7 +9481,12 @@ func (*MessagesAddChatUserParams) CRC() uint32 {
 
 // Adds a user to a chat and sends a service message on it.
 func (c *Client) MessagesAddChatUser(chatID int64, userID InputUser, fwdLimit int32) (*MessagesInvitedUsers, error) {
-	responseData, err := c.MakeRequest(&MessagesAddChatUserParams{
+	return c.MessagesAddChatUserCtx(context.Background(), chatID, userID, fwdLimit)
+}
+
+// MessagesAddChatUserCtx is the context-aware variant of MessagesAddChatUser.
+func (c *Client) MessagesAddChatUserCtx(ctx context.Context, chatID int64, userID InputUser, fwdLimit int32) (*MessagesInvitedUsers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesAddChatUserParams{
 		ChatID:   chatID,
 		FwdLimit: fwdLimit,
 		UserID:   userID,
@@ -7938,7 +9514,12 @@ func (*MessagesAppendTodoListParams) CRC() uint32 {
 
 // Appends one or more items to a todo list.
 func (c *Client) MessagesAppendTodoList(peer InputPeer, msgID int32, list []*TodoItem) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesAppendTodoListParams{
+	return c.MessagesAppendTodoListCtx(context.Background(), peer, msgID, list)
+}
+
+// MessagesAppendTodoListCtx is the context-aware variant of MessagesAppendTodoList.
+func (c *Client) MessagesAppendTodoListCtx(ctx context.Context, peer InputPeer, msgID int32, list []*TodoItem) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesAppendTodoListParams{
 		List:  list,
 		MsgID: msgID,
 		Peer:  peer,
@@ -7964,7 +9545,12 @@ func (*MessagesCheckChatInviteParams) CRC() uint32 {
 
 // Check the validity of a chat invite link and get basic info about it
 func (c *Client) MessagesCheckChatInvite(hash string) (ChatInvite, error) {
-	responseData, err := c.MakeRequest(&MessagesCheckChatInviteParams{Hash: hash})
+	return c.MessagesCheckChatInviteCtx(context.Background(), hash)
+}
+
+// MessagesCheckChatInviteCtx is the context-aware variant of MessagesCheckChatInvite.
+func (c *Client) MessagesCheckChatInviteCtx(ctx context.Context, hash string) (ChatInvite, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesCheckChatInviteParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesCheckChatInvite: %w", err)
 	}
@@ -7986,7 +9572,12 @@ func (*MessagesCheckHistoryImportParams) CRC() uint32 {
 
 // Obtains information about a chat export file, generated by a foreign chat app, click here for more info about imported chats.
 func (c *Client) MessagesCheckHistoryImport(importHead string) (*MessagesHistoryImportParsed, error) {
-	responseData, err := c.MakeRequest(&MessagesCheckHistoryImportParams{ImportHead: importHead})
+	return c.MessagesCheckHistoryImportCtx(context.Background(), importHead)
+}
+
+// MessagesCheckHistoryImportCtx is the context-aware variant of MessagesCheckHistoryImport.
+func (c *Client) MessagesCheckHistoryImportCtx(ctx context.Context, importHead string) (*MessagesHistoryImportParsed, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesCheckHistoryImportParams{ImportHead: importHead})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesCheckHistoryImport: %w", err)
 	}
@@ -8008,7 +9599,12 @@ func (*MessagesCheckHistoryImportPeerParams) CRC() uint32 {
 
 // Check whether chat history exported from another chat app can be imported into a specific Telegram chat, click here for more info.
 func (c *Client) MessagesCheckHistoryImportPeer(peer InputPeer) (*MessagesCheckedHistoryImportPeer, error) {
-	responseData, err := c.MakeRequest(&MessagesCheckHistoryImportPeerParams{Peer: peer})
+	return c.MessagesCheckHistoryImportPeerCtx(context.Background(), peer)
+}
+
+// MessagesCheckHistoryImportPeerCtx is the context-aware variant of MessagesCheckHistoryImportPeer.
+func (c *Client) MessagesCheckHistoryImportPeerCtx(ctx context.Context, peer InputPeer) (*MessagesCheckedHistoryImportPeer, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesCheckHistoryImportPeerParams{Peer: peer})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesCheckHistoryImportPeer: %w", err)
 	}
@@ -8030,7 +9626,12 @@ func (*MessagesCheckQuickReplyShortcutParams) CRC() uint32 {
 
 // Before offering the user the choice to add a message to a quick reply shortcut, to make sure that none of the limits specified here were reached.
 func (c *Client) MessagesCheckQuickReplyShortcut(shortcut string) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesCheckQuickReplyShortcutParams{Shortcut: shortcut})
+	return c.MessagesCheckQuickReplyShortcutCtx(context.Background(), shortcut)
+}
+
+// MessagesCheckQuickReplyShortcutCtx is the context-aware variant of MessagesCheckQuickReplyShortcut.
+func (c *Client) MessagesCheckQuickReplyShortcutCtx(ctx context.Context, shortcut string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesCheckQuickReplyShortcutParams{Shortcut: shortcut})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesCheckQuickReplyShortcut: %w", err)
 	}
@@ -8050,7 +9651,12 @@ func (*MessagesClearAllDraftsParams) CRC() uint32 {
 
 // Clear all drafts.
 func (c *Client) MessagesClearAllDrafts() (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesClearAllDraftsParams{})
+	return c.MessagesClearAllDraftsCtx(context.Background())
+}
+
+// MessagesClearAllDraftsCtx is the context-aware variant of MessagesClearAllDrafts.
+func (c *Client) MessagesClearAllDraftsCtx(ctx context.Context) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesClearAllDraftsParams{})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesClearAllDrafts: %w", err)
 	}
@@ -8070,7 +9676,12 @@ func (*MessagesClearRecentReactionsParams) CRC() uint32 {
 
 // Clear recently used message reactions
 func (c *Client) MessagesClearRecentReactions() (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesClearRecentReactionsParams{})
+	return c.MessagesClearRecentReactionsCtx(context.Background())
+}
+
+// MessagesClearRecentReactionsCtx is the context-aware variant of MessagesClearRecentReactions.
+func (c *Client) MessagesClearRecentReactionsCtx(ctx context.Context) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesClearRecentReactionsParams{})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesClearRecentReactions: %w", err)
 	}
@@ -8096,7 +9707,12 @@ func (*MessagesClearRecentStickersParams) FlagIndex() int {
 
 // Clear recent stickers
 func (c *Client) MessagesClearRecentStickers(attached bool) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesClearRecentStickersParams{Attached: attached})
+	return c.MessagesClearRecentStickersCtx(context.Background(), attached)
+}
+
+// MessagesClearRecentStickersCtx is the context-aware variant of MessagesClearRecentStickers.
+func (c *Client) MessagesClearRecentStickersCtx(ctx context.Context, attached bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesClearRecentStickersParams{Attached: attached})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesClearRecentStickers: %w", err)
 	}
@@ -8124,7 +9740,12 @@ func (*MessagesClickSponsoredMessageParams) FlagIndex() int {
 
 // Informs the server that the user has interacted with a sponsored message in one of the ways listed here.
 func (c *Client) MessagesClickSponsoredMessage(media, fullscreen bool, randomID []byte) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesClickSponsoredMessageParams{
+	return c.MessagesClickSponsoredMessageCtx(context.Background(), media, fullscreen, randomID)
+}
+
+// MessagesClickSponsoredMessageCtx is the context-aware variant of MessagesClickSponsoredMessage.
+func (c *Client) MessagesClickSponsoredMessageCtx(ctx context.Context, media, fullscreen bool, randomID []byte) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesClickSponsoredMessageParams{
 		Fullscreen: fullscreen,
 		Media:      media,
 		RandomID:   randomID,
@@ -8149,7 +9770,12 @@ func (*MessagesCraftStarGiftParams) CRC() uint32 {
 }
 
 func (c *Client) MessagesCraftStarGift(stargift []InputSavedStarGift) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesCraftStarGiftParams{Stargift: stargift})
+	return c.MessagesCraftStarGiftCtx(context.Background(), stargift)
+}
+
+// MessagesCraftStarGiftCtx is the context-aware variant of MessagesCraftStarGift.
+func (c *Client) MessagesCraftStarGiftCtx(ctx context.Context, stargift []InputSavedStarGift) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesCraftStarGiftParams{Stargift: stargift})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesCraftStarGift: %w", err)
 	}
@@ -8177,7 +9803,12 @@ func (*MessagesCreateChatParams) FlagIndex() int {
 
 // Creates a new chat.
 func (c *Client) MessagesCreateChat(users []InputUser, title string, ttlPeriod int32) (*MessagesInvitedUsers, error) {
-	responseData, err := c.MakeRequest(&MessagesCreateChatParams{
+	return c.MessagesCreateChatCtx(context.Background(), users, title, ttlPeriod)
+}
+
+// MessagesCreateChatCtx is the context-aware variant of MessagesCreateChat.
+func (c *Client) MessagesCreateChatCtx(ctx context.Context, users []InputUser, title string, ttlPeriod int32) (*MessagesInvitedUsers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesCreateChatParams{
 		Title:     title,
 		TtlPeriod: ttlPeriod,
 		Users:     users,
@@ -8212,7 +9843,12 @@ func (*MessagesCreateForumTopicParams) FlagIndex() int {
 }
 
 func (c *Client) MessagesCreateForumTopic(params *MessagesCreateForumTopicParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesCreateForumTopicCtx(context.Background(), params)
+}
+
+// MessagesCreateForumTopicCtx is the context-aware variant of MessagesCreateForumTopic.
+func (c *Client) MessagesCreateForumTopicCtx(ctx context.Context, params *MessagesCreateForumTopicParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesCreateForumTopic: %w", err)
 	}
@@ -8234,7 +9870,12 @@ func (*MessagesDeleteChatParams) CRC() uint32 {
 
 // Delete a chat
 func (c *Client) MessagesDeleteChat(chatID int64) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesDeleteChatParams{ChatID: chatID})
+	return c.MessagesDeleteChatCtx(context.Background(), chatID)
+}
+
+// MessagesDeleteChatCtx is the context-aware variant of MessagesDeleteChat.
+func (c *Client) MessagesDeleteChatCtx(ctx context.Context, chatID int64) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesDeleteChatParams{ChatID: chatID})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesDeleteChat: %w", err)
 	}
@@ -8262,7 +9903,12 @@ func (*MessagesDeleteChatUserParams) FlagIndex() int {
 
 // Deletes a user from a chat and sends a service message on it.
 func (c *Client) MessagesDeleteChatUser(revokeHistory bool, chatID int64, userID InputUser) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesDeleteChatUserParams{
+	return c.MessagesDeleteChatUserCtx(context.Background(), revokeHistory, chatID, userID)
+}
+
+// MessagesDeleteChatUserCtx is the context-aware variant of MessagesDeleteChatUser.
+func (c *Client) MessagesDeleteChatUserCtx(ctx context.Context, revokeHistory bool, chatID int64, userID InputUser) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesDeleteChatUserParams{
 		ChatID:        chatID,
 		RevokeHistory: revokeHistory,
 		UserID:        userID,
@@ -8289,7 +9935,12 @@ func (*MessagesDeleteExportedChatInviteParams) CRC() uint32 {
 
 // Delete a chat invite
 func (c *Client) MessagesDeleteExportedChatInvite(peer InputPeer, link string) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesDeleteExportedChatInviteParams{
+	return c.MessagesDeleteExportedChatInviteCtx(context.Background(), peer, link)
+}
+
+// MessagesDeleteExportedChatInviteCtx is the context-aware variant of MessagesDeleteExportedChatInvite.
+func (c *Client) MessagesDeleteExportedChatInviteCtx(ctx context.Context, peer InputPeer, link string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesDeleteExportedChatInviteParams{
 		Link: link,
 		Peer: peer,
 	})
@@ -8315,7 +9966,12 @@ func (*MessagesDeleteFactCheckParams) CRC() uint32 {
 
 // Delete a fact-check from a message.
 func (c *Client) MessagesDeleteFactCheck(peer InputPeer, msgID int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesDeleteFactCheckParams{
+	return c.MessagesDeleteFactCheckCtx(context.Background(), peer, msgID)
+}
+
+// MessagesDeleteFactCheckCtx is the context-aware variant of MessagesDeleteFactCheck.
+func (c *Client) MessagesDeleteFactCheckCtx(ctx context.Context, peer InputPeer, msgID int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesDeleteFactCheckParams{
 		MsgID: msgID,
 		Peer:  peer,
 	})
@@ -8349,7 +10005,12 @@ func (*MessagesDeleteHistoryParams) FlagIndex() int {
 
 // Deletes communication history.
 func (c *Client) MessagesDeleteHistory(params *MessagesDeleteHistoryParams) (*MessagesAffectedHistory, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesDeleteHistoryCtx(context.Background(), params)
+}
+
+// MessagesDeleteHistoryCtx is the context-aware variant of MessagesDeleteHistory.
+func (c *Client) MessagesDeleteHistoryCtx(ctx context.Context, params *MessagesDeleteHistoryParams) (*MessagesAffectedHistory, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesDeleteHistory: %w", err)
 	}
@@ -8376,7 +10037,12 @@ func (*MessagesDeleteMessagesParams) FlagIndex() int {
 
 // Deletes messages by their identifiers.
 func (c *Client) MessagesDeleteMessages(revoke bool, id []int32) (*MessagesAffectedMessages, error) {
-	responseData, err := c.MakeRequest(&MessagesDeleteMessagesParams{
+	return c.MessagesDeleteMessagesCtx(context.Background(), revoke, id)
+}
+
+// MessagesDeleteMessagesCtx is the context-aware variant of MessagesDeleteMessages.
+func (c *Client) MessagesDeleteMessagesCtx(ctx context.Context, revoke bool, id []int32) (*MessagesAffectedMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesDeleteMessagesParams{
 		ID:     id,
 		Revoke: revoke,
 	})
@@ -8405,7 +10071,12 @@ func (*MessagesDeletePhoneCallHistoryParams) FlagIndex() int {
 
 // Delete the entire phone call history.
 func (c *Client) MessagesDeletePhoneCallHistory(revoke bool) (*MessagesAffectedFoundMessages, error) {
-	responseData, err := c.MakeRequest(&MessagesDeletePhoneCallHistoryParams{Revoke: revoke})
+	return c.MessagesDeletePhoneCallHistoryCtx(context.Background(), revoke)
+}
+
+// MessagesDeletePhoneCallHistoryCtx is the context-aware variant of MessagesDeletePhoneCallHistory.
+func (c *Client) MessagesDeletePhoneCallHistoryCtx(ctx context.Context, revoke bool) (*MessagesAffectedFoundMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesDeletePhoneCallHistoryParams{Revoke: revoke})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesDeletePhoneCallHistory: %w", err)
 	}
@@ -8428,7 +10099,12 @@ func (*MessagesDeleteQuickReplyMessagesParams) CRC() uint32 {
 
 // Delete one or more messages from a quick reply shortcut. This will also emit an updateDeleteQuickReplyMessages update.
 func (c *Client) MessagesDeleteQuickReplyMessages(shortcutID int32, id []int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesDeleteQuickReplyMessagesParams{
+	return c.MessagesDeleteQuickReplyMessagesCtx(context.Background(), shortcutID, id)
+}
+
+// MessagesDeleteQuickReplyMessagesCtx is the context-aware variant of MessagesDeleteQuickReplyMessages.
+func (c *Client) MessagesDeleteQuickReplyMessagesCtx(ctx context.Context, shortcutID int32, id []int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesDeleteQuickReplyMessagesParams{
 		ID:         id,
 		ShortcutID: shortcutID,
 	})
@@ -8453,7 +10129,12 @@ func (*MessagesDeleteQuickReplyShortcutParams) CRC() uint32 {
 
 // Completely delete a quick reply shortcut. This will also emit an updateDeleteQuickReply update to other logged-in sessions (and <em>no</em> updateDeleteQuickReplyMessages updates, even if all the messages in the shortcuts are also deleted by this method).
 func (c *Client) MessagesDeleteQuickReplyShortcut(shortcutID int32) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesDeleteQuickReplyShortcutParams{ShortcutID: shortcutID})
+	return c.MessagesDeleteQuickReplyShortcutCtx(context.Background(), shortcutID)
+}
+
+// MessagesDeleteQuickReplyShortcutCtx is the context-aware variant of MessagesDeleteQuickReplyShortcut.
+func (c *Client) MessagesDeleteQuickReplyShortcutCtx(ctx context.Context, shortcutID int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesDeleteQuickReplyShortcutParams{ShortcutID: shortcutID})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesDeleteQuickReplyShortcut: %w", err)
 	}
@@ -8476,7 +10157,12 @@ func (*MessagesDeleteRevokedExportedChatInvitesParams) CRC() uint32 {
 
 // Delete all revoked chat invites
 func (c *Client) MessagesDeleteRevokedExportedChatInvites(peer InputPeer, adminID InputUser) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesDeleteRevokedExportedChatInvitesParams{
+	return c.MessagesDeleteRevokedExportedChatInvitesCtx(context.Background(), peer, adminID)
+}
+
+// MessagesDeleteRevokedExportedChatInvitesCtx is the context-aware variant of MessagesDeleteRevokedExportedChatInvites.
+func (c *Client) MessagesDeleteRevokedExportedChatInvitesCtx(ctx context.Context, peer InputPeer, adminID InputUser) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesDeleteRevokedExportedChatInvitesParams{
 		AdminID: adminID,
 		Peer:    peer,
 	})
@@ -8509,7 +10195,12 @@ func (*MessagesDeleteSavedHistoryParams) FlagIndex() int {
 
 // Deletes messages from a monoforum topic, or deletes messages forwarded from a specific peer to saved messages.
 func (c *Client) MessagesDeleteSavedHistory(params *MessagesDeleteSavedHistoryParams) (*MessagesAffectedHistory, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesDeleteSavedHistoryCtx(context.Background(), params)
+}
+
+// MessagesDeleteSavedHistoryCtx is the context-aware variant of MessagesDeleteSavedHistory.
+func (c *Client) MessagesDeleteSavedHistoryCtx(ctx context.Context, params *MessagesDeleteSavedHistoryParams) (*MessagesAffectedHistory, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesDeleteSavedHistory: %w", err)
 	}
@@ -8532,7 +10223,12 @@ func (*MessagesDeleteScheduledMessagesParams) CRC() uint32 {
 
 // Delete scheduled messages
 func (c *Client) MessagesDeleteScheduledMessages(peer InputPeer, id []int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesDeleteScheduledMessagesParams{
+	return c.MessagesDeleteScheduledMessagesCtx(context.Background(), peer, id)
+}
+
+// MessagesDeleteScheduledMessagesCtx is the context-aware variant of MessagesDeleteScheduledMessages.
+func (c *Client) MessagesDeleteScheduledMessagesCtx(ctx context.Context, peer InputPeer, id []int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesDeleteScheduledMessagesParams{
 		ID:   id,
 		Peer: peer,
 	})
@@ -8557,7 +10253,12 @@ func (*MessagesDeleteTopicHistoryParams) CRC() uint32 {
 }
 
 func (c *Client) MessagesDeleteTopicHistory(peer InputPeer, topMsgID int32) (*MessagesAffectedHistory, error) {
-	responseData, err := c.MakeRequest(&MessagesDeleteTopicHistoryParams{
+	return c.MessagesDeleteTopicHistoryCtx(context.Background(), peer, topMsgID)
+}
+
+// MessagesDeleteTopicHistoryCtx is the context-aware variant of MessagesDeleteTopicHistory.
+func (c *Client) MessagesDeleteTopicHistoryCtx(ctx context.Context, peer InputPeer, topMsgID int32) (*MessagesAffectedHistory, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesDeleteTopicHistoryParams{
 		Peer:     peer,
 		TopMsgID: topMsgID,
 	})
@@ -8587,7 +10288,12 @@ func (*MessagesDiscardEncryptionParams) FlagIndex() int {
 
 // Cancels a request for creation and/or delete info on secret chat.
 func (c *Client) MessagesDiscardEncryption(deleteHistory bool, chatID int32) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesDiscardEncryptionParams{
+	return c.MessagesDiscardEncryptionCtx(context.Background(), deleteHistory, chatID)
+}
+
+// MessagesDiscardEncryptionCtx is the context-aware variant of MessagesDiscardEncryption.
+func (c *Client) MessagesDiscardEncryptionCtx(ctx context.Context, deleteHistory bool, chatID int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesDiscardEncryptionParams{
 		ChatID:        chatID,
 		DeleteHistory: deleteHistory,
 	})
@@ -8613,7 +10319,12 @@ func (*MessagesEditChatAboutParams) CRC() uint32 {
 
 // Edit the description of a group/supergroup/channel.
 func (c *Client) MessagesEditChatAbout(peer InputPeer, about string) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesEditChatAboutParams{
+	return c.MessagesEditChatAboutCtx(context.Background(), peer, about)
+}
+
+// MessagesEditChatAboutCtx is the context-aware variant of MessagesEditChatAbout.
+func (c *Client) MessagesEditChatAboutCtx(ctx context.Context, peer InputPeer, about string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesEditChatAboutParams{
 		About: about,
 		Peer:  peer,
 	})
@@ -8640,7 +10351,12 @@ func (*MessagesEditChatAdminParams) CRC() uint32 {
 
 // Make a user admin in a basic group.
 func (c *Client) MessagesEditChatAdmin(chatID int64, userID InputUser, isAdmin bool) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesEditChatAdminParams{
+	return c.MessagesEditChatAdminCtx(context.Background(), chatID, userID, isAdmin)
+}
+
+// MessagesEditChatAdminCtx is the context-aware variant of MessagesEditChatAdmin.
+func (c *Client) MessagesEditChatAdminCtx(ctx context.Context, chatID int64, userID InputUser, isAdmin bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesEditChatAdminParams{
 		ChatID:  chatID,
 		IsAdmin: isAdmin,
 		UserID:  userID,
@@ -8667,7 +10383,12 @@ func (*MessagesEditChatDefaultBannedRightsParams) CRC() uint32 {
 
 // Edit the default banned rights of a channel/supergroup/group.
 func (c *Client) MessagesEditChatDefaultBannedRights(peer InputPeer, bannedRights *ChatBannedRights) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesEditChatDefaultBannedRightsParams{
+	return c.MessagesEditChatDefaultBannedRightsCtx(context.Background(), peer, bannedRights)
+}
+
+// MessagesEditChatDefaultBannedRightsCtx is the context-aware variant of MessagesEditChatDefaultBannedRights.
+func (c *Client) MessagesEditChatDefaultBannedRightsCtx(ctx context.Context, peer InputPeer, bannedRights *ChatBannedRights) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesEditChatDefaultBannedRightsParams{
 		BannedRights: bannedRights,
 		Peer:         peer,
 	})
@@ -8693,7 +10414,12 @@ func (*MessagesEditChatPhotoParams) CRC() uint32 {
 
 // Changes chat photo and sends a service message on it
 func (c *Client) MessagesEditChatPhoto(chatID int64, photo InputChatPhoto) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesEditChatPhotoParams{
+	return c.MessagesEditChatPhotoCtx(context.Background(), chatID, photo)
+}
+
+// MessagesEditChatPhotoCtx is the context-aware variant of MessagesEditChatPhoto.
+func (c *Client) MessagesEditChatPhotoCtx(ctx context.Context, chatID int64, photo InputChatPhoto) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesEditChatPhotoParams{
 		ChatID: chatID,
 		Photo:  photo,
 	})
@@ -8719,7 +10445,12 @@ func (*MessagesEditChatTitleParams) CRC() uint32 {
 
 // Changes chat name and sends a service message on it.
 func (c *Client) MessagesEditChatTitle(chatID int64, title string) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesEditChatTitleParams{
+	return c.MessagesEditChatTitleCtx(context.Background(), chatID, title)
+}
+
+// MessagesEditChatTitleCtx is the context-aware variant of MessagesEditChatTitle.
+func (c *Client) MessagesEditChatTitleCtx(ctx context.Context, chatID int64, title string) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesEditChatTitleParams{
 		ChatID: chatID,
 		Title:  title,
 	})
@@ -8754,7 +10485,12 @@ func (*MessagesEditExportedChatInviteParams) FlagIndex() int {
 
 // Edit an exported chat invite
 func (c *Client) MessagesEditExportedChatInvite(params *MessagesEditExportedChatInviteParams) (MessagesExportedChatInvite, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesEditExportedChatInviteCtx(context.Background(), params)
+}
+
+// MessagesEditExportedChatInviteCtx is the context-aware variant of MessagesEditExportedChatInvite.
+func (c *Client) MessagesEditExportedChatInviteCtx(ctx context.Context, params *MessagesEditExportedChatInviteParams) (MessagesExportedChatInvite, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesEditExportedChatInvite: %w", err)
 	}
@@ -8778,7 +10514,12 @@ func (*MessagesEditFactCheckParams) CRC() uint32 {
 
 // Edit/create a fact-check on a message.
 func (c *Client) MessagesEditFactCheck(peer InputPeer, msgID int32, text *TextWithEntities) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesEditFactCheckParams{
+	return c.MessagesEditFactCheckCtx(context.Background(), peer, msgID, text)
+}
+
+// MessagesEditFactCheckCtx is the context-aware variant of MessagesEditFactCheck.
+func (c *Client) MessagesEditFactCheckCtx(ctx context.Context, peer InputPeer, msgID int32, text *TextWithEntities) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesEditFactCheckParams{
 		MsgID: msgID,
 		Peer:  peer,
 		Text:  text,
@@ -8812,7 +10553,12 @@ func (*MessagesEditForumTopicParams) FlagIndex() int {
 }
 
 func (c *Client) MessagesEditForumTopic(params *MessagesEditForumTopicParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesEditForumTopicCtx(context.Background(), params)
+}
+
+// MessagesEditForumTopicCtx is the context-aware variant of MessagesEditForumTopic.
+func (c *Client) MessagesEditForumTopicCtx(ctx context.Context, params *MessagesEditForumTopicParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesEditForumTopic: %w", err)
 	}
@@ -8844,7 +10590,12 @@ func (*MessagesEditInlineBotMessageParams) FlagIndex() int {
 
 // Edit an inline bot message
 func (c *Client) MessagesEditInlineBotMessage(params *MessagesEditInlineBotMessageParams) (bool, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesEditInlineBotMessageCtx(context.Background(), params)
+}
+
+// MessagesEditInlineBotMessageCtx is the context-aware variant of MessagesEditInlineBotMessage.
+func (c *Client) MessagesEditInlineBotMessageCtx(ctx context.Context, params *MessagesEditInlineBotMessageParams) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesEditInlineBotMessage: %w", err)
 	}
@@ -8880,7 +10631,12 @@ func (*MessagesEditMessageParams) FlagIndex() int {
 
 // Edit message
 func (c *Client) MessagesEditMessage(params *MessagesEditMessageParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesEditMessageCtx(context.Background(), params)
+}
+
+// MessagesEditMessageCtx is the context-aware variant of MessagesEditMessage.
+func (c *Client) MessagesEditMessageCtx(ctx context.Context, params *MessagesEditMessageParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesEditMessage: %w", err)
 	}
@@ -8903,7 +10659,12 @@ func (*MessagesEditQuickReplyShortcutParams) CRC() uint32 {
 
 // Rename a quick reply shortcut. This will emit an updateQuickReplies update to other logged-in sessions.
 func (c *Client) MessagesEditQuickReplyShortcut(shortcutID int32, shortcut string) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesEditQuickReplyShortcutParams{
+	return c.MessagesEditQuickReplyShortcutCtx(context.Background(), shortcutID, shortcut)
+}
+
+// MessagesEditQuickReplyShortcutCtx is the context-aware variant of MessagesEditQuickReplyShortcut.
+func (c *Client) MessagesEditQuickReplyShortcutCtx(ctx context.Context, shortcutID int32, shortcut string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesEditQuickReplyShortcutParams{
 		Shortcut:   shortcut,
 		ShortcutID: shortcutID,
 	})
@@ -8938,7 +10699,12 @@ func (*MessagesExportChatInviteParams) FlagIndex() int {
 
 // Export an invite link for a chat
 func (c *Client) MessagesExportChatInvite(params *MessagesExportChatInviteParams) (ExportedChatInvite, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesExportChatInviteCtx(context.Background(), params)
+}
+
+// MessagesExportChatInviteCtx is the context-aware variant of MessagesExportChatInvite.
+func (c *Client) MessagesExportChatInviteCtx(ctx context.Context, params *MessagesExportChatInviteParams) (ExportedChatInvite, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesExportChatInvite: %w", err)
 	}
@@ -8961,7 +10727,12 @@ func (*MessagesFaveStickerParams) CRC() uint32 {
 
 // Mark or unmark a sticker as favorite
 func (c *Client) MessagesFaveSticker(id InputDocument, unfave bool) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesFaveStickerParams{
+	return c.MessagesFaveStickerCtx(context.Background(), id, unfave)
+}
+
+// MessagesFaveStickerCtx is the context-aware variant of MessagesFaveSticker.
+func (c *Client) MessagesFaveStickerCtx(ctx context.Context, id InputDocument, unfave bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesFaveStickerParams{
 		ID:     id,
 		Unfave: unfave,
 	})
@@ -8988,7 +10759,12 @@ func (*MessagesForwardMessageParams) CRC() uint32 {
 
 // Forwards single messages.
 func (c *Client) MessagesForwardMessage(peer InputPeer, id int32, randomID int64) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesForwardMessageParams{
+	return c.MessagesForwardMessageCtx(context.Background(), peer, id, randomID)
+}
+
+// MessagesForwardMessageCtx is the context-aware variant of MessagesForwardMessage.
+func (c *Client) MessagesForwardMessageCtx(ctx context.Context, peer InputPeer, id int32, randomID int64) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesForwardMessageParams{
 		ID:       id,
 		Peer:     peer,
 		RandomID: randomID,
@@ -9038,7 +10814,12 @@ func (*MessagesForwardMessagesParams) FlagIndex() int {
 
 // Forwards messages by their IDs.
 func (c *Client) MessagesForwardMessages(params *MessagesForwardMessagesParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesForwardMessagesCtx(context.Background(), params)
+}
+
+// MessagesForwardMessagesCtx is the context-aware variant of MessagesForwardMessages.
+func (c *Client) MessagesForwardMessagesCtx(ctx context.Context, params *MessagesForwardMessagesParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesForwardMessages: %w", err)
 	}
@@ -9060,7 +10841,12 @@ func (*MessagesGetAdminsWithInvitesParams) CRC() uint32 {
 
 // Get info about chat invites generated by admins.
 func (c *Client) MessagesGetAdminsWithInvites(peer InputPeer) (*MessagesChatAdminsWithInvites, error) {
-	responseData, err := c.MakeRequest(&MessagesGetAdminsWithInvitesParams{Peer: peer})
+	return c.MessagesGetAdminsWithInvitesCtx(context.Background(), peer)
+}
+
+// MessagesGetAdminsWithInvitesCtx is the context-aware variant of MessagesGetAdminsWithInvites.
+func (c *Client) MessagesGetAdminsWithInvitesCtx(ctx context.Context, peer InputPeer) (*MessagesChatAdminsWithInvites, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetAdminsWithInvitesParams{Peer: peer})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetAdminsWithInvites: %w", err)
 	}
@@ -9082,7 +10868,12 @@ func (*MessagesGetAllChatsParams) CRC() uint32 {
 
 // Get all chats, channels and supergroups
 func (c *Client) MessagesGetAllChats(exceptIds []int64) (MessagesChats, error) {
-	responseData, err := c.MakeRequest(&MessagesGetAllChatsParams{ExceptIds: exceptIds})
+	return c.MessagesGetAllChatsCtx(context.Background(), exceptIds)
+}
+
+// MessagesGetAllChatsCtx is the context-aware variant of MessagesGetAllChats.
+func (c *Client) MessagesGetAllChatsCtx(ctx context.Context, exceptIds []int64) (MessagesChats, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetAllChatsParams{ExceptIds: exceptIds})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetAllChats: %w", err)
 	}
@@ -9102,7 +10893,12 @@ func (*MessagesGetAllDraftsParams) CRC() uint32 {
 
 // Return all message drafts. Returns all the latest updateDraftMessage updates related to all chats with drafts.
 func (c *Client) MessagesGetAllDrafts() (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesGetAllDraftsParams{})
+	return c.MessagesGetAllDraftsCtx(context.Background())
+}
+
+// MessagesGetAllDraftsCtx is the context-aware variant of MessagesGetAllDrafts.
+func (c *Client) MessagesGetAllDraftsCtx(ctx context.Context) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetAllDraftsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetAllDrafts: %w", err)
 	}
@@ -9124,7 +10920,12 @@ func (*MessagesGetAllStickersParams) CRC() uint32 {
 
 // Get all installed stickers
 func (c *Client) MessagesGetAllStickers(hash int64) (MessagesAllStickers, error) {
-	responseData, err := c.MakeRequest(&MessagesGetAllStickersParams{Hash: hash})
+	return c.MessagesGetAllStickersCtx(context.Background(), hash)
+}
+
+// MessagesGetAllStickersCtx is the context-aware variant of MessagesGetAllStickers.
+func (c *Client) MessagesGetAllStickersCtx(ctx context.Context, hash int64) (MessagesAllStickers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetAllStickersParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetAllStickers: %w", err)
 	}
@@ -9153,7 +10954,12 @@ func (*MessagesGetArchivedStickersParams) FlagIndex() int {
 
 // Get all archived stickers
 func (c *Client) MessagesGetArchivedStickers(masks, emojis bool, offsetID int64, limit int32) (*MessagesArchivedStickers, error) {
-	responseData, err := c.MakeRequest(&MessagesGetArchivedStickersParams{
+	return c.MessagesGetArchivedStickersCtx(context.Background(), masks, emojis, offsetID, limit)
+}
+
+// MessagesGetArchivedStickersCtx is the context-aware variant of MessagesGetArchivedStickers.
+func (c *Client) MessagesGetArchivedStickersCtx(ctx context.Context, masks, emojis bool, offsetID int64, limit int32) (*MessagesArchivedStickers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetArchivedStickersParams{
 		Emojis:   emojis,
 		Limit:    limit,
 		Masks:    masks,
@@ -9180,7 +10986,12 @@ func (*MessagesGetAttachMenuBotParams) CRC() uint32 {
 
 // Returns attachment menu entry for a bot mini app that can be launched from the attachment menu
 func (c *Client) MessagesGetAttachMenuBot(bot InputUser) (*AttachMenuBotsBot, error) {
-	responseData, err := c.MakeRequest(&MessagesGetAttachMenuBotParams{Bot: bot})
+	return c.MessagesGetAttachMenuBotCtx(context.Background(), bot)
+}
+
+// MessagesGetAttachMenuBotCtx is the context-aware variant of MessagesGetAttachMenuBot.
+func (c *Client) MessagesGetAttachMenuBotCtx(ctx context.Context, bot InputUser) (*AttachMenuBotsBot, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetAttachMenuBotParams{Bot: bot})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetAttachMenuBot: %w", err)
 	}
@@ -9202,7 +11013,12 @@ func (*MessagesGetAttachMenuBotsParams) CRC() uint32 {
 
 // Returns installed attachment menu bot mini apps
 func (c *Client) MessagesGetAttachMenuBots(hash int64) (AttachMenuBots, error) {
-	responseData, err := c.MakeRequest(&MessagesGetAttachMenuBotsParams{Hash: hash})
+	return c.MessagesGetAttachMenuBotsCtx(context.Background(), hash)
+}
+
+// MessagesGetAttachMenuBotsCtx is the context-aware variant of MessagesGetAttachMenuBots.
+func (c *Client) MessagesGetAttachMenuBotsCtx(ctx context.Context, hash int64) (AttachMenuBots, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetAttachMenuBotsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetAttachMenuBots: %w", err)
 	}
@@ -9224,7 +11040,12 @@ func (*MessagesGetAttachedStickersParams) CRC() uint32 {
 
 // Get stickers attached to a photo or video
 func (c *Client) MessagesGetAttachedStickers(media InputStickeredMedia) ([]StickerSetCovered, error) {
-	responseData, err := c.MakeRequest(&MessagesGetAttachedStickersParams{Media: media})
+	return c.MessagesGetAttachedStickersCtx(context.Background(), media)
+}
+
+// MessagesGetAttachedStickersCtx is the context-aware variant of MessagesGetAttachedStickers.
+func (c *Client) MessagesGetAttachedStickersCtx(ctx context.Context, media InputStickeredMedia) ([]StickerSetCovered, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetAttachedStickersParams{Media: media})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetAttachedStickers: %w", err)
 	}
@@ -9246,7 +11067,12 @@ func (*MessagesGetAvailableEffectsParams) CRC() uint32 {
 
 // Fetch the full list of usable animated message effects.
 func (c *Client) MessagesGetAvailableEffects(hash int32) (MessagesAvailableEffects, error) {
-	responseData, err := c.MakeRequest(&MessagesGetAvailableEffectsParams{Hash: hash})
+	return c.MessagesGetAvailableEffectsCtx(context.Background(), hash)
+}
+
+// MessagesGetAvailableEffectsCtx is the context-aware variant of MessagesGetAvailableEffects.
+func (c *Client) MessagesGetAvailableEffectsCtx(ctx context.Context, hash int32) (MessagesAvailableEffects, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetAvailableEffectsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetAvailableEffects: %w", err)
 	}
@@ -9268,7 +11094,12 @@ func (*MessagesGetAvailableReactionsParams) CRC() uint32 {
 
 // Obtain available message reactions
 func (c *Client) MessagesGetAvailableReactions(hash int32) (MessagesAvailableReactions, error) {
-	responseData, err := c.MakeRequest(&MessagesGetAvailableReactionsParams{Hash: hash})
+	return c.MessagesGetAvailableReactionsCtx(context.Background(), hash)
+}
+
+// MessagesGetAvailableReactionsCtx is the context-aware variant of MessagesGetAvailableReactions.
+func (c *Client) MessagesGetAvailableReactionsCtx(ctx context.Context, hash int32) (MessagesAvailableReactions, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetAvailableReactionsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetAvailableReactions: %w", err)
 	}
@@ -9291,7 +11122,12 @@ func (*MessagesGetBotAppParams) CRC() uint32 {
 
 // Obtain information about a direct link Mini App
 func (c *Client) MessagesGetBotApp(app InputBotApp, hash int64) (*MessagesBotApp, error) {
-	responseData, err := c.MakeRequest(&MessagesGetBotAppParams{
+	return c.MessagesGetBotAppCtx(context.Background(), app, hash)
+}
+
+// MessagesGetBotAppCtx is the context-aware variant of MessagesGetBotApp.
+func (c *Client) MessagesGetBotAppCtx(ctx context.Context, app InputBotApp, hash int64) (*MessagesBotApp, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetBotAppParams{
 		App:  app,
 		Hash: hash,
 	})
@@ -9324,7 +11160,12 @@ func (*MessagesGetBotCallbackAnswerParams) FlagIndex() int {
 
 // Press an inline callback button and get a callback answer from the bot
 func (c *Client) MessagesGetBotCallbackAnswer(params *MessagesGetBotCallbackAnswerParams) (*MessagesBotCallbackAnswer, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetBotCallbackAnswerCtx(context.Background(), params)
+}
+
+// MessagesGetBotCallbackAnswerCtx is the context-aware variant of MessagesGetBotCallbackAnswer.
+func (c *Client) MessagesGetBotCallbackAnswerCtx(ctx context.Context, params *MessagesGetBotCallbackAnswerParams) (*MessagesBotCallbackAnswer, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetBotCallbackAnswer: %w", err)
 	}
@@ -9357,7 +11198,12 @@ func (*MessagesGetChatInviteImportersParams) FlagIndex() int {
 
 // Get info about the users that joined the chat using a specific chat invite
 func (c *Client) MessagesGetChatInviteImporters(params *MessagesGetChatInviteImportersParams) (*MessagesChatInviteImporters, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetChatInviteImportersCtx(context.Background(), params)
+}
+
+// MessagesGetChatInviteImportersCtx is the context-aware variant of MessagesGetChatInviteImporters.
+func (c *Client) MessagesGetChatInviteImportersCtx(ctx context.Context, params *MessagesGetChatInviteImportersParams) (*MessagesChatInviteImporters, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetChatInviteImporters: %w", err)
 	}
@@ -9379,7 +11225,12 @@ func (*MessagesGetChatsParams) CRC() uint32 {
 
 // Returns chat basic info on their IDs.
 func (c *Client) MessagesGetChats(id []int64) (MessagesChats, error) {
-	responseData, err := c.MakeRequest(&MessagesGetChatsParams{ID: id})
+	return c.MessagesGetChatsCtx(context.Background(), id)
+}
+
+// MessagesGetChatsCtx is the context-aware variant of MessagesGetChats.
+func (c *Client) MessagesGetChatsCtx(ctx context.Context, id []int64) (MessagesChats, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetChatsParams{ID: id})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetChats: %w", err)
 	}
@@ -9403,7 +11254,12 @@ func (*MessagesGetCommonChatsParams) CRC() uint32 {
 
 // Get chats in common with a user
 func (c *Client) MessagesGetCommonChats(userID InputUser, maxID int64, limit int32) (MessagesChats, error) {
-	responseData, err := c.MakeRequest(&MessagesGetCommonChatsParams{
+	return c.MessagesGetCommonChatsCtx(context.Background(), userID, maxID, limit)
+}
+
+// MessagesGetCommonChatsCtx is the context-aware variant of MessagesGetCommonChats.
+func (c *Client) MessagesGetCommonChatsCtx(ctx context.Context, userID InputUser, maxID int64, limit int32) (MessagesChats, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetCommonChatsParams{
 		Limit:  limit,
 		MaxID:  maxID,
 		UserID: userID,
@@ -9430,7 +11286,12 @@ func (*MessagesGetCraftStarGiftsParams) CRC() uint32 {
 }
 
 func (c *Client) MessagesGetCraftStarGifts(giftID int64, offset string, limit int32) (*PaymentsSavedStarGifts, error) {
-	responseData, err := c.MakeRequest(&MessagesGetCraftStarGiftsParams{
+	return c.MessagesGetCraftStarGiftsCtx(context.Background(), giftID, offset, limit)
+}
+
+// MessagesGetCraftStarGiftsCtx is the context-aware variant of MessagesGetCraftStarGifts.
+func (c *Client) MessagesGetCraftStarGiftsCtx(ctx context.Context, giftID int64, offset string, limit int32) (*PaymentsSavedStarGifts, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetCraftStarGiftsParams{
 		GiftID: giftID,
 		Limit:  limit,
 		Offset: offset,
@@ -9456,7 +11317,12 @@ func (*MessagesGetCustomEmojiDocumentsParams) CRC() uint32 {
 
 // Fetch custom emoji stickers.
 func (c *Client) MessagesGetCustomEmojiDocuments(documentID []int64) ([]Document, error) {
-	responseData, err := c.MakeRequest(&MessagesGetCustomEmojiDocumentsParams{DocumentID: documentID})
+	return c.MessagesGetCustomEmojiDocumentsCtx(context.Background(), documentID)
+}
+
+// MessagesGetCustomEmojiDocumentsCtx is the context-aware variant of MessagesGetCustomEmojiDocuments.
+func (c *Client) MessagesGetCustomEmojiDocumentsCtx(ctx context.Context, documentID []int64) ([]Document, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetCustomEmojiDocumentsParams{DocumentID: documentID})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetCustomEmojiDocuments: %w", err)
 	}
@@ -9476,7 +11342,12 @@ func (*MessagesGetDefaultHistoryTtlParams) CRC() uint32 {
 
 // Gets the default value of the Time-To-Live setting, applied to all new chats.
 func (c *Client) MessagesGetDefaultHistoryTtl() (*DefaultHistoryTtl, error) {
-	responseData, err := c.MakeRequest(&MessagesGetDefaultHistoryTtlParams{})
+	return c.MessagesGetDefaultHistoryTtlCtx(context.Background())
+}
+
+// MessagesGetDefaultHistoryTtlCtx is the context-aware variant of MessagesGetDefaultHistoryTtl.
+func (c *Client) MessagesGetDefaultHistoryTtlCtx(ctx context.Context) (*DefaultHistoryTtl, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetDefaultHistoryTtlParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetDefaultHistoryTtl: %w", err)
 	}
@@ -9498,7 +11369,12 @@ func (*MessagesGetDefaultTagReactionsParams) CRC() uint32 {
 
 // Fetch a default recommended list of saved message tag reactions.
 func (c *Client) MessagesGetDefaultTagReactions(hash int64) (MessagesReactions, error) {
-	responseData, err := c.MakeRequest(&MessagesGetDefaultTagReactionsParams{Hash: hash})
+	return c.MessagesGetDefaultTagReactionsCtx(context.Background(), hash)
+}
+
+// MessagesGetDefaultTagReactionsCtx is the context-aware variant of MessagesGetDefaultTagReactions.
+func (c *Client) MessagesGetDefaultTagReactionsCtx(ctx context.Context, hash int64) (MessagesReactions, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetDefaultTagReactionsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetDefaultTagReactions: %w", err)
 	}
@@ -9521,7 +11397,12 @@ func (*MessagesGetDhConfigParams) CRC() uint32 {
 
 // Returns configuration parameters for Diffie-Hellman key generation. Can also return a random sequence of bytes of required length.
 func (c *Client) MessagesGetDhConfig(version, randomLength int32) (MessagesDhConfig, error) {
-	responseData, err := c.MakeRequest(&MessagesGetDhConfigParams{
+	return c.MessagesGetDhConfigCtx(context.Background(), version, randomLength)
+}
+
+// MessagesGetDhConfigCtx is the context-aware variant of MessagesGetDhConfig.
+func (c *Client) MessagesGetDhConfigCtx(ctx context.Context, version, randomLength int32) (MessagesDhConfig, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetDhConfigParams{
 		RandomLength: randomLength,
 		Version:      version,
 	})
@@ -9544,7 +11425,12 @@ func (*MessagesGetDialogFiltersParams) CRC() uint32 {
 
 // Get folders
 func (c *Client) MessagesGetDialogFilters() (*MessagesDialogFilters, error) {
-	responseData, err := c.MakeRequest(&MessagesGetDialogFiltersParams{})
+	return c.MessagesGetDialogFiltersCtx(context.Background())
+}
+
+// MessagesGetDialogFiltersCtx is the context-aware variant of MessagesGetDialogFilters.
+func (c *Client) MessagesGetDialogFiltersCtx(ctx context.Context) (*MessagesDialogFilters, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetDialogFiltersParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetDialogFilters: %w", err)
 	}
@@ -9570,7 +11456,12 @@ func (*MessagesGetDialogUnreadMarksParams) FlagIndex() int {
 
 // Get dialogs manually marked as unread
 func (c *Client) MessagesGetDialogUnreadMarks(parentPeer InputPeer) ([]DialogPeer, error) {
-	responseData, err := c.MakeRequest(&MessagesGetDialogUnreadMarksParams{ParentPeer: parentPeer})
+	return c.MessagesGetDialogUnreadMarksCtx(context.Background(), parentPeer)
+}
+
+// MessagesGetDialogUnreadMarksCtx is the context-aware variant of MessagesGetDialogUnreadMarks.
+func (c *Client) MessagesGetDialogUnreadMarksCtx(ctx context.Context, parentPeer InputPeer) ([]DialogPeer, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetDialogUnreadMarksParams{ParentPeer: parentPeer})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetDialogUnreadMarks: %w", err)
 	}
@@ -9602,7 +11493,12 @@ func (*MessagesGetDialogsParams) FlagIndex() int {
 
 // Returns the current user dialog list.
 func (c *Client) MessagesGetDialogs(params *MessagesGetDialogsParams) (MessagesDialogs, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetDialogsCtx(context.Background(), params)
+}
+
+// MessagesGetDialogsCtx is the context-aware variant of MessagesGetDialogs.
+func (c *Client) MessagesGetDialogsCtx(ctx context.Context, params *MessagesGetDialogsParams) (MessagesDialogs, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetDialogs: %w", err)
 	}
@@ -9625,7 +11521,12 @@ func (*MessagesGetDiscussionMessageParams) CRC() uint32 {
 
 // Get discussion message from the associated discussion group of a channel to show it on top of the comment section, without actually joining the group
 func (c *Client) MessagesGetDiscussionMessage(peer InputPeer, msgID int32) (*MessagesDiscussionMessage, error) {
-	responseData, err := c.MakeRequest(&MessagesGetDiscussionMessageParams{
+	return c.MessagesGetDiscussionMessageCtx(context.Background(), peer, msgID)
+}
+
+// MessagesGetDiscussionMessageCtx is the context-aware variant of MessagesGetDiscussionMessage.
+func (c *Client) MessagesGetDiscussionMessageCtx(ctx context.Context, peer InputPeer, msgID int32) (*MessagesDiscussionMessage, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetDiscussionMessageParams{
 		MsgID: msgID,
 		Peer:  peer,
 	})
@@ -9652,7 +11553,12 @@ func (*MessagesGetDocumentByHashParams) CRC() uint32 {
 
 // Get a document by its SHA256 hash, mainly used for gifs
 func (c *Client) MessagesGetDocumentByHash(sha256 []byte, size int64, mimeType string) (Document, error) {
-	responseData, err := c.MakeRequest(&MessagesGetDocumentByHashParams{
+	return c.MessagesGetDocumentByHashCtx(context.Background(), sha256, size, mimeType)
+}
+
+// MessagesGetDocumentByHashCtx is the context-aware variant of MessagesGetDocumentByHash.
+func (c *Client) MessagesGetDocumentByHashCtx(ctx context.Context, sha256 []byte, size int64, mimeType string) (Document, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetDocumentByHashParams{
 		MimeType: mimeType,
 		SHA256:   sha256,
 		Size:     size,
@@ -9675,7 +11581,12 @@ func (*MessagesGetEmojiGameInfoParams) CRC() uint32 {
 }
 
 func (c *Client) MessagesGetEmojiGameInfo() (MessagesEmojiGameInfo, error) {
-	responseData, err := c.MakeRequest(&MessagesGetEmojiGameInfoParams{})
+	return c.MessagesGetEmojiGameInfoCtx(context.Background())
+}
+
+// MessagesGetEmojiGameInfoCtx is the context-aware variant of MessagesGetEmojiGameInfo.
+func (c *Client) MessagesGetEmojiGameInfoCtx(ctx context.Context) (MessagesEmojiGameInfo, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetEmojiGameInfoParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetEmojiGameInfo: %w", err)
 	}
@@ -9697,7 +11608,12 @@ func (*MessagesGetEmojiGroupsParams) CRC() uint32 {
 
 // Represents a list of emoji categories.
 func (c *Client) MessagesGetEmojiGroups(hash int32) (MessagesEmojiGroups, error) {
-	responseData, err := c.MakeRequest(&MessagesGetEmojiGroupsParams{Hash: hash})
+	return c.MessagesGetEmojiGroupsCtx(context.Background(), hash)
+}
+
+// MessagesGetEmojiGroupsCtx is the context-aware variant of MessagesGetEmojiGroups.
+func (c *Client) MessagesGetEmojiGroupsCtx(ctx context.Context, hash int32) (MessagesEmojiGroups, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetEmojiGroupsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetEmojiGroups: %w", err)
 	}
@@ -9719,7 +11635,12 @@ func (*MessagesGetEmojiKeywordsParams) CRC() uint32 {
 
 // Get localized emoji keywords.
 func (c *Client) MessagesGetEmojiKeywords(langCode string) (*EmojiKeywordsDifference, error) {
-	responseData, err := c.MakeRequest(&MessagesGetEmojiKeywordsParams{LangCode: langCode})
+	return c.MessagesGetEmojiKeywordsCtx(context.Background(), langCode)
+}
+
+// MessagesGetEmojiKeywordsCtx is the context-aware variant of MessagesGetEmojiKeywords.
+func (c *Client) MessagesGetEmojiKeywordsCtx(ctx context.Context, langCode string) (*EmojiKeywordsDifference, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetEmojiKeywordsParams{LangCode: langCode})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetEmojiKeywords: %w", err)
 	}
@@ -9742,7 +11663,12 @@ func (*MessagesGetEmojiKeywordsDifferenceParams) CRC() uint32 {
 
 // Get changed emoji keywords.
 func (c *Client) MessagesGetEmojiKeywordsDifference(langCode string, fromVersion int32) (*EmojiKeywordsDifference, error) {
-	responseData, err := c.MakeRequest(&MessagesGetEmojiKeywordsDifferenceParams{
+	return c.MessagesGetEmojiKeywordsDifferenceCtx(context.Background(), langCode, fromVersion)
+}
+
+// MessagesGetEmojiKeywordsDifferenceCtx is the context-aware variant of MessagesGetEmojiKeywordsDifference.
+func (c *Client) MessagesGetEmojiKeywordsDifferenceCtx(ctx context.Context, langCode string, fromVersion int32) (*EmojiKeywordsDifference, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetEmojiKeywordsDifferenceParams{
 		FromVersion: fromVersion,
 		LangCode:    langCode,
 	})
@@ -9767,7 +11693,12 @@ func (*MessagesGetEmojiKeywordsLanguagesParams) CRC() uint32 {
 
 // Obtain a list of related languages that must be used when fetching emoji keyword lists.
 func (c *Client) MessagesGetEmojiKeywordsLanguages(langCodes []string) ([]*EmojiLanguage, error) {
-	responseData, err := c.MakeRequest(&MessagesGetEmojiKeywordsLanguagesParams{LangCodes: langCodes})
+	return c.MessagesGetEmojiKeywordsLanguagesCtx(context.Background(), langCodes)
+}
+
+// MessagesGetEmojiKeywordsLanguagesCtx is the context-aware variant of MessagesGetEmojiKeywordsLanguages.
+func (c *Client) MessagesGetEmojiKeywordsLanguagesCtx(ctx context.Context, langCodes []string) ([]*EmojiLanguage, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetEmojiKeywordsLanguagesParams{LangCodes: langCodes})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetEmojiKeywordsLanguages: %w", err)
 	}
@@ -9789,7 +11720,12 @@ func (*MessagesGetEmojiProfilePhotoGroupsParams) CRC() uint32 {
 
 // Represents a list of emoji categories, to be used when selecting custom emojis to set as profile picture.
 func (c *Client) MessagesGetEmojiProfilePhotoGroups(hash int32) (MessagesEmojiGroups, error) {
-	responseData, err := c.MakeRequest(&MessagesGetEmojiProfilePhotoGroupsParams{Hash: hash})
+	return c.MessagesGetEmojiProfilePhotoGroupsCtx(context.Background(), hash)
+}
+
+// MessagesGetEmojiProfilePhotoGroupsCtx is the context-aware variant of MessagesGetEmojiProfilePhotoGroups.
+func (c *Client) MessagesGetEmojiProfilePhotoGroupsCtx(ctx context.Context, hash int32) (MessagesEmojiGroups, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetEmojiProfilePhotoGroupsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetEmojiProfilePhotoGroups: %w", err)
 	}
@@ -9811,7 +11747,12 @@ func (*MessagesGetEmojiStatusGroupsParams) CRC() uint32 {
 
 // Represents a list of emoji categories, to be used when selecting custom emojis to set as custom emoji status.
 func (c *Client) MessagesGetEmojiStatusGroups(hash int32) (MessagesEmojiGroups, error) {
-	responseData, err := c.MakeRequest(&MessagesGetEmojiStatusGroupsParams{Hash: hash})
+	return c.MessagesGetEmojiStatusGroupsCtx(context.Background(), hash)
+}
+
+// MessagesGetEmojiStatusGroupsCtx is the context-aware variant of MessagesGetEmojiStatusGroups.
+func (c *Client) MessagesGetEmojiStatusGroupsCtx(ctx context.Context, hash int32) (MessagesEmojiGroups, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetEmojiStatusGroupsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetEmojiStatusGroups: %w", err)
 	}
@@ -9833,7 +11774,12 @@ func (*MessagesGetEmojiStickerGroupsParams) CRC() uint32 {
 
 // Represents a list of emoji categories, to be used when choosing a sticker.
 func (c *Client) MessagesGetEmojiStickerGroups(hash int32) (MessagesEmojiGroups, error) {
-	responseData, err := c.MakeRequest(&MessagesGetEmojiStickerGroupsParams{Hash: hash})
+	return c.MessagesGetEmojiStickerGroupsCtx(context.Background(), hash)
+}
+
+// MessagesGetEmojiStickerGroupsCtx is the context-aware variant of MessagesGetEmojiStickerGroups.
+func (c *Client) MessagesGetEmojiStickerGroupsCtx(ctx context.Context, hash int32) (MessagesEmojiGroups, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetEmojiStickerGroupsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetEmojiStickerGroups: %w", err)
 	}
@@ -9855,7 +11801,12 @@ func (*MessagesGetEmojiStickersParams) CRC() uint32 {
 
 // Gets the list of currently installed custom emoji stickersets.
 func (c *Client) MessagesGetEmojiStickers(hash int64) (MessagesAllStickers, error) {
-	responseData, err := c.MakeRequest(&MessagesGetEmojiStickersParams{Hash: hash})
+	return c.MessagesGetEmojiStickersCtx(context.Background(), hash)
+}
+
+// MessagesGetEmojiStickersCtx is the context-aware variant of MessagesGetEmojiStickers.
+func (c *Client) MessagesGetEmojiStickersCtx(ctx context.Context, hash int64) (MessagesAllStickers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetEmojiStickersParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetEmojiStickers: %w", err)
 	}
@@ -9877,7 +11828,12 @@ func (*MessagesGetEmojiURLParams) CRC() uint32 {
 
 // Returns an HTTP URL which can be used to automatically log in into translation platform and suggest new emoji keywords. The URL will be valid for 30 seconds after generation.
 func (c *Client) MessagesGetEmojiURL(langCode string) (*EmojiURL, error) {
-	responseData, err := c.MakeRequest(&MessagesGetEmojiURLParams{LangCode: langCode})
+	return c.MessagesGetEmojiURLCtx(context.Background(), langCode)
+}
+
+// MessagesGetEmojiURLCtx is the context-aware variant of MessagesGetEmojiURL.
+func (c *Client) MessagesGetEmojiURLCtx(ctx context.Context, langCode string) (*EmojiURL, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetEmojiURLParams{LangCode: langCode})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetEmojiURL: %w", err)
 	}
@@ -9900,7 +11856,12 @@ func (*MessagesGetExportedChatInviteParams) CRC() uint32 {
 
 // Get info about a chat invite
 func (c *Client) MessagesGetExportedChatInvite(peer InputPeer, link string) (MessagesExportedChatInvite, error) {
-	responseData, err := c.MakeRequest(&MessagesGetExportedChatInviteParams{
+	return c.MessagesGetExportedChatInviteCtx(context.Background(), peer, link)
+}
+
+// MessagesGetExportedChatInviteCtx is the context-aware variant of MessagesGetExportedChatInvite.
+func (c *Client) MessagesGetExportedChatInviteCtx(ctx context.Context, peer InputPeer, link string) (MessagesExportedChatInvite, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetExportedChatInviteParams{
 		Link: link,
 		Peer: peer,
 	})
@@ -9934,7 +11895,12 @@ func (*MessagesGetExportedChatInvitesParams) FlagIndex() int {
 
 // Get info about the chat invites of a specific chat
 func (c *Client) MessagesGetExportedChatInvites(params *MessagesGetExportedChatInvitesParams) (*MessagesExportedChatInvites, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetExportedChatInvitesCtx(context.Background(), params)
+}
+
+// MessagesGetExportedChatInvitesCtx is the context-aware variant of MessagesGetExportedChatInvites.
+func (c *Client) MessagesGetExportedChatInvitesCtx(ctx context.Context, params *MessagesGetExportedChatInvitesParams) (*MessagesExportedChatInvites, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetExportedChatInvites: %w", err)
 	}
@@ -9957,7 +11923,12 @@ func (*MessagesGetExtendedMediaParams) CRC() uint32 {
 
 // Fetch updated information about paid media
 func (c *Client) MessagesGetExtendedMedia(peer InputPeer, id []int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesGetExtendedMediaParams{
+	return c.MessagesGetExtendedMediaCtx(context.Background(), peer, id)
+}
+
+// MessagesGetExtendedMediaCtx is the context-aware variant of MessagesGetExtendedMedia.
+func (c *Client) MessagesGetExtendedMediaCtx(ctx context.Context, peer InputPeer, id []int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetExtendedMediaParams{
 		ID:   id,
 		Peer: peer,
 	})
@@ -9983,7 +11954,12 @@ func (*MessagesGetFactCheckParams) CRC() uint32 {
 
 // Fetch one or more factchecks
 func (c *Client) MessagesGetFactCheck(peer InputPeer, msgID []int32) ([]*FactCheck, error) {
-	responseData, err := c.MakeRequest(&MessagesGetFactCheckParams{
+	return c.MessagesGetFactCheckCtx(context.Background(), peer, msgID)
+}
+
+// MessagesGetFactCheckCtx is the context-aware variant of MessagesGetFactCheck.
+func (c *Client) MessagesGetFactCheckCtx(ctx context.Context, peer InputPeer, msgID []int32) ([]*FactCheck, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetFactCheckParams{
 		MsgID: msgID,
 		Peer:  peer,
 	})
@@ -10008,7 +11984,12 @@ func (*MessagesGetFavedStickersParams) CRC() uint32 {
 
 // Get faved stickers
 func (c *Client) MessagesGetFavedStickers(hash int64) (MessagesFavedStickers, error) {
-	responseData, err := c.MakeRequest(&MessagesGetFavedStickersParams{Hash: hash})
+	return c.MessagesGetFavedStickersCtx(context.Background(), hash)
+}
+
+// MessagesGetFavedStickersCtx is the context-aware variant of MessagesGetFavedStickers.
+func (c *Client) MessagesGetFavedStickersCtx(ctx context.Context, hash int64) (MessagesFavedStickers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetFavedStickersParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetFavedStickers: %w", err)
 	}
@@ -10030,7 +12011,12 @@ func (*MessagesGetFeaturedEmojiStickersParams) CRC() uint32 {
 
 // Gets featured custom emoji stickersets.
 func (c *Client) MessagesGetFeaturedEmojiStickers(hash int64) (MessagesFeaturedStickers, error) {
-	responseData, err := c.MakeRequest(&MessagesGetFeaturedEmojiStickersParams{Hash: hash})
+	return c.MessagesGetFeaturedEmojiStickersCtx(context.Background(), hash)
+}
+
+// MessagesGetFeaturedEmojiStickersCtx is the context-aware variant of MessagesGetFeaturedEmojiStickers.
+func (c *Client) MessagesGetFeaturedEmojiStickersCtx(ctx context.Context, hash int64) (MessagesFeaturedStickers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetFeaturedEmojiStickersParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetFeaturedEmojiStickers: %w", err)
 	}
@@ -10052,7 +12038,12 @@ func (*MessagesGetFeaturedStickersParams) CRC() uint32 {
 
 // Get featured stickers
 func (c *Client) MessagesGetFeaturedStickers(hash int64) (MessagesFeaturedStickers, error) {
-	responseData, err := c.MakeRequest(&MessagesGetFeaturedStickersParams{Hash: hash})
+	return c.MessagesGetFeaturedStickersCtx(context.Background(), hash)
+}
+
+// MessagesGetFeaturedStickersCtx is the context-aware variant of MessagesGetFeaturedStickers.
+func (c *Client) MessagesGetFeaturedStickersCtx(ctx context.Context, hash int64) (MessagesFeaturedStickers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetFeaturedStickersParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetFeaturedStickers: %w", err)
 	}
@@ -10082,7 +12073,12 @@ func (*MessagesGetForumTopicsParams) FlagIndex() int {
 }
 
 func (c *Client) MessagesGetForumTopics(params *MessagesGetForumTopicsParams) (*MessagesForumTopics, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetForumTopicsCtx(context.Background(), params)
+}
+
+// MessagesGetForumTopicsCtx is the context-aware variant of MessagesGetForumTopics.
+func (c *Client) MessagesGetForumTopicsCtx(ctx context.Context, params *MessagesGetForumTopicsParams) (*MessagesForumTopics, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetForumTopics: %w", err)
 	}
@@ -10104,7 +12100,12 @@ func (*MessagesGetForumTopicsByIDParams) CRC() uint32 {
 }
 
 func (c *Client) MessagesGetForumTopicsByID(peer InputPeer, topics []int32) (*MessagesForumTopics, error) {
-	responseData, err := c.MakeRequest(&MessagesGetForumTopicsByIDParams{
+	return c.MessagesGetForumTopicsByIDCtx(context.Background(), peer, topics)
+}
+
+// MessagesGetForumTopicsByIDCtx is the context-aware variant of MessagesGetForumTopicsByID.
+func (c *Client) MessagesGetForumTopicsByIDCtx(ctx context.Context, peer InputPeer, topics []int32) (*MessagesForumTopics, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetForumTopicsByIDParams{
 		Peer:   peer,
 		Topics: topics,
 	})
@@ -10129,7 +12130,12 @@ func (*MessagesGetFullChatParams) CRC() uint32 {
 
 // Get full info about a basic group.
 func (c *Client) MessagesGetFullChat(chatID int64) (*MessagesChatFull, error) {
-	responseData, err := c.MakeRequest(&MessagesGetFullChatParams{ChatID: chatID})
+	return c.MessagesGetFullChatCtx(context.Background(), chatID)
+}
+
+// MessagesGetFullChatCtx is the context-aware variant of MessagesGetFullChat.
+func (c *Client) MessagesGetFullChatCtx(ctx context.Context, chatID int64) (*MessagesChatFull, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetFullChatParams{ChatID: chatID})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetFullChat: %w", err)
 	}
@@ -10153,7 +12159,12 @@ func (*MessagesGetGameHighScoresParams) CRC() uint32 {
 
 // Get highscores of a game
 func (c *Client) MessagesGetGameHighScores(peer InputPeer, id int32, userID InputUser) (*MessagesHighScores, error) {
-	responseData, err := c.MakeRequest(&MessagesGetGameHighScoresParams{
+	return c.MessagesGetGameHighScoresCtx(context.Background(), peer, id, userID)
+}
+
+// MessagesGetGameHighScoresCtx is the context-aware variant of MessagesGetGameHighScores.
+func (c *Client) MessagesGetGameHighScoresCtx(ctx context.Context, peer InputPeer, id int32, userID InputUser) (*MessagesHighScores, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetGameHighScoresParams{
 		ID:     id,
 		Peer:   peer,
 		UserID: userID,
@@ -10186,7 +12197,12 @@ func (*MessagesGetHistoryParams) CRC() uint32 {
 
 // Returns the conversation history with one interlocutor / within a chat
 func (c *Client) MessagesGetHistory(params *MessagesGetHistoryParams) (MessagesMessages, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetHistoryCtx(context.Background(), params)
+}
+
+// MessagesGetHistoryCtx is the context-aware variant of MessagesGetHistory.
+func (c *Client) MessagesGetHistoryCtx(ctx context.Context, params *MessagesGetHistoryParams) (MessagesMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetHistory: %w", err)
 	}
@@ -10216,7 +12232,12 @@ func (*MessagesGetInlineBotResultsParams) FlagIndex() int {
 
 // Query an inline bot
 func (c *Client) MessagesGetInlineBotResults(params *MessagesGetInlineBotResultsParams) (*MessagesBotResults, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetInlineBotResultsCtx(context.Background(), params)
+}
+
+// MessagesGetInlineBotResultsCtx is the context-aware variant of MessagesGetInlineBotResults.
+func (c *Client) MessagesGetInlineBotResultsCtx(ctx context.Context, params *MessagesGetInlineBotResultsParams) (*MessagesBotResults, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetInlineBotResults: %w", err)
 	}
@@ -10239,7 +12260,12 @@ func (*MessagesGetInlineGameHighScoresParams) CRC() uint32 {
 
 // Get highscores of a game sent using an inline bot
 func (c *Client) MessagesGetInlineGameHighScores(id InputBotInlineMessageID, userID InputUser) (*MessagesHighScores, error) {
-	responseData, err := c.MakeRequest(&MessagesGetInlineGameHighScoresParams{
+	return c.MessagesGetInlineGameHighScoresCtx(context.Background(), id, userID)
+}
+
+// MessagesGetInlineGameHighScoresCtx is the context-aware variant of MessagesGetInlineGameHighScores.
+func (c *Client) MessagesGetInlineGameHighScoresCtx(ctx context.Context, id InputBotInlineMessageID, userID InputUser) (*MessagesHighScores, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetInlineGameHighScoresParams{
 		ID:     id,
 		UserID: userID,
 	})
@@ -10264,7 +12290,12 @@ func (*MessagesGetMaskStickersParams) CRC() uint32 {
 
 // Get installed mask stickers
 func (c *Client) MessagesGetMaskStickers(hash int64) (MessagesAllStickers, error) {
-	responseData, err := c.MakeRequest(&MessagesGetMaskStickersParams{Hash: hash})
+	return c.MessagesGetMaskStickersCtx(context.Background(), hash)
+}
+
+// MessagesGetMaskStickersCtx is the context-aware variant of MessagesGetMaskStickers.
+func (c *Client) MessagesGetMaskStickersCtx(ctx context.Context, hash int64) (MessagesAllStickers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetMaskStickersParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetMaskStickers: %w", err)
 	}
@@ -10287,7 +12318,12 @@ func (*MessagesGetMessageEditDataParams) CRC() uint32 {
 
 // Find out if a media message's caption can be edited
 func (c *Client) MessagesGetMessageEditData(peer InputPeer, id int32) (*MessagesMessageEditData, error) {
-	responseData, err := c.MakeRequest(&MessagesGetMessageEditDataParams{
+	return c.MessagesGetMessageEditDataCtx(context.Background(), peer, id)
+}
+
+// MessagesGetMessageEditDataCtx is the context-aware variant of MessagesGetMessageEditData.
+func (c *Client) MessagesGetMessageEditDataCtx(ctx context.Context, peer InputPeer, id int32) (*MessagesMessageEditData, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetMessageEditDataParams{
 		ID:   id,
 		Peer: peer,
 	})
@@ -10320,7 +12356,12 @@ func (*MessagesGetMessageReactionsListParams) FlagIndex() int {
 
 // Get message reaction list, along with the sender of each reaction.
 func (c *Client) MessagesGetMessageReactionsList(params *MessagesGetMessageReactionsListParams) (*MessagesMessageReactionsList, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetMessageReactionsListCtx(context.Background(), params)
+}
+
+// MessagesGetMessageReactionsListCtx is the context-aware variant of MessagesGetMessageReactionsList.
+func (c *Client) MessagesGetMessageReactionsListCtx(ctx context.Context, params *MessagesGetMessageReactionsListParams) (*MessagesMessageReactionsList, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetMessageReactionsList: %w", err)
 	}
@@ -10343,7 +12384,12 @@ func (*MessagesGetMessageReadParticipantsParams) CRC() uint32 {
 
 // Get which users read a specific message: only available for groups and supergroups with less than `chat_read_mark_size_threshold` members, read receipts will be stored for `chat_read_mark_expire_period` seconds after the message was sent, see client configuration for more info.
 func (c *Client) MessagesGetMessageReadParticipants(peer InputPeer, msgID int32) ([]*ReadParticipantDate, error) {
-	responseData, err := c.MakeRequest(&MessagesGetMessageReadParticipantsParams{
+	return c.MessagesGetMessageReadParticipantsCtx(context.Background(), peer, msgID)
+}
+
+// MessagesGetMessageReadParticipantsCtx is the context-aware variant of MessagesGetMessageReadParticipants.
+func (c *Client) MessagesGetMessageReadParticipantsCtx(ctx context.Context, peer InputPeer, msgID int32) ([]*ReadParticipantDate, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetMessageReadParticipantsParams{
 		MsgID: msgID,
 		Peer:  peer,
 	})
@@ -10368,7 +12414,12 @@ func (*MessagesGetMessagesParams) CRC() uint32 {
 
 // Returns the list of messages by their IDs.
 func (c *Client) MessagesGetMessages(id []InputMessage) (MessagesMessages, error) {
-	responseData, err := c.MakeRequest(&MessagesGetMessagesParams{ID: id})
+	return c.MessagesGetMessagesCtx(context.Background(), id)
+}
+
+// MessagesGetMessagesCtx is the context-aware variant of MessagesGetMessages.
+func (c *Client) MessagesGetMessagesCtx(ctx context.Context, id []InputMessage) (MessagesMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetMessagesParams{ID: id})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetMessages: %w", err)
 	}
@@ -10391,7 +12442,12 @@ func (*MessagesGetMessagesReactionsParams) CRC() uint32 {
 
 // Get message reactions
 func (c *Client) MessagesGetMessagesReactions(peer InputPeer, id []int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesGetMessagesReactionsParams{
+	return c.MessagesGetMessagesReactionsCtx(context.Background(), peer, id)
+}
+
+// MessagesGetMessagesReactionsCtx is the context-aware variant of MessagesGetMessagesReactions.
+func (c *Client) MessagesGetMessagesReactionsCtx(ctx context.Context, peer InputPeer, id []int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetMessagesReactionsParams{
 		ID:   id,
 		Peer: peer,
 	})
@@ -10418,7 +12474,12 @@ func (*MessagesGetMessagesViewsParams) CRC() uint32 {
 
 // Get and increase the view counter of a message sent or forwarded from a channel
 func (c *Client) MessagesGetMessagesViews(peer InputPeer, id []int32, increment bool) (*MessagesMessageViews, error) {
-	responseData, err := c.MakeRequest(&MessagesGetMessagesViewsParams{
+	return c.MessagesGetMessagesViewsCtx(context.Background(), peer, id, increment)
+}
+
+// MessagesGetMessagesViewsCtx is the context-aware variant of MessagesGetMessagesViews.
+func (c *Client) MessagesGetMessagesViewsCtx(ctx context.Context, peer InputPeer, id []int32, increment bool) (*MessagesMessageViews, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetMessagesViewsParams{
 		ID:        id,
 		Increment: increment,
 		Peer:      peer,
@@ -10445,7 +12506,12 @@ func (*MessagesGetMyStickersParams) CRC() uint32 {
 
 // Fetch all stickersets owned by the current user.
 func (c *Client) MessagesGetMyStickers(offsetID int64, limit int32) (*MessagesMyStickers, error) {
-	responseData, err := c.MakeRequest(&MessagesGetMyStickersParams{
+	return c.MessagesGetMyStickersCtx(context.Background(), offsetID, limit)
+}
+
+// MessagesGetMyStickersCtx is the context-aware variant of MessagesGetMyStickers.
+func (c *Client) MessagesGetMyStickersCtx(ctx context.Context, offsetID int64, limit int32) (*MessagesMyStickers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetMyStickersParams{
 		Limit:    limit,
 		OffsetID: offsetID,
 	})
@@ -10472,7 +12538,12 @@ func (*MessagesGetOldFeaturedStickersParams) CRC() uint32 {
 
 // Method for fetching previously featured stickers
 func (c *Client) MessagesGetOldFeaturedStickers(offset, limit int32, hash int64) (MessagesFeaturedStickers, error) {
-	responseData, err := c.MakeRequest(&MessagesGetOldFeaturedStickersParams{
+	return c.MessagesGetOldFeaturedStickersCtx(context.Background(), offset, limit, hash)
+}
+
+// MessagesGetOldFeaturedStickersCtx is the context-aware variant of MessagesGetOldFeaturedStickers.
+func (c *Client) MessagesGetOldFeaturedStickersCtx(ctx context.Context, offset, limit int32, hash int64) (MessagesFeaturedStickers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetOldFeaturedStickersParams{
 		Hash:   hash,
 		Limit:  limit,
 		Offset: offset,
@@ -10498,7 +12569,12 @@ func (*MessagesGetOnlinesParams) CRC() uint32 {
 
 // Get count of online users in a chat
 func (c *Client) MessagesGetOnlines(peer InputPeer) (*ChatOnlines, error) {
-	responseData, err := c.MakeRequest(&MessagesGetOnlinesParams{Peer: peer})
+	return c.MessagesGetOnlinesCtx(context.Background(), peer)
+}
+
+// MessagesGetOnlinesCtx is the context-aware variant of MessagesGetOnlines.
+func (c *Client) MessagesGetOnlinesCtx(ctx context.Context, peer InputPeer) (*ChatOnlines, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetOnlinesParams{Peer: peer})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetOnlines: %w", err)
 	}
@@ -10521,7 +12597,12 @@ func (*MessagesGetOutboxReadDateParams) CRC() uint32 {
 
 // Get the exact read date of one of our messages, sent to a private chat with another user.
 func (c *Client) MessagesGetOutboxReadDate(peer InputPeer, msgID int32) (*OutboxReadDate, error) {
-	responseData, err := c.MakeRequest(&MessagesGetOutboxReadDateParams{
+	return c.MessagesGetOutboxReadDateCtx(context.Background(), peer, msgID)
+}
+
+// MessagesGetOutboxReadDateCtx is the context-aware variant of MessagesGetOutboxReadDate.
+func (c *Client) MessagesGetOutboxReadDateCtx(ctx context.Context, peer InputPeer, msgID int32) (*OutboxReadDate, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetOutboxReadDateParams{
 		MsgID: msgID,
 		Peer:  peer,
 	})
@@ -10544,7 +12625,12 @@ func (*MessagesGetPaidReactionPrivacyParams) CRC() uint32 {
 
 // Fetches an updatePaidReactionPrivacy update with the current default paid reaction privacy
 func (c *Client) MessagesGetPaidReactionPrivacy() (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesGetPaidReactionPrivacyParams{})
+	return c.MessagesGetPaidReactionPrivacyCtx(context.Background())
+}
+
+// MessagesGetPaidReactionPrivacyCtx is the context-aware variant of MessagesGetPaidReactionPrivacy.
+func (c *Client) MessagesGetPaidReactionPrivacyCtx(ctx context.Context) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetPaidReactionPrivacyParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetPaidReactionPrivacy: %w", err)
 	}
@@ -10566,7 +12652,12 @@ func (*MessagesGetPeerDialogsParams) CRC() uint32 {
 
 // Get dialog info of specified peers
 func (c *Client) MessagesGetPeerDialogs(peers []InputDialogPeer) (*MessagesPeerDialogs, error) {
-	responseData, err := c.MakeRequest(&MessagesGetPeerDialogsParams{Peers: peers})
+	return c.MessagesGetPeerDialogsCtx(context.Background(), peers)
+}
+
+// MessagesGetPeerDialogsCtx is the context-aware variant of MessagesGetPeerDialogs.
+func (c *Client) MessagesGetPeerDialogsCtx(ctx context.Context, peers []InputDialogPeer) (*MessagesPeerDialogs, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetPeerDialogsParams{Peers: peers})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetPeerDialogs: %w", err)
 	}
@@ -10588,7 +12679,12 @@ func (*MessagesGetPeerSettingsParams) CRC() uint32 {
 
 // Get peer settings
 func (c *Client) MessagesGetPeerSettings(peer InputPeer) (*MessagesPeerSettings, error) {
-	responseData, err := c.MakeRequest(&MessagesGetPeerSettingsParams{Peer: peer})
+	return c.MessagesGetPeerSettingsCtx(context.Background(), peer)
+}
+
+// MessagesGetPeerSettingsCtx is the context-aware variant of MessagesGetPeerSettings.
+func (c *Client) MessagesGetPeerSettingsCtx(ctx context.Context, peer InputPeer) (*MessagesPeerSettings, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetPeerSettingsParams{Peer: peer})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetPeerSettings: %w", err)
 	}
@@ -10610,7 +12706,12 @@ func (*MessagesGetPinnedDialogsParams) CRC() uint32 {
 
 // Get pinned dialogs
 func (c *Client) MessagesGetPinnedDialogs(folderID int32) (*MessagesPeerDialogs, error) {
-	responseData, err := c.MakeRequest(&MessagesGetPinnedDialogsParams{FolderID: folderID})
+	return c.MessagesGetPinnedDialogsCtx(context.Background(), folderID)
+}
+
+// MessagesGetPinnedDialogsCtx is the context-aware variant of MessagesGetPinnedDialogs.
+func (c *Client) MessagesGetPinnedDialogsCtx(ctx context.Context, folderID int32) (*MessagesPeerDialogs, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetPinnedDialogsParams{FolderID: folderID})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetPinnedDialogs: %w", err)
 	}
@@ -10630,7 +12731,12 @@ func (*MessagesGetPinnedSavedDialogsParams) CRC() uint32 {
 
 // Get pinned saved dialogs
 func (c *Client) MessagesGetPinnedSavedDialogs() (MessagesSavedDialogs, error) {
-	responseData, err := c.MakeRequest(&MessagesGetPinnedSavedDialogsParams{})
+	return c.MessagesGetPinnedSavedDialogsCtx(context.Background())
+}
+
+// MessagesGetPinnedSavedDialogsCtx is the context-aware variant of MessagesGetPinnedSavedDialogs.
+func (c *Client) MessagesGetPinnedSavedDialogsCtx(ctx context.Context) (MessagesSavedDialogs, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetPinnedSavedDialogsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetPinnedSavedDialogs: %w", err)
 	}
@@ -10653,7 +12759,12 @@ func (*MessagesGetPollResultsParams) CRC() uint32 {
 
 // Get poll results
 func (c *Client) MessagesGetPollResults(peer InputPeer, msgID int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesGetPollResultsParams{
+	return c.MessagesGetPollResultsCtx(context.Background(), peer, msgID)
+}
+
+// MessagesGetPollResultsCtx is the context-aware variant of MessagesGetPollResults.
+func (c *Client) MessagesGetPollResultsCtx(ctx context.Context, peer InputPeer, msgID int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetPollResultsParams{
 		MsgID: msgID,
 		Peer:  peer,
 	})
@@ -10686,7 +12797,12 @@ func (*MessagesGetPollVotesParams) FlagIndex() int {
 
 // Get poll results for non-anonymous polls
 func (c *Client) MessagesGetPollVotes(params *MessagesGetPollVotesParams) (*MessagesVotesList, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetPollVotesCtx(context.Background(), params)
+}
+
+// MessagesGetPollVotesCtx is the context-aware variant of MessagesGetPollVotes.
+func (c *Client) MessagesGetPollVotesCtx(ctx context.Context, params *MessagesGetPollVotesParams) (*MessagesVotesList, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetPollVotes: %w", err)
 	}
@@ -10709,7 +12825,12 @@ func (*MessagesGetPreparedInlineMessageParams) CRC() uint32 {
 
 // Obtain a prepared inline message generated by a mini app: invoked when handling web_app_send_prepared_message events
 func (c *Client) MessagesGetPreparedInlineMessage(bot InputUser, id string) (*MessagesPreparedInlineMessage, error) {
-	responseData, err := c.MakeRequest(&MessagesGetPreparedInlineMessageParams{
+	return c.MessagesGetPreparedInlineMessageCtx(context.Background(), bot, id)
+}
+
+// MessagesGetPreparedInlineMessageCtx is the context-aware variant of MessagesGetPreparedInlineMessage.
+func (c *Client) MessagesGetPreparedInlineMessageCtx(ctx context.Context, bot InputUser, id string) (*MessagesPreparedInlineMessage, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetPreparedInlineMessageParams{
 		Bot: bot,
 		ID:  id,
 	})
@@ -10734,7 +12855,12 @@ func (*MessagesGetQuickRepliesParams) CRC() uint32 {
 
 // Fetch basic info about all existing quick reply shortcuts.
 func (c *Client) MessagesGetQuickReplies(hash int64) (MessagesQuickReplies, error) {
-	responseData, err := c.MakeRequest(&MessagesGetQuickRepliesParams{Hash: hash})
+	return c.MessagesGetQuickRepliesCtx(context.Background(), hash)
+}
+
+// MessagesGetQuickRepliesCtx is the context-aware variant of MessagesGetQuickReplies.
+func (c *Client) MessagesGetQuickRepliesCtx(ctx context.Context, hash int64) (MessagesQuickReplies, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetQuickRepliesParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetQuickReplies: %w", err)
 	}
@@ -10762,7 +12888,12 @@ func (*MessagesGetQuickReplyMessagesParams) FlagIndex() int {
 
 // Fetch (a subset or all) messages in a quick reply shortcut.
 func (c *Client) MessagesGetQuickReplyMessages(shortcutID int32, id []int32, hash int64) (MessagesMessages, error) {
-	responseData, err := c.MakeRequest(&MessagesGetQuickReplyMessagesParams{
+	return c.MessagesGetQuickReplyMessagesCtx(context.Background(), shortcutID, id, hash)
+}
+
+// MessagesGetQuickReplyMessagesCtx is the context-aware variant of MessagesGetQuickReplyMessages.
+func (c *Client) MessagesGetQuickReplyMessagesCtx(ctx context.Context, shortcutID int32, id []int32, hash int64) (MessagesMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetQuickReplyMessagesParams{
 		Hash:       hash,
 		ID:         id,
 		ShortcutID: shortcutID,
@@ -10790,7 +12921,12 @@ func (*MessagesGetRecentLocationsParams) CRC() uint32 {
 
 // Get live location history of a certain user
 func (c *Client) MessagesGetRecentLocations(peer InputPeer, limit int32, hash int64) (MessagesMessages, error) {
-	responseData, err := c.MakeRequest(&MessagesGetRecentLocationsParams{
+	return c.MessagesGetRecentLocationsCtx(context.Background(), peer, limit, hash)
+}
+
+// MessagesGetRecentLocationsCtx is the context-aware variant of MessagesGetRecentLocations.
+func (c *Client) MessagesGetRecentLocationsCtx(ctx context.Context, peer InputPeer, limit int32, hash int64) (MessagesMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetRecentLocationsParams{
 		Hash:  hash,
 		Limit: limit,
 		Peer:  peer,
@@ -10817,7 +12953,12 @@ func (*MessagesGetRecentReactionsParams) CRC() uint32 {
 
 // Get recently used message reactions
 func (c *Client) MessagesGetRecentReactions(limit int32, hash int64) (MessagesReactions, error) {
-	responseData, err := c.MakeRequest(&MessagesGetRecentReactionsParams{
+	return c.MessagesGetRecentReactionsCtx(context.Background(), limit, hash)
+}
+
+// MessagesGetRecentReactionsCtx is the context-aware variant of MessagesGetRecentReactions.
+func (c *Client) MessagesGetRecentReactionsCtx(ctx context.Context, limit int32, hash int64) (MessagesReactions, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetRecentReactionsParams{
 		Hash:  hash,
 		Limit: limit,
 	})
@@ -10847,7 +12988,12 @@ func (*MessagesGetRecentStickersParams) FlagIndex() int {
 
 // Get recent stickers
 func (c *Client) MessagesGetRecentStickers(attached bool, hash int64) (MessagesRecentStickers, error) {
-	responseData, err := c.MakeRequest(&MessagesGetRecentStickersParams{
+	return c.MessagesGetRecentStickersCtx(context.Background(), attached, hash)
+}
+
+// MessagesGetRecentStickersCtx is the context-aware variant of MessagesGetRecentStickers.
+func (c *Client) MessagesGetRecentStickersCtx(ctx context.Context, attached bool, hash int64) (MessagesRecentStickers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetRecentStickersParams{
 		Attached: attached,
 		Hash:     hash,
 	})
@@ -10880,7 +13026,12 @@ func (*MessagesGetRepliesParams) CRC() uint32 {
 
 // Get messages in a reply thread
 func (c *Client) MessagesGetReplies(params *MessagesGetRepliesParams) (MessagesMessages, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetRepliesCtx(context.Background(), params)
+}
+
+// MessagesGetRepliesCtx is the context-aware variant of MessagesGetReplies.
+func (c *Client) MessagesGetRepliesCtx(ctx context.Context, params *MessagesGetRepliesParams) (MessagesMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetReplies: %w", err)
 	}
@@ -10912,7 +13063,12 @@ func (*MessagesGetSavedDialogsParams) FlagIndex() int {
 
 // Returns the current saved dialog list or monoforum topic list.
 func (c *Client) MessagesGetSavedDialogs(params *MessagesGetSavedDialogsParams) (MessagesSavedDialogs, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetSavedDialogsCtx(context.Background(), params)
+}
+
+// MessagesGetSavedDialogsCtx is the context-aware variant of MessagesGetSavedDialogs.
+func (c *Client) MessagesGetSavedDialogsCtx(ctx context.Context, params *MessagesGetSavedDialogsParams) (MessagesSavedDialogs, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetSavedDialogs: %w", err)
 	}
@@ -10939,7 +13095,12 @@ func (*MessagesGetSavedDialogsByIDParams) FlagIndex() int {
 
 // Obtain information about specific saved message dialogs or monoforum topics.
 func (c *Client) MessagesGetSavedDialogsByID(parentPeer InputPeer, ids []InputPeer) (MessagesSavedDialogs, error) {
-	responseData, err := c.MakeRequest(&MessagesGetSavedDialogsByIDParams{
+	return c.MessagesGetSavedDialogsByIDCtx(context.Background(), parentPeer, ids)
+}
+
+// MessagesGetSavedDialogsByIDCtx is the context-aware variant of MessagesGetSavedDialogsByID.
+func (c *Client) MessagesGetSavedDialogsByIDCtx(ctx context.Context, parentPeer InputPeer, ids []InputPeer) (MessagesSavedDialogs, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetSavedDialogsByIDParams{
 		Ids:        ids,
 		ParentPeer: parentPeer,
 	})
@@ -10964,7 +13125,12 @@ func (*MessagesGetSavedGifsParams) CRC() uint32 {
 
 // Get saved GIFs.
 func (c *Client) MessagesGetSavedGifs(hash int64) (MessagesSavedGifs, error) {
-	responseData, err := c.MakeRequest(&MessagesGetSavedGifsParams{Hash: hash})
+	return c.MessagesGetSavedGifsCtx(context.Background(), hash)
+}
+
+// MessagesGetSavedGifsCtx is the context-aware variant of MessagesGetSavedGifs.
+func (c *Client) MessagesGetSavedGifsCtx(ctx context.Context, hash int64) (MessagesSavedGifs, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetSavedGifsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetSavedGifs: %w", err)
 	}
@@ -10998,7 +13164,12 @@ func (*MessagesGetSavedHistoryParams) FlagIndex() int {
 
 // Fetch saved messages forwarded from a specific peer, or fetch messages from a monoforum topic.
 func (c *Client) MessagesGetSavedHistory(params *MessagesGetSavedHistoryParams) (MessagesMessages, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetSavedHistoryCtx(context.Background(), params)
+}
+
+// MessagesGetSavedHistoryCtx is the context-aware variant of MessagesGetSavedHistory.
+func (c *Client) MessagesGetSavedHistoryCtx(ctx context.Context, params *MessagesGetSavedHistoryParams) (MessagesMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetSavedHistory: %w", err)
 	}
@@ -11025,7 +13196,12 @@ func (*MessagesGetSavedReactionTagsParams) FlagIndex() int {
 
 // Fetch the full list of saved message tags created by the user.
 func (c *Client) MessagesGetSavedReactionTags(peer InputPeer, hash int64) (MessagesSavedReactionTags, error) {
-	responseData, err := c.MakeRequest(&MessagesGetSavedReactionTagsParams{
+	return c.MessagesGetSavedReactionTagsCtx(context.Background(), peer, hash)
+}
+
+// MessagesGetSavedReactionTagsCtx is the context-aware variant of MessagesGetSavedReactionTags.
+func (c *Client) MessagesGetSavedReactionTagsCtx(ctx context.Context, peer InputPeer, hash int64) (MessagesSavedReactionTags, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetSavedReactionTagsParams{
 		Hash: hash,
 		Peer: peer,
 	})
@@ -11051,7 +13227,12 @@ func (*MessagesGetScheduledHistoryParams) CRC() uint32 {
 
 // Get scheduled messages
 func (c *Client) MessagesGetScheduledHistory(peer InputPeer, hash int64) (MessagesMessages, error) {
-	responseData, err := c.MakeRequest(&MessagesGetScheduledHistoryParams{
+	return c.MessagesGetScheduledHistoryCtx(context.Background(), peer, hash)
+}
+
+// MessagesGetScheduledHistoryCtx is the context-aware variant of MessagesGetScheduledHistory.
+func (c *Client) MessagesGetScheduledHistoryCtx(ctx context.Context, peer InputPeer, hash int64) (MessagesMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetScheduledHistoryParams{
 		Hash: hash,
 		Peer: peer,
 	})
@@ -11077,7 +13258,12 @@ func (*MessagesGetScheduledMessagesParams) CRC() uint32 {
 
 // Get scheduled messages
 func (c *Client) MessagesGetScheduledMessages(peer InputPeer, id []int32) (MessagesMessages, error) {
-	responseData, err := c.MakeRequest(&MessagesGetScheduledMessagesParams{
+	return c.MessagesGetScheduledMessagesCtx(context.Background(), peer, id)
+}
+
+// MessagesGetScheduledMessagesCtx is the context-aware variant of MessagesGetScheduledMessages.
+func (c *Client) MessagesGetScheduledMessagesCtx(ctx context.Context, peer InputPeer, id []int32) (MessagesMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetScheduledMessagesParams{
 		ID:   id,
 		Peer: peer,
 	})
@@ -11109,7 +13295,12 @@ func (*MessagesGetSearchCountersParams) FlagIndex() int {
 
 // Get the number of results that would be found by a messages.search call with the same parameters
 func (c *Client) MessagesGetSearchCounters(peer, savedPeerID InputPeer, topMsgID int32, filters []MessagesFilter) ([]*MessagesSearchCounter, error) {
-	responseData, err := c.MakeRequest(&MessagesGetSearchCountersParams{
+	return c.MessagesGetSearchCountersCtx(context.Background(), peer, savedPeerID, topMsgID, filters)
+}
+
+// MessagesGetSearchCountersCtx is the context-aware variant of MessagesGetSearchCounters.
+func (c *Client) MessagesGetSearchCountersCtx(ctx context.Context, peer, savedPeerID InputPeer, topMsgID int32, filters []MessagesFilter) ([]*MessagesSearchCounter, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetSearchCountersParams{
 		Filters:     filters,
 		Peer:        peer,
 		SavedPeerID: savedPeerID,
@@ -11144,7 +13335,12 @@ func (*MessagesGetSearchResultsCalendarParams) FlagIndex() int {
 
 // Returns information about the next messages of the specified type in the chat split by days.
 func (c *Client) MessagesGetSearchResultsCalendar(params *MessagesGetSearchResultsCalendarParams) (*MessagesSearchResultsCalendar, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetSearchResultsCalendarCtx(context.Background(), params)
+}
+
+// MessagesGetSearchResultsCalendarCtx is the context-aware variant of MessagesGetSearchResultsCalendar.
+func (c *Client) MessagesGetSearchResultsCalendarCtx(ctx context.Context, params *MessagesGetSearchResultsCalendarParams) (*MessagesSearchResultsCalendar, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetSearchResultsCalendar: %w", err)
 	}
@@ -11174,7 +13370,12 @@ func (*MessagesGetSearchResultsPositionsParams) FlagIndex() int {
 
 // Returns sparse positions of messages of the specified type in the chat to be used for shared media scroll implementation.
 func (c *Client) MessagesGetSearchResultsPositions(params *MessagesGetSearchResultsPositionsParams) (*MessagesSearchResultsPositions, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetSearchResultsPositionsCtx(context.Background(), params)
+}
+
+// MessagesGetSearchResultsPositionsCtx is the context-aware variant of MessagesGetSearchResultsPositions.
+func (c *Client) MessagesGetSearchResultsPositionsCtx(ctx context.Context, params *MessagesGetSearchResultsPositionsParams) (*MessagesSearchResultsPositions, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetSearchResultsPositions: %w", err)
 	}
@@ -11194,7 +13395,12 @@ func (*MessagesGetSplitRangesParams) CRC() uint32 {
 
 // Get message ranges for saving the user's chat history
 func (c *Client) MessagesGetSplitRanges() ([]*MessageRange, error) {
-	responseData, err := c.MakeRequest(&MessagesGetSplitRangesParams{})
+	return c.MessagesGetSplitRangesCtx(context.Background())
+}
+
+// MessagesGetSplitRangesCtx is the context-aware variant of MessagesGetSplitRanges.
+func (c *Client) MessagesGetSplitRangesCtx(ctx context.Context) ([]*MessageRange, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetSplitRangesParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetSplitRanges: %w", err)
 	}
@@ -11221,7 +13427,12 @@ func (*MessagesGetSponsoredMessagesParams) FlagIndex() int {
 
 // Get a list of sponsored messages for a peer
 func (c *Client) MessagesGetSponsoredMessages(peer InputPeer, msgID int32) (MessagesSponsoredMessages, error) {
-	responseData, err := c.MakeRequest(&MessagesGetSponsoredMessagesParams{
+	return c.MessagesGetSponsoredMessagesCtx(context.Background(), peer, msgID)
+}
+
+// MessagesGetSponsoredMessagesCtx is the context-aware variant of MessagesGetSponsoredMessages.
+func (c *Client) MessagesGetSponsoredMessagesCtx(ctx context.Context, peer InputPeer, msgID int32) (MessagesSponsoredMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetSponsoredMessagesParams{
 		MsgID: msgID,
 		Peer:  peer,
 	})
@@ -11252,7 +13463,12 @@ func (*MessagesGetStatsURLParams) FlagIndex() int {
 
 // Returns URL with the chat statistics. Currently this method can be used only for channels
 func (c *Client) MessagesGetStatsURL(dark bool, peer InputPeer, params string) (*StatsURL, error) {
-	responseData, err := c.MakeRequest(&MessagesGetStatsURLParams{
+	return c.MessagesGetStatsURLCtx(context.Background(), dark, peer, params)
+}
+
+// MessagesGetStatsURLCtx is the context-aware variant of MessagesGetStatsURL.
+func (c *Client) MessagesGetStatsURLCtx(ctx context.Context, dark bool, peer InputPeer, params string) (*StatsURL, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetStatsURLParams{
 		Dark:   dark,
 		Params: params,
 		Peer:   peer,
@@ -11279,7 +13495,12 @@ func (*MessagesGetStickerSetParams) CRC() uint32 {
 
 // Get info about a stickerset
 func (c *Client) MessagesGetStickerSet(stickerset InputStickerSet, hash int32) (MessagesStickerSet, error) {
-	responseData, err := c.MakeRequest(&MessagesGetStickerSetParams{
+	return c.MessagesGetStickerSetCtx(context.Background(), stickerset, hash)
+}
+
+// MessagesGetStickerSetCtx is the context-aware variant of MessagesGetStickerSet.
+func (c *Client) MessagesGetStickerSetCtx(ctx context.Context, stickerset InputStickerSet, hash int32) (MessagesStickerSet, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetStickerSetParams{
 		Hash:       hash,
 		Stickerset: stickerset,
 	})
@@ -11305,7 +13526,12 @@ func (*MessagesGetStickersParams) CRC() uint32 {
 
 // Get stickers by emoji
 func (c *Client) MessagesGetStickers(emoticon string, hash int64) (MessagesStickers, error) {
-	responseData, err := c.MakeRequest(&MessagesGetStickersParams{
+	return c.MessagesGetStickersCtx(context.Background(), emoticon, hash)
+}
+
+// MessagesGetStickersCtx is the context-aware variant of MessagesGetStickers.
+func (c *Client) MessagesGetStickersCtx(ctx context.Context, emoticon string, hash int64) (MessagesStickers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetStickersParams{
 		Emoticon: emoticon,
 		Hash:     hash,
 	})
@@ -11328,7 +13554,12 @@ func (*MessagesGetSuggestedDialogFiltersParams) CRC() uint32 {
 
 // Get suggested folders
 func (c *Client) MessagesGetSuggestedDialogFilters() ([]*DialogFilterSuggested, error) {
-	responseData, err := c.MakeRequest(&MessagesGetSuggestedDialogFiltersParams{})
+	return c.MessagesGetSuggestedDialogFiltersCtx(context.Background())
+}
+
+// MessagesGetSuggestedDialogFiltersCtx is the context-aware variant of MessagesGetSuggestedDialogFilters.
+func (c *Client) MessagesGetSuggestedDialogFiltersCtx(ctx context.Context) ([]*DialogFilterSuggested, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetSuggestedDialogFiltersParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetSuggestedDialogFilters: %w", err)
 	}
@@ -11351,7 +13582,12 @@ func (*MessagesGetTopReactionsParams) CRC() uint32 {
 
 // Got popular message reactions
 func (c *Client) MessagesGetTopReactions(limit int32, hash int64) (MessagesReactions, error) {
-	responseData, err := c.MakeRequest(&MessagesGetTopReactionsParams{
+	return c.MessagesGetTopReactionsCtx(context.Background(), limit, hash)
+}
+
+// MessagesGetTopReactionsCtx is the context-aware variant of MessagesGetTopReactions.
+func (c *Client) MessagesGetTopReactionsCtx(ctx context.Context, limit int32, hash int64) (MessagesReactions, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetTopReactionsParams{
 		Hash:  hash,
 		Limit: limit,
 	})
@@ -11386,7 +13622,12 @@ func (*MessagesGetUnreadMentionsParams) FlagIndex() int {
 
 // Get unread messages where we were mentioned
 func (c *Client) MessagesGetUnreadMentions(params *MessagesGetUnreadMentionsParams) (MessagesMessages, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetUnreadMentionsCtx(context.Background(), params)
+}
+
+// MessagesGetUnreadMentionsCtx is the context-aware variant of MessagesGetUnreadMentions.
+func (c *Client) MessagesGetUnreadMentionsCtx(ctx context.Context, params *MessagesGetUnreadMentionsParams) (MessagesMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetUnreadMentions: %w", err)
 	}
@@ -11419,7 +13660,12 @@ func (*MessagesGetUnreadReactionsParams) FlagIndex() int {
 
 // Get unread reactions to messages you sent
 func (c *Client) MessagesGetUnreadReactions(params *MessagesGetUnreadReactionsParams) (MessagesMessages, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesGetUnreadReactionsCtx(context.Background(), params)
+}
+
+// MessagesGetUnreadReactionsCtx is the context-aware variant of MessagesGetUnreadReactions.
+func (c *Client) MessagesGetUnreadReactionsCtx(ctx context.Context, params *MessagesGetUnreadReactionsParams) (MessagesMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesGetUnreadReactions: %w", err)
 	}
@@ -11442,7 +13688,12 @@ func (*MessagesGetWebPageParams) CRC() uint32 {
 
 // Get instant view page
 func (c *Client) MessagesGetWebPage(url string, hash int32) (*MessagesWebPage, error) {
-	responseData, err := c.MakeRequest(&MessagesGetWebPageParams{
+	return c.MessagesGetWebPageCtx(context.Background(), url, hash)
+}
+
+// MessagesGetWebPageCtx is the context-aware variant of MessagesGetWebPage.
+func (c *Client) MessagesGetWebPageCtx(ctx context.Context, url string, hash int32) (*MessagesWebPage, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetWebPageParams{
 		Hash: hash,
 		URL:  url,
 	})
@@ -11472,7 +13723,12 @@ func (*MessagesGetWebPagePreviewParams) FlagIndex() int {
 
 // Get preview of webpage
 func (c *Client) MessagesGetWebPagePreview(message string, entities []MessageEntity) (*MessagesWebPagePreview, error) {
-	responseData, err := c.MakeRequest(&MessagesGetWebPagePreviewParams{
+	return c.MessagesGetWebPagePreviewCtx(context.Background(), message, entities)
+}
+
+// MessagesGetWebPagePreviewCtx is the context-aware variant of MessagesGetWebPagePreview.
+func (c *Client) MessagesGetWebPagePreviewCtx(ctx context.Context, message string, entities []MessageEntity) (*MessagesWebPagePreview, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetWebPagePreviewParams{
 		Entities: entities,
 		Message:  message,
 	})
@@ -11498,7 +13754,12 @@ func (*MessagesGetWebViewResultParams) CRC() uint32 {
 }
 
 func (c *Client) MessagesGetWebViewResult(peer InputPeer, bot InputUser, queryID int64) (*MessagesWebViewResult, error) {
-	responseData, err := c.MakeRequest(&MessagesGetWebViewResultParams{
+	return c.MessagesGetWebViewResultCtx(context.Background(), peer, bot, queryID)
+}
+
+// MessagesGetWebViewResultCtx is the context-aware variant of MessagesGetWebViewResult.
+func (c *Client) MessagesGetWebViewResultCtx(ctx context.Context, peer InputPeer, bot InputUser, queryID int64) (*MessagesWebViewResult, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesGetWebViewResultParams{
 		Bot:     bot,
 		Peer:    peer,
 		QueryID: queryID,
@@ -11530,7 +13791,12 @@ func (*MessagesHideAllChatJoinRequestsParams) FlagIndex() int {
 
 // Dismiss or approve all join requests related to a specific chat or channel.
 func (c *Client) MessagesHideAllChatJoinRequests(approved bool, peer InputPeer, link string) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesHideAllChatJoinRequestsParams{
+	return c.MessagesHideAllChatJoinRequestsCtx(context.Background(), approved, peer, link)
+}
+
+// MessagesHideAllChatJoinRequestsCtx is the context-aware variant of MessagesHideAllChatJoinRequests.
+func (c *Client) MessagesHideAllChatJoinRequestsCtx(ctx context.Context, approved bool, peer InputPeer, link string) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesHideAllChatJoinRequestsParams{
 		Approved: approved,
 		Link:     link,
 		Peer:     peer,
@@ -11562,7 +13828,12 @@ func (*MessagesHideChatJoinRequestParams) FlagIndex() int {
 
 // Dismiss or approve a chat join request related to a specific chat or channel.
 func (c *Client) MessagesHideChatJoinRequest(approved bool, peer InputPeer, userID InputUser) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesHideChatJoinRequestParams{
+	return c.MessagesHideChatJoinRequestCtx(context.Background(), approved, peer, userID)
+}
+
+// MessagesHideChatJoinRequestCtx is the context-aware variant of MessagesHideChatJoinRequest.
+func (c *Client) MessagesHideChatJoinRequestCtx(ctx context.Context, approved bool, peer InputPeer, userID InputUser) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesHideChatJoinRequestParams{
 		Approved: approved,
 		Peer:     peer,
 		UserID:   userID,
@@ -11588,7 +13859,12 @@ func (*MessagesHidePeerSettingsBarParams) CRC() uint32 {
 
 // Should be called after the user hides the report spam/add as contact bar of a new chat, effectively prevents the user from executing the actions specified in the action bar.
 func (c *Client) MessagesHidePeerSettingsBar(peer InputPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesHidePeerSettingsBarParams{Peer: peer})
+	return c.MessagesHidePeerSettingsBarCtx(context.Background(), peer)
+}
+
+// MessagesHidePeerSettingsBarCtx is the context-aware variant of MessagesHidePeerSettingsBar.
+func (c *Client) MessagesHidePeerSettingsBarCtx(ctx context.Context, peer InputPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesHidePeerSettingsBarParams{Peer: peer})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesHidePeerSettingsBar: %w", err)
 	}
@@ -11610,7 +13886,12 @@ func (*MessagesImportChatInviteParams) CRC() uint32 {
 
 // Import a chat invite and join a private chat/supergroup/channel
 func (c *Client) MessagesImportChatInvite(hash string) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesImportChatInviteParams{Hash: hash})
+	return c.MessagesImportChatInviteCtx(context.Background(), hash)
+}
+
+// MessagesImportChatInviteCtx is the context-aware variant of MessagesImportChatInvite.
+func (c *Client) MessagesImportChatInviteCtx(ctx context.Context, hash string) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesImportChatInviteParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesImportChatInvite: %w", err)
 	}
@@ -11634,7 +13915,12 @@ func (*MessagesInitHistoryImportParams) CRC() uint32 {
 
 // Import chat history from a foreign chat app into a specific Telegram chat, click here for more info about imported chats.
 func (c *Client) MessagesInitHistoryImport(peer InputPeer, file InputFile, mediaCount int32) (*MessagesHistoryImport, error) {
-	responseData, err := c.MakeRequest(&MessagesInitHistoryImportParams{
+	return c.MessagesInitHistoryImportCtx(context.Background(), peer, file, mediaCount)
+}
+
+// MessagesInitHistoryImportCtx is the context-aware variant of MessagesInitHistoryImport.
+func (c *Client) MessagesInitHistoryImportCtx(ctx context.Context, peer InputPeer, file InputFile, mediaCount int32) (*MessagesHistoryImport, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesInitHistoryImportParams{
 		File:       file,
 		MediaCount: mediaCount,
 		Peer:       peer,
@@ -11661,7 +13947,12 @@ func (*MessagesInstallStickerSetParams) CRC() uint32 {
 
 // Install a stickerset
 func (c *Client) MessagesInstallStickerSet(stickerset InputStickerSet, archived bool) (MessagesStickerSetInstallResult, error) {
-	responseData, err := c.MakeRequest(&MessagesInstallStickerSetParams{
+	return c.MessagesInstallStickerSetCtx(context.Background(), stickerset, archived)
+}
+
+// MessagesInstallStickerSetCtx is the context-aware variant of MessagesInstallStickerSet.
+func (c *Client) MessagesInstallStickerSetCtx(ctx context.Context, stickerset InputStickerSet, archived bool) (MessagesStickerSetInstallResult, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesInstallStickerSetParams{
 		Archived:   archived,
 		Stickerset: stickerset,
 	})
@@ -11692,7 +13983,12 @@ func (*MessagesMarkDialogUnreadParams) FlagIndex() int {
 
 // Manually mark dialog as unread
 func (c *Client) MessagesMarkDialogUnread(unread bool, parentPeer InputPeer, peer InputDialogPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesMarkDialogUnreadParams{
+	return c.MessagesMarkDialogUnreadCtx(context.Background(), unread, parentPeer, peer)
+}
+
+// MessagesMarkDialogUnreadCtx is the context-aware variant of MessagesMarkDialogUnread.
+func (c *Client) MessagesMarkDialogUnreadCtx(ctx context.Context, unread bool, parentPeer InputPeer, peer InputDialogPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesMarkDialogUnreadParams{
 		ParentPeer: parentPeer,
 		Peer:       peer,
 		Unread:     unread,
@@ -11718,7 +14014,12 @@ func (*MessagesMigrateChatParams) CRC() uint32 {
 
 // Turn a basic group into a supergroup
 func (c *Client) MessagesMigrateChat(chatID int64) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesMigrateChatParams{ChatID: chatID})
+	return c.MessagesMigrateChatCtx(context.Background(), chatID)
+}
+
+// MessagesMigrateChatCtx is the context-aware variant of MessagesMigrateChat.
+func (c *Client) MessagesMigrateChatCtx(ctx context.Context, chatID int64) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesMigrateChatParams{ChatID: chatID})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesMigrateChat: %w", err)
 	}
@@ -11749,7 +14050,12 @@ func (*MessagesProlongWebViewParams) FlagIndex() int {
 
 // Indicate to the server (from the user side) that the user is still using a web app.
 func (c *Client) MessagesProlongWebView(params *MessagesProlongWebViewParams) (bool, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesProlongWebViewCtx(context.Background(), params)
+}
+
+// MessagesProlongWebViewCtx is the context-aware variant of MessagesProlongWebView.
+func (c *Client) MessagesProlongWebViewCtx(ctx context.Context, params *MessagesProlongWebViewParams) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesProlongWebView: %w", err)
 	}
@@ -11774,7 +14080,12 @@ func (*MessagesRateTranscribedAudioParams) CRC() uint32 {
 
 // Rate transcribed voice message
 func (c *Client) MessagesRateTranscribedAudio(peer InputPeer, msgID int32, transcriptionID int64, good bool) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesRateTranscribedAudioParams{
+	return c.MessagesRateTranscribedAudioCtx(context.Background(), peer, msgID, transcriptionID, good)
+}
+
+// MessagesRateTranscribedAudioCtx is the context-aware variant of MessagesRateTranscribedAudio.
+func (c *Client) MessagesRateTranscribedAudioCtx(ctx context.Context, peer InputPeer, msgID int32, transcriptionID int64, good bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesRateTranscribedAudioParams{
 		Good:            good,
 		MsgID:           msgID,
 		Peer:            peer,
@@ -11803,7 +14114,12 @@ func (*MessagesReadDiscussionParams) CRC() uint32 {
 
 // Mark a thread as read
 func (c *Client) MessagesReadDiscussion(peer InputPeer, msgID, readMaxID int32) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesReadDiscussionParams{
+	return c.MessagesReadDiscussionCtx(context.Background(), peer, msgID, readMaxID)
+}
+
+// MessagesReadDiscussionCtx is the context-aware variant of MessagesReadDiscussion.
+func (c *Client) MessagesReadDiscussionCtx(ctx context.Context, peer InputPeer, msgID, readMaxID int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReadDiscussionParams{
 		MsgID:     msgID,
 		Peer:      peer,
 		ReadMaxID: readMaxID,
@@ -11830,7 +14146,12 @@ func (*MessagesReadEncryptedHistoryParams) CRC() uint32 {
 
 // Marks message history within a secret chat as read.
 func (c *Client) MessagesReadEncryptedHistory(peer *InputEncryptedChat, maxDate int32) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesReadEncryptedHistoryParams{
+	return c.MessagesReadEncryptedHistoryCtx(context.Background(), peer, maxDate)
+}
+
+// MessagesReadEncryptedHistoryCtx is the context-aware variant of MessagesReadEncryptedHistory.
+func (c *Client) MessagesReadEncryptedHistoryCtx(ctx context.Context, peer *InputEncryptedChat, maxDate int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReadEncryptedHistoryParams{
 		MaxDate: maxDate,
 		Peer:    peer,
 	})
@@ -11855,7 +14176,12 @@ func (*MessagesReadFeaturedStickersParams) CRC() uint32 {
 
 // Mark new featured stickers as read
 func (c *Client) MessagesReadFeaturedStickers(id []int64) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesReadFeaturedStickersParams{ID: id})
+	return c.MessagesReadFeaturedStickersCtx(context.Background(), id)
+}
+
+// MessagesReadFeaturedStickersCtx is the context-aware variant of MessagesReadFeaturedStickers.
+func (c *Client) MessagesReadFeaturedStickersCtx(ctx context.Context, id []int64) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReadFeaturedStickersParams{ID: id})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesReadFeaturedStickers: %w", err)
 	}
@@ -11878,7 +14204,12 @@ func (*MessagesReadHistoryParams) CRC() uint32 {
 
 // Marks message history as read.
 func (c *Client) MessagesReadHistory(peer InputPeer, maxID int32) (*MessagesAffectedMessages, error) {
-	responseData, err := c.MakeRequest(&MessagesReadHistoryParams{
+	return c.MessagesReadHistoryCtx(context.Background(), peer, maxID)
+}
+
+// MessagesReadHistoryCtx is the context-aware variant of MessagesReadHistory.
+func (c *Client) MessagesReadHistoryCtx(ctx context.Context, peer InputPeer, maxID int32) (*MessagesAffectedMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReadHistoryParams{
 		MaxID: maxID,
 		Peer:  peer,
 	})
@@ -11908,7 +14239,12 @@ func (*MessagesReadMentionsParams) FlagIndex() int {
 
 // Mark mentions as read
 func (c *Client) MessagesReadMentions(peer InputPeer, topMsgID int32) (*MessagesAffectedHistory, error) {
-	responseData, err := c.MakeRequest(&MessagesReadMentionsParams{
+	return c.MessagesReadMentionsCtx(context.Background(), peer, topMsgID)
+}
+
+// MessagesReadMentionsCtx is the context-aware variant of MessagesReadMentions.
+func (c *Client) MessagesReadMentionsCtx(ctx context.Context, peer InputPeer, topMsgID int32) (*MessagesAffectedHistory, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReadMentionsParams{
 		Peer:     peer,
 		TopMsgID: topMsgID,
 	})
@@ -11933,7 +14269,12 @@ func (*MessagesReadMessageContentsParams) CRC() uint32 {
 
 // Notifies the sender about the recipient having listened a voice message or watched a video, emitting an updateReadMessagesContents.
 func (c *Client) MessagesReadMessageContents(id []int32) (*MessagesAffectedMessages, error) {
-	responseData, err := c.MakeRequest(&MessagesReadMessageContentsParams{ID: id})
+	return c.MessagesReadMessageContentsCtx(context.Background(), id)
+}
+
+// MessagesReadMessageContentsCtx is the context-aware variant of MessagesReadMessageContents.
+func (c *Client) MessagesReadMessageContentsCtx(ctx context.Context, id []int32) (*MessagesAffectedMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReadMessageContentsParams{ID: id})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesReadMessageContents: %w", err)
 	}
@@ -11961,7 +14302,12 @@ func (*MessagesReadReactionsParams) FlagIndex() int {
 
 // Mark message reactions as read
 func (c *Client) MessagesReadReactions(peer InputPeer, topMsgID int32, savedPeerID InputPeer) (*MessagesAffectedHistory, error) {
-	responseData, err := c.MakeRequest(&MessagesReadReactionsParams{
+	return c.MessagesReadReactionsCtx(context.Background(), peer, topMsgID, savedPeerID)
+}
+
+// MessagesReadReactionsCtx is the context-aware variant of MessagesReadReactions.
+func (c *Client) MessagesReadReactionsCtx(ctx context.Context, peer InputPeer, topMsgID int32, savedPeerID InputPeer) (*MessagesAffectedHistory, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReadReactionsParams{
 		Peer:        peer,
 		SavedPeerID: savedPeerID,
 		TopMsgID:    topMsgID,
@@ -11989,7 +14335,12 @@ func (*MessagesReadSavedHistoryParams) CRC() uint32 {
 
 // Mark messages as read in a monoforum topic.
 func (c *Client) MessagesReadSavedHistory(parentPeer, peer InputPeer, maxID int32) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesReadSavedHistoryParams{
+	return c.MessagesReadSavedHistoryCtx(context.Background(), parentPeer, peer, maxID)
+}
+
+// MessagesReadSavedHistoryCtx is the context-aware variant of MessagesReadSavedHistory.
+func (c *Client) MessagesReadSavedHistoryCtx(ctx context.Context, parentPeer, peer InputPeer, maxID int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReadSavedHistoryParams{
 		MaxID:      maxID,
 		ParentPeer: parentPeer,
 		Peer:       peer,
@@ -12015,7 +14366,12 @@ func (*MessagesReceivedMessagesParams) CRC() uint32 {
 
 // Confirms receipt of messages by a client, cancels PUSH-notification sending.
 func (c *Client) MessagesReceivedMessages(maxID int32) ([]*ReceivedNotifyMessage, error) {
-	responseData, err := c.MakeRequest(&MessagesReceivedMessagesParams{MaxID: maxID})
+	return c.MessagesReceivedMessagesCtx(context.Background(), maxID)
+}
+
+// MessagesReceivedMessagesCtx is the context-aware variant of MessagesReceivedMessages.
+func (c *Client) MessagesReceivedMessagesCtx(ctx context.Context, maxID int32) ([]*ReceivedNotifyMessage, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReceivedMessagesParams{MaxID: maxID})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesReceivedMessages: %w", err)
 	}
@@ -12037,7 +14393,12 @@ func (*MessagesReceivedQueueParams) CRC() uint32 {
 
 // Confirms receipt of messages in a secret chat by client, cancels push notifications. The method returns a list of random_ids of messages for which push notifications were cancelled.
 func (c *Client) MessagesReceivedQueue(maxQts int32) ([]int64, error) {
-	responseData, err := c.MakeRequest(&MessagesReceivedQueueParams{MaxQts: maxQts})
+	return c.MessagesReceivedQueueCtx(context.Background(), maxQts)
+}
+
+// MessagesReceivedQueueCtx is the context-aware variant of MessagesReceivedQueue.
+func (c *Client) MessagesReceivedQueueCtx(ctx context.Context, maxQts int32) ([]int64, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReceivedQueueParams{MaxQts: maxQts})
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesReceivedQueue: %w", err)
 	}
@@ -12065,7 +14426,12 @@ func (*MessagesReorderPinnedDialogsParams) FlagIndex() int {
 
 // Reorder pinned dialogs
 func (c *Client) MessagesReorderPinnedDialogs(force bool, folderID int32, order []InputDialogPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesReorderPinnedDialogsParams{
+	return c.MessagesReorderPinnedDialogsCtx(context.Background(), force, folderID, order)
+}
+
+// MessagesReorderPinnedDialogsCtx is the context-aware variant of MessagesReorderPinnedDialogs.
+func (c *Client) MessagesReorderPinnedDialogsCtx(ctx context.Context, force bool, folderID int32, order []InputDialogPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReorderPinnedDialogsParams{
 		FolderID: folderID,
 		Force:    force,
 		Order:    order,
@@ -12096,7 +14462,12 @@ func (*MessagesReorderPinnedForumTopicsParams) FlagIndex() int {
 }
 
 func (c *Client) MessagesReorderPinnedForumTopics(force bool, peer InputPeer, order []int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesReorderPinnedForumTopicsParams{
+	return c.MessagesReorderPinnedForumTopicsCtx(context.Background(), force, peer, order)
+}
+
+// MessagesReorderPinnedForumTopicsCtx is the context-aware variant of MessagesReorderPinnedForumTopics.
+func (c *Client) MessagesReorderPinnedForumTopicsCtx(ctx context.Context, force bool, peer InputPeer, order []int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReorderPinnedForumTopicsParams{
 		Force: force,
 		Order: order,
 		Peer:  peer,
@@ -12127,7 +14498,12 @@ func (*MessagesReorderPinnedSavedDialogsParams) FlagIndex() int {
 
 // Reorder pinned saved message dialogs.
 func (c *Client) MessagesReorderPinnedSavedDialogs(force bool, order []InputDialogPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesReorderPinnedSavedDialogsParams{
+	return c.MessagesReorderPinnedSavedDialogsCtx(context.Background(), force, order)
+}
+
+// MessagesReorderPinnedSavedDialogsCtx is the context-aware variant of MessagesReorderPinnedSavedDialogs.
+func (c *Client) MessagesReorderPinnedSavedDialogsCtx(ctx context.Context, force bool, order []InputDialogPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReorderPinnedSavedDialogsParams{
 		Force: force,
 		Order: order,
 	})
@@ -12152,7 +14528,12 @@ func (*MessagesReorderQuickRepliesParams) CRC() uint32 {
 
 // Reorder quick reply shortcuts.
 func (c *Client) MessagesReorderQuickReplies(order []int32) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesReorderQuickRepliesParams{Order: order})
+	return c.MessagesReorderQuickRepliesCtx(context.Background(), order)
+}
+
+// MessagesReorderQuickRepliesCtx is the context-aware variant of MessagesReorderQuickReplies.
+func (c *Client) MessagesReorderQuickRepliesCtx(ctx context.Context, order []int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReorderQuickRepliesParams{Order: order})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesReorderQuickReplies: %w", err)
 	}
@@ -12180,7 +14561,12 @@ func (*MessagesReorderStickerSetsParams) FlagIndex() int {
 
 // Reorder installed stickersets
 func (c *Client) MessagesReorderStickerSets(masks, emojis bool, order []int64) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesReorderStickerSetsParams{
+	return c.MessagesReorderStickerSetsCtx(context.Background(), masks, emojis, order)
+}
+
+// MessagesReorderStickerSetsCtx is the context-aware variant of MessagesReorderStickerSets.
+func (c *Client) MessagesReorderStickerSetsCtx(ctx context.Context, masks, emojis bool, order []int64) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReorderStickerSetsParams{
 		Emojis: emojis,
 		Masks:  masks,
 		Order:  order,
@@ -12209,7 +14595,12 @@ func (*MessagesReportParams) CRC() uint32 {
 
 // Report a message in a chat for violation of telegram's Terms of Service
 func (c *Client) MessagesReport(peer InputPeer, id []int32, option []byte, message string) (ReportResult, error) {
-	responseData, err := c.MakeRequest(&MessagesReportParams{
+	return c.MessagesReportCtx(context.Background(), peer, id, option, message)
+}
+
+// MessagesReportCtx is the context-aware variant of MessagesReport.
+func (c *Client) MessagesReportCtx(ctx context.Context, peer InputPeer, id []int32, option []byte, message string) (ReportResult, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReportParams{
 		ID:      id,
 		Message: message,
 		Option:  option,
@@ -12236,7 +14627,12 @@ func (*MessagesReportEncryptedSpamParams) CRC() uint32 {
 
 // Report a secret chat for spam
 func (c *Client) MessagesReportEncryptedSpam(peer *InputEncryptedChat) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesReportEncryptedSpamParams{Peer: peer})
+	return c.MessagesReportEncryptedSpamCtx(context.Background(), peer)
+}
+
+// MessagesReportEncryptedSpamCtx is the context-aware variant of MessagesReportEncryptedSpam.
+func (c *Client) MessagesReportEncryptedSpamCtx(ctx context.Context, peer *InputEncryptedChat) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReportEncryptedSpamParams{Peer: peer})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesReportEncryptedSpam: %w", err)
 	}
@@ -12264,7 +14660,12 @@ func (*MessagesReportMessagesDeliveryParams) FlagIndex() int {
 
 // Used for Telegram Gateway verification messages : indicate to the server that one or more messages were received by the client, if requested by the message.report_delivery_until_date flag or the equivalent flag in push notifications.
 func (c *Client) MessagesReportMessagesDelivery(push bool, peer InputPeer, id []int32) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesReportMessagesDeliveryParams{
+	return c.MessagesReportMessagesDeliveryCtx(context.Background(), push, peer, id)
+}
+
+// MessagesReportMessagesDeliveryCtx is the context-aware variant of MessagesReportMessagesDelivery.
+func (c *Client) MessagesReportMessagesDeliveryCtx(ctx context.Context, push bool, peer InputPeer, id []int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReportMessagesDeliveryParams{
 		ID:   id,
 		Peer: peer,
 		Push: push,
@@ -12292,7 +14693,12 @@ func (*MessagesReportReactionParams) CRC() uint32 {
 
 // Report a message reaction
 func (c *Client) MessagesReportReaction(peer InputPeer, id int32, reactionPeer InputPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesReportReactionParams{
+	return c.MessagesReportReactionCtx(context.Background(), peer, id, reactionPeer)
+}
+
+// MessagesReportReactionCtx is the context-aware variant of MessagesReportReaction.
+func (c *Client) MessagesReportReactionCtx(ctx context.Context, peer InputPeer, id int32, reactionPeer InputPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReportReactionParams{
 		ID:           id,
 		Peer:         peer,
 		ReactionPeer: reactionPeer,
@@ -12318,7 +14724,12 @@ func (*MessagesReportSpamParams) CRC() uint32 {
 
 // Report a new incoming chat for spam, if the peer settings of the chat allow us to do that
 func (c *Client) MessagesReportSpam(peer InputPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesReportSpamParams{Peer: peer})
+	return c.MessagesReportSpamCtx(context.Background(), peer)
+}
+
+// MessagesReportSpamCtx is the context-aware variant of MessagesReportSpam.
+func (c *Client) MessagesReportSpamCtx(ctx context.Context, peer InputPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReportSpamParams{Peer: peer})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesReportSpam: %w", err)
 	}
@@ -12341,7 +14752,12 @@ func (*MessagesReportSponsoredMessageParams) CRC() uint32 {
 
 // Report a sponsored message
 func (c *Client) MessagesReportSponsoredMessage(randomID, option []byte) (ChannelsSponsoredMessageReportResult, error) {
-	responseData, err := c.MakeRequest(&MessagesReportSponsoredMessageParams{
+	return c.MessagesReportSponsoredMessageCtx(context.Background(), randomID, option)
+}
+
+// MessagesReportSponsoredMessageCtx is the context-aware variant of MessagesReportSponsoredMessage.
+func (c *Client) MessagesReportSponsoredMessageCtx(ctx context.Context, randomID, option []byte) (ChannelsSponsoredMessageReportResult, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesReportSponsoredMessageParams{
 		Option:   option,
 		RandomID: randomID,
 	})
@@ -12377,7 +14793,12 @@ func (*MessagesRequestAppWebViewParams) FlagIndex() int {
 
 // Open a bot mini app from a direct Mini App deep link, sending over user information after user confirmation.
 func (c *Client) MessagesRequestAppWebView(params *MessagesRequestAppWebViewParams) (*WebViewResultURL, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesRequestAppWebViewCtx(context.Background(), params)
+}
+
+// MessagesRequestAppWebViewCtx is the context-aware variant of MessagesRequestAppWebView.
+func (c *Client) MessagesRequestAppWebViewCtx(ctx context.Context, params *MessagesRequestAppWebViewParams) (*WebViewResultURL, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesRequestAppWebView: %w", err)
 	}
@@ -12401,7 +14822,12 @@ func (*MessagesRequestEncryptionParams) CRC() uint32 {
 
 // Sends a request to start a secret chat to the user.
 func (c *Client) MessagesRequestEncryption(userID InputUser, randomID int32, gA []byte) (EncryptedChat, error) {
-	responseData, err := c.MakeRequest(&MessagesRequestEncryptionParams{
+	return c.MessagesRequestEncryptionCtx(context.Background(), userID, randomID, gA)
+}
+
+// MessagesRequestEncryptionCtx is the context-aware variant of MessagesRequestEncryption.
+func (c *Client) MessagesRequestEncryptionCtx(ctx context.Context, userID InputUser, randomID int32, gA []byte) (EncryptedChat, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesRequestEncryptionParams{
 		GA:       gA,
 		RandomID: randomID,
 		UserID:   userID,
@@ -12437,7 +14863,12 @@ func (*MessagesRequestMainWebViewParams) FlagIndex() int {
 
 // Open a Main Mini App.
 func (c *Client) MessagesRequestMainWebView(params *MessagesRequestMainWebViewParams) (*WebViewResultURL, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesRequestMainWebViewCtx(context.Background(), params)
+}
+
+// MessagesRequestMainWebViewCtx is the context-aware variant of MessagesRequestMainWebView.
+func (c *Client) MessagesRequestMainWebViewCtx(ctx context.Context, params *MessagesRequestMainWebViewParams) (*WebViewResultURL, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesRequestMainWebView: %w", err)
 	}
@@ -12471,7 +14902,12 @@ func (*MessagesRequestSimpleWebViewParams) FlagIndex() int {
 
 // Open a bot mini app.
 func (c *Client) MessagesRequestSimpleWebView(params *MessagesRequestSimpleWebViewParams) (*WebViewResultURL, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesRequestSimpleWebViewCtx(context.Background(), params)
+}
+
+// MessagesRequestSimpleWebViewCtx is the context-aware variant of MessagesRequestSimpleWebView.
+func (c *Client) MessagesRequestSimpleWebViewCtx(ctx context.Context, params *MessagesRequestSimpleWebViewParams) (*WebViewResultURL, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesRequestSimpleWebView: %w", err)
 	}
@@ -12500,7 +14936,12 @@ func (*MessagesRequestURLAuthParams) FlagIndex() int {
 
 // Get more info about a Seamless Telegram Login authorization request, for more info click here
 func (c *Client) MessagesRequestURLAuth(peer InputPeer, msgID, buttonID int32, url string) (URLAuthResult, error) {
-	responseData, err := c.MakeRequest(&MessagesRequestURLAuthParams{
+	return c.MessagesRequestURLAuthCtx(context.Background(), peer, msgID, buttonID, url)
+}
+
+// MessagesRequestURLAuthCtx is the context-aware variant of MessagesRequestURLAuth.
+func (c *Client) MessagesRequestURLAuthCtx(ctx context.Context, peer InputPeer, msgID, buttonID int32, url string) (URLAuthResult, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesRequestURLAuthParams{
 		ButtonID: buttonID,
 		MsgID:    msgID,
 		Peer:     peer,
@@ -12542,7 +14983,12 @@ func (*MessagesRequestWebViewParams) FlagIndex() int {
 
 // Open a bot mini app, sending over user information after user confirmation.
 func (c *Client) MessagesRequestWebView(params *MessagesRequestWebViewParams) (*WebViewResultURL, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesRequestWebViewCtx(context.Background(), params)
+}
+
+// MessagesRequestWebViewCtx is the context-aware variant of MessagesRequestWebView.
+func (c *Client) MessagesRequestWebViewCtx(ctx context.Context, params *MessagesRequestWebViewParams) (*WebViewResultURL, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesRequestWebView: %w", err)
 	}
@@ -12565,7 +15011,12 @@ func (*MessagesSaveDefaultSendAsParams) CRC() uint32 {
 
 // Change the default peer that should be used when sending messages, reactions, poll votes to a specific group
 func (c *Client) MessagesSaveDefaultSendAs(peer, sendAs InputPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesSaveDefaultSendAsParams{
+	return c.MessagesSaveDefaultSendAsCtx(context.Background(), peer, sendAs)
+}
+
+// MessagesSaveDefaultSendAsCtx is the context-aware variant of MessagesSaveDefaultSendAs.
+func (c *Client) MessagesSaveDefaultSendAsCtx(ctx context.Context, peer, sendAs InputPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSaveDefaultSendAsParams{
 		Peer:   peer,
 		SendAs: sendAs,
 	})
@@ -12602,7 +15053,12 @@ func (*MessagesSaveDraftParams) FlagIndex() int {
 
 // Save a message draft associated to a chat.
 func (c *Client) MessagesSaveDraft(params *MessagesSaveDraftParams) (bool, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSaveDraftCtx(context.Background(), params)
+}
+
+// MessagesSaveDraftCtx is the context-aware variant of MessagesSaveDraft.
+func (c *Client) MessagesSaveDraftCtx(ctx context.Context, params *MessagesSaveDraftParams) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesSaveDraft: %w", err)
 	}
@@ -12625,7 +15081,12 @@ func (*MessagesSaveGifParams) CRC() uint32 {
 
 // Add GIF to saved gifs list
 func (c *Client) MessagesSaveGif(id InputDocument, unsave bool) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesSaveGifParams{
+	return c.MessagesSaveGifCtx(context.Background(), id, unsave)
+}
+
+// MessagesSaveGifCtx is the context-aware variant of MessagesSaveGif.
+func (c *Client) MessagesSaveGifCtx(ctx context.Context, id InputDocument, unsave bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSaveGifParams{
 		ID:     id,
 		Unsave: unsave,
 	})
@@ -12656,7 +15117,12 @@ func (*MessagesSavePreparedInlineMessageParams) FlagIndex() int {
 
 // Save a prepared inline message, to be shared by the user of the mini app using a web_app_send_prepared_message event
 func (c *Client) MessagesSavePreparedInlineMessage(result InputBotInlineResult, userID InputUser, peerTypes []InlineQueryPeerType) (*MessagesBotPreparedInlineMessage, error) {
-	responseData, err := c.MakeRequest(&MessagesSavePreparedInlineMessageParams{
+	return c.MessagesSavePreparedInlineMessageCtx(context.Background(), result, userID, peerTypes)
+}
+
+// MessagesSavePreparedInlineMessageCtx is the context-aware variant of MessagesSavePreparedInlineMessage.
+func (c *Client) MessagesSavePreparedInlineMessageCtx(ctx context.Context, result InputBotInlineResult, userID InputUser, peerTypes []InlineQueryPeerType) (*MessagesBotPreparedInlineMessage, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSavePreparedInlineMessageParams{
 		PeerTypes: peerTypes,
 		Result:    result,
 		UserID:    userID,
@@ -12688,7 +15154,12 @@ func (*MessagesSaveRecentStickerParams) FlagIndex() int {
 
 // Add/remove sticker from recent stickers list
 func (c *Client) MessagesSaveRecentSticker(attached bool, id InputDocument, unsave bool) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesSaveRecentStickerParams{
+	return c.MessagesSaveRecentStickerCtx(context.Background(), attached, id, unsave)
+}
+
+// MessagesSaveRecentStickerCtx is the context-aware variant of MessagesSaveRecentSticker.
+func (c *Client) MessagesSaveRecentStickerCtx(ctx context.Context, attached bool, id InputDocument, unsave bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSaveRecentStickerParams{
 		Attached: attached,
 		ID:       id,
 		Unsave:   unsave,
@@ -12732,7 +15203,12 @@ func (*MessagesSearchParams) FlagIndex() int {
 
 // Search for messages.
 func (c *Client) MessagesSearch(params *MessagesSearchParams) (MessagesMessages, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSearchCtx(context.Background(), params)
+}
+
+// MessagesSearchCtx is the context-aware variant of MessagesSearch.
+func (c *Client) MessagesSearchCtx(ctx context.Context, params *MessagesSearchParams) (MessagesMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesSearch: %w", err)
 	}
@@ -12755,7 +15231,12 @@ func (*MessagesSearchCustomEmojiParams) CRC() uint32 {
 
 // Look for custom emojis associated to a UTF8 emoji
 func (c *Client) MessagesSearchCustomEmoji(emoticon string, hash int64) (EmojiList, error) {
-	responseData, err := c.MakeRequest(&MessagesSearchCustomEmojiParams{
+	return c.MessagesSearchCustomEmojiCtx(context.Background(), emoticon, hash)
+}
+
+// MessagesSearchCustomEmojiCtx is the context-aware variant of MessagesSearchCustomEmoji.
+func (c *Client) MessagesSearchCustomEmojiCtx(ctx context.Context, emoticon string, hash int64) (EmojiList, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSearchCustomEmojiParams{
 		Emoticon: emoticon,
 		Hash:     hash,
 	})
@@ -12786,7 +15267,12 @@ func (*MessagesSearchEmojiStickerSetsParams) FlagIndex() int {
 
 // Search for custom emoji stickersets
 func (c *Client) MessagesSearchEmojiStickerSets(excludeFeatured bool, q string, hash int64) (MessagesFoundStickerSets, error) {
-	responseData, err := c.MakeRequest(&MessagesSearchEmojiStickerSetsParams{
+	return c.MessagesSearchEmojiStickerSetsCtx(context.Background(), excludeFeatured, q, hash)
+}
+
+// MessagesSearchEmojiStickerSetsCtx is the context-aware variant of MessagesSearchEmojiStickerSets.
+func (c *Client) MessagesSearchEmojiStickerSetsCtx(ctx context.Context, excludeFeatured bool, q string, hash int64) (MessagesFoundStickerSets, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSearchEmojiStickerSetsParams{
 		ExcludeFeatured: excludeFeatured,
 		Hash:            hash,
 		Q:               q,
@@ -12827,7 +15313,12 @@ func (*MessagesSearchGlobalParams) FlagIndex() int {
 
 // Search for messages and peers globally
 func (c *Client) MessagesSearchGlobal(params *MessagesSearchGlobalParams) (MessagesMessages, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSearchGlobalCtx(context.Background(), params)
+}
+
+// MessagesSearchGlobalCtx is the context-aware variant of MessagesSearchGlobal.
+func (c *Client) MessagesSearchGlobalCtx(ctx context.Context, params *MessagesSearchGlobalParams) (MessagesMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesSearchGlobal: %w", err)
 	}
@@ -12851,7 +15342,12 @@ func (*MessagesSearchSentMediaParams) CRC() uint32 {
 
 // View and search recently sent media. This method does not support pagination.
 func (c *Client) MessagesSearchSentMedia(q string, filter MessagesFilter, limit int32) (MessagesMessages, error) {
-	responseData, err := c.MakeRequest(&MessagesSearchSentMediaParams{
+	return c.MessagesSearchSentMediaCtx(context.Background(), q, filter, limit)
+}
+
+// MessagesSearchSentMediaCtx is the context-aware variant of MessagesSearchSentMedia.
+func (c *Client) MessagesSearchSentMediaCtx(ctx context.Context, q string, filter MessagesFilter, limit int32) (MessagesMessages, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSearchSentMediaParams{
 		Filter: filter,
 		Limit:  limit,
 		Q:      q,
@@ -12883,7 +15379,12 @@ func (*MessagesSearchStickerSetsParams) FlagIndex() int {
 
 // Search for stickersets
 func (c *Client) MessagesSearchStickerSets(excludeFeatured bool, q string, hash int64) (MessagesFoundStickerSets, error) {
-	responseData, err := c.MakeRequest(&MessagesSearchStickerSetsParams{
+	return c.MessagesSearchStickerSetsCtx(context.Background(), excludeFeatured, q, hash)
+}
+
+// MessagesSearchStickerSetsCtx is the context-aware variant of MessagesSearchStickerSets.
+func (c *Client) MessagesSearchStickerSetsCtx(ctx context.Context, excludeFeatured bool, q string, hash int64) (MessagesFoundStickerSets, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSearchStickerSetsParams{
 		ExcludeFeatured: excludeFeatured,
 		Hash:            hash,
 		Q:               q,
@@ -12919,7 +15420,12 @@ func (*MessagesSearchStickersParams) FlagIndex() int {
 
 // Search for stickers using AI-powered keyword search
 func (c *Client) MessagesSearchStickers(params *MessagesSearchStickersParams) (MessagesFoundStickers, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSearchStickersCtx(context.Background(), params)
+}
+
+// MessagesSearchStickersCtx is the context-aware variant of MessagesSearchStickers.
+func (c *Client) MessagesSearchStickersCtx(ctx context.Context, params *MessagesSearchStickersParams) (MessagesFoundStickers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesSearchStickers: %w", err)
 	}
@@ -12944,7 +15450,12 @@ func (*MessagesSendBotRequestedPeerParams) CRC() uint32 {
 
 // Send one or more chosen peers, as requested by a keyboardButtonRequestPeer button.
 func (c *Client) MessagesSendBotRequestedPeer(peer InputPeer, msgID, buttonID int32, requestedPeers []InputPeer) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesSendBotRequestedPeerParams{
+	return c.MessagesSendBotRequestedPeerCtx(context.Background(), peer, msgID, buttonID, requestedPeers)
+}
+
+// MessagesSendBotRequestedPeerCtx is the context-aware variant of MessagesSendBotRequestedPeer.
+func (c *Client) MessagesSendBotRequestedPeerCtx(ctx context.Context, peer InputPeer, msgID, buttonID int32, requestedPeers []InputPeer) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSendBotRequestedPeerParams{
 		ButtonID:       buttonID,
 		MsgID:          msgID,
 		Peer:           peer,
@@ -12978,7 +15489,12 @@ func (*MessagesSendEncryptedParams) FlagIndex() int {
 
 // Sends a text message to a secret chat.
 func (c *Client) MessagesSendEncrypted(silent bool, peer *InputEncryptedChat, randomID int64, data []byte) (MessagesSentEncryptedMessage, error) {
-	responseData, err := c.MakeRequest(&MessagesSendEncryptedParams{
+	return c.MessagesSendEncryptedCtx(context.Background(), silent, peer, randomID, data)
+}
+
+// MessagesSendEncryptedCtx is the context-aware variant of MessagesSendEncrypted.
+func (c *Client) MessagesSendEncryptedCtx(ctx context.Context, silent bool, peer *InputEncryptedChat, randomID int64, data []byte) (MessagesSentEncryptedMessage, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSendEncryptedParams{
 		Data:     data,
 		Peer:     peer,
 		RandomID: randomID,
@@ -13013,7 +15529,12 @@ func (*MessagesSendEncryptedFileParams) FlagIndex() int {
 
 // Sends a message with a file attachment to a secret chat
 func (c *Client) MessagesSendEncryptedFile(params *MessagesSendEncryptedFileParams) (MessagesSentEncryptedMessage, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSendEncryptedFileCtx(context.Background(), params)
+}
+
+// MessagesSendEncryptedFileCtx is the context-aware variant of MessagesSendEncryptedFile.
+func (c *Client) MessagesSendEncryptedFileCtx(ctx context.Context, params *MessagesSendEncryptedFileParams) (MessagesSentEncryptedMessage, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesSendEncryptedFile: %w", err)
 	}
@@ -13037,7 +15558,12 @@ func (*MessagesSendEncryptedServiceParams) CRC() uint32 {
 
 // Sends a service message to a secret chat.
 func (c *Client) MessagesSendEncryptedService(peer *InputEncryptedChat, randomID int64, data []byte) (MessagesSentEncryptedMessage, error) {
-	responseData, err := c.MakeRequest(&MessagesSendEncryptedServiceParams{
+	return c.MessagesSendEncryptedServiceCtx(context.Background(), peer, randomID, data)
+}
+
+// MessagesSendEncryptedServiceCtx is the context-aware variant of MessagesSendEncryptedService.
+func (c *Client) MessagesSendEncryptedServiceCtx(ctx context.Context, peer *InputEncryptedChat, randomID int64, data []byte) (MessagesSentEncryptedMessage, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSendEncryptedServiceParams{
 		Data:     data,
 		Peer:     peer,
 		RandomID: randomID,
@@ -13079,7 +15605,12 @@ func (*MessagesSendInlineBotResultParams) FlagIndex() int {
 
 // Send a result obtained using messages.getInlineBotResults.
 func (c *Client) MessagesSendInlineBotResult(params *MessagesSendInlineBotResultParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSendInlineBotResultCtx(context.Background(), params)
+}
+
+// MessagesSendInlineBotResultCtx is the context-aware variant of MessagesSendInlineBotResult.
+func (c *Client) MessagesSendInlineBotResultCtx(ctx context.Context, params *MessagesSendInlineBotResultParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesSendInlineBotResult: %w", err)
 	}
@@ -13125,7 +15656,12 @@ func (*MessagesSendMediaParams) FlagIndex() int {
 
 // Send a media
 func (c *Client) MessagesSendMedia(params *MessagesSendMediaParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSendMediaCtx(context.Background(), params)
+}
+
+// MessagesSendMediaCtx is the context-aware variant of MessagesSendMedia.
+func (c *Client) MessagesSendMediaCtx(ctx context.Context, params *MessagesSendMediaParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesSendMedia: %w", err)
 	}
@@ -13171,7 +15707,12 @@ func (*MessagesSendMessageParams) FlagIndex() int {
 
 // Sends a message to a chat
 func (c *Client) MessagesSendMessage(params *MessagesSendMessageParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSendMessageCtx(context.Background(), params)
+}
+
+// MessagesSendMessageCtx is the context-aware variant of MessagesSendMessage.
+func (c *Client) MessagesSendMessageCtx(ctx context.Context, params *MessagesSendMessageParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesSendMessage: %w", err)
 	}
@@ -13211,7 +15752,12 @@ func (*MessagesSendMultiMediaParams) FlagIndex() int {
 
 // Send an album or grouped media
 func (c *Client) MessagesSendMultiMedia(params *MessagesSendMultiMediaParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSendMultiMediaCtx(context.Background(), params)
+}
+
+// MessagesSendMultiMediaCtx is the context-aware variant of MessagesSendMultiMedia.
+func (c *Client) MessagesSendMultiMediaCtx(ctx context.Context, params *MessagesSendMultiMediaParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesSendMultiMedia: %w", err)
 	}
@@ -13241,7 +15787,12 @@ func (*MessagesSendPaidReactionParams) FlagIndex() int {
 
 // Sends one or more paid Telegram Star reactions, transferring Telegram Stars to a channel's balance.
 func (c *Client) MessagesSendPaidReaction(params *MessagesSendPaidReactionParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSendPaidReactionCtx(context.Background(), params)
+}
+
+// MessagesSendPaidReactionCtx is the context-aware variant of MessagesSendPaidReaction.
+func (c *Client) MessagesSendPaidReactionCtx(ctx context.Context, params *MessagesSendPaidReactionParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesSendPaidReaction: %w", err)
 	}
@@ -13266,7 +15817,12 @@ func (*MessagesSendQuickReplyMessagesParams) CRC() uint32 {
 
 // Send a quick reply shortcut.
 func (c *Client) MessagesSendQuickReplyMessages(peer InputPeer, shortcutID int32, id []int32, randomID []int64) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesSendQuickReplyMessagesParams{
+	return c.MessagesSendQuickReplyMessagesCtx(context.Background(), peer, shortcutID, id, randomID)
+}
+
+// MessagesSendQuickReplyMessagesCtx is the context-aware variant of MessagesSendQuickReplyMessages.
+func (c *Client) MessagesSendQuickReplyMessagesCtx(ctx context.Context, peer InputPeer, shortcutID int32, id []int32, randomID []int64) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSendQuickReplyMessagesParams{
 		ID:         id,
 		Peer:       peer,
 		RandomID:   randomID,
@@ -13301,7 +15857,12 @@ func (*MessagesSendReactionParams) FlagIndex() int {
 
 // React to message.
 func (c *Client) MessagesSendReaction(params *MessagesSendReactionParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSendReactionCtx(context.Background(), params)
+}
+
+// MessagesSendReactionCtx is the context-aware variant of MessagesSendReaction.
+func (c *Client) MessagesSendReactionCtx(ctx context.Context, params *MessagesSendReactionParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesSendReaction: %w", err)
 	}
@@ -13324,7 +15885,12 @@ func (*MessagesSendScheduledMessagesParams) CRC() uint32 {
 
 // Send scheduled messages right away
 func (c *Client) MessagesSendScheduledMessages(peer InputPeer, id []int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesSendScheduledMessagesParams{
+	return c.MessagesSendScheduledMessagesCtx(context.Background(), peer, id)
+}
+
+// MessagesSendScheduledMessagesCtx is the context-aware variant of MessagesSendScheduledMessages.
+func (c *Client) MessagesSendScheduledMessagesCtx(ctx context.Context, peer InputPeer, id []int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSendScheduledMessagesParams{
 		ID:   id,
 		Peer: peer,
 	})
@@ -13351,7 +15917,12 @@ func (*MessagesSendScreenshotNotificationParams) CRC() uint32 {
 
 // Notify the other user in a private chat that a screenshot of the chat was taken
 func (c *Client) MessagesSendScreenshotNotification(peer InputPeer, replyTo InputReplyTo, randomID int64) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesSendScreenshotNotificationParams{
+	return c.MessagesSendScreenshotNotificationCtx(context.Background(), peer, replyTo, randomID)
+}
+
+// MessagesSendScreenshotNotificationCtx is the context-aware variant of MessagesSendScreenshotNotification.
+func (c *Client) MessagesSendScreenshotNotificationCtx(ctx context.Context, peer InputPeer, replyTo InputReplyTo, randomID int64) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSendScreenshotNotificationParams{
 		Peer:     peer,
 		RandomID: randomID,
 		ReplyTo:  replyTo,
@@ -13379,7 +15950,12 @@ func (*MessagesSendVoteParams) CRC() uint32 {
 
 // Vote in a poll
 func (c *Client) MessagesSendVote(peer InputPeer, msgID int32, options [][]byte) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesSendVoteParams{
+	return c.MessagesSendVoteCtx(context.Background(), peer, msgID, options)
+}
+
+// MessagesSendVoteCtx is the context-aware variant of MessagesSendVote.
+func (c *Client) MessagesSendVoteCtx(ctx context.Context, peer InputPeer, msgID int32, options [][]byte) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSendVoteParams{
 		MsgID:   msgID,
 		Options: options,
 		Peer:    peer,
@@ -13408,7 +15984,12 @@ func (*MessagesSendWebViewDataParams) CRC() uint32 {
 
 // Used by the user to relay data from an opened reply keyboard bot mini app to the bot that owns it.
 func (c *Client) MessagesSendWebViewData(bot InputUser, randomID int64, buttonText, data string) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesSendWebViewDataParams{
+	return c.MessagesSendWebViewDataCtx(context.Background(), bot, randomID, buttonText, data)
+}
+
+// MessagesSendWebViewDataCtx is the context-aware variant of MessagesSendWebViewData.
+func (c *Client) MessagesSendWebViewDataCtx(ctx context.Context, bot InputUser, randomID int64, buttonText, data string) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSendWebViewDataParams{
 		Bot:        bot,
 		ButtonText: buttonText,
 		Data:       data,
@@ -13436,7 +16017,12 @@ func (*MessagesSendWebViewResultMessageParams) CRC() uint32 {
 
 // Terminate webview interaction started with messages.requestWebView, sending the specified message to the chat on behalf of the user.
 func (c *Client) MessagesSendWebViewResultMessage(botQueryID string, result InputBotInlineResult) (*WebViewMessageSent, error) {
-	responseData, err := c.MakeRequest(&MessagesSendWebViewResultMessageParams{
+	return c.MessagesSendWebViewResultMessageCtx(context.Background(), botQueryID, result)
+}
+
+// MessagesSendWebViewResultMessageCtx is the context-aware variant of MessagesSendWebViewResultMessage.
+func (c *Client) MessagesSendWebViewResultMessageCtx(ctx context.Context, botQueryID string, result InputBotInlineResult) (*WebViewMessageSent, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSendWebViewResultMessageParams{
 		BotQueryID: botQueryID,
 		Result:     result,
 	})
@@ -13469,7 +16055,12 @@ func (*MessagesSetBotCallbackAnswerParams) FlagIndex() int {
 
 // Set the callback answer to a user button press (bots only)
 func (c *Client) MessagesSetBotCallbackAnswer(params *MessagesSetBotCallbackAnswerParams) (bool, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSetBotCallbackAnswerCtx(context.Background(), params)
+}
+
+// MessagesSetBotCallbackAnswerCtx is the context-aware variant of MessagesSetBotCallbackAnswer.
+func (c *Client) MessagesSetBotCallbackAnswerCtx(ctx context.Context, params *MessagesSetBotCallbackAnswerParams) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesSetBotCallbackAnswer: %w", err)
 	}
@@ -13497,7 +16088,12 @@ func (*MessagesSetBotPrecheckoutResultsParams) FlagIndex() int {
 
 // Once the user has confirmed their payment and shipping details, the bot receives an updateBotPrecheckoutQuery update. Use this method to respond to such pre-checkout queries. Note: Telegram must receive an answer within 10 seconds after the pre-checkout query was sent.
 func (c *Client) MessagesSetBotPrecheckoutResults(success bool, queryID int64, error string) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesSetBotPrecheckoutResultsParams{
+	return c.MessagesSetBotPrecheckoutResultsCtx(context.Background(), success, queryID, error)
+}
+
+// MessagesSetBotPrecheckoutResultsCtx is the context-aware variant of MessagesSetBotPrecheckoutResults.
+func (c *Client) MessagesSetBotPrecheckoutResultsCtx(ctx context.Context, success bool, queryID int64, error string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSetBotPrecheckoutResultsParams{
 		Error:   error,
 		QueryID: queryID,
 		Success: success,
@@ -13529,7 +16125,12 @@ func (*MessagesSetBotShippingResultsParams) FlagIndex() int {
 
 // If you sent an invoice requesting a shipping address and the parameter is_flexible was specified, the bot will receive an updateBotShippingQuery update. Use this method to reply to shipping queries.
 func (c *Client) MessagesSetBotShippingResults(queryID int64, error string, shippingOptions []*ShippingOption) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesSetBotShippingResultsParams{
+	return c.MessagesSetBotShippingResultsCtx(context.Background(), queryID, error, shippingOptions)
+}
+
+// MessagesSetBotShippingResultsCtx is the context-aware variant of MessagesSetBotShippingResults.
+func (c *Client) MessagesSetBotShippingResultsCtx(ctx context.Context, queryID int64, error string, shippingOptions []*ShippingOption) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSetBotShippingResultsParams{
 		Error:           error,
 		QueryID:         queryID,
 		ShippingOptions: shippingOptions,
@@ -13562,7 +16163,12 @@ func (*MessagesSetChatAvailableReactionsParams) FlagIndex() int {
 
 // Change the set of message reactions that can be used in a certain group, supergroup or channel
 func (c *Client) MessagesSetChatAvailableReactions(peer InputPeer, availableReactions ChatReactions, reactionsLimit int32, paidEnabled bool) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesSetChatAvailableReactionsParams{
+	return c.MessagesSetChatAvailableReactionsCtx(context.Background(), peer, availableReactions, reactionsLimit, paidEnabled)
+}
+
+// MessagesSetChatAvailableReactionsCtx is the context-aware variant of MessagesSetChatAvailableReactions.
+func (c *Client) MessagesSetChatAvailableReactionsCtx(ctx context.Context, peer InputPeer, availableReactions ChatReactions, reactionsLimit int32, paidEnabled bool) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSetChatAvailableReactionsParams{
 		AvailableReactions: availableReactions,
 		PaidEnabled:        paidEnabled,
 		Peer:               peer,
@@ -13590,7 +16196,12 @@ func (*MessagesSetChatThemeParams) CRC() uint32 {
 
 // Change the chat theme of a certain chat
 func (c *Client) MessagesSetChatTheme(peer InputPeer, theme InputChatTheme) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesSetChatThemeParams{
+	return c.MessagesSetChatThemeCtx(context.Background(), peer, theme)
+}
+
+// MessagesSetChatThemeCtx is the context-aware variant of MessagesSetChatTheme.
+func (c *Client) MessagesSetChatThemeCtx(ctx context.Context, peer InputPeer, theme InputChatTheme) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSetChatThemeParams{
 		Peer:  peer,
 		Theme: theme,
 	})
@@ -13624,7 +16235,12 @@ func (*MessagesSetChatWallPaperParams) FlagIndex() int {
 
 // Set a custom wallpaper in a specific private chat with another user.
 func (c *Client) MessagesSetChatWallPaper(params *MessagesSetChatWallPaperParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSetChatWallPaperCtx(context.Background(), params)
+}
+
+// MessagesSetChatWallPaperCtx is the context-aware variant of MessagesSetChatWallPaper.
+func (c *Client) MessagesSetChatWallPaperCtx(ctx context.Context, params *MessagesSetChatWallPaperParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesSetChatWallPaper: %w", err)
 	}
@@ -13646,7 +16262,12 @@ func (*MessagesSetDefaultHistoryTtlParams) CRC() uint32 {
 
 // Changes the default value of the Time-To-Live setting, applied to all new chats.
 func (c *Client) MessagesSetDefaultHistoryTtl(period int32) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesSetDefaultHistoryTtlParams{Period: period})
+	return c.MessagesSetDefaultHistoryTtlCtx(context.Background(), period)
+}
+
+// MessagesSetDefaultHistoryTtlCtx is the context-aware variant of MessagesSetDefaultHistoryTtl.
+func (c *Client) MessagesSetDefaultHistoryTtlCtx(ctx context.Context, period int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSetDefaultHistoryTtlParams{Period: period})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesSetDefaultHistoryTtl: %w", err)
 	}
@@ -13668,7 +16289,12 @@ func (*MessagesSetDefaultReactionParams) CRC() uint32 {
 
 // Change default emoji reaction to use in the quick reaction menu: the value is synced across devices and can be fetched using help.getConfig, `reactions_default` field.
 func (c *Client) MessagesSetDefaultReaction(reaction Reaction) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesSetDefaultReactionParams{Reaction: reaction})
+	return c.MessagesSetDefaultReactionCtx(context.Background(), reaction)
+}
+
+// MessagesSetDefaultReactionCtx is the context-aware variant of MessagesSetDefaultReaction.
+func (c *Client) MessagesSetDefaultReactionCtx(ctx context.Context, reaction Reaction) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSetDefaultReactionParams{Reaction: reaction})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesSetDefaultReaction: %w", err)
 	}
@@ -13691,7 +16317,12 @@ func (*MessagesSetEncryptedTypingParams) CRC() uint32 {
 
 // Send typing event by the current user to a secret chat.
 func (c *Client) MessagesSetEncryptedTyping(peer *InputEncryptedChat, typing bool) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesSetEncryptedTypingParams{
+	return c.MessagesSetEncryptedTypingCtx(context.Background(), peer, typing)
+}
+
+// MessagesSetEncryptedTypingCtx is the context-aware variant of MessagesSetEncryptedTyping.
+func (c *Client) MessagesSetEncryptedTypingCtx(ctx context.Context, peer *InputEncryptedChat, typing bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSetEncryptedTypingParams{
 		Peer:   peer,
 		Typing: typing,
 	})
@@ -13725,7 +16356,12 @@ func (*MessagesSetGameScoreParams) FlagIndex() int {
 
 // Use this method to set the score of the specified user in a game sent as a normal message (bots only).
 func (c *Client) MessagesSetGameScore(params *MessagesSetGameScoreParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSetGameScoreCtx(context.Background(), params)
+}
+
+// MessagesSetGameScoreCtx is the context-aware variant of MessagesSetGameScore.
+func (c *Client) MessagesSetGameScoreCtx(ctx context.Context, params *MessagesSetGameScoreParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesSetGameScore: %w", err)
 	}
@@ -13748,7 +16384,12 @@ func (*MessagesSetHistoryTtlParams) CRC() uint32 {
 
 // Set maximum Time-To-Live of all messages in the specified chat
 func (c *Client) MessagesSetHistoryTtl(peer InputPeer, period int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesSetHistoryTtlParams{
+	return c.MessagesSetHistoryTtlCtx(context.Background(), peer, period)
+}
+
+// MessagesSetHistoryTtlCtx is the context-aware variant of MessagesSetHistoryTtl.
+func (c *Client) MessagesSetHistoryTtlCtx(ctx context.Context, peer InputPeer, period int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSetHistoryTtlParams{
 		Peer:   peer,
 		Period: period,
 	})
@@ -13784,7 +16425,12 @@ func (*MessagesSetInlineBotResultsParams) FlagIndex() int {
 
 // Answer an inline query, for bots only
 func (c *Client) MessagesSetInlineBotResults(params *MessagesSetInlineBotResultsParams) (bool, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSetInlineBotResultsCtx(context.Background(), params)
+}
+
+// MessagesSetInlineBotResultsCtx is the context-aware variant of MessagesSetInlineBotResults.
+func (c *Client) MessagesSetInlineBotResultsCtx(ctx context.Context, params *MessagesSetInlineBotResultsParams) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesSetInlineBotResults: %w", err)
 	}
@@ -13814,7 +16460,12 @@ func (*MessagesSetInlineGameScoreParams) FlagIndex() int {
 
 // Use this method to set the score of the specified user in a game sent as an inline message (bots only).
 func (c *Client) MessagesSetInlineGameScore(params *MessagesSetInlineGameScoreParams) (bool, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesSetInlineGameScoreCtx(context.Background(), params)
+}
+
+// MessagesSetInlineGameScoreCtx is the context-aware variant of MessagesSetInlineGameScore.
+func (c *Client) MessagesSetInlineGameScoreCtx(ctx context.Context, params *MessagesSetInlineGameScoreParams) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesSetInlineGameScore: %w", err)
 	}
@@ -13842,7 +16493,12 @@ func (*MessagesSetTypingParams) FlagIndex() int {
 
 // Sends a current user typing event (see SendMessageAction for all event types) to a conversation partner or group.
 func (c *Client) MessagesSetTyping(peer InputPeer, topMsgID int32, action SendMessageAction) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesSetTypingParams{
+	return c.MessagesSetTypingCtx(context.Background(), peer, topMsgID, action)
+}
+
+// MessagesSetTypingCtx is the context-aware variant of MessagesSetTyping.
+func (c *Client) MessagesSetTypingCtx(ctx context.Context, peer InputPeer, topMsgID int32, action SendMessageAction) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSetTypingParams{
 		Action:   action,
 		Peer:     peer,
 		TopMsgID: topMsgID,
@@ -13867,7 +16523,12 @@ func (*MessagesSetWebViewResultParams) CRC() uint32 {
 }
 
 func (c *Client) MessagesSetWebViewResult(queryID int64) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesSetWebViewResultParams{QueryID: queryID})
+	return c.MessagesSetWebViewResultCtx(context.Background(), queryID)
+}
+
+// MessagesSetWebViewResultCtx is the context-aware variant of MessagesSetWebViewResult.
+func (c *Client) MessagesSetWebViewResultCtx(ctx context.Context, queryID int64) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSetWebViewResultParams{QueryID: queryID})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesSetWebViewResult: %w", err)
 	}
@@ -13892,7 +16553,12 @@ func (*MessagesStartBotParams) CRC() uint32 {
 
 // Start a conversation with a bot using a deep linking parameter
 func (c *Client) MessagesStartBot(bot InputUser, peer InputPeer, randomID int64, startParam string) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesStartBotParams{
+	return c.MessagesStartBotCtx(context.Background(), bot, peer, randomID, startParam)
+}
+
+// MessagesStartBotCtx is the context-aware variant of MessagesStartBot.
+func (c *Client) MessagesStartBotCtx(ctx context.Context, bot InputUser, peer InputPeer, randomID int64, startParam string) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesStartBotParams{
 		Bot:        bot,
 		Peer:       peer,
 		RandomID:   randomID,
@@ -13920,7 +16586,12 @@ func (*MessagesStartHistoryImportParams) CRC() uint32 {
 
 // Complete the history import process, importing all messages into the chat. To be called only after initializing the import with messages.initHistoryImport and uploading all files using messages.uploadImportedMedia.
 func (c *Client) MessagesStartHistoryImport(peer InputPeer, importID int64) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesStartHistoryImportParams{
+	return c.MessagesStartHistoryImportCtx(context.Background(), peer, importID)
+}
+
+// MessagesStartHistoryImportCtx is the context-aware variant of MessagesStartHistoryImport.
+func (c *Client) MessagesStartHistoryImportCtx(ctx context.Context, peer InputPeer, importID int64) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesStartHistoryImportParams{
 		ImportID: importID,
 		Peer:     peer,
 	})
@@ -13950,7 +16621,12 @@ func (*MessagesSummarizeTextParams) FlagIndex() int {
 }
 
 func (c *Client) MessagesSummarizeText(peer InputPeer, id int32, toLang string) (*TextWithEntities, error) {
-	responseData, err := c.MakeRequest(&MessagesSummarizeTextParams{
+	return c.MessagesSummarizeTextCtx(context.Background(), peer, id, toLang)
+}
+
+// MessagesSummarizeTextCtx is the context-aware variant of MessagesSummarizeText.
+func (c *Client) MessagesSummarizeTextCtx(ctx context.Context, peer InputPeer, id int32, toLang string) (*TextWithEntities, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesSummarizeTextParams{
 		ID:     id,
 		Peer:   peer,
 		ToLang: toLang,
@@ -13982,7 +16658,12 @@ func (*MessagesToggleBotInAttachMenuParams) FlagIndex() int {
 
 // Enable or disable web bot attachment menu
 func (c *Client) MessagesToggleBotInAttachMenu(writeAllowed bool, bot InputUser, enabled bool) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesToggleBotInAttachMenuParams{
+	return c.MessagesToggleBotInAttachMenuCtx(context.Background(), writeAllowed, bot, enabled)
+}
+
+// MessagesToggleBotInAttachMenuCtx is the context-aware variant of MessagesToggleBotInAttachMenu.
+func (c *Client) MessagesToggleBotInAttachMenuCtx(ctx context.Context, writeAllowed bool, bot InputUser, enabled bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesToggleBotInAttachMenuParams{
 		Bot:          bot,
 		Enabled:      enabled,
 		WriteAllowed: writeAllowed,
@@ -14008,7 +16689,12 @@ func (*MessagesToggleDialogFilterTagsParams) CRC() uint32 {
 
 // Enable or disable folder tags.
 func (c *Client) MessagesToggleDialogFilterTags(enabled bool) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesToggleDialogFilterTagsParams{Enabled: enabled})
+	return c.MessagesToggleDialogFilterTagsCtx(context.Background(), enabled)
+}
+
+// MessagesToggleDialogFilterTagsCtx is the context-aware variant of MessagesToggleDialogFilterTags.
+func (c *Client) MessagesToggleDialogFilterTagsCtx(ctx context.Context, enabled bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesToggleDialogFilterTagsParams{Enabled: enabled})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesToggleDialogFilterTags: %w", err)
 	}
@@ -14035,7 +16721,12 @@ func (*MessagesToggleDialogPinParams) FlagIndex() int {
 
 // Pin/unpin a dialog
 func (c *Client) MessagesToggleDialogPin(pinned bool, peer InputDialogPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesToggleDialogPinParams{
+	return c.MessagesToggleDialogPinCtx(context.Background(), pinned, peer)
+}
+
+// MessagesToggleDialogPinCtx is the context-aware variant of MessagesToggleDialogPin.
+func (c *Client) MessagesToggleDialogPinCtx(ctx context.Context, pinned bool, peer InputDialogPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesToggleDialogPinParams{
 		Peer:   peer,
 		Pinned: pinned,
 	})
@@ -14061,7 +16752,12 @@ func (*MessagesToggleNoForwardsParams) CRC() uint32 {
 
 // Enable or disable content protection on a channel or chat
 func (c *Client) MessagesToggleNoForwards(peer InputPeer, enabled bool) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesToggleNoForwardsParams{
+	return c.MessagesToggleNoForwardsCtx(context.Background(), peer, enabled)
+}
+
+// MessagesToggleNoForwardsCtx is the context-aware variant of MessagesToggleNoForwards.
+func (c *Client) MessagesToggleNoForwardsCtx(ctx context.Context, peer InputPeer, enabled bool) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesToggleNoForwardsParams{
 		Enabled: enabled,
 		Peer:    peer,
 	})
@@ -14088,7 +16784,12 @@ func (*MessagesTogglePaidReactionPrivacyParams) CRC() uint32 {
 
 // Changes the privacy of already sent paid reactions on a specific message.
 func (c *Client) MessagesTogglePaidReactionPrivacy(peer InputPeer, msgID int32, private PaidReactionPrivacy) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesTogglePaidReactionPrivacyParams{
+	return c.MessagesTogglePaidReactionPrivacyCtx(context.Background(), peer, msgID, private)
+}
+
+// MessagesTogglePaidReactionPrivacyCtx is the context-aware variant of MessagesTogglePaidReactionPrivacy.
+func (c *Client) MessagesTogglePaidReactionPrivacyCtx(ctx context.Context, peer InputPeer, msgID int32, private PaidReactionPrivacy) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesTogglePaidReactionPrivacyParams{
 		MsgID:   msgID,
 		Peer:    peer,
 		Private: private,
@@ -14119,7 +16820,12 @@ func (*MessagesTogglePeerTranslationsParams) FlagIndex() int {
 
 // Show or hide the real-time chat translation popup for a certain chat
 func (c *Client) MessagesTogglePeerTranslations(disabled bool, peer InputPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesTogglePeerTranslationsParams{
+	return c.MessagesTogglePeerTranslationsCtx(context.Background(), disabled, peer)
+}
+
+// MessagesTogglePeerTranslationsCtx is the context-aware variant of MessagesTogglePeerTranslations.
+func (c *Client) MessagesTogglePeerTranslationsCtx(ctx context.Context, disabled bool, peer InputPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesTogglePeerTranslationsParams{
 		Disabled: disabled,
 		Peer:     peer,
 	})
@@ -14149,7 +16855,12 @@ func (*MessagesToggleSavedDialogPinParams) FlagIndex() int {
 
 // Pin or unpin a saved message dialog.
 func (c *Client) MessagesToggleSavedDialogPin(pinned bool, peer InputDialogPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesToggleSavedDialogPinParams{
+	return c.MessagesToggleSavedDialogPinCtx(context.Background(), pinned, peer)
+}
+
+// MessagesToggleSavedDialogPinCtx is the context-aware variant of MessagesToggleSavedDialogPin.
+func (c *Client) MessagesToggleSavedDialogPinCtx(ctx context.Context, pinned bool, peer InputDialogPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesToggleSavedDialogPinParams{
 		Peer:   peer,
 		Pinned: pinned,
 	})
@@ -14181,7 +16892,12 @@ func (*MessagesToggleStickerSetsParams) FlagIndex() int {
 
 // Apply changes to multiple stickersets
 func (c *Client) MessagesToggleStickerSets(uninstall, archive, unarchive bool, stickersets []InputStickerSet) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesToggleStickerSetsParams{
+	return c.MessagesToggleStickerSetsCtx(context.Background(), uninstall, archive, unarchive, stickersets)
+}
+
+// MessagesToggleStickerSetsCtx is the context-aware variant of MessagesToggleStickerSets.
+func (c *Client) MessagesToggleStickerSetsCtx(ctx context.Context, uninstall, archive, unarchive bool, stickersets []InputStickerSet) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesToggleStickerSetsParams{
 		Archive:     archive,
 		Stickersets: stickersets,
 		Unarchive:   unarchive,
@@ -14216,7 +16932,12 @@ func (*MessagesToggleSuggestedPostApprovalParams) FlagIndex() int {
 
 // Approve or reject a suggested post.
 func (c *Client) MessagesToggleSuggestedPostApproval(params *MessagesToggleSuggestedPostApprovalParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesToggleSuggestedPostApprovalCtx(context.Background(), params)
+}
+
+// MessagesToggleSuggestedPostApprovalCtx is the context-aware variant of MessagesToggleSuggestedPostApproval.
+func (c *Client) MessagesToggleSuggestedPostApprovalCtx(ctx context.Context, params *MessagesToggleSuggestedPostApprovalParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesToggleSuggestedPostApproval: %w", err)
 	}
@@ -14241,7 +16962,12 @@ func (*MessagesToggleTodoCompletedParams) CRC() uint32 {
 
 // Mark one or more items of a todo list as completed or not completed.
 func (c *Client) MessagesToggleTodoCompleted(peer InputPeer, msgID int32, completed, incompleted []int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesToggleTodoCompletedParams{
+	return c.MessagesToggleTodoCompletedCtx(context.Background(), peer, msgID, completed, incompleted)
+}
+
+// MessagesToggleTodoCompletedCtx is the context-aware variant of MessagesToggleTodoCompleted.
+func (c *Client) MessagesToggleTodoCompletedCtx(ctx context.Context, peer InputPeer, msgID int32, completed, incompleted []int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesToggleTodoCompletedParams{
 		Completed:   completed,
 		Incompleted: incompleted,
 		MsgID:       msgID,
@@ -14269,7 +16995,12 @@ func (*MessagesTranscribeAudioParams) CRC() uint32 {
 
 // Transcribe voice message
 func (c *Client) MessagesTranscribeAudio(peer InputPeer, msgID int32) (*MessagesTranscribedAudio, error) {
-	responseData, err := c.MakeRequest(&MessagesTranscribeAudioParams{
+	return c.MessagesTranscribeAudioCtx(context.Background(), peer, msgID)
+}
+
+// MessagesTranscribeAudioCtx is the context-aware variant of MessagesTranscribeAudio.
+func (c *Client) MessagesTranscribeAudioCtx(ctx context.Context, peer InputPeer, msgID int32) (*MessagesTranscribedAudio, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesTranscribeAudioParams{
 		MsgID: msgID,
 		Peer:  peer,
 	})
@@ -14301,7 +17032,12 @@ func (*MessagesTranslateTextParams) FlagIndex() int {
 
 // Translate a given text.
 func (c *Client) MessagesTranslateText(peer InputPeer, id []int32, text []*TextWithEntities, toLang string) (*MessagesTranslateResult, error) {
-	responseData, err := c.MakeRequest(&MessagesTranslateTextParams{
+	return c.MessagesTranslateTextCtx(context.Background(), peer, id, text, toLang)
+}
+
+// MessagesTranslateTextCtx is the context-aware variant of MessagesTranslateText.
+func (c *Client) MessagesTranslateTextCtx(ctx context.Context, peer InputPeer, id []int32, text []*TextWithEntities, toLang string) (*MessagesTranslateResult, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesTranslateTextParams{
 		ID:     id,
 		Peer:   peer,
 		Text:   text,
@@ -14328,7 +17064,12 @@ func (*MessagesUninstallStickerSetParams) CRC() uint32 {
 
 // Uninstall a stickerset
 func (c *Client) MessagesUninstallStickerSet(stickerset InputStickerSet) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesUninstallStickerSetParams{Stickerset: stickerset})
+	return c.MessagesUninstallStickerSetCtx(context.Background(), stickerset)
+}
+
+// MessagesUninstallStickerSetCtx is the context-aware variant of MessagesUninstallStickerSet.
+func (c *Client) MessagesUninstallStickerSetCtx(ctx context.Context, stickerset InputStickerSet) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesUninstallStickerSetParams{Stickerset: stickerset})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesUninstallStickerSet: %w", err)
 	}
@@ -14356,7 +17097,12 @@ func (*MessagesUnpinAllMessagesParams) FlagIndex() int {
 
 // Unpin all pinned messages
 func (c *Client) MessagesUnpinAllMessages(peer InputPeer, topMsgID int32, savedPeerID InputPeer) (*MessagesAffectedHistory, error) {
-	responseData, err := c.MakeRequest(&MessagesUnpinAllMessagesParams{
+	return c.MessagesUnpinAllMessagesCtx(context.Background(), peer, topMsgID, savedPeerID)
+}
+
+// MessagesUnpinAllMessagesCtx is the context-aware variant of MessagesUnpinAllMessages.
+func (c *Client) MessagesUnpinAllMessagesCtx(ctx context.Context, peer InputPeer, topMsgID int32, savedPeerID InputPeer) (*MessagesAffectedHistory, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesUnpinAllMessagesParams{
 		Peer:        peer,
 		SavedPeerID: savedPeerID,
 		TopMsgID:    topMsgID,
@@ -14387,7 +17133,12 @@ func (*MessagesUpdateDialogFilterParams) FlagIndex() int {
 
 // Update folder
 func (c *Client) MessagesUpdateDialogFilter(id int32, filter DialogFilter) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesUpdateDialogFilterParams{
+	return c.MessagesUpdateDialogFilterCtx(context.Background(), id, filter)
+}
+
+// MessagesUpdateDialogFilterCtx is the context-aware variant of MessagesUpdateDialogFilter.
+func (c *Client) MessagesUpdateDialogFilterCtx(ctx context.Context, id int32, filter DialogFilter) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesUpdateDialogFilterParams{
 		Filter: filter,
 		ID:     id,
 	})
@@ -14412,7 +17163,12 @@ func (*MessagesUpdateDialogFiltersOrderParams) CRC() uint32 {
 
 // Reorder folders
 func (c *Client) MessagesUpdateDialogFiltersOrder(order []int32) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesUpdateDialogFiltersOrderParams{Order: order})
+	return c.MessagesUpdateDialogFiltersOrderCtx(context.Background(), order)
+}
+
+// MessagesUpdateDialogFiltersOrderCtx is the context-aware variant of MessagesUpdateDialogFiltersOrder.
+func (c *Client) MessagesUpdateDialogFiltersOrderCtx(ctx context.Context, order []int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesUpdateDialogFiltersOrderParams{Order: order})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesUpdateDialogFiltersOrder: %w", err)
 	}
@@ -14435,7 +17191,12 @@ func (*MessagesUpdatePinnedForumTopicParams) CRC() uint32 {
 }
 
 func (c *Client) MessagesUpdatePinnedForumTopic(peer InputPeer, topicID int32, pinned bool) (Updates, error) {
-	responseData, err := c.MakeRequest(&MessagesUpdatePinnedForumTopicParams{
+	return c.MessagesUpdatePinnedForumTopicCtx(context.Background(), peer, topicID, pinned)
+}
+
+// MessagesUpdatePinnedForumTopicCtx is the context-aware variant of MessagesUpdatePinnedForumTopic.
+func (c *Client) MessagesUpdatePinnedForumTopicCtx(ctx context.Context, peer InputPeer, topicID int32, pinned bool) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesUpdatePinnedForumTopicParams{
 		Peer:    peer,
 		Pinned:  pinned,
 		TopicID: topicID,
@@ -14469,7 +17230,12 @@ func (*MessagesUpdatePinnedMessageParams) FlagIndex() int {
 
 // Pin a message
 func (c *Client) MessagesUpdatePinnedMessage(params *MessagesUpdatePinnedMessageParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.MessagesUpdatePinnedMessageCtx(context.Background(), params)
+}
+
+// MessagesUpdatePinnedMessageCtx is the context-aware variant of MessagesUpdatePinnedMessage.
+func (c *Client) MessagesUpdatePinnedMessageCtx(ctx context.Context, params *MessagesUpdatePinnedMessageParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending MessagesUpdatePinnedMessage: %w", err)
 	}
@@ -14496,7 +17262,12 @@ func (*MessagesUpdateSavedReactionTagParams) FlagIndex() int {
 
 // Update the description of a saved message tag.
 func (c *Client) MessagesUpdateSavedReactionTag(reaction Reaction, title string) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesUpdateSavedReactionTagParams{
+	return c.MessagesUpdateSavedReactionTagCtx(context.Background(), reaction, title)
+}
+
+// MessagesUpdateSavedReactionTagCtx is the context-aware variant of MessagesUpdateSavedReactionTag.
+func (c *Client) MessagesUpdateSavedReactionTagCtx(ctx context.Context, reaction Reaction, title string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesUpdateSavedReactionTagParams{
 		Reaction: reaction,
 		Title:    title,
 	})
@@ -14522,7 +17293,12 @@ func (*MessagesUploadEncryptedFileParams) CRC() uint32 {
 
 // Upload encrypted file and associate it to a secret chat (without actually sending it to the chat).
 func (c *Client) MessagesUploadEncryptedFile(peer *InputEncryptedChat, file InputEncryptedFile) (EncryptedFile, error) {
-	responseData, err := c.MakeRequest(&MessagesUploadEncryptedFileParams{
+	return c.MessagesUploadEncryptedFileCtx(context.Background(), peer, file)
+}
+
+// MessagesUploadEncryptedFileCtx is the context-aware variant of MessagesUploadEncryptedFile.
+func (c *Client) MessagesUploadEncryptedFileCtx(ctx context.Context, peer *InputEncryptedChat, file InputEncryptedFile) (EncryptedFile, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesUploadEncryptedFileParams{
 		File: file,
 		Peer: peer,
 	})
@@ -14550,7 +17326,12 @@ func (*MessagesUploadImportedMediaParams) CRC() uint32 {
 
 // Upload a media file associated with an imported chat, click here for more info.
 func (c *Client) MessagesUploadImportedMedia(peer InputPeer, importID int64, fileName string, media InputMedia) (MessageMedia, error) {
-	responseData, err := c.MakeRequest(&MessagesUploadImportedMediaParams{
+	return c.MessagesUploadImportedMediaCtx(context.Background(), peer, importID, fileName, media)
+}
+
+// MessagesUploadImportedMediaCtx is the context-aware variant of MessagesUploadImportedMedia.
+func (c *Client) MessagesUploadImportedMediaCtx(ctx context.Context, peer InputPeer, importID int64, fileName string, media InputMedia) (MessageMedia, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesUploadImportedMediaParams{
 		FileName: fileName,
 		ImportID: importID,
 		Media:    media,
@@ -14583,7 +17364,12 @@ func (*MessagesUploadMediaParams) FlagIndex() int {
 
 // Upload a file and associate it to a chat (without actually sending it to the chat)
 func (c *Client) MessagesUploadMedia(businessConnectionID string, peer InputPeer, media InputMedia) (MessageMedia, error) {
-	responseData, err := c.MakeRequest(&MessagesUploadMediaParams{
+	return c.MessagesUploadMediaCtx(context.Background(), businessConnectionID, peer, media)
+}
+
+// MessagesUploadMediaCtx is the context-aware variant of MessagesUploadMedia.
+func (c *Client) MessagesUploadMediaCtx(ctx context.Context, businessConnectionID string, peer InputPeer, media InputMedia) (MessageMedia, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesUploadMediaParams{
 		BusinessConnectionID: businessConnectionID,
 		Media:                media,
 		Peer:                 peer,
@@ -14609,7 +17395,12 @@ func (*MessagesViewSponsoredMessageParams) CRC() uint32 {
 
 // Mark a specific sponsored message as read
 func (c *Client) MessagesViewSponsoredMessage(randomID []byte) (bool, error) {
-	responseData, err := c.MakeRequest(&MessagesViewSponsoredMessageParams{RandomID: randomID})
+	return c.MessagesViewSponsoredMessageCtx(context.Background(), randomID)
+}
+
+// MessagesViewSponsoredMessageCtx is the context-aware variant of MessagesViewSponsoredMessage.
+func (c *Client) MessagesViewSponsoredMessageCtx(ctx context.Context, randomID []byte) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &MessagesViewSponsoredMessageParams{RandomID: randomID})
 	if err != nil {
 		return false, fmt.Errorf("sending MessagesViewSponsoredMessage: %w", err)
 	}
@@ -14631,7 +17422,12 @@ func (*PaymentsApplyGiftCodeParams) CRC() uint32 {
 
 // Apply a Telegram Premium giftcode
 func (c *Client) PaymentsApplyGiftCode(slug string) (Updates, error) {
-	responseData, err := c.MakeRequest(&PaymentsApplyGiftCodeParams{Slug: slug})
+	return c.PaymentsApplyGiftCodeCtx(context.Background(), slug)
+}
+
+// PaymentsApplyGiftCodeCtx is the context-aware variant of PaymentsApplyGiftCode.
+func (c *Client) PaymentsApplyGiftCodeCtx(ctx context.Context, slug string) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsApplyGiftCodeParams{Slug: slug})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsApplyGiftCode: %w", err)
 	}
@@ -14654,7 +17450,12 @@ func (*PaymentsAssignAppStoreTransactionParams) CRC() uint32 {
 
 // Informs server about a purchase made through the App Store: for official applications only.
 func (c *Client) PaymentsAssignAppStoreTransaction(receipt []byte, purpose InputStorePaymentPurpose) (Updates, error) {
-	responseData, err := c.MakeRequest(&PaymentsAssignAppStoreTransactionParams{
+	return c.PaymentsAssignAppStoreTransactionCtx(context.Background(), receipt, purpose)
+}
+
+// PaymentsAssignAppStoreTransactionCtx is the context-aware variant of PaymentsAssignAppStoreTransaction.
+func (c *Client) PaymentsAssignAppStoreTransactionCtx(ctx context.Context, receipt []byte, purpose InputStorePaymentPurpose) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsAssignAppStoreTransactionParams{
 		Purpose: purpose,
 		Receipt: receipt,
 	})
@@ -14680,7 +17481,12 @@ func (*PaymentsAssignPlayMarketTransactionParams) CRC() uint32 {
 
 // Informs server about a purchase made through the Play Store: for official applications only.
 func (c *Client) PaymentsAssignPlayMarketTransaction(receipt *DataJson, purpose InputStorePaymentPurpose) (Updates, error) {
-	responseData, err := c.MakeRequest(&PaymentsAssignPlayMarketTransactionParams{
+	return c.PaymentsAssignPlayMarketTransactionCtx(context.Background(), receipt, purpose)
+}
+
+// PaymentsAssignPlayMarketTransactionCtx is the context-aware variant of PaymentsAssignPlayMarketTransaction.
+func (c *Client) PaymentsAssignPlayMarketTransactionCtx(ctx context.Context, receipt *DataJson, purpose InputStorePaymentPurpose) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsAssignPlayMarketTransactionParams{
 		Purpose: purpose,
 		Receipt: receipt,
 	})
@@ -14711,7 +17517,12 @@ func (*PaymentsBotCancelStarsSubscriptionParams) FlagIndex() int {
 
 // Cancel a bot subscription
 func (c *Client) PaymentsBotCancelStarsSubscription(restore bool, userID InputUser, chargeID string) (bool, error) {
-	responseData, err := c.MakeRequest(&PaymentsBotCancelStarsSubscriptionParams{
+	return c.PaymentsBotCancelStarsSubscriptionCtx(context.Background(), restore, userID, chargeID)
+}
+
+// PaymentsBotCancelStarsSubscriptionCtx is the context-aware variant of PaymentsBotCancelStarsSubscription.
+func (c *Client) PaymentsBotCancelStarsSubscriptionCtx(ctx context.Context, restore bool, userID InputUser, chargeID string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsBotCancelStarsSubscriptionParams{
 		ChargeID: chargeID,
 		Restore:  restore,
 		UserID:   userID,
@@ -14737,7 +17548,12 @@ func (*PaymentsCanPurchaseStoreParams) CRC() uint32 {
 
 // Checks whether a purchase is possible. Must be called before in-store purchase, official apps only.
 func (c *Client) PaymentsCanPurchaseStore(purpose InputStorePaymentPurpose) (bool, error) {
-	responseData, err := c.MakeRequest(&PaymentsCanPurchaseStoreParams{Purpose: purpose})
+	return c.PaymentsCanPurchaseStoreCtx(context.Background(), purpose)
+}
+
+// PaymentsCanPurchaseStoreCtx is the context-aware variant of PaymentsCanPurchaseStore.
+func (c *Client) PaymentsCanPurchaseStoreCtx(ctx context.Context, purpose InputStorePaymentPurpose) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsCanPurchaseStoreParams{Purpose: purpose})
 	if err != nil {
 		return false, fmt.Errorf("sending PaymentsCanPurchaseStore: %w", err)
 	}
@@ -14765,7 +17581,12 @@ func (*PaymentsChangeStarsSubscriptionParams) FlagIndex() int {
 
 // Activate or deactivate a Telegram Star subscription.
 func (c *Client) PaymentsChangeStarsSubscription(peer InputPeer, subscriptionID string, canceled bool) (bool, error) {
-	responseData, err := c.MakeRequest(&PaymentsChangeStarsSubscriptionParams{
+	return c.PaymentsChangeStarsSubscriptionCtx(context.Background(), peer, subscriptionID, canceled)
+}
+
+// PaymentsChangeStarsSubscriptionCtx is the context-aware variant of PaymentsChangeStarsSubscription.
+func (c *Client) PaymentsChangeStarsSubscriptionCtx(ctx context.Context, peer InputPeer, subscriptionID string, canceled bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsChangeStarsSubscriptionParams{
 		Canceled:       canceled,
 		Peer:           peer,
 		SubscriptionID: subscriptionID,
@@ -14791,7 +17612,12 @@ func (*PaymentsCheckCanSendGiftParams) CRC() uint32 {
 
 // Check if the specified gift can be sent.
 func (c *Client) PaymentsCheckCanSendGift(giftID int64) (PaymentsCheckCanSendGiftResult, error) {
-	responseData, err := c.MakeRequest(&PaymentsCheckCanSendGiftParams{GiftID: giftID})
+	return c.PaymentsCheckCanSendGiftCtx(context.Background(), giftID)
+}
+
+// PaymentsCheckCanSendGiftCtx is the context-aware variant of PaymentsCheckCanSendGift.
+func (c *Client) PaymentsCheckCanSendGiftCtx(ctx context.Context, giftID int64) (PaymentsCheckCanSendGiftResult, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsCheckCanSendGiftParams{GiftID: giftID})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsCheckCanSendGift: %w", err)
 	}
@@ -14813,7 +17639,12 @@ func (*PaymentsCheckGiftCodeParams) CRC() uint32 {
 
 // Obtain information about a Telegram Premium giftcode
 func (c *Client) PaymentsCheckGiftCode(slug string) (*PaymentsCheckedGiftCode, error) {
-	responseData, err := c.MakeRequest(&PaymentsCheckGiftCodeParams{Slug: slug})
+	return c.PaymentsCheckGiftCodeCtx(context.Background(), slug)
+}
+
+// PaymentsCheckGiftCodeCtx is the context-aware variant of PaymentsCheckGiftCode.
+func (c *Client) PaymentsCheckGiftCodeCtx(ctx context.Context, slug string) (*PaymentsCheckedGiftCode, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsCheckGiftCodeParams{Slug: slug})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsCheckGiftCode: %w", err)
 	}
@@ -14840,7 +17671,12 @@ func (*PaymentsClearSavedInfoParams) FlagIndex() int {
 
 // Clear saved payment information
 func (c *Client) PaymentsClearSavedInfo(credentials, info bool) (bool, error) {
-	responseData, err := c.MakeRequest(&PaymentsClearSavedInfoParams{
+	return c.PaymentsClearSavedInfoCtx(context.Background(), credentials, info)
+}
+
+// PaymentsClearSavedInfoCtx is the context-aware variant of PaymentsClearSavedInfo.
+func (c *Client) PaymentsClearSavedInfoCtx(ctx context.Context, credentials, info bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsClearSavedInfoParams{
 		Credentials: credentials,
 		Info:        info,
 	})
@@ -14866,7 +17702,12 @@ func (*PaymentsConnectStarRefBotParams) CRC() uint32 {
 
 // Join a bot's affiliate program, becoming an affiliate
 func (c *Client) PaymentsConnectStarRefBot(peer InputPeer, bot InputUser) (*PaymentsConnectedStarRefBots, error) {
-	responseData, err := c.MakeRequest(&PaymentsConnectStarRefBotParams{
+	return c.PaymentsConnectStarRefBotCtx(context.Background(), peer, bot)
+}
+
+// PaymentsConnectStarRefBotCtx is the context-aware variant of PaymentsConnectStarRefBot.
+func (c *Client) PaymentsConnectStarRefBotCtx(ctx context.Context, peer InputPeer, bot InputUser) (*PaymentsConnectedStarRefBots, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsConnectStarRefBotParams{
 		Bot:  bot,
 		Peer: peer,
 	})
@@ -14891,7 +17732,12 @@ func (*PaymentsConvertStarGiftParams) CRC() uint32 {
 
 // Convert a received gift into Telegram Stars: this will permanently destroy the gift, converting it into starGift.`convert_stars` Telegram Stars, added to the user's balance.
 func (c *Client) PaymentsConvertStarGift(stargift InputSavedStarGift) (bool, error) {
-	responseData, err := c.MakeRequest(&PaymentsConvertStarGiftParams{Stargift: stargift})
+	return c.PaymentsConvertStarGiftCtx(context.Background(), stargift)
+}
+
+// PaymentsConvertStarGiftCtx is the context-aware variant of PaymentsConvertStarGift.
+func (c *Client) PaymentsConvertStarGiftCtx(ctx context.Context, stargift InputSavedStarGift) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsConvertStarGiftParams{Stargift: stargift})
 	if err != nil {
 		return false, fmt.Errorf("sending PaymentsConvertStarGift: %w", err)
 	}
@@ -14915,7 +17761,12 @@ func (*PaymentsCreateStarGiftCollectionParams) CRC() uint32 {
 
 // Create a star gift collection.
 func (c *Client) PaymentsCreateStarGiftCollection(peer InputPeer, title string, stargift []InputSavedStarGift) (*StarGiftCollection, error) {
-	responseData, err := c.MakeRequest(&PaymentsCreateStarGiftCollectionParams{
+	return c.PaymentsCreateStarGiftCollectionCtx(context.Background(), peer, title, stargift)
+}
+
+// PaymentsCreateStarGiftCollectionCtx is the context-aware variant of PaymentsCreateStarGiftCollection.
+func (c *Client) PaymentsCreateStarGiftCollectionCtx(ctx context.Context, peer InputPeer, title string, stargift []InputSavedStarGift) (*StarGiftCollection, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsCreateStarGiftCollectionParams{
 		Peer:     peer,
 		Stargift: stargift,
 		Title:    title,
@@ -14942,7 +17793,12 @@ func (*PaymentsDeleteStarGiftCollectionParams) CRC() uint32 {
 
 // Delete a star gift collection.
 func (c *Client) PaymentsDeleteStarGiftCollection(peer InputPeer, collectionID int32) (bool, error) {
-	responseData, err := c.MakeRequest(&PaymentsDeleteStarGiftCollectionParams{
+	return c.PaymentsDeleteStarGiftCollectionCtx(context.Background(), peer, collectionID)
+}
+
+// PaymentsDeleteStarGiftCollectionCtx is the context-aware variant of PaymentsDeleteStarGiftCollection.
+func (c *Client) PaymentsDeleteStarGiftCollectionCtx(ctx context.Context, peer InputPeer, collectionID int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsDeleteStarGiftCollectionParams{
 		CollectionID: collectionID,
 		Peer:         peer,
 	})
@@ -14973,7 +17829,12 @@ func (*PaymentsEditConnectedStarRefBotParams) FlagIndex() int {
 
 // Leave a bot's affiliate program
 func (c *Client) PaymentsEditConnectedStarRefBot(revoked bool, peer InputPeer, link string) (*PaymentsConnectedStarRefBots, error) {
-	responseData, err := c.MakeRequest(&PaymentsEditConnectedStarRefBotParams{
+	return c.PaymentsEditConnectedStarRefBotCtx(context.Background(), revoked, peer, link)
+}
+
+// PaymentsEditConnectedStarRefBotCtx is the context-aware variant of PaymentsEditConnectedStarRefBot.
+func (c *Client) PaymentsEditConnectedStarRefBotCtx(ctx context.Context, revoked bool, peer InputPeer, link string) (*PaymentsConnectedStarRefBots, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsEditConnectedStarRefBotParams{
 		Link:    link,
 		Peer:    peer,
 		Revoked: revoked,
@@ -14999,7 +17860,12 @@ func (*PaymentsExportInvoiceParams) CRC() uint32 {
 
 // Generate an invoice deep link
 func (c *Client) PaymentsExportInvoice(invoiceMedia InputMedia) (*PaymentsExportedInvoice, error) {
-	responseData, err := c.MakeRequest(&PaymentsExportInvoiceParams{InvoiceMedia: invoiceMedia})
+	return c.PaymentsExportInvoiceCtx(context.Background(), invoiceMedia)
+}
+
+// PaymentsExportInvoiceCtx is the context-aware variant of PaymentsExportInvoice.
+func (c *Client) PaymentsExportInvoiceCtx(ctx context.Context, invoiceMedia InputMedia) (*PaymentsExportedInvoice, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsExportInvoiceParams{InvoiceMedia: invoiceMedia})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsExportInvoice: %w", err)
 	}
@@ -15022,7 +17888,12 @@ func (*PaymentsFulfillStarsSubscriptionParams) CRC() uint32 {
 
 // Re-join a private channel associated to an active Telegram Star subscription.
 func (c *Client) PaymentsFulfillStarsSubscription(peer InputPeer, subscriptionID string) (bool, error) {
-	responseData, err := c.MakeRequest(&PaymentsFulfillStarsSubscriptionParams{
+	return c.PaymentsFulfillStarsSubscriptionCtx(context.Background(), peer, subscriptionID)
+}
+
+// PaymentsFulfillStarsSubscriptionCtx is the context-aware variant of PaymentsFulfillStarsSubscription.
+func (c *Client) PaymentsFulfillStarsSubscriptionCtx(ctx context.Context, peer InputPeer, subscriptionID string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsFulfillStarsSubscriptionParams{
 		Peer:           peer,
 		SubscriptionID: subscriptionID,
 	})
@@ -15047,7 +17918,12 @@ func (*PaymentsGetBankCardDataParams) CRC() uint32 {
 
 // Get info about a credit card
 func (c *Client) PaymentsGetBankCardData(number string) (*PaymentsBankCardData, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetBankCardDataParams{Number: number})
+	return c.PaymentsGetBankCardDataCtx(context.Background(), number)
+}
+
+// PaymentsGetBankCardDataCtx is the context-aware variant of PaymentsGetBankCardData.
+func (c *Client) PaymentsGetBankCardDataCtx(ctx context.Context, number string) (*PaymentsBankCardData, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetBankCardDataParams{Number: number})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetBankCardData: %w", err)
 	}
@@ -15070,7 +17946,12 @@ func (*PaymentsGetConnectedStarRefBotParams) CRC() uint32 {
 
 // Fetch info about a specific bot affiliation
 func (c *Client) PaymentsGetConnectedStarRefBot(peer InputPeer, bot InputUser) (*PaymentsConnectedStarRefBots, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetConnectedStarRefBotParams{
+	return c.PaymentsGetConnectedStarRefBotCtx(context.Background(), peer, bot)
+}
+
+// PaymentsGetConnectedStarRefBotCtx is the context-aware variant of PaymentsGetConnectedStarRefBot.
+func (c *Client) PaymentsGetConnectedStarRefBotCtx(ctx context.Context, peer InputPeer, bot InputUser) (*PaymentsConnectedStarRefBots, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetConnectedStarRefBotParams{
 		Bot:  bot,
 		Peer: peer,
 	})
@@ -15102,7 +17983,12 @@ func (*PaymentsGetConnectedStarRefBotsParams) FlagIndex() int {
 
 // Fetch all affiliations we have created for a certain peer
 func (c *Client) PaymentsGetConnectedStarRefBots(peer InputPeer, offsetDate int32, offsetLink string, limit int32) (*PaymentsConnectedStarRefBots, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetConnectedStarRefBotsParams{
+	return c.PaymentsGetConnectedStarRefBotsCtx(context.Background(), peer, offsetDate, offsetLink, limit)
+}
+
+// PaymentsGetConnectedStarRefBotsCtx is the context-aware variant of PaymentsGetConnectedStarRefBots.
+func (c *Client) PaymentsGetConnectedStarRefBotsCtx(ctx context.Context, peer InputPeer, offsetDate int32, offsetLink string, limit int32) (*PaymentsConnectedStarRefBots, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetConnectedStarRefBotsParams{
 		Limit:      limit,
 		OffsetDate: offsetDate,
 		OffsetLink: offsetLink,
@@ -15130,7 +18016,12 @@ func (*PaymentsGetGiveawayInfoParams) CRC() uint32 {
 
 // Obtain information about a Telegram Premium giveaway.
 func (c *Client) PaymentsGetGiveawayInfo(peer InputPeer, msgID int32) (PaymentsGiveawayInfo, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetGiveawayInfoParams{
+	return c.PaymentsGetGiveawayInfoCtx(context.Background(), peer, msgID)
+}
+
+// PaymentsGetGiveawayInfoCtx is the context-aware variant of PaymentsGetGiveawayInfo.
+func (c *Client) PaymentsGetGiveawayInfoCtx(ctx context.Context, peer InputPeer, msgID int32) (PaymentsGiveawayInfo, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetGiveawayInfoParams{
 		MsgID: msgID,
 		Peer:  peer,
 	})
@@ -15160,7 +18051,12 @@ func (*PaymentsGetPaymentFormParams) FlagIndex() int {
 
 // Get a payment form
 func (c *Client) PaymentsGetPaymentForm(invoice InputInvoice, themeParams *DataJson) (PaymentsPaymentForm, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetPaymentFormParams{
+	return c.PaymentsGetPaymentFormCtx(context.Background(), invoice, themeParams)
+}
+
+// PaymentsGetPaymentFormCtx is the context-aware variant of PaymentsGetPaymentForm.
+func (c *Client) PaymentsGetPaymentFormCtx(ctx context.Context, invoice InputInvoice, themeParams *DataJson) (PaymentsPaymentForm, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetPaymentFormParams{
 		Invoice:     invoice,
 		ThemeParams: themeParams,
 	})
@@ -15186,7 +18082,12 @@ func (*PaymentsGetPaymentReceiptParams) CRC() uint32 {
 
 // Get payment receipt
 func (c *Client) PaymentsGetPaymentReceipt(peer InputPeer, msgID int32) (PaymentsPaymentReceipt, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetPaymentReceiptParams{
+	return c.PaymentsGetPaymentReceiptCtx(context.Background(), peer, msgID)
+}
+
+// PaymentsGetPaymentReceiptCtx is the context-aware variant of PaymentsGetPaymentReceipt.
+func (c *Client) PaymentsGetPaymentReceiptCtx(ctx context.Context, peer InputPeer, msgID int32) (PaymentsPaymentReceipt, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetPaymentReceiptParams{
 		MsgID: msgID,
 		Peer:  peer,
 	})
@@ -15215,7 +18116,12 @@ func (*PaymentsGetPremiumGiftCodeOptionsParams) FlagIndex() int {
 
 // Obtain a list of Telegram Premium giveaway/gift code options.
 func (c *Client) PaymentsGetPremiumGiftCodeOptions(boostPeer InputPeer) ([]*PremiumGiftCodeOption, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetPremiumGiftCodeOptionsParams{BoostPeer: boostPeer})
+	return c.PaymentsGetPremiumGiftCodeOptionsCtx(context.Background(), boostPeer)
+}
+
+// PaymentsGetPremiumGiftCodeOptionsCtx is the context-aware variant of PaymentsGetPremiumGiftCodeOptions.
+func (c *Client) PaymentsGetPremiumGiftCodeOptionsCtx(ctx context.Context, boostPeer InputPeer) ([]*PremiumGiftCodeOption, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetPremiumGiftCodeOptionsParams{BoostPeer: boostPeer})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetPremiumGiftCodeOptions: %w", err)
 	}
@@ -15248,7 +18154,12 @@ func (*PaymentsGetResaleStarGiftsParams) FlagIndex() int {
 
 // Get collectible gifts of a specific type currently on resale
 func (c *Client) PaymentsGetResaleStarGifts(params *PaymentsGetResaleStarGiftsParams) (*PaymentsResaleStarGifts, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PaymentsGetResaleStarGiftsCtx(context.Background(), params)
+}
+
+// PaymentsGetResaleStarGiftsCtx is the context-aware variant of PaymentsGetResaleStarGifts.
+func (c *Client) PaymentsGetResaleStarGiftsCtx(ctx context.Context, params *PaymentsGetResaleStarGiftsParams) (*PaymentsResaleStarGifts, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetResaleStarGifts: %w", err)
 	}
@@ -15268,7 +18179,12 @@ func (*PaymentsGetSavedInfoParams) CRC() uint32 {
 
 // Get saved payment information
 func (c *Client) PaymentsGetSavedInfo() (*PaymentsSavedInfo, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetSavedInfoParams{})
+	return c.PaymentsGetSavedInfoCtx(context.Background())
+}
+
+// PaymentsGetSavedInfoCtx is the context-aware variant of PaymentsGetSavedInfo.
+func (c *Client) PaymentsGetSavedInfoCtx(ctx context.Context) (*PaymentsSavedInfo, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetSavedInfoParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetSavedInfo: %w", err)
 	}
@@ -15290,7 +18206,12 @@ func (*PaymentsGetSavedStarGiftParams) CRC() uint32 {
 
 // Fetch info about specific gifts owned by a peer we control.
 func (c *Client) PaymentsGetSavedStarGift(stargift []InputSavedStarGift) (*PaymentsSavedStarGifts, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetSavedStarGiftParams{Stargift: stargift})
+	return c.PaymentsGetSavedStarGiftCtx(context.Background(), stargift)
+}
+
+// PaymentsGetSavedStarGiftCtx is the context-aware variant of PaymentsGetSavedStarGift.
+func (c *Client) PaymentsGetSavedStarGiftCtx(ctx context.Context, stargift []InputSavedStarGift) (*PaymentsSavedStarGifts, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetSavedStarGiftParams{Stargift: stargift})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetSavedStarGift: %w", err)
 	}
@@ -15328,7 +18249,12 @@ func (*PaymentsGetSavedStarGiftsParams) FlagIndex() int {
 
 // Fetch the full list of gifts owned by a peer.
 func (c *Client) PaymentsGetSavedStarGifts(params *PaymentsGetSavedStarGiftsParams) (*PaymentsSavedStarGifts, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PaymentsGetSavedStarGiftsCtx(context.Background(), params)
+}
+
+// PaymentsGetSavedStarGiftsCtx is the context-aware variant of PaymentsGetSavedStarGifts.
+func (c *Client) PaymentsGetSavedStarGiftsCtx(ctx context.Context, params *PaymentsGetSavedStarGiftsParams) (*PaymentsSavedStarGifts, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetSavedStarGifts: %w", err)
 	}
@@ -15349,7 +18275,12 @@ func (*PaymentsGetStarGiftActiveAuctionsParams) CRC() uint32 {
 }
 
 func (c *Client) PaymentsGetStarGiftActiveAuctions(hash int64) (PaymentsStarGiftActiveAuctions, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarGiftActiveAuctionsParams{Hash: hash})
+	return c.PaymentsGetStarGiftActiveAuctionsCtx(context.Background(), hash)
+}
+
+// PaymentsGetStarGiftActiveAuctionsCtx is the context-aware variant of PaymentsGetStarGiftActiveAuctions.
+func (c *Client) PaymentsGetStarGiftActiveAuctionsCtx(ctx context.Context, hash int64) (PaymentsStarGiftActiveAuctions, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarGiftActiveAuctionsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetStarGiftActiveAuctions: %w", err)
 	}
@@ -15370,7 +18301,12 @@ func (*PaymentsGetStarGiftAuctionAcquiredGiftsParams) CRC() uint32 {
 }
 
 func (c *Client) PaymentsGetStarGiftAuctionAcquiredGifts(giftID int64) (*PaymentsStarGiftAuctionAcquiredGifts, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarGiftAuctionAcquiredGiftsParams{GiftID: giftID})
+	return c.PaymentsGetStarGiftAuctionAcquiredGiftsCtx(context.Background(), giftID)
+}
+
+// PaymentsGetStarGiftAuctionAcquiredGiftsCtx is the context-aware variant of PaymentsGetStarGiftAuctionAcquiredGifts.
+func (c *Client) PaymentsGetStarGiftAuctionAcquiredGiftsCtx(ctx context.Context, giftID int64) (*PaymentsStarGiftAuctionAcquiredGifts, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarGiftAuctionAcquiredGiftsParams{GiftID: giftID})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetStarGiftAuctionAcquiredGifts: %w", err)
 	}
@@ -15392,7 +18328,12 @@ func (*PaymentsGetStarGiftAuctionStateParams) CRC() uint32 {
 }
 
 func (c *Client) PaymentsGetStarGiftAuctionState(auction InputStarGiftAuction, version int32) (*PaymentsStarGiftAuctionState, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarGiftAuctionStateParams{
+	return c.PaymentsGetStarGiftAuctionStateCtx(context.Background(), auction, version)
+}
+
+// PaymentsGetStarGiftAuctionStateCtx is the context-aware variant of PaymentsGetStarGiftAuctionState.
+func (c *Client) PaymentsGetStarGiftAuctionStateCtx(ctx context.Context, auction InputStarGiftAuction, version int32) (*PaymentsStarGiftAuctionState, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarGiftAuctionStateParams{
 		Auction: auction,
 		Version: version,
 	})
@@ -15418,7 +18359,12 @@ func (*PaymentsGetStarGiftCollectionsParams) CRC() uint32 {
 
 // Fetches all star gift collections of a peer.
 func (c *Client) PaymentsGetStarGiftCollections(peer InputPeer, hash int64) (PaymentsStarGiftCollections, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarGiftCollectionsParams{
+	return c.PaymentsGetStarGiftCollectionsCtx(context.Background(), peer, hash)
+}
+
+// PaymentsGetStarGiftCollectionsCtx is the context-aware variant of PaymentsGetStarGiftCollections.
+func (c *Client) PaymentsGetStarGiftCollectionsCtx(ctx context.Context, peer InputPeer, hash int64) (PaymentsStarGiftCollections, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarGiftCollectionsParams{
 		Hash: hash,
 		Peer: peer,
 	})
@@ -15442,7 +18388,12 @@ func (*PaymentsGetStarGiftUpgradeAttributesParams) CRC() uint32 {
 }
 
 func (c *Client) PaymentsGetStarGiftUpgradeAttributes(giftID int64) (*PaymentsStarGiftUpgradeAttributes, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarGiftUpgradeAttributesParams{GiftID: giftID})
+	return c.PaymentsGetStarGiftUpgradeAttributesCtx(context.Background(), giftID)
+}
+
+// PaymentsGetStarGiftUpgradeAttributesCtx is the context-aware variant of PaymentsGetStarGiftUpgradeAttributes.
+func (c *Client) PaymentsGetStarGiftUpgradeAttributesCtx(ctx context.Context, giftID int64) (*PaymentsStarGiftUpgradeAttributes, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarGiftUpgradeAttributesParams{GiftID: giftID})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetStarGiftUpgradeAttributes: %w", err)
 	}
@@ -15464,7 +18415,12 @@ func (*PaymentsGetStarGiftUpgradePreviewParams) CRC() uint32 {
 
 // Obtain a preview of the possible attributes (chosen randomly) a gift can receive after upgrading it to a collectible gift
 func (c *Client) PaymentsGetStarGiftUpgradePreview(giftID int64) (*PaymentsStarGiftUpgradePreview, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarGiftUpgradePreviewParams{GiftID: giftID})
+	return c.PaymentsGetStarGiftUpgradePreviewCtx(context.Background(), giftID)
+}
+
+// PaymentsGetStarGiftUpgradePreviewCtx is the context-aware variant of PaymentsGetStarGiftUpgradePreview.
+func (c *Client) PaymentsGetStarGiftUpgradePreviewCtx(ctx context.Context, giftID int64) (*PaymentsStarGiftUpgradePreview, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarGiftUpgradePreviewParams{GiftID: giftID})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetStarGiftUpgradePreview: %w", err)
 	}
@@ -15487,7 +18443,12 @@ func (*PaymentsGetStarGiftWithdrawalURLParams) CRC() uint32 {
 
 // Convert a collectible gift to an NFT on the TON blockchain.
 func (c *Client) PaymentsGetStarGiftWithdrawalURL(stargift InputSavedStarGift, password InputCheckPasswordSRP) (*PaymentsStarGiftWithdrawalURL, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarGiftWithdrawalURLParams{
+	return c.PaymentsGetStarGiftWithdrawalURLCtx(context.Background(), stargift, password)
+}
+
+// PaymentsGetStarGiftWithdrawalURLCtx is the context-aware variant of PaymentsGetStarGiftWithdrawalURL.
+func (c *Client) PaymentsGetStarGiftWithdrawalURLCtx(ctx context.Context, stargift InputSavedStarGift, password InputCheckPasswordSRP) (*PaymentsStarGiftWithdrawalURL, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarGiftWithdrawalURLParams{
 		Password: password,
 		Stargift: stargift,
 	})
@@ -15512,7 +18473,12 @@ func (*PaymentsGetStarGiftsParams) CRC() uint32 {
 
 // Get a list of available gifts
 func (c *Client) PaymentsGetStarGifts(hash int32) (PaymentsStarGifts, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarGiftsParams{Hash: hash})
+	return c.PaymentsGetStarGiftsCtx(context.Background(), hash)
+}
+
+// PaymentsGetStarGiftsCtx is the context-aware variant of PaymentsGetStarGifts.
+func (c *Client) PaymentsGetStarGiftsCtx(ctx context.Context, hash int32) (PaymentsStarGifts, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarGiftsParams{Hash: hash})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetStarGifts: %w", err)
 	}
@@ -15538,7 +18504,12 @@ func (*PaymentsGetStarsGiftOptionsParams) FlagIndex() int {
 
 // Obtain a list of Telegram Stars gift options as starsGiftOption constructors.
 func (c *Client) PaymentsGetStarsGiftOptions(userID InputUser) ([]*StarsGiftOption, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarsGiftOptionsParams{UserID: userID})
+	return c.PaymentsGetStarsGiftOptionsCtx(context.Background(), userID)
+}
+
+// PaymentsGetStarsGiftOptionsCtx is the context-aware variant of PaymentsGetStarsGiftOptions.
+func (c *Client) PaymentsGetStarsGiftOptionsCtx(ctx context.Context, userID InputUser) ([]*StarsGiftOption, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarsGiftOptionsParams{UserID: userID})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetStarsGiftOptions: %w", err)
 	}
@@ -15558,7 +18529,12 @@ func (*PaymentsGetStarsGiveawayOptionsParams) CRC() uint32 {
 
 // Fetch a list of star giveaway options.
 func (c *Client) PaymentsGetStarsGiveawayOptions() ([]*StarsGiveawayOption, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarsGiveawayOptionsParams{})
+	return c.PaymentsGetStarsGiveawayOptionsCtx(context.Background())
+}
+
+// PaymentsGetStarsGiveawayOptionsCtx is the context-aware variant of PaymentsGetStarsGiveawayOptions.
+func (c *Client) PaymentsGetStarsGiveawayOptionsCtx(ctx context.Context) ([]*StarsGiveawayOption, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarsGiveawayOptionsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetStarsGiveawayOptions: %w", err)
 	}
@@ -15580,7 +18556,12 @@ func (*PaymentsGetStarsRevenueAdsAccountURLParams) CRC() uint32 {
 
 // Returns a URL for a Telegram Ad platform account that can be used to set up advertisements for channel/bot in `peer`, paid using the Telegram Stars owned by the specified `peer`
 func (c *Client) PaymentsGetStarsRevenueAdsAccountURL(peer InputPeer) (*PaymentsStarsRevenueAdsAccountURL, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarsRevenueAdsAccountURLParams{Peer: peer})
+	return c.PaymentsGetStarsRevenueAdsAccountURLCtx(context.Background(), peer)
+}
+
+// PaymentsGetStarsRevenueAdsAccountURLCtx is the context-aware variant of PaymentsGetStarsRevenueAdsAccountURL.
+func (c *Client) PaymentsGetStarsRevenueAdsAccountURLCtx(ctx context.Context, peer InputPeer) (*PaymentsStarsRevenueAdsAccountURL, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarsRevenueAdsAccountURLParams{Peer: peer})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetStarsRevenueAdsAccountURL: %w", err)
 	}
@@ -15608,7 +18589,12 @@ func (*PaymentsGetStarsRevenueStatsParams) FlagIndex() int {
 
 // Get Telegram Star revenue statistics.
 func (c *Client) PaymentsGetStarsRevenueStats(dark, ton bool, peer InputPeer) (*PaymentsStarsRevenueStats, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarsRevenueStatsParams{
+	return c.PaymentsGetStarsRevenueStatsCtx(context.Background(), dark, ton, peer)
+}
+
+// PaymentsGetStarsRevenueStatsCtx is the context-aware variant of PaymentsGetStarsRevenueStats.
+func (c *Client) PaymentsGetStarsRevenueStatsCtx(ctx context.Context, dark, ton bool, peer InputPeer) (*PaymentsStarsRevenueStats, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarsRevenueStatsParams{
 		Dark: dark,
 		Peer: peer,
 		Ton:  ton,
@@ -15641,7 +18627,12 @@ func (*PaymentsGetStarsRevenueWithdrawalURLParams) FlagIndex() int {
 
 // Withdraw funds from a channel or bot's star balance.
 func (c *Client) PaymentsGetStarsRevenueWithdrawalURL(ton bool, peer InputPeer, amount int64, password InputCheckPasswordSRP) (*PaymentsStarsRevenueWithdrawalURL, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarsRevenueWithdrawalURLParams{
+	return c.PaymentsGetStarsRevenueWithdrawalURLCtx(context.Background(), ton, peer, amount, password)
+}
+
+// PaymentsGetStarsRevenueWithdrawalURLCtx is the context-aware variant of PaymentsGetStarsRevenueWithdrawalURL.
+func (c *Client) PaymentsGetStarsRevenueWithdrawalURLCtx(ctx context.Context, ton bool, peer InputPeer, amount int64, password InputCheckPasswordSRP) (*PaymentsStarsRevenueWithdrawalURL, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarsRevenueWithdrawalURLParams{
 		Amount:   amount,
 		Password: password,
 		Peer:     peer,
@@ -15673,7 +18664,12 @@ func (*PaymentsGetStarsStatusParams) FlagIndex() int {
 
 // Get the current Telegram Stars balance of the current account (with peer=inputPeerSelf), or the stars balance of the bot specified in `peer`.
 func (c *Client) PaymentsGetStarsStatus(ton bool, peer InputPeer) (*PaymentsStarsStatus, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarsStatusParams{
+	return c.PaymentsGetStarsStatusCtx(context.Background(), ton, peer)
+}
+
+// PaymentsGetStarsStatusCtx is the context-aware variant of PaymentsGetStarsStatus.
+func (c *Client) PaymentsGetStarsStatusCtx(ctx context.Context, ton bool, peer InputPeer) (*PaymentsStarsStatus, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarsStatusParams{
 		Peer: peer,
 		Ton:  ton,
 	})
@@ -15704,7 +18700,12 @@ func (*PaymentsGetStarsSubscriptionsParams) FlagIndex() int {
 
 // Obtain a list of active, expired or cancelled Telegram Star subscriptions.
 func (c *Client) PaymentsGetStarsSubscriptions(missingBalance bool, peer InputPeer, offset string) (*PaymentsStarsStatus, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarsSubscriptionsParams{
+	return c.PaymentsGetStarsSubscriptionsCtx(context.Background(), missingBalance, peer, offset)
+}
+
+// PaymentsGetStarsSubscriptionsCtx is the context-aware variant of PaymentsGetStarsSubscriptions.
+func (c *Client) PaymentsGetStarsSubscriptionsCtx(ctx context.Context, missingBalance bool, peer InputPeer, offset string) (*PaymentsStarsStatus, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarsSubscriptionsParams{
 		MissingBalance: missingBalance,
 		Offset:         offset,
 		Peer:           peer,
@@ -15728,7 +18729,12 @@ func (*PaymentsGetStarsTopupOptionsParams) CRC() uint32 {
 
 // Obtain a list of Telegram Stars topup options as starsTopupOption constructors.
 func (c *Client) PaymentsGetStarsTopupOptions() ([]*StarsTopupOption, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarsTopupOptionsParams{})
+	return c.PaymentsGetStarsTopupOptionsCtx(context.Background())
+}
+
+// PaymentsGetStarsTopupOptionsCtx is the context-aware variant of PaymentsGetStarsTopupOptions.
+func (c *Client) PaymentsGetStarsTopupOptionsCtx(ctx context.Context) ([]*StarsTopupOption, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarsTopupOptionsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetStarsTopupOptions: %w", err)
 	}
@@ -15761,7 +18767,12 @@ func (*PaymentsGetStarsTransactionsParams) FlagIndex() int {
 
 // Fetch Telegram Stars transactions.
 func (c *Client) PaymentsGetStarsTransactions(params *PaymentsGetStarsTransactionsParams) (*PaymentsStarsStatus, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PaymentsGetStarsTransactionsCtx(context.Background(), params)
+}
+
+// PaymentsGetStarsTransactionsCtx is the context-aware variant of PaymentsGetStarsTransactions.
+func (c *Client) PaymentsGetStarsTransactionsCtx(ctx context.Context, params *PaymentsGetStarsTransactionsParams) (*PaymentsStarsStatus, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetStarsTransactions: %w", err)
 	}
@@ -15789,7 +18800,12 @@ func (*PaymentsGetStarsTransactionsByIDParams) FlagIndex() int {
 
 // Obtain info about Telegram Star transactions using specific transaction IDs.
 func (c *Client) PaymentsGetStarsTransactionsByID(ton bool, peer InputPeer, id []*InputStarsTransaction) (*PaymentsStarsStatus, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetStarsTransactionsByIDParams{
+	return c.PaymentsGetStarsTransactionsByIDCtx(context.Background(), ton, peer, id)
+}
+
+// PaymentsGetStarsTransactionsByIDCtx is the context-aware variant of PaymentsGetStarsTransactionsByID.
+func (c *Client) PaymentsGetStarsTransactionsByIDCtx(ctx context.Context, ton bool, peer InputPeer, id []*InputStarsTransaction) (*PaymentsStarsStatus, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetStarsTransactionsByIDParams{
 		ID:   id,
 		Peer: peer,
 		Ton:  ton,
@@ -15823,7 +18839,12 @@ func (*PaymentsGetSuggestedStarRefBotsParams) FlagIndex() int {
 
 // Obtain a list of suggested mini apps with available affiliate programs
 func (c *Client) PaymentsGetSuggestedStarRefBots(params *PaymentsGetSuggestedStarRefBotsParams) (*PaymentsSuggestedStarRefBots, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PaymentsGetSuggestedStarRefBotsCtx(context.Background(), params)
+}
+
+// PaymentsGetSuggestedStarRefBotsCtx is the context-aware variant of PaymentsGetSuggestedStarRefBots.
+func (c *Client) PaymentsGetSuggestedStarRefBotsCtx(ctx context.Context, params *PaymentsGetSuggestedStarRefBotsParams) (*PaymentsSuggestedStarRefBots, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetSuggestedStarRefBots: %w", err)
 	}
@@ -15845,7 +18866,12 @@ func (*PaymentsGetUniqueStarGiftParams) CRC() uint32 {
 
 // Obtain info about a collectible gift using a slug obtained from a collectible gift link.
 func (c *Client) PaymentsGetUniqueStarGift(slug string) (*PaymentsUniqueStarGift, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetUniqueStarGiftParams{Slug: slug})
+	return c.PaymentsGetUniqueStarGiftCtx(context.Background(), slug)
+}
+
+// PaymentsGetUniqueStarGiftCtx is the context-aware variant of PaymentsGetUniqueStarGift.
+func (c *Client) PaymentsGetUniqueStarGiftCtx(ctx context.Context, slug string) (*PaymentsUniqueStarGift, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetUniqueStarGiftParams{Slug: slug})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetUniqueStarGift: %w", err)
 	}
@@ -15867,7 +18893,12 @@ func (*PaymentsGetUniqueStarGiftValueInfoParams) CRC() uint32 {
 
 // Get information about the value of a collectible gift.
 func (c *Client) PaymentsGetUniqueStarGiftValueInfo(slug string) (*PaymentsUniqueStarGiftValueInfo, error) {
-	responseData, err := c.MakeRequest(&PaymentsGetUniqueStarGiftValueInfoParams{Slug: slug})
+	return c.PaymentsGetUniqueStarGiftValueInfoCtx(context.Background(), slug)
+}
+
+// PaymentsGetUniqueStarGiftValueInfoCtx is the context-aware variant of PaymentsGetUniqueStarGiftValueInfo.
+func (c *Client) PaymentsGetUniqueStarGiftValueInfoCtx(ctx context.Context, slug string) (*PaymentsUniqueStarGiftValueInfo, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsGetUniqueStarGiftValueInfoParams{Slug: slug})
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsGetUniqueStarGiftValueInfo: %w", err)
 	}
@@ -15891,7 +18922,12 @@ func (*PaymentsLaunchPrepaidGiveawayParams) CRC() uint32 {
 
 // Launch a prepaid giveaway.
 func (c *Client) PaymentsLaunchPrepaidGiveaway(peer InputPeer, giveawayID int64, purpose InputStorePaymentPurpose) (Updates, error) {
-	responseData, err := c.MakeRequest(&PaymentsLaunchPrepaidGiveawayParams{
+	return c.PaymentsLaunchPrepaidGiveawayCtx(context.Background(), peer, giveawayID, purpose)
+}
+
+// PaymentsLaunchPrepaidGiveawayCtx is the context-aware variant of PaymentsLaunchPrepaidGiveaway.
+func (c *Client) PaymentsLaunchPrepaidGiveawayCtx(ctx context.Context, peer InputPeer, giveawayID int64, purpose InputStorePaymentPurpose) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsLaunchPrepaidGiveawayParams{
 		GiveawayID: giveawayID,
 		Peer:       peer,
 		Purpose:    purpose,
@@ -15918,7 +18954,12 @@ func (*PaymentsRefundStarsChargeParams) CRC() uint32 {
 
 // Refund a Telegram Stars transaction
 func (c *Client) PaymentsRefundStarsCharge(userID InputUser, chargeID string) (Updates, error) {
-	responseData, err := c.MakeRequest(&PaymentsRefundStarsChargeParams{
+	return c.PaymentsRefundStarsChargeCtx(context.Background(), userID, chargeID)
+}
+
+// PaymentsRefundStarsChargeCtx is the context-aware variant of PaymentsRefundStarsCharge.
+func (c *Client) PaymentsRefundStarsChargeCtx(ctx context.Context, userID InputUser, chargeID string) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsRefundStarsChargeParams{
 		ChargeID: chargeID,
 		UserID:   userID,
 	})
@@ -15944,7 +18985,12 @@ func (*PaymentsReorderStarGiftCollectionsParams) CRC() uint32 {
 
 // Reorder the star gift collections on an owned peer's profile.
 func (c *Client) PaymentsReorderStarGiftCollections(peer InputPeer, order []int32) (bool, error) {
-	responseData, err := c.MakeRequest(&PaymentsReorderStarGiftCollectionsParams{
+	return c.PaymentsReorderStarGiftCollectionsCtx(context.Background(), peer, order)
+}
+
+// PaymentsReorderStarGiftCollectionsCtx is the context-aware variant of PaymentsReorderStarGiftCollections.
+func (c *Client) PaymentsReorderStarGiftCollectionsCtx(ctx context.Context, peer InputPeer, order []int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsReorderStarGiftCollectionsParams{
 		Order: order,
 		Peer:  peer,
 	})
@@ -15970,7 +19016,12 @@ func (*PaymentsRequestRecurringPaymentParams) CRC() uint32 {
 }
 
 func (c *Client) PaymentsRequestRecurringPayment(userID InputUser, recurringInitCharge string, invoiceMedia InputMedia) (Updates, error) {
-	responseData, err := c.MakeRequest(&PaymentsRequestRecurringPaymentParams{
+	return c.PaymentsRequestRecurringPaymentCtx(context.Background(), userID, recurringInitCharge, invoiceMedia)
+}
+
+// PaymentsRequestRecurringPaymentCtx is the context-aware variant of PaymentsRequestRecurringPayment.
+func (c *Client) PaymentsRequestRecurringPaymentCtx(ctx context.Context, userID InputUser, recurringInitCharge string, invoiceMedia InputMedia) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsRequestRecurringPaymentParams{
 		InvoiceMedia:        invoiceMedia,
 		RecurringInitCharge: recurringInitCharge,
 		UserID:              userID,
@@ -16000,7 +19051,12 @@ func (*PaymentsResolveStarGiftOfferParams) FlagIndex() int {
 }
 
 func (c *Client) PaymentsResolveStarGiftOffer(decline bool, offerMsgID int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&PaymentsResolveStarGiftOfferParams{
+	return c.PaymentsResolveStarGiftOfferCtx(context.Background(), decline, offerMsgID)
+}
+
+// PaymentsResolveStarGiftOfferCtx is the context-aware variant of PaymentsResolveStarGiftOffer.
+func (c *Client) PaymentsResolveStarGiftOfferCtx(ctx context.Context, decline bool, offerMsgID int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsResolveStarGiftOfferParams{
 		Decline:    decline,
 		OfferMsgID: offerMsgID,
 	})
@@ -16030,7 +19086,12 @@ func (*PaymentsSaveStarGiftParams) FlagIndex() int {
 
 // Display or remove a received gift from our profile.
 func (c *Client) PaymentsSaveStarGift(unsave bool, stargift InputSavedStarGift) (bool, error) {
-	responseData, err := c.MakeRequest(&PaymentsSaveStarGiftParams{
+	return c.PaymentsSaveStarGiftCtx(context.Background(), unsave, stargift)
+}
+
+// PaymentsSaveStarGiftCtx is the context-aware variant of PaymentsSaveStarGift.
+func (c *Client) PaymentsSaveStarGiftCtx(ctx context.Context, unsave bool, stargift InputSavedStarGift) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsSaveStarGiftParams{
 		Stargift: stargift,
 		Unsave:   unsave,
 	})
@@ -16064,7 +19125,12 @@ func (*PaymentsSendPaymentFormParams) FlagIndex() int {
 
 // Send compiled payment form
 func (c *Client) PaymentsSendPaymentForm(params *PaymentsSendPaymentFormParams) (PaymentsPaymentResult, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PaymentsSendPaymentFormCtx(context.Background(), params)
+}
+
+// PaymentsSendPaymentFormCtx is the context-aware variant of PaymentsSendPaymentForm.
+func (c *Client) PaymentsSendPaymentFormCtx(ctx context.Context, params *PaymentsSendPaymentFormParams) (PaymentsPaymentResult, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsSendPaymentForm: %w", err)
 	}
@@ -16094,7 +19160,12 @@ func (*PaymentsSendStarGiftOfferParams) FlagIndex() int {
 }
 
 func (c *Client) PaymentsSendStarGiftOffer(params *PaymentsSendStarGiftOfferParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PaymentsSendStarGiftOfferCtx(context.Background(), params)
+}
+
+// PaymentsSendStarGiftOfferCtx is the context-aware variant of PaymentsSendStarGiftOffer.
+func (c *Client) PaymentsSendStarGiftOfferCtx(ctx context.Context, params *PaymentsSendStarGiftOfferParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsSendStarGiftOffer: %w", err)
 	}
@@ -16117,7 +19188,12 @@ func (*PaymentsSendStarsFormParams) CRC() uint32 {
 
 // Make a payment using Telegram Stars
 func (c *Client) PaymentsSendStarsForm(formID int64, invoice InputInvoice) (PaymentsPaymentResult, error) {
-	responseData, err := c.MakeRequest(&PaymentsSendStarsFormParams{
+	return c.PaymentsSendStarsFormCtx(context.Background(), formID, invoice)
+}
+
+// PaymentsSendStarsFormCtx is the context-aware variant of PaymentsSendStarsForm.
+func (c *Client) PaymentsSendStarsFormCtx(ctx context.Context, formID int64, invoice InputInvoice) (PaymentsPaymentResult, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsSendStarsFormParams{
 		FormID:  formID,
 		Invoice: invoice,
 	})
@@ -16147,7 +19223,12 @@ func (*PaymentsToggleChatStarGiftNotificationsParams) FlagIndex() int {
 
 // Enables or disables the reception of notifications every time a gift is received by the specified channel, can only be invoked by admins with `post_messages` admin rights.
 func (c *Client) PaymentsToggleChatStarGiftNotifications(enabled bool, peer InputPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&PaymentsToggleChatStarGiftNotificationsParams{
+	return c.PaymentsToggleChatStarGiftNotificationsCtx(context.Background(), enabled, peer)
+}
+
+// PaymentsToggleChatStarGiftNotificationsCtx is the context-aware variant of PaymentsToggleChatStarGiftNotifications.
+func (c *Client) PaymentsToggleChatStarGiftNotificationsCtx(ctx context.Context, enabled bool, peer InputPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsToggleChatStarGiftNotificationsParams{
 		Enabled: enabled,
 		Peer:    peer,
 	})
@@ -16173,7 +19254,12 @@ func (*PaymentsToggleStarGiftsPinnedToTopParams) CRC() uint32 {
 
 // Pins a received gift on top of the profile of the user or owned channels by using payments.toggleStarGiftsPinnedToTop.
 func (c *Client) PaymentsToggleStarGiftsPinnedToTop(peer InputPeer, stargift []InputSavedStarGift) (bool, error) {
-	responseData, err := c.MakeRequest(&PaymentsToggleStarGiftsPinnedToTopParams{
+	return c.PaymentsToggleStarGiftsPinnedToTopCtx(context.Background(), peer, stargift)
+}
+
+// PaymentsToggleStarGiftsPinnedToTopCtx is the context-aware variant of PaymentsToggleStarGiftsPinnedToTop.
+func (c *Client) PaymentsToggleStarGiftsPinnedToTopCtx(ctx context.Context, peer InputPeer, stargift []InputSavedStarGift) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsToggleStarGiftsPinnedToTopParams{
 		Peer:     peer,
 		Stargift: stargift,
 	})
@@ -16199,7 +19285,12 @@ func (*PaymentsTransferStarGiftParams) CRC() uint32 {
 
 // Transfer a collectible gift to another user or channel: can only be used if transfer is free (i.e. messageActionStarGiftUnique.`transfer_stars` is not set)
 func (c *Client) PaymentsTransferStarGift(stargift InputSavedStarGift, toID InputPeer) (Updates, error) {
-	responseData, err := c.MakeRequest(&PaymentsTransferStarGiftParams{
+	return c.PaymentsTransferStarGiftCtx(context.Background(), stargift, toID)
+}
+
+// PaymentsTransferStarGiftCtx is the context-aware variant of PaymentsTransferStarGift.
+func (c *Client) PaymentsTransferStarGiftCtx(ctx context.Context, stargift InputSavedStarGift, toID InputPeer) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsTransferStarGiftParams{
 		Stargift: stargift,
 		ToID:     toID,
 	})
@@ -16233,7 +19324,12 @@ func (*PaymentsUpdateStarGiftCollectionParams) FlagIndex() int {
 
 // Add or remove gifts from a star gift collection, or rename the collection.
 func (c *Client) PaymentsUpdateStarGiftCollection(params *PaymentsUpdateStarGiftCollectionParams) (*StarGiftCollection, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PaymentsUpdateStarGiftCollectionCtx(context.Background(), params)
+}
+
+// PaymentsUpdateStarGiftCollectionCtx is the context-aware variant of PaymentsUpdateStarGiftCollection.
+func (c *Client) PaymentsUpdateStarGiftCollectionCtx(ctx context.Context, params *PaymentsUpdateStarGiftCollectionParams) (*StarGiftCollection, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PaymentsUpdateStarGiftCollection: %w", err)
 	}
@@ -16256,7 +19352,12 @@ func (*PaymentsUpdateStarGiftPriceParams) CRC() uint32 {
 
 // A collectible gift we own can be put up for sale on the gift marketplace with this method
 func (c *Client) PaymentsUpdateStarGiftPrice(stargift InputSavedStarGift, resellAmount StarsAmount) (Updates, error) {
-	responseData, err := c.MakeRequest(&PaymentsUpdateStarGiftPriceParams{
+	return c.PaymentsUpdateStarGiftPriceCtx(context.Background(), stargift, resellAmount)
+}
+
+// PaymentsUpdateStarGiftPriceCtx is the context-aware variant of PaymentsUpdateStarGiftPrice.
+func (c *Client) PaymentsUpdateStarGiftPriceCtx(ctx context.Context, stargift InputSavedStarGift, resellAmount StarsAmount) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsUpdateStarGiftPriceParams{
 		ResellAmount: resellAmount,
 		Stargift:     stargift,
 	})
@@ -16286,7 +19387,12 @@ func (*PaymentsUpgradeStarGiftParams) FlagIndex() int {
 
 // Upgrade a gift to a collectible gift: can only be used if the upgrade was already paid by the gift sender
 func (c *Client) PaymentsUpgradeStarGift(keepOriginalDetails bool, stargift InputSavedStarGift) (Updates, error) {
-	responseData, err := c.MakeRequest(&PaymentsUpgradeStarGiftParams{
+	return c.PaymentsUpgradeStarGiftCtx(context.Background(), keepOriginalDetails, stargift)
+}
+
+// PaymentsUpgradeStarGiftCtx is the context-aware variant of PaymentsUpgradeStarGift.
+func (c *Client) PaymentsUpgradeStarGiftCtx(ctx context.Context, keepOriginalDetails bool, stargift InputSavedStarGift) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsUpgradeStarGiftParams{
 		KeepOriginalDetails: keepOriginalDetails,
 		Stargift:            stargift,
 	})
@@ -16317,7 +19423,12 @@ func (*PaymentsValidateRequestedInfoParams) FlagIndex() int {
 
 // Submit requested order information for validation
 func (c *Client) PaymentsValidateRequestedInfo(save bool, invoice InputInvoice, info *PaymentRequestedInfo) (*PaymentsValidatedRequestedInfo, error) {
-	responseData, err := c.MakeRequest(&PaymentsValidateRequestedInfoParams{
+	return c.PaymentsValidateRequestedInfoCtx(context.Background(), save, invoice, info)
+}
+
+// PaymentsValidateRequestedInfoCtx is the context-aware variant of PaymentsValidateRequestedInfo.
+func (c *Client) PaymentsValidateRequestedInfoCtx(ctx context.Context, save bool, invoice InputInvoice, info *PaymentRequestedInfo) (*PaymentsValidatedRequestedInfo, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PaymentsValidateRequestedInfoParams{
 		Info:    info,
 		Invoice: invoice,
 		Save:    save,
@@ -16345,7 +19456,12 @@ func (*PhoneAcceptCallParams) CRC() uint32 {
 
 // Accept incoming call
 func (c *Client) PhoneAcceptCall(peer *InputPhoneCall, gB []byte, protocol *PhoneCallProtocol) (*PhonePhoneCall, error) {
-	responseData, err := c.MakeRequest(&PhoneAcceptCallParams{
+	return c.PhoneAcceptCallCtx(context.Background(), peer, gB, protocol)
+}
+
+// PhoneAcceptCallCtx is the context-aware variant of PhoneAcceptCall.
+func (c *Client) PhoneAcceptCallCtx(ctx context.Context, peer *InputPhoneCall, gB []byte, protocol *PhoneCallProtocol) (*PhonePhoneCall, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneAcceptCallParams{
 		GB:       gB,
 		Peer:     peer,
 		Protocol: protocol,
@@ -16372,7 +19488,12 @@ func (*PhoneCheckGroupCallParams) CRC() uint32 {
 
 // Check whether the group call Server Forwarding Unit is currently receiving the streams with the specified WebRTC source IDs. Returns an intersection of the source IDs specified in `sources`, and the source IDs currently being forwarded by the SFU.
 func (c *Client) PhoneCheckGroupCall(call InputGroupCall, sources []int32) ([]int32, error) {
-	responseData, err := c.MakeRequest(&PhoneCheckGroupCallParams{
+	return c.PhoneCheckGroupCallCtx(context.Background(), call, sources)
+}
+
+// PhoneCheckGroupCallCtx is the context-aware variant of PhoneCheckGroupCall.
+func (c *Client) PhoneCheckGroupCallCtx(ctx context.Context, call InputGroupCall, sources []int32) ([]int32, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneCheckGroupCallParams{
 		Call:    call,
 		Sources: sources,
 	})
@@ -16400,7 +19521,12 @@ func (*PhoneConfirmCallParams) CRC() uint32 {
 
 // Complete phone call E2E encryption key exchange
 func (c *Client) PhoneConfirmCall(peer *InputPhoneCall, gA []byte, keyFingerprint int64, protocol *PhoneCallProtocol) (*PhonePhoneCall, error) {
-	responseData, err := c.MakeRequest(&PhoneConfirmCallParams{
+	return c.PhoneConfirmCallCtx(context.Background(), peer, gA, keyFingerprint, protocol)
+}
+
+// PhoneConfirmCallCtx is the context-aware variant of PhoneConfirmCall.
+func (c *Client) PhoneConfirmCallCtx(ctx context.Context, peer *InputPhoneCall, gA []byte, keyFingerprint int64, protocol *PhoneCallProtocol) (*PhonePhoneCall, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneConfirmCallParams{
 		GA:             gA,
 		KeyFingerprint: keyFingerprint,
 		Peer:           peer,
@@ -16437,7 +19563,12 @@ func (*PhoneCreateConferenceCallParams) FlagIndex() int {
 
 // Create and optionally join a new conference call.
 func (c *Client) PhoneCreateConferenceCall(params *PhoneCreateConferenceCallParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PhoneCreateConferenceCallCtx(context.Background(), params)
+}
+
+// PhoneCreateConferenceCallCtx is the context-aware variant of PhoneCreateConferenceCall.
+func (c *Client) PhoneCreateConferenceCallCtx(ctx context.Context, params *PhoneCreateConferenceCallParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneCreateConferenceCall: %w", err)
 	}
@@ -16467,7 +19598,12 @@ func (*PhoneCreateGroupCallParams) FlagIndex() int {
 
 // Create a group call or livestream
 func (c *Client) PhoneCreateGroupCall(params *PhoneCreateGroupCallParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PhoneCreateGroupCallCtx(context.Background(), params)
+}
+
+// PhoneCreateGroupCallCtx is the context-aware variant of PhoneCreateGroupCall.
+func (c *Client) PhoneCreateGroupCallCtx(ctx context.Context, params *PhoneCreateGroupCallParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneCreateGroupCall: %w", err)
 	}
@@ -16489,7 +19625,12 @@ func (*PhoneDeclineConferenceCallInviteParams) CRC() uint32 {
 
 // Declines a conference call invite.
 func (c *Client) PhoneDeclineConferenceCallInvite(msgID int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&PhoneDeclineConferenceCallInviteParams{MsgID: msgID})
+	return c.PhoneDeclineConferenceCallInviteCtx(context.Background(), msgID)
+}
+
+// PhoneDeclineConferenceCallInviteCtx is the context-aware variant of PhoneDeclineConferenceCallInvite.
+func (c *Client) PhoneDeclineConferenceCallInviteCtx(ctx context.Context, msgID int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneDeclineConferenceCallInviteParams{MsgID: msgID})
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneDeclineConferenceCallInvite: %w", err)
 	}
@@ -16519,7 +19660,12 @@ func (*PhoneDeleteConferenceCallParticipantsParams) FlagIndex() int {
 
 // Remove participants from a conference call.
 func (c *Client) PhoneDeleteConferenceCallParticipants(params *PhoneDeleteConferenceCallParticipantsParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PhoneDeleteConferenceCallParticipantsCtx(context.Background(), params)
+}
+
+// PhoneDeleteConferenceCallParticipantsCtx is the context-aware variant of PhoneDeleteConferenceCallParticipants.
+func (c *Client) PhoneDeleteConferenceCallParticipantsCtx(ctx context.Context, params *PhoneDeleteConferenceCallParticipantsParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneDeleteConferenceCallParticipants: %w", err)
 	}
@@ -16546,7 +19692,12 @@ func (*PhoneDeleteGroupCallMessagesParams) FlagIndex() int {
 }
 
 func (c *Client) PhoneDeleteGroupCallMessages(reportSpam bool, call InputGroupCall, messages []int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&PhoneDeleteGroupCallMessagesParams{
+	return c.PhoneDeleteGroupCallMessagesCtx(context.Background(), reportSpam, call, messages)
+}
+
+// PhoneDeleteGroupCallMessagesCtx is the context-aware variant of PhoneDeleteGroupCallMessages.
+func (c *Client) PhoneDeleteGroupCallMessagesCtx(ctx context.Context, reportSpam bool, call InputGroupCall, messages []int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneDeleteGroupCallMessagesParams{
 		Call:       call,
 		Messages:   messages,
 		ReportSpam: reportSpam,
@@ -16577,7 +19728,12 @@ func (*PhoneDeleteGroupCallParticipantMessagesParams) FlagIndex() int {
 }
 
 func (c *Client) PhoneDeleteGroupCallParticipantMessages(reportSpam bool, call InputGroupCall, participant InputPeer) (Updates, error) {
-	responseData, err := c.MakeRequest(&PhoneDeleteGroupCallParticipantMessagesParams{
+	return c.PhoneDeleteGroupCallParticipantMessagesCtx(context.Background(), reportSpam, call, participant)
+}
+
+// PhoneDeleteGroupCallParticipantMessagesCtx is the context-aware variant of PhoneDeleteGroupCallParticipantMessages.
+func (c *Client) PhoneDeleteGroupCallParticipantMessagesCtx(ctx context.Context, reportSpam bool, call InputGroupCall, participant InputPeer) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneDeleteGroupCallParticipantMessagesParams{
 		Call:        call,
 		Participant: participant,
 		ReportSpam:  reportSpam,
@@ -16611,7 +19767,12 @@ func (*PhoneDiscardCallParams) FlagIndex() int {
 
 // Refuse or end running call
 func (c *Client) PhoneDiscardCall(params *PhoneDiscardCallParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PhoneDiscardCallCtx(context.Background(), params)
+}
+
+// PhoneDiscardCallCtx is the context-aware variant of PhoneDiscardCall.
+func (c *Client) PhoneDiscardCallCtx(ctx context.Context, params *PhoneDiscardCallParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneDiscardCall: %w", err)
 	}
@@ -16633,7 +19794,12 @@ func (*PhoneDiscardGroupCallParams) CRC() uint32 {
 
 // Terminate a group call
 func (c *Client) PhoneDiscardGroupCall(call InputGroupCall) (Updates, error) {
-	responseData, err := c.MakeRequest(&PhoneDiscardGroupCallParams{Call: call})
+	return c.PhoneDiscardGroupCallCtx(context.Background(), call)
+}
+
+// PhoneDiscardGroupCallCtx is the context-aware variant of PhoneDiscardGroupCall.
+func (c *Client) PhoneDiscardGroupCallCtx(ctx context.Context, call InputGroupCall) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneDiscardGroupCallParams{Call: call})
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneDiscardGroupCall: %w", err)
 	}
@@ -16666,7 +19832,12 @@ func (*PhoneEditGroupCallParticipantParams) FlagIndex() int {
 
 // Edit information about a given group call participant
 func (c *Client) PhoneEditGroupCallParticipant(params *PhoneEditGroupCallParticipantParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PhoneEditGroupCallParticipantCtx(context.Background(), params)
+}
+
+// PhoneEditGroupCallParticipantCtx is the context-aware variant of PhoneEditGroupCallParticipant.
+func (c *Client) PhoneEditGroupCallParticipantCtx(ctx context.Context, params *PhoneEditGroupCallParticipantParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneEditGroupCallParticipant: %w", err)
 	}
@@ -16689,7 +19860,12 @@ func (*PhoneEditGroupCallTitleParams) CRC() uint32 {
 
 // Edit the title of a group call or livestream
 func (c *Client) PhoneEditGroupCallTitle(call InputGroupCall, title string) (Updates, error) {
-	responseData, err := c.MakeRequest(&PhoneEditGroupCallTitleParams{
+	return c.PhoneEditGroupCallTitleCtx(context.Background(), call, title)
+}
+
+// PhoneEditGroupCallTitleCtx is the context-aware variant of PhoneEditGroupCallTitle.
+func (c *Client) PhoneEditGroupCallTitleCtx(ctx context.Context, call InputGroupCall, title string) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneEditGroupCallTitleParams{
 		Call:  call,
 		Title: title,
 	})
@@ -16719,7 +19895,12 @@ func (*PhoneExportGroupCallInviteParams) FlagIndex() int {
 
 // Get an invite link for a group call or livestream
 func (c *Client) PhoneExportGroupCallInvite(canSelfUnmute bool, call InputGroupCall) (*PhoneExportedGroupCallInvite, error) {
-	responseData, err := c.MakeRequest(&PhoneExportGroupCallInviteParams{
+	return c.PhoneExportGroupCallInviteCtx(context.Background(), canSelfUnmute, call)
+}
+
+// PhoneExportGroupCallInviteCtx is the context-aware variant of PhoneExportGroupCallInvite.
+func (c *Client) PhoneExportGroupCallInviteCtx(ctx context.Context, canSelfUnmute bool, call InputGroupCall) (*PhoneExportedGroupCallInvite, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneExportGroupCallInviteParams{
 		Call:          call,
 		CanSelfUnmute: canSelfUnmute,
 	})
@@ -16742,7 +19923,12 @@ func (*PhoneGetCallConfigParams) CRC() uint32 {
 
 // Get phone call configuration to be passed to libtgvoip's shared config
 func (c *Client) PhoneGetCallConfig() (*DataJson, error) {
-	responseData, err := c.MakeRequest(&PhoneGetCallConfigParams{})
+	return c.PhoneGetCallConfigCtx(context.Background())
+}
+
+// PhoneGetCallConfigCtx is the context-aware variant of PhoneGetCallConfig.
+func (c *Client) PhoneGetCallConfigCtx(ctx context.Context) (*DataJson, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneGetCallConfigParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneGetCallConfig: %w", err)
 	}
@@ -16765,7 +19951,12 @@ func (*PhoneGetGroupCallParams) CRC() uint32 {
 
 // Get info about a group call
 func (c *Client) PhoneGetGroupCall(call InputGroupCall, limit int32) (*PhoneGroupCall, error) {
-	responseData, err := c.MakeRequest(&PhoneGetGroupCallParams{
+	return c.PhoneGetGroupCallCtx(context.Background(), call, limit)
+}
+
+// PhoneGetGroupCallCtx is the context-aware variant of PhoneGetGroupCall.
+func (c *Client) PhoneGetGroupCallCtx(ctx context.Context, call InputGroupCall, limit int32) (*PhoneGroupCall, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneGetGroupCallParams{
 		Call:  call,
 		Limit: limit,
 	})
@@ -16793,7 +19984,12 @@ func (*PhoneGetGroupCallChainBlocksParams) CRC() uint32 {
 
 // Fetch the blocks of a conference blockchain.
 func (c *Client) PhoneGetGroupCallChainBlocks(call InputGroupCall, subChainID, offset, limit int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&PhoneGetGroupCallChainBlocksParams{
+	return c.PhoneGetGroupCallChainBlocksCtx(context.Background(), call, subChainID, offset, limit)
+}
+
+// PhoneGetGroupCallChainBlocksCtx is the context-aware variant of PhoneGetGroupCallChainBlocks.
+func (c *Client) PhoneGetGroupCallChainBlocksCtx(ctx context.Context, call InputGroupCall, subChainID, offset, limit int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneGetGroupCallChainBlocksParams{
 		Call:       call,
 		Limit:      limit,
 		Offset:     offset,
@@ -16820,7 +20016,12 @@ func (*PhoneGetGroupCallJoinAsParams) CRC() uint32 {
 
 // Get a list of peers that can be used to join a group call, presenting yourself as a specific user/channel.
 func (c *Client) PhoneGetGroupCallJoinAs(peer InputPeer) (*PhoneJoinAsPeers, error) {
-	responseData, err := c.MakeRequest(&PhoneGetGroupCallJoinAsParams{Peer: peer})
+	return c.PhoneGetGroupCallJoinAsCtx(context.Background(), peer)
+}
+
+// PhoneGetGroupCallJoinAsCtx is the context-aware variant of PhoneGetGroupCallJoinAs.
+func (c *Client) PhoneGetGroupCallJoinAsCtx(ctx context.Context, peer InputPeer) (*PhoneJoinAsPeers, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneGetGroupCallJoinAsParams{Peer: peer})
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneGetGroupCallJoinAs: %w", err)
 	}
@@ -16841,7 +20042,12 @@ func (*PhoneGetGroupCallStarsParams) CRC() uint32 {
 }
 
 func (c *Client) PhoneGetGroupCallStars(call InputGroupCall) (*PhoneGroupCallStars, error) {
-	responseData, err := c.MakeRequest(&PhoneGetGroupCallStarsParams{Call: call})
+	return c.PhoneGetGroupCallStarsCtx(context.Background(), call)
+}
+
+// PhoneGetGroupCallStarsCtx is the context-aware variant of PhoneGetGroupCallStars.
+func (c *Client) PhoneGetGroupCallStarsCtx(ctx context.Context, call InputGroupCall) (*PhoneGroupCallStars, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneGetGroupCallStarsParams{Call: call})
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneGetGroupCallStars: %w", err)
 	}
@@ -16863,7 +20069,12 @@ func (*PhoneGetGroupCallStreamChannelsParams) CRC() uint32 {
 
 // Get info about RTMP streams in a group call or livestream. This method should be invoked to the same group/channel-related DC used for downloading livestream chunks. As usual, the media DC is preferred, if available.
 func (c *Client) PhoneGetGroupCallStreamChannels(call InputGroupCall) (*PhoneGroupCallStreamChannels, error) {
-	responseData, err := c.MakeRequest(&PhoneGetGroupCallStreamChannelsParams{Call: call})
+	return c.PhoneGetGroupCallStreamChannelsCtx(context.Background(), call)
+}
+
+// PhoneGetGroupCallStreamChannelsCtx is the context-aware variant of PhoneGetGroupCallStreamChannels.
+func (c *Client) PhoneGetGroupCallStreamChannelsCtx(ctx context.Context, call InputGroupCall) (*PhoneGroupCallStreamChannels, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneGetGroupCallStreamChannelsParams{Call: call})
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneGetGroupCallStreamChannels: %w", err)
 	}
@@ -16891,7 +20102,12 @@ func (*PhoneGetGroupCallStreamRtmpURLParams) FlagIndex() int {
 
 // Get RTMP URL and stream key for RTMP livestreams. Can be used even before creating the actual RTMP livestream with phone.createGroupCall (the `rtmp_stream` flag must be set).
 func (c *Client) PhoneGetGroupCallStreamRtmpURL(liveStory bool, peer InputPeer, revoke bool) (*PhoneGroupCallStreamRtmpURL, error) {
-	responseData, err := c.MakeRequest(&PhoneGetGroupCallStreamRtmpURLParams{
+	return c.PhoneGetGroupCallStreamRtmpURLCtx(context.Background(), liveStory, peer, revoke)
+}
+
+// PhoneGetGroupCallStreamRtmpURLCtx is the context-aware variant of PhoneGetGroupCallStreamRtmpURL.
+func (c *Client) PhoneGetGroupCallStreamRtmpURLCtx(ctx context.Context, liveStory bool, peer InputPeer, revoke bool) (*PhoneGroupCallStreamRtmpURL, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneGetGroupCallStreamRtmpURLParams{
 		LiveStory: liveStory,
 		Peer:      peer,
 		Revoke:    revoke,
@@ -16921,7 +20137,12 @@ func (*PhoneGetGroupParticipantsParams) CRC() uint32 {
 
 // Get group call participants
 func (c *Client) PhoneGetGroupParticipants(call InputGroupCall, ids []InputPeer, sources []int32, offset string, limit int32) (*PhoneGroupParticipants, error) {
-	responseData, err := c.MakeRequest(&PhoneGetGroupParticipantsParams{
+	return c.PhoneGetGroupParticipantsCtx(context.Background(), call, ids, sources, offset, limit)
+}
+
+// PhoneGetGroupParticipantsCtx is the context-aware variant of PhoneGetGroupParticipants.
+func (c *Client) PhoneGetGroupParticipantsCtx(ctx context.Context, call InputGroupCall, ids []InputPeer, sources []int32, offset string, limit int32) (*PhoneGroupParticipants, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneGetGroupParticipantsParams{
 		Call:    call,
 		Ids:     ids,
 		Limit:   limit,
@@ -16955,7 +20176,12 @@ func (*PhoneInviteConferenceCallParticipantParams) FlagIndex() int {
 
 // Invite a user to a conference call.
 func (c *Client) PhoneInviteConferenceCallParticipant(video bool, call InputGroupCall, userID InputUser) (Updates, error) {
-	responseData, err := c.MakeRequest(&PhoneInviteConferenceCallParticipantParams{
+	return c.PhoneInviteConferenceCallParticipantCtx(context.Background(), video, call, userID)
+}
+
+// PhoneInviteConferenceCallParticipantCtx is the context-aware variant of PhoneInviteConferenceCallParticipant.
+func (c *Client) PhoneInviteConferenceCallParticipantCtx(ctx context.Context, video bool, call InputGroupCall, userID InputUser) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneInviteConferenceCallParticipantParams{
 		Call:   call,
 		UserID: userID,
 		Video:  video,
@@ -16982,7 +20208,12 @@ func (*PhoneInviteToGroupCallParams) CRC() uint32 {
 
 // Invite a set of users to a group call.
 func (c *Client) PhoneInviteToGroupCall(call InputGroupCall, users []InputUser) (Updates, error) {
-	responseData, err := c.MakeRequest(&PhoneInviteToGroupCallParams{
+	return c.PhoneInviteToGroupCallCtx(context.Background(), call, users)
+}
+
+// PhoneInviteToGroupCallCtx is the context-aware variant of PhoneInviteToGroupCall.
+func (c *Client) PhoneInviteToGroupCallCtx(ctx context.Context, call InputGroupCall, users []InputUser) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneInviteToGroupCallParams{
 		Call:  call,
 		Users: users,
 	})
@@ -17018,7 +20249,12 @@ func (*PhoneJoinGroupCallParams) FlagIndex() int {
 
 // Join a group call
 func (c *Client) PhoneJoinGroupCall(params *PhoneJoinGroupCallParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PhoneJoinGroupCallCtx(context.Background(), params)
+}
+
+// PhoneJoinGroupCallCtx is the context-aware variant of PhoneJoinGroupCall.
+func (c *Client) PhoneJoinGroupCallCtx(ctx context.Context, params *PhoneJoinGroupCallParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneJoinGroupCall: %w", err)
 	}
@@ -17041,7 +20277,12 @@ func (*PhoneJoinGroupCallPresentationParams) CRC() uint32 {
 
 // Start screen sharing in a call
 func (c *Client) PhoneJoinGroupCallPresentation(call InputGroupCall, params *DataJson) (Updates, error) {
-	responseData, err := c.MakeRequest(&PhoneJoinGroupCallPresentationParams{
+	return c.PhoneJoinGroupCallPresentationCtx(context.Background(), call, params)
+}
+
+// PhoneJoinGroupCallPresentationCtx is the context-aware variant of PhoneJoinGroupCallPresentation.
+func (c *Client) PhoneJoinGroupCallPresentationCtx(ctx context.Context, call InputGroupCall, params *DataJson) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneJoinGroupCallPresentationParams{
 		Call:   call,
 		Params: params,
 	})
@@ -17067,7 +20308,12 @@ func (*PhoneLeaveGroupCallParams) CRC() uint32 {
 
 // Leave a group call
 func (c *Client) PhoneLeaveGroupCall(call InputGroupCall, source int32) (Updates, error) {
-	responseData, err := c.MakeRequest(&PhoneLeaveGroupCallParams{
+	return c.PhoneLeaveGroupCallCtx(context.Background(), call, source)
+}
+
+// PhoneLeaveGroupCallCtx is the context-aware variant of PhoneLeaveGroupCall.
+func (c *Client) PhoneLeaveGroupCallCtx(ctx context.Context, call InputGroupCall, source int32) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneLeaveGroupCallParams{
 		Call:   call,
 		Source: source,
 	})
@@ -17092,7 +20338,12 @@ func (*PhoneLeaveGroupCallPresentationParams) CRC() uint32 {
 
 // Stop screen sharing in a group call
 func (c *Client) PhoneLeaveGroupCallPresentation(call InputGroupCall) (Updates, error) {
-	responseData, err := c.MakeRequest(&PhoneLeaveGroupCallPresentationParams{Call: call})
+	return c.PhoneLeaveGroupCallPresentationCtx(context.Background(), call)
+}
+
+// PhoneLeaveGroupCallPresentationCtx is the context-aware variant of PhoneLeaveGroupCallPresentation.
+func (c *Client) PhoneLeaveGroupCallPresentationCtx(ctx context.Context, call InputGroupCall) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneLeaveGroupCallPresentationParams{Call: call})
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneLeaveGroupCallPresentation: %w", err)
 	}
@@ -17114,7 +20365,12 @@ func (*PhoneReceivedCallParams) CRC() uint32 {
 
 // Optional: notify the server that the user is currently busy in a call: this will automatically refuse all incoming phone calls until the current phone call is ended.
 func (c *Client) PhoneReceivedCall(peer *InputPhoneCall) (bool, error) {
-	responseData, err := c.MakeRequest(&PhoneReceivedCallParams{Peer: peer})
+	return c.PhoneReceivedCallCtx(context.Background(), peer)
+}
+
+// PhoneReceivedCallCtx is the context-aware variant of PhoneReceivedCall.
+func (c *Client) PhoneReceivedCallCtx(ctx context.Context, peer *InputPhoneCall) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneReceivedCallParams{Peer: peer})
 	if err != nil {
 		return false, fmt.Errorf("sending PhoneReceivedCall: %w", err)
 	}
@@ -17144,7 +20400,12 @@ func (*PhoneRequestCallParams) FlagIndex() int {
 
 // Start a telegram phone call
 func (c *Client) PhoneRequestCall(params *PhoneRequestCallParams) (*PhonePhoneCall, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PhoneRequestCallCtx(context.Background(), params)
+}
+
+// PhoneRequestCallCtx is the context-aware variant of PhoneRequestCall.
+func (c *Client) PhoneRequestCallCtx(ctx context.Context, params *PhoneRequestCallParams) (*PhonePhoneCall, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneRequestCall: %w", err)
 	}
@@ -17167,7 +20428,12 @@ func (*PhoneSaveCallDebugParams) CRC() uint32 {
 
 // Send phone call debug data to server
 func (c *Client) PhoneSaveCallDebug(peer *InputPhoneCall, debug *DataJson) (bool, error) {
-	responseData, err := c.MakeRequest(&PhoneSaveCallDebugParams{
+	return c.PhoneSaveCallDebugCtx(context.Background(), peer, debug)
+}
+
+// PhoneSaveCallDebugCtx is the context-aware variant of PhoneSaveCallDebug.
+func (c *Client) PhoneSaveCallDebugCtx(ctx context.Context, peer *InputPhoneCall, debug *DataJson) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneSaveCallDebugParams{
 		Debug: debug,
 		Peer:  peer,
 	})
@@ -17193,7 +20459,12 @@ func (*PhoneSaveCallLogParams) CRC() uint32 {
 
 // Save phone call debug information
 func (c *Client) PhoneSaveCallLog(peer *InputPhoneCall, file InputFile) (bool, error) {
-	responseData, err := c.MakeRequest(&PhoneSaveCallLogParams{
+	return c.PhoneSaveCallLogCtx(context.Background(), peer, file)
+}
+
+// PhoneSaveCallLogCtx is the context-aware variant of PhoneSaveCallLog.
+func (c *Client) PhoneSaveCallLogCtx(ctx context.Context, peer *InputPhoneCall, file InputFile) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneSaveCallLogParams{
 		File: file,
 		Peer: peer,
 	})
@@ -17219,7 +20490,12 @@ func (*PhoneSaveDefaultGroupCallJoinAsParams) CRC() uint32 {
 
 // Set the default peer that will be used to join a group call in a specific dialog.
 func (c *Client) PhoneSaveDefaultGroupCallJoinAs(peer, joinAs InputPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&PhoneSaveDefaultGroupCallJoinAsParams{
+	return c.PhoneSaveDefaultGroupCallJoinAsCtx(context.Background(), peer, joinAs)
+}
+
+// PhoneSaveDefaultGroupCallJoinAsCtx is the context-aware variant of PhoneSaveDefaultGroupCallJoinAs.
+func (c *Client) PhoneSaveDefaultGroupCallJoinAsCtx(ctx context.Context, peer, joinAs InputPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneSaveDefaultGroupCallJoinAsParams{
 		JoinAs: joinAs,
 		Peer:   peer,
 	})
@@ -17244,7 +20520,12 @@ func (*PhoneSaveDefaultSendAsParams) CRC() uint32 {
 }
 
 func (c *Client) PhoneSaveDefaultSendAs(call InputGroupCall, sendAs InputPeer) (bool, error) {
-	responseData, err := c.MakeRequest(&PhoneSaveDefaultSendAsParams{
+	return c.PhoneSaveDefaultSendAsCtx(context.Background(), call, sendAs)
+}
+
+// PhoneSaveDefaultSendAsCtx is the context-aware variant of PhoneSaveDefaultSendAs.
+func (c *Client) PhoneSaveDefaultSendAsCtx(ctx context.Context, call InputGroupCall, sendAs InputPeer) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneSaveDefaultSendAsParams{
 		Call:   call,
 		SendAs: sendAs,
 	})
@@ -17270,7 +20551,12 @@ func (*PhoneSendConferenceCallBroadcastParams) CRC() uint32 {
 
 // Broadcast a blockchain block to all members of a conference call
 func (c *Client) PhoneSendConferenceCallBroadcast(call InputGroupCall, block []byte) (Updates, error) {
-	responseData, err := c.MakeRequest(&PhoneSendConferenceCallBroadcastParams{
+	return c.PhoneSendConferenceCallBroadcastCtx(context.Background(), call, block)
+}
+
+// PhoneSendConferenceCallBroadcastCtx is the context-aware variant of PhoneSendConferenceCallBroadcast.
+func (c *Client) PhoneSendConferenceCallBroadcastCtx(ctx context.Context, call InputGroupCall, block []byte) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneSendConferenceCallBroadcastParams{
 		Block: block,
 		Call:  call,
 	})
@@ -17295,7 +20581,12 @@ func (*PhoneSendGroupCallEncryptedMessageParams) CRC() uint32 {
 }
 
 func (c *Client) PhoneSendGroupCallEncryptedMessage(call InputGroupCall, encryptedMessage []byte) (bool, error) {
-	responseData, err := c.MakeRequest(&PhoneSendGroupCallEncryptedMessageParams{
+	return c.PhoneSendGroupCallEncryptedMessageCtx(context.Background(), call, encryptedMessage)
+}
+
+// PhoneSendGroupCallEncryptedMessageCtx is the context-aware variant of PhoneSendGroupCallEncryptedMessage.
+func (c *Client) PhoneSendGroupCallEncryptedMessageCtx(ctx context.Context, call InputGroupCall, encryptedMessage []byte) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneSendGroupCallEncryptedMessageParams{
 		Call:             call,
 		EncryptedMessage: encryptedMessage,
 	})
@@ -17327,7 +20618,12 @@ func (*PhoneSendGroupCallMessageParams) FlagIndex() int {
 }
 
 func (c *Client) PhoneSendGroupCallMessage(params *PhoneSendGroupCallMessageParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PhoneSendGroupCallMessageCtx(context.Background(), params)
+}
+
+// PhoneSendGroupCallMessageCtx is the context-aware variant of PhoneSendGroupCallMessage.
+func (c *Client) PhoneSendGroupCallMessageCtx(ctx context.Context, params *PhoneSendGroupCallMessageParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneSendGroupCallMessage: %w", err)
 	}
@@ -17350,7 +20646,12 @@ func (*PhoneSendSignalingDataParams) CRC() uint32 {
 
 // Send VoIP signaling data
 func (c *Client) PhoneSendSignalingData(peer *InputPhoneCall, data []byte) (bool, error) {
-	responseData, err := c.MakeRequest(&PhoneSendSignalingDataParams{
+	return c.PhoneSendSignalingDataCtx(context.Background(), peer, data)
+}
+
+// PhoneSendSignalingDataCtx is the context-aware variant of PhoneSendSignalingData.
+func (c *Client) PhoneSendSignalingDataCtx(ctx context.Context, peer *InputPhoneCall, data []byte) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneSendSignalingDataParams{
 		Data: data,
 		Peer: peer,
 	})
@@ -17382,7 +20683,12 @@ func (*PhoneSetCallRatingParams) FlagIndex() int {
 
 // Rate a call, returns info about the rating message sent to the official VoIP bot.
 func (c *Client) PhoneSetCallRating(userInitiative bool, peer *InputPhoneCall, rating int32, comment string) (Updates, error) {
-	responseData, err := c.MakeRequest(&PhoneSetCallRatingParams{
+	return c.PhoneSetCallRatingCtx(context.Background(), userInitiative, peer, rating, comment)
+}
+
+// PhoneSetCallRatingCtx is the context-aware variant of PhoneSetCallRating.
+func (c *Client) PhoneSetCallRatingCtx(ctx context.Context, userInitiative bool, peer *InputPhoneCall, rating int32, comment string) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneSetCallRatingParams{
 		Comment:        comment,
 		Peer:           peer,
 		Rating:         rating,
@@ -17409,7 +20715,12 @@ func (*PhoneStartScheduledGroupCallParams) CRC() uint32 {
 
 // Start a scheduled group call.
 func (c *Client) PhoneStartScheduledGroupCall(call InputGroupCall) (Updates, error) {
-	responseData, err := c.MakeRequest(&PhoneStartScheduledGroupCallParams{Call: call})
+	return c.PhoneStartScheduledGroupCallCtx(context.Background(), call)
+}
+
+// PhoneStartScheduledGroupCallCtx is the context-aware variant of PhoneStartScheduledGroupCall.
+func (c *Client) PhoneStartScheduledGroupCallCtx(ctx context.Context, call InputGroupCall) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneStartScheduledGroupCallParams{Call: call})
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneStartScheduledGroupCall: %w", err)
 	}
@@ -17439,7 +20750,12 @@ func (*PhoneToggleGroupCallRecordParams) FlagIndex() int {
 
 // Start or stop recording a group call: the recorded audio and video streams will be automatically sent to `Saved messages` (the chat with ourselves).
 func (c *Client) PhoneToggleGroupCallRecord(params *PhoneToggleGroupCallRecordParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PhoneToggleGroupCallRecordCtx(context.Background(), params)
+}
+
+// PhoneToggleGroupCallRecordCtx is the context-aware variant of PhoneToggleGroupCallRecord.
+func (c *Client) PhoneToggleGroupCallRecordCtx(ctx context.Context, params *PhoneToggleGroupCallRecordParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneToggleGroupCallRecord: %w", err)
 	}
@@ -17469,7 +20785,12 @@ func (*PhoneToggleGroupCallSettingsParams) FlagIndex() int {
 
 // Change group call settings
 func (c *Client) PhoneToggleGroupCallSettings(params *PhoneToggleGroupCallSettingsParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PhoneToggleGroupCallSettingsCtx(context.Background(), params)
+}
+
+// PhoneToggleGroupCallSettingsCtx is the context-aware variant of PhoneToggleGroupCallSettings.
+func (c *Client) PhoneToggleGroupCallSettingsCtx(ctx context.Context, params *PhoneToggleGroupCallSettingsParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PhoneToggleGroupCallSettings: %w", err)
 	}
@@ -17492,7 +20813,12 @@ func (*PhoneToggleGroupCallStartSubscriptionParams) CRC() uint32 {
 
 // Subscribe or unsubscribe to a scheduled group call
 func (c *Client) PhoneToggleGroupCallStartSubscription(call InputGroupCall, subscribed bool) (Updates, error) {
-	responseData, err := c.MakeRequest(&PhoneToggleGroupCallStartSubscriptionParams{
+	return c.PhoneToggleGroupCallStartSubscriptionCtx(context.Background(), call, subscribed)
+}
+
+// PhoneToggleGroupCallStartSubscriptionCtx is the context-aware variant of PhoneToggleGroupCallStartSubscription.
+func (c *Client) PhoneToggleGroupCallStartSubscriptionCtx(ctx context.Context, call InputGroupCall, subscribed bool) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhoneToggleGroupCallStartSubscriptionParams{
 		Call:       call,
 		Subscribed: subscribed,
 	})
@@ -17517,7 +20843,12 @@ func (*PhotosDeletePhotosParams) CRC() uint32 {
 
 // Deletes profile photos. The method returns a list of successfully deleted photo IDs.
 func (c *Client) PhotosDeletePhotos(id []InputPhoto) ([]int64, error) {
-	responseData, err := c.MakeRequest(&PhotosDeletePhotosParams{ID: id})
+	return c.PhotosDeletePhotosCtx(context.Background(), id)
+}
+
+// PhotosDeletePhotosCtx is the context-aware variant of PhotosDeletePhotos.
+func (c *Client) PhotosDeletePhotosCtx(ctx context.Context, id []InputPhoto) ([]int64, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhotosDeletePhotosParams{ID: id})
 	if err != nil {
 		return nil, fmt.Errorf("sending PhotosDeletePhotos: %w", err)
 	}
@@ -17542,7 +20873,12 @@ func (*PhotosGetUserPhotosParams) CRC() uint32 {
 
 // Returns the list of user photos.
 func (c *Client) PhotosGetUserPhotos(userID InputUser, offset int32, maxID int64, limit int32) (PhotosPhotos, error) {
-	responseData, err := c.MakeRequest(&PhotosGetUserPhotosParams{
+	return c.PhotosGetUserPhotosCtx(context.Background(), userID, offset, maxID, limit)
+}
+
+// PhotosGetUserPhotosCtx is the context-aware variant of PhotosGetUserPhotos.
+func (c *Client) PhotosGetUserPhotosCtx(ctx context.Context, userID InputUser, offset int32, maxID int64, limit int32) (PhotosPhotos, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhotosGetUserPhotosParams{
 		Limit:  limit,
 		MaxID:  maxID,
 		Offset: offset,
@@ -17575,7 +20911,12 @@ func (*PhotosUpdateProfilePhotoParams) FlagIndex() int {
 
 // Installs a previously uploaded photo as a profile photo.
 func (c *Client) PhotosUpdateProfilePhoto(fallback bool, bot InputUser, id InputPhoto) (*PhotosPhoto, error) {
-	responseData, err := c.MakeRequest(&PhotosUpdateProfilePhotoParams{
+	return c.PhotosUpdateProfilePhotoCtx(context.Background(), fallback, bot, id)
+}
+
+// PhotosUpdateProfilePhotoCtx is the context-aware variant of PhotosUpdateProfilePhoto.
+func (c *Client) PhotosUpdateProfilePhotoCtx(ctx context.Context, fallback bool, bot InputUser, id InputPhoto) (*PhotosPhoto, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PhotosUpdateProfilePhotoParams{
 		Bot:      bot,
 		Fallback: fallback,
 		ID:       id,
@@ -17611,7 +20952,12 @@ func (*PhotosUploadContactProfilePhotoParams) FlagIndex() int {
 
 // Upload a custom profile picture for a contact, or suggest a new profile picture to a contact.
 func (c *Client) PhotosUploadContactProfilePhoto(params *PhotosUploadContactProfilePhotoParams) (*PhotosPhoto, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PhotosUploadContactProfilePhotoCtx(context.Background(), params)
+}
+
+// PhotosUploadContactProfilePhotoCtx is the context-aware variant of PhotosUploadContactProfilePhoto.
+func (c *Client) PhotosUploadContactProfilePhotoCtx(ctx context.Context, params *PhotosUploadContactProfilePhotoParams) (*PhotosPhoto, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PhotosUploadContactProfilePhoto: %w", err)
 	}
@@ -17642,7 +20988,12 @@ func (*PhotosUploadProfilePhotoParams) FlagIndex() int {
 
 // Updates current user profile photo.
 func (c *Client) PhotosUploadProfilePhoto(params *PhotosUploadProfilePhotoParams) (*PhotosPhoto, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.PhotosUploadProfilePhotoCtx(context.Background(), params)
+}
+
+// PhotosUploadProfilePhotoCtx is the context-aware variant of PhotosUploadProfilePhoto.
+func (c *Client) PhotosUploadProfilePhotoCtx(ctx context.Context, params *PhotosUploadProfilePhotoParams) (*PhotosPhoto, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending PhotosUploadProfilePhoto: %w", err)
 	}
@@ -17669,7 +21020,12 @@ func (*PremiumApplyBoostParams) FlagIndex() int {
 
 // Apply one or more boosts to a peer.
 func (c *Client) PremiumApplyBoost(slots []int32, peer InputPeer) (*PremiumMyBoosts, error) {
-	responseData, err := c.MakeRequest(&PremiumApplyBoostParams{
+	return c.PremiumApplyBoostCtx(context.Background(), slots, peer)
+}
+
+// PremiumApplyBoostCtx is the context-aware variant of PremiumApplyBoost.
+func (c *Client) PremiumApplyBoostCtx(ctx context.Context, slots []int32, peer InputPeer) (*PremiumMyBoosts, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PremiumApplyBoostParams{
 		Peer:  peer,
 		Slots: slots,
 	})
@@ -17701,7 +21057,12 @@ func (*PremiumGetBoostsListParams) FlagIndex() int {
 
 // Obtains info about the boosts that were applied to a certain channel or supergroup (admins only)
 func (c *Client) PremiumGetBoostsList(gifts bool, peer InputPeer, offset string, limit int32) (*PremiumBoostsList, error) {
-	responseData, err := c.MakeRequest(&PremiumGetBoostsListParams{
+	return c.PremiumGetBoostsListCtx(context.Background(), gifts, peer, offset, limit)
+}
+
+// PremiumGetBoostsListCtx is the context-aware variant of PremiumGetBoostsList.
+func (c *Client) PremiumGetBoostsListCtx(ctx context.Context, gifts bool, peer InputPeer, offset string, limit int32) (*PremiumBoostsList, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PremiumGetBoostsListParams{
 		Gifts:  gifts,
 		Limit:  limit,
 		Offset: offset,
@@ -17728,7 +21089,12 @@ func (*PremiumGetBoostsStatusParams) CRC() uint32 {
 
 // Gets the current number of boosts of a channel/supergroup.
 func (c *Client) PremiumGetBoostsStatus(peer InputPeer) (*PremiumBoostsStatus, error) {
-	responseData, err := c.MakeRequest(&PremiumGetBoostsStatusParams{Peer: peer})
+	return c.PremiumGetBoostsStatusCtx(context.Background(), peer)
+}
+
+// PremiumGetBoostsStatusCtx is the context-aware variant of PremiumGetBoostsStatus.
+func (c *Client) PremiumGetBoostsStatusCtx(ctx context.Context, peer InputPeer) (*PremiumBoostsStatus, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PremiumGetBoostsStatusParams{Peer: peer})
 	if err != nil {
 		return nil, fmt.Errorf("sending PremiumGetBoostsStatus: %w", err)
 	}
@@ -17748,7 +21114,12 @@ func (*PremiumGetMyBoostsParams) CRC() uint32 {
 
 // Obtain which peers are we currently boosting, and how many boost slots we have left.
 func (c *Client) PremiumGetMyBoosts() (*PremiumMyBoosts, error) {
-	responseData, err := c.MakeRequest(&PremiumGetMyBoostsParams{})
+	return c.PremiumGetMyBoostsCtx(context.Background())
+}
+
+// PremiumGetMyBoostsCtx is the context-aware variant of PremiumGetMyBoosts.
+func (c *Client) PremiumGetMyBoostsCtx(ctx context.Context) (*PremiumMyBoosts, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PremiumGetMyBoostsParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending PremiumGetMyBoosts: %w", err)
 	}
@@ -17771,7 +21142,12 @@ func (*PremiumGetUserBoostsParams) CRC() uint32 {
 
 // Returns the lists of boost that were applied to a channel/supergroup by a specific user (admins only)
 func (c *Client) PremiumGetUserBoosts(peer InputPeer, userID InputUser) (*PremiumBoostsList, error) {
-	responseData, err := c.MakeRequest(&PremiumGetUserBoostsParams{
+	return c.PremiumGetUserBoostsCtx(context.Background(), peer, userID)
+}
+
+// PremiumGetUserBoostsCtx is the context-aware variant of PremiumGetUserBoosts.
+func (c *Client) PremiumGetUserBoostsCtx(ctx context.Context, peer InputPeer, userID InputUser) (*PremiumBoostsList, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &PremiumGetUserBoostsParams{
 		Peer:   peer,
 		UserID: userID,
 	})
@@ -17801,7 +21177,12 @@ func (*SmsjobsFinishJobParams) FlagIndex() int {
 
 // Finish an SMS job (official clients only).
 func (c *Client) SmsjobsFinishJob(jobID, error string) (bool, error) {
-	responseData, err := c.MakeRequest(&SmsjobsFinishJobParams{
+	return c.SmsjobsFinishJobCtx(context.Background(), jobID, error)
+}
+
+// SmsjobsFinishJobCtx is the context-aware variant of SmsjobsFinishJob.
+func (c *Client) SmsjobsFinishJobCtx(ctx context.Context, jobID, error string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &SmsjobsFinishJobParams{
 		Error: error,
 		JobID: jobID,
 	})
@@ -17826,7 +21207,12 @@ func (*SmsjobsGetSmsJobParams) CRC() uint32 {
 
 // Get info about an SMS job (official clients only).
 func (c *Client) SmsjobsGetSmsJob(jobID string) (*SmsJob, error) {
-	responseData, err := c.MakeRequest(&SmsjobsGetSmsJobParams{JobID: jobID})
+	return c.SmsjobsGetSmsJobCtx(context.Background(), jobID)
+}
+
+// SmsjobsGetSmsJobCtx is the context-aware variant of SmsjobsGetSmsJob.
+func (c *Client) SmsjobsGetSmsJobCtx(ctx context.Context, jobID string) (*SmsJob, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &SmsjobsGetSmsJobParams{JobID: jobID})
 	if err != nil {
 		return nil, fmt.Errorf("sending SmsjobsGetSmsJob: %w", err)
 	}
@@ -17846,7 +21232,12 @@ func (*SmsjobsGetStatusParams) CRC() uint32 {
 
 // Get SMS jobs status (official clients only).
 func (c *Client) SmsjobsGetStatus() (*SmsjobsStatus, error) {
-	responseData, err := c.MakeRequest(&SmsjobsGetStatusParams{})
+	return c.SmsjobsGetStatusCtx(context.Background())
+}
+
+// SmsjobsGetStatusCtx is the context-aware variant of SmsjobsGetStatus.
+func (c *Client) SmsjobsGetStatusCtx(ctx context.Context) (*SmsjobsStatus, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &SmsjobsGetStatusParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending SmsjobsGetStatus: %w", err)
 	}
@@ -17866,7 +21257,12 @@ func (*SmsjobsIsEligibleToJoinParams) CRC() uint32 {
 
 // Check if we can process SMS jobs (official clients only).
 func (c *Client) SmsjobsIsEligibleToJoin() (*SmsjobsEligibleToJoin, error) {
-	responseData, err := c.MakeRequest(&SmsjobsIsEligibleToJoinParams{})
+	return c.SmsjobsIsEligibleToJoinCtx(context.Background())
+}
+
+// SmsjobsIsEligibleToJoinCtx is the context-aware variant of SmsjobsIsEligibleToJoin.
+func (c *Client) SmsjobsIsEligibleToJoinCtx(ctx context.Context) (*SmsjobsEligibleToJoin, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &SmsjobsIsEligibleToJoinParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending SmsjobsIsEligibleToJoin: %w", err)
 	}
@@ -17886,7 +21282,12 @@ func (*SmsjobsJoinParams) CRC() uint32 {
 
 // Enable SMS jobs (official clients only).
 func (c *Client) SmsjobsJoin() (bool, error) {
-	responseData, err := c.MakeRequest(&SmsjobsJoinParams{})
+	return c.SmsjobsJoinCtx(context.Background())
+}
+
+// SmsjobsJoinCtx is the context-aware variant of SmsjobsJoin.
+func (c *Client) SmsjobsJoinCtx(ctx context.Context) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &SmsjobsJoinParams{})
 	if err != nil {
 		return false, fmt.Errorf("sending SmsjobsJoin: %w", err)
 	}
@@ -17906,7 +21307,12 @@ func (*SmsjobsLeaveParams) CRC() uint32 {
 
 // Disable SMS jobs (official clients only).
 func (c *Client) SmsjobsLeave() (bool, error) {
-	responseData, err := c.MakeRequest(&SmsjobsLeaveParams{})
+	return c.SmsjobsLeaveCtx(context.Background())
+}
+
+// SmsjobsLeaveCtx is the context-aware variant of SmsjobsLeave.
+func (c *Client) SmsjobsLeaveCtx(ctx context.Context) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &SmsjobsLeaveParams{})
 	if err != nil {
 		return false, fmt.Errorf("sending SmsjobsLeave: %w", err)
 	}
@@ -17932,7 +21338,12 @@ func (*SmsjobsUpdateSettingsParams) FlagIndex() int {
 
 // Update SMS job settings (official clients only).
 func (c *Client) SmsjobsUpdateSettings(allowInternational bool) (bool, error) {
-	responseData, err := c.MakeRequest(&SmsjobsUpdateSettingsParams{AllowInternational: allowInternational})
+	return c.SmsjobsUpdateSettingsCtx(context.Background(), allowInternational)
+}
+
+// SmsjobsUpdateSettingsCtx is the context-aware variant of SmsjobsUpdateSettings.
+func (c *Client) SmsjobsUpdateSettingsCtx(ctx context.Context, allowInternational bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &SmsjobsUpdateSettingsParams{AllowInternational: allowInternational})
 	if err != nil {
 		return false, fmt.Errorf("sending SmsjobsUpdateSettings: %w", err)
 	}
@@ -17959,7 +21370,12 @@ func (*StatsGetBroadcastStatsParams) FlagIndex() int {
 
 // Get channel statistics
 func (c *Client) StatsGetBroadcastStats(dark bool, channel InputChannel) (*StatsBroadcastStats, error) {
-	responseData, err := c.MakeRequest(&StatsGetBroadcastStatsParams{
+	return c.StatsGetBroadcastStatsCtx(context.Background(), dark, channel)
+}
+
+// StatsGetBroadcastStatsCtx is the context-aware variant of StatsGetBroadcastStats.
+func (c *Client) StatsGetBroadcastStatsCtx(ctx context.Context, dark bool, channel InputChannel) (*StatsBroadcastStats, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StatsGetBroadcastStatsParams{
 		Channel: channel,
 		Dark:    dark,
 	})
@@ -17989,7 +21405,12 @@ func (*StatsGetMegagroupStatsParams) FlagIndex() int {
 
 // Get supergroup statistics
 func (c *Client) StatsGetMegagroupStats(dark bool, channel InputChannel) (*StatsMegagroupStats, error) {
-	responseData, err := c.MakeRequest(&StatsGetMegagroupStatsParams{
+	return c.StatsGetMegagroupStatsCtx(context.Background(), dark, channel)
+}
+
+// StatsGetMegagroupStatsCtx is the context-aware variant of StatsGetMegagroupStats.
+func (c *Client) StatsGetMegagroupStatsCtx(ctx context.Context, dark bool, channel InputChannel) (*StatsMegagroupStats, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StatsGetMegagroupStatsParams{
 		Channel: channel,
 		Dark:    dark,
 	})
@@ -18017,7 +21438,12 @@ func (*StatsGetMessagePublicForwardsParams) CRC() uint32 {
 
 // Obtains a list of messages, indicating to which other public channels was a channel message forwarded. Will return a list of messages with `peer_id` equal to the public channel to which this message was forwarded.
 func (c *Client) StatsGetMessagePublicForwards(channel InputChannel, msgID int32, offset string, limit int32) (*StatsPublicForwards, error) {
-	responseData, err := c.MakeRequest(&StatsGetMessagePublicForwardsParams{
+	return c.StatsGetMessagePublicForwardsCtx(context.Background(), channel, msgID, offset, limit)
+}
+
+// StatsGetMessagePublicForwardsCtx is the context-aware variant of StatsGetMessagePublicForwards.
+func (c *Client) StatsGetMessagePublicForwardsCtx(ctx context.Context, channel InputChannel, msgID int32, offset string, limit int32) (*StatsPublicForwards, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StatsGetMessagePublicForwardsParams{
 		Channel: channel,
 		Limit:   limit,
 		MsgID:   msgID,
@@ -18050,7 +21476,12 @@ func (*StatsGetMessageStatsParams) FlagIndex() int {
 
 // Get message statistics
 func (c *Client) StatsGetMessageStats(dark bool, channel InputChannel, msgID int32) (*StatsMessageStats, error) {
-	responseData, err := c.MakeRequest(&StatsGetMessageStatsParams{
+	return c.StatsGetMessageStatsCtx(context.Background(), dark, channel, msgID)
+}
+
+// StatsGetMessageStatsCtx is the context-aware variant of StatsGetMessageStats.
+func (c *Client) StatsGetMessageStatsCtx(ctx context.Context, dark bool, channel InputChannel, msgID int32) (*StatsMessageStats, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StatsGetMessageStatsParams{
 		Channel: channel,
 		Dark:    dark,
 		MsgID:   msgID,
@@ -18079,7 +21510,12 @@ func (*StatsGetStoryPublicForwardsParams) CRC() uint32 {
 
 // Obtain forwards of a story as a message to public chats and reposts by public channels.
 func (c *Client) StatsGetStoryPublicForwards(peer InputPeer, id int32, offset string, limit int32) (*StatsPublicForwards, error) {
-	responseData, err := c.MakeRequest(&StatsGetStoryPublicForwardsParams{
+	return c.StatsGetStoryPublicForwardsCtx(context.Background(), peer, id, offset, limit)
+}
+
+// StatsGetStoryPublicForwardsCtx is the context-aware variant of StatsGetStoryPublicForwards.
+func (c *Client) StatsGetStoryPublicForwardsCtx(ctx context.Context, peer InputPeer, id int32, offset string, limit int32) (*StatsPublicForwards, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StatsGetStoryPublicForwardsParams{
 		ID:     id,
 		Limit:  limit,
 		Offset: offset,
@@ -18112,7 +21548,12 @@ func (*StatsGetStoryStatsParams) FlagIndex() int {
 
 // Get statistics for a certain story.
 func (c *Client) StatsGetStoryStats(dark bool, peer InputPeer, id int32) (*StatsStoryStats, error) {
-	responseData, err := c.MakeRequest(&StatsGetStoryStatsParams{
+	return c.StatsGetStoryStatsCtx(context.Background(), dark, peer, id)
+}
+
+// StatsGetStoryStatsCtx is the context-aware variant of StatsGetStoryStats.
+func (c *Client) StatsGetStoryStatsCtx(ctx context.Context, dark bool, peer InputPeer, id int32) (*StatsStoryStats, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StatsGetStoryStatsParams{
 		Dark: dark,
 		ID:   id,
 		Peer: peer,
@@ -18143,7 +21584,12 @@ func (*StatsLoadAsyncGraphParams) FlagIndex() int {
 
 // Load channel statistics graph asynchronously
 func (c *Client) StatsLoadAsyncGraph(token string, x int64) (StatsGraph, error) {
-	responseData, err := c.MakeRequest(&StatsLoadAsyncGraphParams{
+	return c.StatsLoadAsyncGraphCtx(context.Background(), token, x)
+}
+
+// StatsLoadAsyncGraphCtx is the context-aware variant of StatsLoadAsyncGraph.
+func (c *Client) StatsLoadAsyncGraphCtx(ctx context.Context, token string, x int64) (StatsGraph, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StatsLoadAsyncGraphParams{
 		Token: token,
 		X:     x,
 	})
@@ -18169,7 +21615,12 @@ func (*StickersAddStickerToSetParams) CRC() uint32 {
 
 // Add a sticker to a stickerset. The sticker set must have been created by the current user/bot.
 func (c *Client) StickersAddStickerToSet(stickerset InputStickerSet, sticker *InputStickerSetItem) (MessagesStickerSet, error) {
-	responseData, err := c.MakeRequest(&StickersAddStickerToSetParams{
+	return c.StickersAddStickerToSetCtx(context.Background(), stickerset, sticker)
+}
+
+// StickersAddStickerToSetCtx is the context-aware variant of StickersAddStickerToSet.
+func (c *Client) StickersAddStickerToSetCtx(ctx context.Context, stickerset InputStickerSet, sticker *InputStickerSetItem) (MessagesStickerSet, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StickersAddStickerToSetParams{
 		Sticker:    sticker,
 		Stickerset: stickerset,
 	})
@@ -18201,7 +21652,12 @@ func (*StickersChangeStickerParams) FlagIndex() int {
 
 // Update the keywords, emojis or mask coordinates of a sticker.
 func (c *Client) StickersChangeSticker(sticker InputDocument, emoji string, maskCoords *MaskCoords, keywords string) (MessagesStickerSet, error) {
-	responseData, err := c.MakeRequest(&StickersChangeStickerParams{
+	return c.StickersChangeStickerCtx(context.Background(), sticker, emoji, maskCoords, keywords)
+}
+
+// StickersChangeStickerCtx is the context-aware variant of StickersChangeSticker.
+func (c *Client) StickersChangeStickerCtx(ctx context.Context, sticker InputDocument, emoji string, maskCoords *MaskCoords, keywords string) (MessagesStickerSet, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StickersChangeStickerParams{
 		Emoji:      emoji,
 		Keywords:   keywords,
 		MaskCoords: maskCoords,
@@ -18229,7 +21685,12 @@ func (*StickersChangeStickerPositionParams) CRC() uint32 {
 
 // Changes the absolute position of a sticker in the set to which it belongs. The sticker set must have been created by the current user/bot.
 func (c *Client) StickersChangeStickerPosition(sticker InputDocument, position int32) (MessagesStickerSet, error) {
-	responseData, err := c.MakeRequest(&StickersChangeStickerPositionParams{
+	return c.StickersChangeStickerPositionCtx(context.Background(), sticker, position)
+}
+
+// StickersChangeStickerPositionCtx is the context-aware variant of StickersChangeStickerPosition.
+func (c *Client) StickersChangeStickerPositionCtx(ctx context.Context, sticker InputDocument, position int32) (MessagesStickerSet, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StickersChangeStickerPositionParams{
 		Position: position,
 		Sticker:  sticker,
 	})
@@ -18254,7 +21715,12 @@ func (*StickersCheckShortNameParams) CRC() uint32 {
 
 // Check whether the given short name is available
 func (c *Client) StickersCheckShortName(shortName string) (bool, error) {
-	responseData, err := c.MakeRequest(&StickersCheckShortNameParams{ShortName: shortName})
+	return c.StickersCheckShortNameCtx(context.Background(), shortName)
+}
+
+// StickersCheckShortNameCtx is the context-aware variant of StickersCheckShortName.
+func (c *Client) StickersCheckShortNameCtx(ctx context.Context, shortName string) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StickersCheckShortNameParams{ShortName: shortName})
 	if err != nil {
 		return false, fmt.Errorf("sending StickersCheckShortName: %w", err)
 	}
@@ -18288,7 +21754,12 @@ func (*StickersCreateStickerSetParams) FlagIndex() int {
 
 // Create a stickerset.
 func (c *Client) StickersCreateStickerSet(params *StickersCreateStickerSetParams) (MessagesStickerSet, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.StickersCreateStickerSetCtx(context.Background(), params)
+}
+
+// StickersCreateStickerSetCtx is the context-aware variant of StickersCreateStickerSet.
+func (c *Client) StickersCreateStickerSetCtx(ctx context.Context, params *StickersCreateStickerSetParams) (MessagesStickerSet, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending StickersCreateStickerSet: %w", err)
 	}
@@ -18310,7 +21781,12 @@ func (*StickersDeleteStickerSetParams) CRC() uint32 {
 
 // Deletes a stickerset we created.
 func (c *Client) StickersDeleteStickerSet(stickerset InputStickerSet) (bool, error) {
-	responseData, err := c.MakeRequest(&StickersDeleteStickerSetParams{Stickerset: stickerset})
+	return c.StickersDeleteStickerSetCtx(context.Background(), stickerset)
+}
+
+// StickersDeleteStickerSetCtx is the context-aware variant of StickersDeleteStickerSet.
+func (c *Client) StickersDeleteStickerSetCtx(ctx context.Context, stickerset InputStickerSet) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StickersDeleteStickerSetParams{Stickerset: stickerset})
 	if err != nil {
 		return false, fmt.Errorf("sending StickersDeleteStickerSet: %w", err)
 	}
@@ -18332,7 +21808,12 @@ func (*StickersRemoveStickerFromSetParams) CRC() uint32 {
 
 // Remove a sticker from the set where it belongs. The sticker set must have been created by the current user/bot.
 func (c *Client) StickersRemoveStickerFromSet(sticker InputDocument) (MessagesStickerSet, error) {
-	responseData, err := c.MakeRequest(&StickersRemoveStickerFromSetParams{Sticker: sticker})
+	return c.StickersRemoveStickerFromSetCtx(context.Background(), sticker)
+}
+
+// StickersRemoveStickerFromSetCtx is the context-aware variant of StickersRemoveStickerFromSet.
+func (c *Client) StickersRemoveStickerFromSetCtx(ctx context.Context, sticker InputDocument) (MessagesStickerSet, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StickersRemoveStickerFromSetParams{Sticker: sticker})
 	if err != nil {
 		return nil, fmt.Errorf("sending StickersRemoveStickerFromSet: %w", err)
 	}
@@ -18355,7 +21836,12 @@ func (*StickersRenameStickerSetParams) CRC() uint32 {
 
 // Renames a stickerset.
 func (c *Client) StickersRenameStickerSet(stickerset InputStickerSet, title string) (MessagesStickerSet, error) {
-	responseData, err := c.MakeRequest(&StickersRenameStickerSetParams{
+	return c.StickersRenameStickerSetCtx(context.Background(), stickerset, title)
+}
+
+// StickersRenameStickerSetCtx is the context-aware variant of StickersRenameStickerSet.
+func (c *Client) StickersRenameStickerSetCtx(ctx context.Context, stickerset InputStickerSet, title string) (MessagesStickerSet, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StickersRenameStickerSetParams{
 		Stickerset: stickerset,
 		Title:      title,
 	})
@@ -18381,7 +21867,12 @@ func (*StickersReplaceStickerParams) CRC() uint32 {
 
 // Replace a sticker in a stickerset.
 func (c *Client) StickersReplaceSticker(sticker InputDocument, newSticker *InputStickerSetItem) (MessagesStickerSet, error) {
-	responseData, err := c.MakeRequest(&StickersReplaceStickerParams{
+	return c.StickersReplaceStickerCtx(context.Background(), sticker, newSticker)
+}
+
+// StickersReplaceStickerCtx is the context-aware variant of StickersReplaceSticker.
+func (c *Client) StickersReplaceStickerCtx(ctx context.Context, sticker InputDocument, newSticker *InputStickerSetItem) (MessagesStickerSet, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StickersReplaceStickerParams{
 		NewSticker: newSticker,
 		Sticker:    sticker,
 	})
@@ -18412,7 +21903,12 @@ func (*StickersSetStickerSetThumbParams) FlagIndex() int {
 
 // Set stickerset thumbnail
 func (c *Client) StickersSetStickerSetThumb(stickerset InputStickerSet, thumb InputDocument, thumbDocumentID int64) (MessagesStickerSet, error) {
-	responseData, err := c.MakeRequest(&StickersSetStickerSetThumbParams{
+	return c.StickersSetStickerSetThumbCtx(context.Background(), stickerset, thumb, thumbDocumentID)
+}
+
+// StickersSetStickerSetThumbCtx is the context-aware variant of StickersSetStickerSetThumb.
+func (c *Client) StickersSetStickerSetThumbCtx(ctx context.Context, stickerset InputStickerSet, thumb InputDocument, thumbDocumentID int64) (MessagesStickerSet, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StickersSetStickerSetThumbParams{
 		Stickerset:      stickerset,
 		Thumb:           thumb,
 		ThumbDocumentID: thumbDocumentID,
@@ -18438,7 +21934,12 @@ func (*StickersSuggestShortNameParams) CRC() uint32 {
 
 // Suggests a short name for a given stickerpack name
 func (c *Client) StickersSuggestShortName(title string) (*StickersSuggestedShortName, error) {
-	responseData, err := c.MakeRequest(&StickersSuggestShortNameParams{Title: title})
+	return c.StickersSuggestShortNameCtx(context.Background(), title)
+}
+
+// StickersSuggestShortNameCtx is the context-aware variant of StickersSuggestShortName.
+func (c *Client) StickersSuggestShortNameCtx(ctx context.Context, title string) (*StickersSuggestedShortName, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StickersSuggestShortNameParams{Title: title})
 	if err != nil {
 		return nil, fmt.Errorf("sending StickersSuggestShortName: %w", err)
 	}
@@ -18465,7 +21966,12 @@ func (*StoriesActivateStealthModeParams) FlagIndex() int {
 
 // Activates stories stealth mode
 func (c *Client) StoriesActivateStealthMode(past, future bool) (Updates, error) {
-	responseData, err := c.MakeRequest(&StoriesActivateStealthModeParams{
+	return c.StoriesActivateStealthModeCtx(context.Background(), past, future)
+}
+
+// StoriesActivateStealthModeCtx is the context-aware variant of StoriesActivateStealthMode.
+func (c *Client) StoriesActivateStealthModeCtx(ctx context.Context, past, future bool) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesActivateStealthModeParams{
 		Future: future,
 		Past:   past,
 	})
@@ -18490,7 +21996,12 @@ func (*StoriesCanSendStoryParams) CRC() uint32 {
 
 // Check whether we can post stories as the specified peer.
 func (c *Client) StoriesCanSendStory(peer InputPeer) (*StoriesCanSendStoryCount, error) {
-	responseData, err := c.MakeRequest(&StoriesCanSendStoryParams{Peer: peer})
+	return c.StoriesCanSendStoryCtx(context.Background(), peer)
+}
+
+// StoriesCanSendStoryCtx is the context-aware variant of StoriesCanSendStory.
+func (c *Client) StoriesCanSendStoryCtx(ctx context.Context, peer InputPeer) (*StoriesCanSendStoryCount, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesCanSendStoryParams{Peer: peer})
 	if err != nil {
 		return nil, fmt.Errorf("sending StoriesCanSendStory: %w", err)
 	}
@@ -18514,7 +22025,12 @@ func (*StoriesCreateAlbumParams) CRC() uint32 {
 
 // Creates a story album.
 func (c *Client) StoriesCreateAlbum(peer InputPeer, title string, stories []int32) (*StoryAlbum, error) {
-	responseData, err := c.MakeRequest(&StoriesCreateAlbumParams{
+	return c.StoriesCreateAlbumCtx(context.Background(), peer, title, stories)
+}
+
+// StoriesCreateAlbumCtx is the context-aware variant of StoriesCreateAlbum.
+func (c *Client) StoriesCreateAlbumCtx(ctx context.Context, peer InputPeer, title string, stories []int32) (*StoryAlbum, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesCreateAlbumParams{
 		Peer:    peer,
 		Stories: stories,
 		Title:   title,
@@ -18541,7 +22057,12 @@ func (*StoriesDeleteAlbumParams) CRC() uint32 {
 
 // Delete a story album.
 func (c *Client) StoriesDeleteAlbum(peer InputPeer, albumID int32) (bool, error) {
-	responseData, err := c.MakeRequest(&StoriesDeleteAlbumParams{
+	return c.StoriesDeleteAlbumCtx(context.Background(), peer, albumID)
+}
+
+// StoriesDeleteAlbumCtx is the context-aware variant of StoriesDeleteAlbum.
+func (c *Client) StoriesDeleteAlbumCtx(ctx context.Context, peer InputPeer, albumID int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesDeleteAlbumParams{
 		AlbumID: albumID,
 		Peer:    peer,
 	})
@@ -18567,7 +22088,12 @@ func (*StoriesDeleteStoriesParams) CRC() uint32 {
 
 // Deletes some posted stories.
 func (c *Client) StoriesDeleteStories(peer InputPeer, id []int32) ([]int32, error) {
-	responseData, err := c.MakeRequest(&StoriesDeleteStoriesParams{
+	return c.StoriesDeleteStoriesCtx(context.Background(), peer, id)
+}
+
+// StoriesDeleteStoriesCtx is the context-aware variant of StoriesDeleteStories.
+func (c *Client) StoriesDeleteStoriesCtx(ctx context.Context, peer InputPeer, id []int32) ([]int32, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesDeleteStoriesParams{
 		ID:   id,
 		Peer: peer,
 	})
@@ -18602,7 +22128,12 @@ func (*StoriesEditStoryParams) FlagIndex() int {
 
 // Edit an uploaded story
 func (c *Client) StoriesEditStory(params *StoriesEditStoryParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.StoriesEditStoryCtx(context.Background(), params)
+}
+
+// StoriesEditStoryCtx is the context-aware variant of StoriesEditStory.
+func (c *Client) StoriesEditStoryCtx(ctx context.Context, params *StoriesEditStoryParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending StoriesEditStory: %w", err)
 	}
@@ -18625,7 +22156,12 @@ func (*StoriesExportStoryLinkParams) CRC() uint32 {
 
 // Generate a story deep link for a specific story
 func (c *Client) StoriesExportStoryLink(peer InputPeer, id int32) (*ExportedStoryLink, error) {
-	responseData, err := c.MakeRequest(&StoriesExportStoryLinkParams{
+	return c.StoriesExportStoryLinkCtx(context.Background(), peer, id)
+}
+
+// StoriesExportStoryLinkCtx is the context-aware variant of StoriesExportStoryLink.
+func (c *Client) StoriesExportStoryLinkCtx(ctx context.Context, peer InputPeer, id int32) (*ExportedStoryLink, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesExportStoryLinkParams{
 		ID:   id,
 		Peer: peer,
 	})
@@ -18653,7 +22189,12 @@ func (*StoriesGetAlbumStoriesParams) CRC() uint32 {
 
 // Get stories in a story album.
 func (c *Client) StoriesGetAlbumStories(peer InputPeer, albumID, offset, limit int32) (*StoriesStories, error) {
-	responseData, err := c.MakeRequest(&StoriesGetAlbumStoriesParams{
+	return c.StoriesGetAlbumStoriesCtx(context.Background(), peer, albumID, offset, limit)
+}
+
+// StoriesGetAlbumStoriesCtx is the context-aware variant of StoriesGetAlbumStories.
+func (c *Client) StoriesGetAlbumStoriesCtx(ctx context.Context, peer InputPeer, albumID, offset, limit int32) (*StoriesStories, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesGetAlbumStoriesParams{
 		AlbumID: albumID,
 		Limit:   limit,
 		Offset:  offset,
@@ -18681,7 +22222,12 @@ func (*StoriesGetAlbumsParams) CRC() uint32 {
 
 // Get story albums created by a peer.
 func (c *Client) StoriesGetAlbums(peer InputPeer, hash int64) (StoriesAlbums, error) {
-	responseData, err := c.MakeRequest(&StoriesGetAlbumsParams{
+	return c.StoriesGetAlbumsCtx(context.Background(), peer, hash)
+}
+
+// StoriesGetAlbumsCtx is the context-aware variant of StoriesGetAlbums.
+func (c *Client) StoriesGetAlbumsCtx(ctx context.Context, peer InputPeer, hash int64) (StoriesAlbums, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesGetAlbumsParams{
 		Hash: hash,
 		Peer: peer,
 	})
@@ -18704,7 +22250,12 @@ func (*StoriesGetAllReadPeerStoriesParams) CRC() uint32 {
 
 // Obtain the latest read story ID for all peers when first logging in, returned as a list of updateReadStories updates
 func (c *Client) StoriesGetAllReadPeerStories() (Updates, error) {
-	responseData, err := c.MakeRequest(&StoriesGetAllReadPeerStoriesParams{})
+	return c.StoriesGetAllReadPeerStoriesCtx(context.Background())
+}
+
+// StoriesGetAllReadPeerStoriesCtx is the context-aware variant of StoriesGetAllReadPeerStories.
+func (c *Client) StoriesGetAllReadPeerStoriesCtx(ctx context.Context) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesGetAllReadPeerStoriesParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending StoriesGetAllReadPeerStories: %w", err)
 	}
@@ -18732,7 +22283,12 @@ func (*StoriesGetAllStoriesParams) FlagIndex() int {
 
 // Fetch the List of active (or active and hidden) stories
 func (c *Client) StoriesGetAllStories(next, hidden bool, state string) (StoriesAllStories, error) {
-	responseData, err := c.MakeRequest(&StoriesGetAllStoriesParams{
+	return c.StoriesGetAllStoriesCtx(context.Background(), next, hidden, state)
+}
+
+// StoriesGetAllStoriesCtx is the context-aware variant of StoriesGetAllStories.
+func (c *Client) StoriesGetAllStoriesCtx(ctx context.Context, next, hidden bool, state string) (StoriesAllStories, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesGetAllStoriesParams{
 		Hidden: hidden,
 		Next:   next,
 		State:  state,
@@ -18756,7 +22312,12 @@ func (*StoriesGetChatsToSendParams) CRC() uint32 {
 
 // Obtain a list of channels where the user can post stories
 func (c *Client) StoriesGetChatsToSend() (MessagesChats, error) {
-	responseData, err := c.MakeRequest(&StoriesGetChatsToSendParams{})
+	return c.StoriesGetChatsToSendCtx(context.Background())
+}
+
+// StoriesGetChatsToSendCtx is the context-aware variant of StoriesGetChatsToSend.
+func (c *Client) StoriesGetChatsToSendCtx(ctx context.Context) (MessagesChats, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesGetChatsToSendParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending StoriesGetChatsToSend: %w", err)
 	}
@@ -18778,7 +22339,12 @@ func (*StoriesGetPeerMaxIDsParams) CRC() uint32 {
 
 // Get the IDs of the maximum read stories for a set of peers.
 func (c *Client) StoriesGetPeerMaxIDs(id []InputPeer) ([]*RecentStory, error) {
-	responseData, err := c.MakeRequest(&StoriesGetPeerMaxIDsParams{ID: id})
+	return c.StoriesGetPeerMaxIDsCtx(context.Background(), id)
+}
+
+// StoriesGetPeerMaxIDsCtx is the context-aware variant of StoriesGetPeerMaxIDs.
+func (c *Client) StoriesGetPeerMaxIDsCtx(ctx context.Context, id []InputPeer) ([]*RecentStory, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesGetPeerMaxIDsParams{ID: id})
 	if err != nil {
 		return nil, fmt.Errorf("sending StoriesGetPeerMaxIDs: %w", err)
 	}
@@ -18800,7 +22366,12 @@ func (*StoriesGetPeerStoriesParams) CRC() uint32 {
 
 // Fetch the full active story list of a specific peer.
 func (c *Client) StoriesGetPeerStories(peer InputPeer) (*StoriesPeerStories, error) {
-	responseData, err := c.MakeRequest(&StoriesGetPeerStoriesParams{Peer: peer})
+	return c.StoriesGetPeerStoriesCtx(context.Background(), peer)
+}
+
+// StoriesGetPeerStoriesCtx is the context-aware variant of StoriesGetPeerStories.
+func (c *Client) StoriesGetPeerStoriesCtx(ctx context.Context, peer InputPeer) (*StoriesPeerStories, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesGetPeerStoriesParams{Peer: peer})
 	if err != nil {
 		return nil, fmt.Errorf("sending StoriesGetPeerStories: %w", err)
 	}
@@ -18824,7 +22395,12 @@ func (*StoriesGetPinnedStoriesParams) CRC() uint32 {
 
 // Fetch the stories pinned on a peer's profile.
 func (c *Client) StoriesGetPinnedStories(peer InputPeer, offsetID, limit int32) (*StoriesStories, error) {
-	responseData, err := c.MakeRequest(&StoriesGetPinnedStoriesParams{
+	return c.StoriesGetPinnedStoriesCtx(context.Background(), peer, offsetID, limit)
+}
+
+// StoriesGetPinnedStoriesCtx is the context-aware variant of StoriesGetPinnedStories.
+func (c *Client) StoriesGetPinnedStoriesCtx(ctx context.Context, peer InputPeer, offsetID, limit int32) (*StoriesStories, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesGetPinnedStoriesParams{
 		Limit:    limit,
 		OffsetID: offsetID,
 		Peer:     peer,
@@ -18852,7 +22428,12 @@ func (*StoriesGetStoriesArchiveParams) CRC() uint32 {
 
 // Fetch the story archive of a peer we control.
 func (c *Client) StoriesGetStoriesArchive(peer InputPeer, offsetID, limit int32) (*StoriesStories, error) {
-	responseData, err := c.MakeRequest(&StoriesGetStoriesArchiveParams{
+	return c.StoriesGetStoriesArchiveCtx(context.Background(), peer, offsetID, limit)
+}
+
+// StoriesGetStoriesArchiveCtx is the context-aware variant of StoriesGetStoriesArchive.
+func (c *Client) StoriesGetStoriesArchiveCtx(ctx context.Context, peer InputPeer, offsetID, limit int32) (*StoriesStories, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesGetStoriesArchiveParams{
 		Limit:    limit,
 		OffsetID: offsetID,
 		Peer:     peer,
@@ -18879,7 +22460,12 @@ func (*StoriesGetStoriesByIDParams) CRC() uint32 {
 
 // Obtain full info about a set of stories by their IDs.
 func (c *Client) StoriesGetStoriesByID(peer InputPeer, id []int32) (*StoriesStories, error) {
-	responseData, err := c.MakeRequest(&StoriesGetStoriesByIDParams{
+	return c.StoriesGetStoriesByIDCtx(context.Background(), peer, id)
+}
+
+// StoriesGetStoriesByIDCtx is the context-aware variant of StoriesGetStoriesByID.
+func (c *Client) StoriesGetStoriesByIDCtx(ctx context.Context, peer InputPeer, id []int32) (*StoriesStories, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesGetStoriesByIDParams{
 		ID:   id,
 		Peer: peer,
 	})
@@ -18905,7 +22491,12 @@ func (*StoriesGetStoriesViewsParams) CRC() uint32 {
 
 // Obtain info about the view count, forward count, reactions and recent viewers of one or more stories.
 func (c *Client) StoriesGetStoriesViews(peer InputPeer, id []int32) (*StoriesStoryViews, error) {
-	responseData, err := c.MakeRequest(&StoriesGetStoriesViewsParams{
+	return c.StoriesGetStoriesViewsCtx(context.Background(), peer, id)
+}
+
+// StoriesGetStoriesViewsCtx is the context-aware variant of StoriesGetStoriesViews.
+func (c *Client) StoriesGetStoriesViewsCtx(ctx context.Context, peer InputPeer, id []int32) (*StoriesStoryViews, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesGetStoriesViewsParams{
 		ID:   id,
 		Peer: peer,
 	})
@@ -18939,7 +22530,12 @@ func (*StoriesGetStoryReactionsListParams) FlagIndex() int {
 
 // Get the reaction and interaction list of a story posted to a channel, along with the sender of each reaction.
 func (c *Client) StoriesGetStoryReactionsList(params *StoriesGetStoryReactionsListParams) (*StoriesStoryReactionsList, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.StoriesGetStoryReactionsListCtx(context.Background(), params)
+}
+
+// StoriesGetStoryReactionsListCtx is the context-aware variant of StoriesGetStoryReactionsList.
+func (c *Client) StoriesGetStoryReactionsListCtx(ctx context.Context, params *StoriesGetStoryReactionsListParams) (*StoriesStoryReactionsList, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending StoriesGetStoryReactionsList: %w", err)
 	}
@@ -18972,7 +22568,12 @@ func (*StoriesGetStoryViewsListParams) FlagIndex() int {
 
 // Obtain the list of users that have viewed a specific story we posted
 func (c *Client) StoriesGetStoryViewsList(params *StoriesGetStoryViewsListParams) (*StoriesStoryViewsList, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.StoriesGetStoryViewsListCtx(context.Background(), params)
+}
+
+// StoriesGetStoryViewsListCtx is the context-aware variant of StoriesGetStoryViewsList.
+func (c *Client) StoriesGetStoryViewsListCtx(ctx context.Context, params *StoriesGetStoryViewsListParams) (*StoriesStoryViewsList, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending StoriesGetStoryViewsList: %w", err)
 	}
@@ -18995,7 +22596,12 @@ func (*StoriesIncrementStoryViewsParams) CRC() uint32 {
 
 // Increment the view counter of one or more stories.
 func (c *Client) StoriesIncrementStoryViews(peer InputPeer, id []int32) (bool, error) {
-	responseData, err := c.MakeRequest(&StoriesIncrementStoryViewsParams{
+	return c.StoriesIncrementStoryViewsCtx(context.Background(), peer, id)
+}
+
+// StoriesIncrementStoryViewsCtx is the context-aware variant of StoriesIncrementStoryViews.
+func (c *Client) StoriesIncrementStoryViewsCtx(ctx context.Context, peer InputPeer, id []int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesIncrementStoryViewsParams{
 		ID:   id,
 		Peer: peer,
 	})
@@ -19021,7 +22627,12 @@ func (*StoriesReadStoriesParams) CRC() uint32 {
 
 // Mark all stories up to a certain ID as read, for a given peer; will emit an updateReadStories update to all logged-in sessions.
 func (c *Client) StoriesReadStories(peer InputPeer, maxID int32) ([]int32, error) {
-	responseData, err := c.MakeRequest(&StoriesReadStoriesParams{
+	return c.StoriesReadStoriesCtx(context.Background(), peer, maxID)
+}
+
+// StoriesReadStoriesCtx is the context-aware variant of StoriesReadStories.
+func (c *Client) StoriesReadStoriesCtx(ctx context.Context, peer InputPeer, maxID int32) ([]int32, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesReadStoriesParams{
 		MaxID: maxID,
 		Peer:  peer,
 	})
@@ -19047,7 +22658,12 @@ func (*StoriesReorderAlbumsParams) CRC() uint32 {
 
 // Reorder story albums on a profile.
 func (c *Client) StoriesReorderAlbums(peer InputPeer, order []int32) (bool, error) {
-	responseData, err := c.MakeRequest(&StoriesReorderAlbumsParams{
+	return c.StoriesReorderAlbumsCtx(context.Background(), peer, order)
+}
+
+// StoriesReorderAlbumsCtx is the context-aware variant of StoriesReorderAlbums.
+func (c *Client) StoriesReorderAlbumsCtx(ctx context.Context, peer InputPeer, order []int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesReorderAlbumsParams{
 		Order: order,
 		Peer:  peer,
 	})
@@ -19075,7 +22691,12 @@ func (*StoriesReportParams) CRC() uint32 {
 
 // Report a story.
 func (c *Client) StoriesReport(peer InputPeer, id []int32, option []byte, message string) (ReportResult, error) {
-	responseData, err := c.MakeRequest(&StoriesReportParams{
+	return c.StoriesReportCtx(context.Background(), peer, id, option, message)
+}
+
+// StoriesReportCtx is the context-aware variant of StoriesReport.
+func (c *Client) StoriesReportCtx(ctx context.Context, peer InputPeer, id []int32, option []byte, message string) (ReportResult, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesReportParams{
 		ID:      id,
 		Message: message,
 		Option:  option,
@@ -19110,7 +22731,12 @@ func (*StoriesSearchPostsParams) FlagIndex() int {
 
 // Globally search for stories using a hashtag or a location media area
 func (c *Client) StoriesSearchPosts(params *StoriesSearchPostsParams) (*StoriesFoundStories, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.StoriesSearchPostsCtx(context.Background(), params)
+}
+
+// StoriesSearchPostsCtx is the context-aware variant of StoriesSearchPosts.
+func (c *Client) StoriesSearchPostsCtx(ctx context.Context, params *StoriesSearchPostsParams) (*StoriesFoundStories, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending StoriesSearchPosts: %w", err)
 	}
@@ -19139,7 +22765,12 @@ func (*StoriesSendReactionParams) FlagIndex() int {
 
 // React to a story.
 func (c *Client) StoriesSendReaction(addToRecent bool, peer InputPeer, storyID int32, reaction Reaction) (Updates, error) {
-	responseData, err := c.MakeRequest(&StoriesSendReactionParams{
+	return c.StoriesSendReactionCtx(context.Background(), addToRecent, peer, storyID, reaction)
+}
+
+// StoriesSendReactionCtx is the context-aware variant of StoriesSendReaction.
+func (c *Client) StoriesSendReactionCtx(ctx context.Context, addToRecent bool, peer InputPeer, storyID int32, reaction Reaction) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesSendReactionParams{
 		AddToRecent: addToRecent,
 		Peer:        peer,
 		Reaction:    reaction,
@@ -19183,7 +22814,12 @@ func (*StoriesSendStoryParams) FlagIndex() int {
 
 // Uploads a Telegram Story.
 func (c *Client) StoriesSendStory(params *StoriesSendStoryParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.StoriesSendStoryCtx(context.Background(), params)
+}
+
+// StoriesSendStoryCtx is the context-aware variant of StoriesSendStory.
+func (c *Client) StoriesSendStoryCtx(ctx context.Context, params *StoriesSendStoryParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending StoriesSendStory: %w", err)
 	}
@@ -19217,7 +22853,12 @@ func (*StoriesStartLiveParams) FlagIndex() int {
 }
 
 func (c *Client) StoriesStartLive(params *StoriesStartLiveParams) (Updates, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.StoriesStartLiveCtx(context.Background(), params)
+}
+
+// StoriesStartLiveCtx is the context-aware variant of StoriesStartLive.
+func (c *Client) StoriesStartLiveCtx(ctx context.Context, params *StoriesStartLiveParams) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending StoriesStartLive: %w", err)
 	}
@@ -19239,7 +22880,12 @@ func (*StoriesToggleAllStoriesHiddenParams) CRC() uint32 {
 
 // Hide the active stories of a specific peer, preventing them from being displayed on the action bar on the homescreen.
 func (c *Client) StoriesToggleAllStoriesHidden(hidden bool) (bool, error) {
-	responseData, err := c.MakeRequest(&StoriesToggleAllStoriesHiddenParams{Hidden: hidden})
+	return c.StoriesToggleAllStoriesHiddenCtx(context.Background(), hidden)
+}
+
+// StoriesToggleAllStoriesHiddenCtx is the context-aware variant of StoriesToggleAllStoriesHidden.
+func (c *Client) StoriesToggleAllStoriesHiddenCtx(ctx context.Context, hidden bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesToggleAllStoriesHiddenParams{Hidden: hidden})
 	if err != nil {
 		return false, fmt.Errorf("sending StoriesToggleAllStoriesHidden: %w", err)
 	}
@@ -19262,7 +22908,12 @@ func (*StoriesTogglePeerStoriesHiddenParams) CRC() uint32 {
 
 // Hide the active stories of a user, preventing them from being displayed on the action bar on the homescreen
 func (c *Client) StoriesTogglePeerStoriesHidden(peer InputPeer, hidden bool) (bool, error) {
-	responseData, err := c.MakeRequest(&StoriesTogglePeerStoriesHiddenParams{
+	return c.StoriesTogglePeerStoriesHiddenCtx(context.Background(), peer, hidden)
+}
+
+// StoriesTogglePeerStoriesHiddenCtx is the context-aware variant of StoriesTogglePeerStoriesHidden.
+func (c *Client) StoriesTogglePeerStoriesHiddenCtx(ctx context.Context, peer InputPeer, hidden bool) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesTogglePeerStoriesHiddenParams{
 		Hidden: hidden,
 		Peer:   peer,
 	})
@@ -19289,7 +22940,12 @@ func (*StoriesTogglePinnedParams) CRC() uint32 {
 
 // Pin or unpin one or more stories
 func (c *Client) StoriesTogglePinned(peer InputPeer, id []int32, pinned bool) ([]int32, error) {
-	responseData, err := c.MakeRequest(&StoriesTogglePinnedParams{
+	return c.StoriesTogglePinnedCtx(context.Background(), peer, id, pinned)
+}
+
+// StoriesTogglePinnedCtx is the context-aware variant of StoriesTogglePinned.
+func (c *Client) StoriesTogglePinnedCtx(ctx context.Context, peer InputPeer, id []int32, pinned bool) ([]int32, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesTogglePinnedParams{
 		ID:     id,
 		Peer:   peer,
 		Pinned: pinned,
@@ -19316,7 +22972,12 @@ func (*StoriesTogglePinnedToTopParams) CRC() uint32 {
 
 // Pin some stories to the top of the profile
 func (c *Client) StoriesTogglePinnedToTop(peer InputPeer, id []int32) (bool, error) {
-	responseData, err := c.MakeRequest(&StoriesTogglePinnedToTopParams{
+	return c.StoriesTogglePinnedToTopCtx(context.Background(), peer, id)
+}
+
+// StoriesTogglePinnedToTopCtx is the context-aware variant of StoriesTogglePinnedToTop.
+func (c *Client) StoriesTogglePinnedToTopCtx(ctx context.Context, peer InputPeer, id []int32) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &StoriesTogglePinnedToTopParams{
 		ID:   id,
 		Peer: peer,
 	})
@@ -19350,7 +23011,12 @@ func (*StoriesUpdateAlbumParams) FlagIndex() int {
 
 // Rename a story albums, or add, delete or reorder stories in it.
 func (c *Client) StoriesUpdateAlbum(params *StoriesUpdateAlbumParams) (*StoryAlbum, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.StoriesUpdateAlbumCtx(context.Background(), params)
+}
+
+// StoriesUpdateAlbumCtx is the context-aware variant of StoriesUpdateAlbum.
+func (c *Client) StoriesUpdateAlbumCtx(ctx context.Context, params *StoriesUpdateAlbumParams) (*StoryAlbum, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending StoriesUpdateAlbum: %w", err)
 	}
@@ -19380,7 +23046,12 @@ func (*UpdatesGetChannelDifferenceParams) FlagIndex() int {
 
 // Returns the difference between the current state of updates of a certain channel and transmitted.
 func (c *Client) UpdatesGetChannelDifference(params *UpdatesGetChannelDifferenceParams) (UpdatesChannelDifference, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.UpdatesGetChannelDifferenceCtx(context.Background(), params)
+}
+
+// UpdatesGetChannelDifferenceCtx is the context-aware variant of UpdatesGetChannelDifference.
+func (c *Client) UpdatesGetChannelDifferenceCtx(ctx context.Context, params *UpdatesGetChannelDifferenceParams) (UpdatesChannelDifference, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending UpdatesGetChannelDifference: %w", err)
 	}
@@ -19411,7 +23082,12 @@ func (*UpdatesGetDifferenceParams) FlagIndex() int {
 
 // Get new updates.
 func (c *Client) UpdatesGetDifference(params *UpdatesGetDifferenceParams) (UpdatesDifference, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.UpdatesGetDifferenceCtx(context.Background(), params)
+}
+
+// UpdatesGetDifferenceCtx is the context-aware variant of UpdatesGetDifference.
+func (c *Client) UpdatesGetDifferenceCtx(ctx context.Context, params *UpdatesGetDifferenceParams) (UpdatesDifference, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending UpdatesGetDifference: %w", err)
 	}
@@ -19431,7 +23107,12 @@ func (*UpdatesGetStateParams) CRC() uint32 {
 
 // Returns a current state of updates.
 func (c *Client) UpdatesGetState() (*UpdatesState, error) {
-	responseData, err := c.MakeRequest(&UpdatesGetStateParams{})
+	return c.UpdatesGetStateCtx(context.Background())
+}
+
+// UpdatesGetStateCtx is the context-aware variant of UpdatesGetState.
+func (c *Client) UpdatesGetStateCtx(ctx context.Context) (*UpdatesState, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &UpdatesGetStateParams{})
 	if err != nil {
 		return nil, fmt.Errorf("sending UpdatesGetState: %w", err)
 	}
@@ -19455,7 +23136,12 @@ func (*UploadGetCdnFileParams) CRC() uint32 {
 
 // Download a CDN file.
 func (c *Client) UploadGetCdnFile(fileToken []byte, offset int64, limit int32) (UploadCdnFile, error) {
-	responseData, err := c.MakeRequest(&UploadGetCdnFileParams{
+	return c.UploadGetCdnFileCtx(context.Background(), fileToken, offset, limit)
+}
+
+// UploadGetCdnFileCtx is the context-aware variant of UploadGetCdnFile.
+func (c *Client) UploadGetCdnFileCtx(ctx context.Context, fileToken []byte, offset int64, limit int32) (UploadCdnFile, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &UploadGetCdnFileParams{
 		FileToken: fileToken,
 		Limit:     limit,
 		Offset:    offset,
@@ -19482,7 +23168,12 @@ func (*UploadGetCdnFileHashesParams) CRC() uint32 {
 
 // Get SHA256 hashes for verifying downloaded CDN files
 func (c *Client) UploadGetCdnFileHashes(fileToken []byte, offset int64) ([]*FileHash, error) {
-	responseData, err := c.MakeRequest(&UploadGetCdnFileHashesParams{
+	return c.UploadGetCdnFileHashesCtx(context.Background(), fileToken, offset)
+}
+
+// UploadGetCdnFileHashesCtx is the context-aware variant of UploadGetCdnFileHashes.
+func (c *Client) UploadGetCdnFileHashesCtx(ctx context.Context, fileToken []byte, offset int64) ([]*FileHash, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &UploadGetCdnFileHashesParams{
 		FileToken: fileToken,
 		Offset:    offset,
 	})
@@ -19515,7 +23206,12 @@ func (*UploadGetFileParams) FlagIndex() int {
 
 // Returns content of a whole file or its part.
 func (c *Client) UploadGetFile(params *UploadGetFileParams) (UploadFile, error) {
-	responseData, err := c.MakeRequest(params)
+	return c.UploadGetFileCtx(context.Background(), params)
+}
+
+// UploadGetFileCtx is the context-aware variant of UploadGetFile.
+func (c *Client) UploadGetFileCtx(ctx context.Context, params *UploadGetFileParams) (UploadFile, error) {
+	responseData, err := c.MakeRequestCtx(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("sending UploadGetFile: %w", err)
 	}
@@ -19538,7 +23234,12 @@ func (*UploadGetFileHashesParams) CRC() uint32 {
 
 // Get SHA256 hashes for verifying downloaded files
 func (c *Client) UploadGetFileHashes(location InputFileLocation, offset int64) ([]*FileHash, error) {
-	responseData, err := c.MakeRequest(&UploadGetFileHashesParams{
+	return c.UploadGetFileHashesCtx(context.Background(), location, offset)
+}
+
+// UploadGetFileHashesCtx is the context-aware variant of UploadGetFileHashes.
+func (c *Client) UploadGetFileHashesCtx(ctx context.Context, location InputFileLocation, offset int64) ([]*FileHash, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &UploadGetFileHashesParams{
 		Location: location,
 		Offset:   offset,
 	})
@@ -19565,7 +23266,12 @@ func (*UploadGetWebFileParams) CRC() uint32 {
 
 // Returns content of a web file, by proxying the request through telegram, see the webfile docs for more info.
 func (c *Client) UploadGetWebFile(location InputWebFileLocation, offset, limit int32) (*UploadWebFile, error) {
-	responseData, err := c.MakeRequest(&UploadGetWebFileParams{
+	return c.UploadGetWebFileCtx(context.Background(), location, offset, limit)
+}
+
+// UploadGetWebFileCtx is the context-aware variant of UploadGetWebFile.
+func (c *Client) UploadGetWebFileCtx(ctx context.Context, location InputWebFileLocation, offset, limit int32) (*UploadWebFile, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &UploadGetWebFileParams{
 		Limit:    limit,
 		Location: location,
 		Offset:   offset,
@@ -19592,7 +23298,12 @@ func (*UploadReuploadCdnFileParams) CRC() uint32 {
 
 // Request a reupload of a certain file to a CDN DC.
 func (c *Client) UploadReuploadCdnFile(fileToken, requestToken []byte) ([]*FileHash, error) {
-	responseData, err := c.MakeRequest(&UploadReuploadCdnFileParams{
+	return c.UploadReuploadCdnFileCtx(context.Background(), fileToken, requestToken)
+}
+
+// UploadReuploadCdnFileCtx is the context-aware variant of UploadReuploadCdnFile.
+func (c *Client) UploadReuploadCdnFileCtx(ctx context.Context, fileToken, requestToken []byte) ([]*FileHash, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &UploadReuploadCdnFileParams{
 		FileToken:    fileToken,
 		RequestToken: requestToken,
 	})
@@ -19620,7 +23331,12 @@ func (*UploadSaveBigFilePartParams) CRC() uint32 {
 
 // Saves a part of a large file (over 10 MB in size) to be later passed to one of the methods.
 func (c *Client) UploadSaveBigFilePart(fileID int64, filePart, fileTotalParts int32, bytes []byte) (bool, error) {
-	responseData, err := c.MakeRequest(&UploadSaveBigFilePartParams{
+	return c.UploadSaveBigFilePartCtx(context.Background(), fileID, filePart, fileTotalParts, bytes)
+}
+
+// UploadSaveBigFilePartCtx is the context-aware variant of UploadSaveBigFilePart.
+func (c *Client) UploadSaveBigFilePartCtx(ctx context.Context, fileID int64, filePart, fileTotalParts int32, bytes []byte) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &UploadSaveBigFilePartParams{
 		Bytes:          bytes,
 		FileID:         fileID,
 		FilePart:       filePart,
@@ -19649,7 +23365,12 @@ func (*UploadSaveFilePartParams) CRC() uint32 {
 
 // Saves a part of file for further sending to one of the methods.
 func (c *Client) UploadSaveFilePart(fileID int64, filePart int32, bytes []byte) (bool, error) {
-	responseData, err := c.MakeRequest(&UploadSaveFilePartParams{
+	return c.UploadSaveFilePartCtx(context.Background(), fileID, filePart, bytes)
+}
+
+// UploadSaveFilePartCtx is the context-aware variant of UploadSaveFilePart.
+func (c *Client) UploadSaveFilePartCtx(ctx context.Context, fileID int64, filePart int32, bytes []byte) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &UploadSaveFilePartParams{
 		Bytes:    bytes,
 		FileID:   fileID,
 		FilePart: filePart,
@@ -19675,7 +23396,12 @@ func (*UsersGetFullUserParams) CRC() uint32 {
 
 // Returns extended user info by ID.
 func (c *Client) UsersGetFullUser(id InputUser) (*UsersUserFull, error) {
-	responseData, err := c.MakeRequest(&UsersGetFullUserParams{ID: id})
+	return c.UsersGetFullUserCtx(context.Background(), id)
+}
+
+// UsersGetFullUserCtx is the context-aware variant of UsersGetFullUser.
+func (c *Client) UsersGetFullUserCtx(ctx context.Context, id InputUser) (*UsersUserFull, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &UsersGetFullUserParams{ID: id})
 	if err != nil {
 		return nil, fmt.Errorf("sending UsersGetFullUser: %w", err)
 	}
@@ -19697,7 +23423,12 @@ func (*UsersGetRequirementsToContactParams) CRC() uint32 {
 
 // Check whether we can write to the specified users, used to implement bulk checks for Premium-only messages and paid messages.
 func (c *Client) UsersGetRequirementsToContact(id []InputUser) ([]RequirementToContact, error) {
-	responseData, err := c.MakeRequest(&UsersGetRequirementsToContactParams{ID: id})
+	return c.UsersGetRequirementsToContactCtx(context.Background(), id)
+}
+
+// UsersGetRequirementsToContactCtx is the context-aware variant of UsersGetRequirementsToContact.
+func (c *Client) UsersGetRequirementsToContactCtx(ctx context.Context, id []InputUser) ([]RequirementToContact, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &UsersGetRequirementsToContactParams{ID: id})
 	if err != nil {
 		return nil, fmt.Errorf("sending UsersGetRequirementsToContact: %w", err)
 	}
@@ -19722,7 +23453,12 @@ func (*UsersGetSavedMusicParams) CRC() uint32 {
 
 // Get songs pinned to the user's profile
 func (c *Client) UsersGetSavedMusic(id InputUser, offset, limit int32, hash int64) (UsersSavedMusic, error) {
-	responseData, err := c.MakeRequest(&UsersGetSavedMusicParams{
+	return c.UsersGetSavedMusicCtx(context.Background(), id, offset, limit, hash)
+}
+
+// UsersGetSavedMusicCtx is the context-aware variant of UsersGetSavedMusic.
+func (c *Client) UsersGetSavedMusicCtx(ctx context.Context, id InputUser, offset, limit int32, hash int64) (UsersSavedMusic, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &UsersGetSavedMusicParams{
 		Hash:   hash,
 		ID:     id,
 		Limit:  limit,
@@ -19750,7 +23486,12 @@ func (*UsersGetSavedMusicByIDParams) CRC() uint32 {
 
 // Check if the passed songs are still pinned to the user's profile, or refresh the file references of songs pinned on a user's profile
 func (c *Client) UsersGetSavedMusicByID(id InputUser, documents []InputDocument) (UsersSavedMusic, error) {
-	responseData, err := c.MakeRequest(&UsersGetSavedMusicByIDParams{
+	return c.UsersGetSavedMusicByIDCtx(context.Background(), id, documents)
+}
+
+// UsersGetSavedMusicByIDCtx is the context-aware variant of UsersGetSavedMusicByID.
+func (c *Client) UsersGetSavedMusicByIDCtx(ctx context.Context, id InputUser, documents []InputDocument) (UsersSavedMusic, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &UsersGetSavedMusicByIDParams{
 		Documents: documents,
 		ID:        id,
 	})
@@ -19775,7 +23516,12 @@ func (*UsersGetUsersParams) CRC() uint32 {
 
 // Returns basic user info according to their identifiers.
 func (c *Client) UsersGetUsers(id []InputUser) ([]User, error) {
-	responseData, err := c.MakeRequest(&UsersGetUsersParams{ID: id})
+	return c.UsersGetUsersCtx(context.Background(), id)
+}
+
+// UsersGetUsersCtx is the context-aware variant of UsersGetUsers.
+func (c *Client) UsersGetUsersCtx(ctx context.Context, id []InputUser) ([]User, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &UsersGetUsersParams{ID: id})
 	if err != nil {
 		return nil, fmt.Errorf("sending UsersGetUsers: %w", err)
 	}
@@ -19811,7 +23557,12 @@ func (*UsersSetSecureValueErrorsParams) CRC() uint32 {
 
 // Notify the user that the sent passport data contains some errors The user will not be able to re-submit their Passport data to you until the errors are fixed (the contents of the field for which you returned the error must change).
 func (c *Client) UsersSetSecureValueErrors(id InputUser, errorsw []SecureValueError) (bool, error) {
-	responseData, err := c.MakeRequest(&UsersSetSecureValueErrorsParams{
+	return c.UsersSetSecureValueErrorsCtx(context.Background(), id, errorsw)
+}
+
+// UsersSetSecureValueErrorsCtx is the context-aware variant of UsersSetSecureValueErrors.
+func (c *Client) UsersSetSecureValueErrorsCtx(ctx context.Context, id InputUser, errorsw []SecureValueError) (bool, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &UsersSetSecureValueErrorsParams{
 		Errors: errorsw,
 		ID:     id,
 	})
@@ -19836,7 +23587,12 @@ func (*UsersSuggestBirthdayParams) CRC() uint32 {
 }
 
 func (c *Client) UsersSuggestBirthday(id InputUser, birthday *Birthday) (Updates, error) {
-	responseData, err := c.MakeRequest(&UsersSuggestBirthdayParams{
+	return c.UsersSuggestBirthdayCtx(context.Background(), id, birthday)
+}
+
+// UsersSuggestBirthdayCtx is the context-aware variant of UsersSuggestBirthday.
+func (c *Client) UsersSuggestBirthdayCtx(ctx context.Context, id InputUser, birthday *Birthday) (Updates, error) {
+	responseData, err := c.MakeRequestCtx(ctx, &UsersSuggestBirthdayParams{
 		Birthday: birthday,
 		ID:       id,
 	})
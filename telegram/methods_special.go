@@ -8,6 +8,17 @@ import (
 	"github.com/amarnathcjd/gogram/internal/encoding/tl"
 )
 
+func init() {
+	// wrappers are written by hand, so they are registered here rather than in init_gen.go
+	tl.RegisterObjects(
+		&InitConnectionParams{},
+		&InvokeWithLayerParams{},
+		&InvokeWithoutUpdatesParams{},
+		&InvokeWithMessagesRangeParams{},
+		&InvokeWithTakeoutParams{},
+	)
+}
+
 //invokeAfterMsg#cb9f372d {X:Type} msg_id:long query:!X = X;
 //invokeAfterMsgs#3dc4b4f0 {X:Type} msg_ids:Vector<long> query:!X = X;
 
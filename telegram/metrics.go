@@ -0,0 +1,274 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	mtproto "github.com/amarnathcjd/gogram"
+)
+
+// default RPC latency buckets, in seconds
+var rpcLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// Metrics collects client measurements and serves them in the Prometheus text
+// exposition format. Enable it with ClientConfig.EnableMetrics and mount
+// Client.Metrics().Handler() on any http.ServeMux.
+type Metrics struct {
+	client *Client
+
+	mu              sync.Mutex
+	rpcCalls        map[string]float64 // method, status
+	rpcLatency      map[string]*histogram
+	floodWaits      map[string]float64 // method
+	floodWaitSecs   map[string]float64 // method
+	reconnects      map[string]float64 // dc, result
+	bytesIn         map[string]float64 // transport, dc
+	bytesOut        map[string]float64 // transport, dc
+	exportedSenders map[string]float64 // dc
+}
+
+type histogram struct {
+	counts []uint64 // per bucket, not cumulative
+	sum    float64
+	count  uint64
+}
+
+func (h *histogram) observe(v float64) {
+	i, _ := slices.BinarySearch(rpcLatencyBuckets, v)
+	if i < len(h.counts) {
+		h.counts[i]++
+	}
+	h.sum += v
+	h.count++
+}
+
+func newMetrics(c *Client) *Metrics {
+	return &Metrics{
+		client:          c,
+		rpcCalls:        make(map[string]float64),
+		rpcLatency:      make(map[string]*histogram),
+		floodWaits:      make(map[string]float64),
+		floodWaitSecs:   make(map[string]float64),
+		reconnects:      make(map[string]float64),
+		bytesIn:         make(map[string]float64),
+		bytesOut:        make(map[string]float64),
+		exportedSenders: make(map[string]float64),
+	}
+}
+
+// Metrics returns the metrics registry of the client, or nil if ClientConfig.EnableMetrics was not set.
+func (c *Client) Metrics() *Metrics {
+	return c.metrics
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// labels renders label pairs (name, value, name, value...) in exposition format.
+func labels(pairs ...string) string {
+	var b strings.Builder
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(pairs[i])
+		b.WriteString(`="`)
+		b.WriteString(labelEscaper.Replace(pairs[i+1]))
+		b.WriteByte('"')
+	}
+	return b.String()
+}
+
+// interceptor records per-method call counts and latencies.
+func (m *Metrics) interceptor(ctx context.Context, info *RequestInfo, req TLObject, next Invoker) (any, error) {
+	resp, err := next(ctx, req)
+	elapsed := info.Duration().Seconds()
+
+	status := "ok"
+	if err != nil {
+		status = "error"
+		var rpcErr *mtproto.ErrResponseCode
+		if errors.As(err, &rpcErr) {
+			status = rpcErr.Message
+		}
+	}
+
+	m.mu.Lock()
+	m.rpcCalls[labels("method", info.Method, "status", status)]++
+	key := labels("method", info.Method)
+	h, ok := m.rpcLatency[key]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(rpcLatencyBuckets))}
+		m.rpcLatency[key] = h
+	}
+	h.observe(elapsed)
+	m.mu.Unlock()
+
+	return resp, err
+}
+
+// ObserveReconnect implements mtproto.MetricsSink.
+func (m *Metrics) ObserveReconnect(dc int, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	m.mu.Lock()
+	m.reconnects[labels("dc", strconv.Itoa(dc), "result", result)]++
+	m.mu.Unlock()
+}
+
+// ObserveFloodWait implements mtproto.MetricsSink.
+func (m *Metrics) ObserveFloodWait(method string, seconds int) {
+	key := labels("method", method)
+	m.mu.Lock()
+	m.floodWaits[key]++
+	m.floodWaitSecs[key] += float64(seconds)
+	m.mu.Unlock()
+}
+
+// ObserveTraffic implements mtproto.MetricsSink.
+func (m *Metrics) ObserveTraffic(transport string, dc int, in, out int) {
+	key := labels("transport", transport, "dc", strconv.Itoa(dc))
+	m.mu.Lock()
+	if in > 0 {
+		m.bytesIn[key] += float64(in)
+	}
+	if out > 0 {
+		m.bytesOut[key] += float64(out)
+	}
+	m.mu.Unlock()
+}
+
+func (m *Metrics) observeExportedSender(dc int) {
+	m.mu.Lock()
+	m.exportedSenders[labels("dc", strconv.Itoa(dc))]++
+	m.mu.Unlock()
+}
+
+// Handler returns an http.Handler serving the metrics in the Prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	return m
+}
+
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(w)
+}
+
+// WriteTo writes all metrics to w in the Prometheus text format.
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	bw := bufio.NewWriter(w)
+	cw := &countWriter{w: bw}
+
+	m.mu.Lock()
+	writeFamily(cw, "gogram_rpc_calls_total", "counter", "RPC calls by method and result.", m.rpcCalls)
+	writeHistograms(cw, "gogram_rpc_duration_seconds", "RPC latency by method, including retries.", m.rpcLatency)
+	writeFamily(cw, "gogram_flood_waits_total", "counter", "FLOOD_WAIT errors received by method.", m.floodWaits)
+	writeFamily(cw, "gogram_flood_wait_seconds_total", "counter", "Seconds of FLOOD_WAIT imposed by method.", m.floodWaitSecs)
+	writeFamily(cw, "gogram_reconnects_total", "counter", "Reconnects by data center and result.", m.reconnects)
+	writeFamily(cw, "gogram_transport_received_bytes_total", "counter", "Bytes read from the wire.", m.bytesIn)
+	writeFamily(cw, "gogram_transport_sent_bytes_total", "counter", "Bytes written to the wire.", m.bytesOut)
+	writeFamily(cw, "gogram_exported_senders_created_total", "counter", "Exported senders created by data center.", m.exportedSenders)
+	m.mu.Unlock()
+
+	cached := make(map[string]float64)
+	for dc, n := range m.client.GetExportedSendersStatus() {
+		cached[labels("dc", strconv.Itoa(dc))] = float64(n)
+	}
+	writeFamily(cw, "gogram_exported_senders", "gauge", "Cached exported senders by data center.", cached)
+
+	calls, errs, secs := m.handlerStats()
+	writeFamily(cw, "gogram_handler_calls_total", "counter", "Message handler invocations.", calls)
+	writeFamily(cw, "gogram_handler_errors_total", "counter", "Message handler invocations that returned an error.", errs)
+	writeFamily(cw, "gogram_handler_duration_seconds_total", "counter", "Time spent in message handlers.", secs)
+
+	if stats, ok := m.client.WorkerPoolStats(); ok {
+		writeFamily(cw, "gogram_dispatcher_queue_length", "gauge", "Updates waiting for a worker.", map[string]float64{"": float64(stats.Queued)})
+		writeFamily(cw, "gogram_dispatcher_running", "gauge", "Updates being handled by workers.", map[string]float64{"": float64(stats.Running)})
+		writeFamily(cw, "gogram_dispatcher_keys", "gauge", "Keys with updates waiting or running.", map[string]float64{"": float64(stats.Keys)})
+		writeFamily(cw, "gogram_dispatcher_processed_total", "counter", "Updates handled by workers.", map[string]float64{"": float64(stats.Processed)})
+		writeFamily(cw, "gogram_dispatcher_dropped_total", "counter", "Updates dropped because the worker queue was full.", map[string]float64{"": float64(stats.Dropped)})
+	}
+
+	if err := bw.Flush(); err != nil && cw.err == nil {
+		cw.err = err
+	}
+	return cw.n, cw.err
+}
+
+// handlerStats collects HandlerMetrics of every registered message handler.
+func (m *Metrics) handlerStats() (calls, errs, secs map[string]float64) {
+	calls, errs, secs = make(map[string]float64), make(map[string]float64), make(map[string]float64)
+	d := m.client.dispatcher
+	if d == nil {
+		return
+	}
+
+	d.RLock()
+	defer d.RUnlock()
+	for group, handles := range d.messageHandles {
+		for _, h := range handles {
+			if h.metrics == nil {
+				continue
+			}
+			key := labels("handler", strconv.FormatUint(h.id, 10), "group", strconv.Itoa(group))
+			calls[key] = float64(h.metrics.TotalCalls.Load())
+			errs[key] = float64(h.metrics.Errors.Load())
+			secs[key] = time.Duration(h.metrics.TotalTimeNs.Load()).Seconds()
+		}
+	}
+	return
+}
+
+type countWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (c *countWriter) printf(format string, args ...any) {
+	if c.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(c.w, format, args...)
+	c.n += int64(n)
+	c.err = err
+}
+
+func writeFamily(w *countWriter, name, kind, help string, values map[string]float64) {
+	w.printf("# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		w.printf("%s{%s} %s\n", name, key, formatFloat(values[key]))
+	}
+}
+
+func writeHistograms(w *countWriter, name, help string, values map[string]*histogram) {
+	w.printf("# HELP %s %s\n# TYPE %s histogram\n", name, help, name)
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		h := values[key]
+		var cumulative uint64
+		for i, bound := range rpcLatencyBuckets {
+			cumulative += h.counts[i]
+			w.printf("%s_bucket{%s,le=\"%s\"} %d\n", name, key, formatFloat(bound), cumulative)
+		}
+		w.printf("%s_bucket{%s,le=\"+Inf\"} %d\n", name, key, h.count)
+		w.printf("%s_sum{%s} %s\n", name, key, formatFloat(h.sum))
+		w.printf("%s_count{%s} %d\n", name, key, h.count)
+	}
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
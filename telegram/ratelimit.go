@@ -0,0 +1,370 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	mtproto "github.com/amarnathcjd/gogram"
+)
+
+const (
+	floodPenaltyMin      = 1.0 / 16        // lowest fraction of the configured rate a bucket is slowed down to
+	floodRecoveryPeriod  = 1 * time.Minute // penalty halves after this long without a flood wait
+	rateLimiterMaxHoldup = 24 * time.Hour
+	maxIdlePeerBuckets   = 4096 // idle per-peer buckets are dropped once this many exist
+)
+
+// RateLimit configures a token bucket.
+type RateLimit struct {
+	Rate  float64 // Sustained requests per second; zero disables the limit
+	Burst int     // Requests allowed back to back (default: 1)
+}
+
+// RateLimiterConfig configures a RateLimiter. Every limit is optional.
+type RateLimiterConfig struct {
+	Global  RateLimit            // Applies to every request
+	Methods map[string]RateLimit // Per method, keyed like RequestInfo.Method (e.g. "MessagesSendMessage")
+	Peers   RateLimit            // Per target peer, for requests that carry a Peer field
+	// Requests to a method that is in flood wait for longer than this fail immediately
+	// with the FLOOD_WAIT error instead of being held back (default: wait for any duration).
+	MaxHoldup time.Duration
+}
+
+// RateLimiter schedules requests proactively so they stay within the configured
+// rates, and holds back requests to methods the server has put in flood wait
+// instead of sending them only to be rejected again. A flood wait holds back every
+// request of its method, a slow mode wait only those to its chat. Install it
+// with ClientConfig.RateLimiter, which also has it learn the flood waits that
+// FloodHandler or a retry policy absorb, or with AddInterceptor(limiter.Interceptor).
+type RateLimiter struct {
+	global    *tokenBucket
+	methods   map[string]*tokenBucket
+	peerLimit RateLimit
+	maxHoldup time.Duration
+
+	mu         sync.Mutex
+	peers      map[int64]*tokenBucket
+	floodUntil map[floodKey]time.Time // end of the last flood wait seen for each key
+
+	hooked         atomic.Bool // flood waits come from MTProto, not from the results of Interceptor
+	queued         atomic.Int64
+	queuedByMethod sync.Map // method -> *atomic.Int64
+}
+
+func NewRateLimiter(cfg RateLimiterConfig) *RateLimiter {
+	r := &RateLimiter{
+		global:     newTokenBucket(cfg.Global),
+		methods:    make(map[string]*tokenBucket, len(cfg.Methods)),
+		peerLimit:  cfg.Peers,
+		maxHoldup:  getValue(cfg.MaxHoldup, rateLimiterMaxHoldup),
+		peers:      make(map[int64]*tokenBucket),
+		floodUntil: make(map[floodKey]time.Time),
+	}
+	for method, limit := range cfg.Methods {
+		r.methods[method] = newTokenBucket(limit)
+	}
+	return r
+}
+
+// RateLimiter returns the rate limiter installed through ClientConfig, or nil.
+func (c *Client) RateLimiter() *RateLimiter {
+	return c.rateLimiter
+}
+
+// QueueDepth returns the number of requests currently held back by the limiter.
+func (r *RateLimiter) QueueDepth() int {
+	return int(r.queued.Load())
+}
+
+// QueueDepthByMethod returns the number of held back requests per method.
+func (r *RateLimiter) QueueDepthByMethod() map[string]int {
+	depth := make(map[string]int)
+	r.queuedByMethod.Range(func(key, value any) bool {
+		if n := value.(*atomic.Int64).Load(); n > 0 {
+			depth[key.(string)] = int(n)
+		}
+		return true
+	})
+	return depth
+}
+
+// floodKey is what a flood wait applies to: a method, or, for slow mode waits, a
+// method used on one peer.
+type floodKey struct {
+	method  string
+	peerID  int64
+	hasPeer bool
+}
+
+func newFloodKey(method string, req TLObject) floodKey {
+	peerID, hasPeer := requestPeerID(req)
+	return floodKey{method: method, peerID: peerID, hasPeer: hasPeer}
+}
+
+func (k floodKey) String() string {
+	if !k.hasPeer {
+		return k.method
+	}
+	return k.method + ":" + strconv.FormatInt(k.peerID, 10)
+}
+
+// FloodWaits returns what is currently in flood wait and when the wait ends,
+// keyed by method, or by method and peer ID for slow mode waits, with the peer ID
+// in the Bot API form (e.g. "MessagesSendMessage:-1001234567890").
+func (r *RateLimiter) FloodWaits() map[string]time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	waits := make(map[string]time.Time)
+	for key, until := range r.floodUntil {
+		if !until.After(now) {
+			delete(r.floodUntil, key)
+			continue
+		}
+		waits[key.String()] = until
+	}
+	return waits
+}
+
+// Interceptor delays every request until the limits allow it, and learns flood waits from the results.
+func (r *RateLimiter) Interceptor(ctx context.Context, info *RequestInfo, req TLObject, next Invoker) (any, error) {
+	key := newFloodKey(info.Method, req)
+	if err := r.wait(ctx, key); err != nil {
+		return nil, err
+	}
+
+	resp, err := next(ctx, req)
+	var slowMode *SlowModeWaitError
+	if errors.As(err, &slowMode) && key.hasPeer {
+		r.observeFloodWait(key, time.Duration(slowMode.Seconds)*time.Second, true)
+	} else if wait := GetFloodWait(err); wait > 0 && !r.hooked.Load() {
+		r.observeFloodWait(key, time.Duration(wait)*time.Second, false)
+	}
+	return resp, err
+}
+
+// floodWaitHook returns the function MTProto calls on every flood wait, also the
+// ones retried before the result reaches Interceptor.
+func (r *RateLimiter) floodWaitHook() func(method string, req TLObject, wait time.Duration) {
+	r.hooked.Store(true)
+	return func(method string, req TLObject, wait time.Duration) {
+		r.observeFloodWait(newFloodKey(method, req), wait, false)
+	}
+}
+
+func (r *RateLimiter) wait(ctx context.Context, key floodKey) error {
+	method := key.method
+	now := time.Now()
+	readyAt := now
+
+	r.mu.Lock()
+	until := r.floodUntil[floodKey{method: method}]
+	if key.hasPeer {
+		until = maxTime(until, r.floodUntil[key])
+	}
+	if until.After(now) {
+		if until.Sub(now) > r.maxHoldup {
+			r.mu.Unlock()
+			seconds := int(until.Sub(now).Seconds()) + 1
+			return &FloodWaitError{
+				ErrResponseCode: &mtproto.ErrResponseCode{
+					Code:           420,
+					Message:        "FLOOD_WAIT_X",
+					Description:    fmt.Sprintf("Please wait %d seconds before repeating the action. (method: %s)", seconds, method),
+					AdditionalInfo: seconds,
+					Method:         method,
+				},
+				Seconds: seconds,
+			}
+		}
+		readyAt = maxTime(readyAt, until)
+	}
+	var peer *tokenBucket
+	if key.hasPeer && r.peerLimit.Rate > 0 {
+		peer = r.peers[key.peerID]
+		if peer == nil {
+			if len(r.peers) >= maxIdlePeerBuckets {
+				maps.DeleteFunc(r.peers, func(_ int64, b *tokenBucket) bool { return b.idle(now) })
+			}
+			peer = newTokenBucket(r.peerLimit)
+			r.peers[key.peerID] = peer
+		}
+	}
+	r.mu.Unlock()
+
+	var reserved []*tokenBucket
+	for _, b := range []*tokenBucket{r.global, r.methods[method], peer} {
+		if b == nil {
+			continue
+		}
+		readyAt = maxTime(readyAt, b.reserve(now))
+		reserved = append(reserved, b)
+	}
+
+	delay := time.Until(readyAt)
+	if delay <= 0 {
+		return nil
+	}
+
+	r.queued.Add(1)
+	counter, _ := r.queuedByMethod.LoadOrStore(method, &atomic.Int64{})
+	counter.(*atomic.Int64).Add(1)
+	defer func() {
+		r.queued.Add(-1)
+		counter.(*atomic.Int64).Add(-1)
+	}()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		for _, b := range reserved {
+			b.release()
+		}
+		return fmt.Errorf("waiting for rate limit: %w", ctx.Err())
+	}
+}
+
+// observeFloodWait records a wait of the method of key, or, if peerScoped, of its
+// method used on its peer, and slows down the buckets the request went through.
+func (r *RateLimiter) observeFloodWait(key floodKey, wait time.Duration, peerScoped bool) {
+	until := time.Now().Add(wait)
+	scope := floodKey{method: key.method}
+	if peerScoped {
+		scope = key
+	}
+
+	r.mu.Lock()
+	if until.After(r.floodUntil[scope]) {
+		r.floodUntil[scope] = until
+	}
+	peer := r.peers[key.peerID]
+	r.mu.Unlock()
+
+	r.global.penalize()
+	if b := r.methods[key.method]; b != nil {
+		b.penalize()
+	}
+	if key.hasPeer && peer != nil {
+		peer.penalize()
+	}
+}
+
+// tokenBucket is a token bucket whose rate is cut on every flood wait and
+// recovers gradually while no new flood waits arrive.
+type tokenBucket struct {
+	mu        sync.Mutex
+	rate      float64
+	burst     float64
+	tokens    float64
+	last      time.Time
+	penalty   float64 // fraction of rate currently allowed
+	lastFlood time.Time
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	if limit.Rate <= 0 {
+		return nil
+	}
+	burst := float64(max(limit.Burst, 1))
+	return &tokenBucket{
+		rate:    limit.Rate,
+		burst:   burst,
+		tokens:  burst,
+		last:    time.Now(),
+		penalty: 1,
+	}
+}
+
+// reserve takes a token and returns when it becomes available.
+func (b *tokenBucket) reserve(now time.Time) time.Time {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for b.penalty < 1 && now.Sub(b.lastFlood) >= floodRecoveryPeriod {
+		b.penalty = min(b.penalty*2, 1)
+		b.lastFlood = b.lastFlood.Add(floodRecoveryPeriod)
+	}
+
+	rate := b.rate * b.penalty
+	if now.After(b.last) {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*rate)
+		b.last = now
+	}
+	b.tokens--
+	if b.tokens >= 0 {
+		return now
+	}
+	return now.Add(time.Duration(-b.tokens / rate * float64(time.Second)))
+}
+
+// idle reports whether the bucket is full again and carries no flood penalty, so dropping it loses nothing.
+func (b *tokenBucket) idle(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.penalty == 1 && b.tokens+now.Sub(b.last).Seconds()*b.rate >= b.burst
+}
+
+func (b *tokenBucket) release() {
+	b.mu.Lock()
+	b.tokens = min(b.burst, b.tokens+1)
+	b.mu.Unlock()
+}
+
+func (b *tokenBucket) penalize() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	b.penalty = max(b.penalty/2, floodPenaltyMin)
+	b.lastFlood = time.Now()
+	b.mu.Unlock()
+}
+
+// requestPeerID returns the ID of the peer a request targets, if it has a Peer
+// field, in the Bot API form like peerKey, which keeps users, chats and channels apart.
+func requestPeerID(req TLObject) (int64, bool) {
+	v := reflect.ValueOf(req)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return 0, false
+	}
+	field := v.Elem().FieldByName("Peer")
+	if !field.IsValid() || !field.CanInterface() {
+		return 0, false
+	}
+
+	switch peer := field.Interface().(type) {
+	case *InputPeerUser:
+		return peer.UserID, true
+	case *InputPeerChat:
+		return peerKey(&PeerChat{ChatID: peer.ChatID}), true
+	case *InputPeerChannel:
+		return peerKey(&PeerChannel{ChannelID: peer.ChannelID}), true
+	case *InputPeerUserFromMessage:
+		return peer.UserID, true
+	case *InputPeerChannelFromMessage:
+		return peerKey(&PeerChannel{ChannelID: peer.ChannelID}), true
+	case *InputPeerSelf:
+		return 0, true
+	}
+	return 0, false
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mtproto "github.com/amarnathcjd/gogram"
+)
+
+// A flood wait holds back its method for every peer.
+func TestRateLimiterFloodWait(t *testing.T) {
+	r := NewRateLimiter(RateLimiterConfig{MaxHoldup: time.Second})
+	info := &RequestInfo{Method: "MessagesSendMessage"}
+	flooded := &MessagesSendMessageParams{Peer: &InputPeerUser{UserID: 1}}
+	other := &MessagesSendMessageParams{Peer: &InputPeerUser{UserID: 2}}
+
+	r.floodWaitHook()(info.Method, flooded, time.Minute)
+	if waits := r.FloodWaits(); len(waits) != 1 || waits["MessagesSendMessage"].IsZero() {
+		t.Fatalf("flood waits %v, want MessagesSendMessage", waits)
+	}
+
+	var sent int
+	next := func(context.Context, TLObject) (any, error) {
+		sent++
+		return nil, nil
+	}
+	for _, req := range []TLObject{flooded, other} {
+		_, err := r.Interceptor(context.Background(), info, req, next)
+		if wait := GetFloodWait(err); wait < 59 || sent != 0 {
+			t.Fatalf("request during the flood wait: %v, sent %d", err, sent)
+		}
+	}
+}
+
+// A slow mode wait holds back its chat only, which is told apart from a user or
+// channel of the same ID.
+func TestRateLimiterSlowModeWait(t *testing.T) {
+	r := NewRateLimiter(RateLimiterConfig{MaxHoldup: time.Second})
+	info := &RequestInfo{Method: "MessagesSendMessage"}
+	slowed := &MessagesSendMessageParams{Peer: &InputPeerChat{ChatID: 777}}
+
+	var sent int
+	next := func(_ context.Context, req TLObject) (any, error) {
+		sent++
+		if req == slowed {
+			return nil, &SlowModeWaitError{
+				ErrResponseCode: &mtproto.ErrResponseCode{Code: 420, Message: "SLOWMODE_WAIT_X", AdditionalInfo: 60},
+				Seconds:         60,
+			}
+		}
+		return nil, nil
+	}
+	r.Interceptor(context.Background(), info, slowed, next)
+	if waits := r.FloodWaits(); len(waits) != 1 || waits["MessagesSendMessage:-777"].IsZero() {
+		t.Fatalf("flood waits %v, want MessagesSendMessage:-777", waits)
+	}
+
+	for _, peer := range []InputPeer{&InputPeerUser{UserID: 777}, &InputPeerChannel{ChannelID: 777}} {
+		if _, err := r.Interceptor(context.Background(), info, &MessagesSendMessageParams{Peer: peer}, next); err != nil {
+			t.Fatalf("request to %T 777: %v", peer, err)
+		}
+	}
+	if sent != 3 {
+		t.Fatalf("sent %d requests, want 3", sent)
+	}
+	_, err := r.Interceptor(context.Background(), info, slowed, next)
+	if wait := GetFloodWait(err); wait < 59 || sent != 3 {
+		t.Fatalf("request to the slowed chat: %v, sent %d", err, sent)
+	}
+}
+
+// A user, a chat and a channel of the same ID are different peers.
+func TestRequestPeerID(t *testing.T) {
+	seen := make(map[int64]InputPeer)
+	for _, peer := range []InputPeer{&InputPeerUser{UserID: 777}, &InputPeerChat{ChatID: 777}, &InputPeerChannel{ChannelID: 777}} {
+		id, ok := requestPeerID(&MessagesSendMessageParams{Peer: peer})
+		if !ok {
+			t.Fatalf("%T: no peer", peer)
+		}
+		if other, ok := seen[id]; ok {
+			t.Fatalf("%T and %T share the peer ID %d", other, peer, id)
+		}
+		seen[id] = peer
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import mtproto "github.com/amarnathcjd/gogram"
+
+type (
+	// RetryPolicy tells how a failed request is sent again, see ClientConfig.RetryPolicies.
+	RetryPolicy = mtproto.RetryPolicy
+	// RetryPolicies chooses the RetryPolicy of a request by its method and the class of its error.
+	RetryPolicies = mtproto.RetryPolicies
+	// Idempotency tells whether a request may be sent again when it may have been executed already.
+	Idempotency = mtproto.Idempotency
+)
+
+const (
+	IdempotentReads  = mtproto.IdempotentReads
+	IdempotentAlways = mtproto.IdempotentAlways
+	IdempotentNever  = mtproto.IdempotentNever
+)
+
+// DefaultRetryPolicies returns policies that retry server errors and timeouts
+// with backoff and absorb short flood waits, resending only reads when a
+// request may have been executed already.
+func DefaultRetryPolicies() *RetryPolicies {
+	return mtproto.DefaultRetryPolicies()
+}
+
+// fallbackSender returns a connection to dcID authorized as this client, for
+// requests whose RetryPolicy falls back to another DC.
+func (c *Client) fallbackSender(dcID int) (*mtproto.MTProto, error) {
+	if senders := c.exSenders.GetSenders(dcID); len(senders) > 0 {
+		return senders[0].MTProto, nil
+	}
+	conn, err := c.CreateExportedSender(dcID, false)
+	if err != nil {
+		return nil, err
+	}
+	c.exSenders.AddSender(dcID, NewExSender(conn))
+	return conn, nil
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import "github.com/amarnathcjd/gogram/internal/session"
+
+type (
+	// SessionStorage persists the auth state of a client. Pass one in
+	// ClientConfig.SessionStorage to keep sessions anywhere, e.g. in your own
+	// database, instead of a session file.
+	SessionStorage = session.SessionLoader
+	// SessionState is the auth state loaded from and stored into a SessionStorage.
+	SessionState = session.Session
+	// SessionDCAuth is the auth key of a DC other than the home one, part of SessionState.
+	SessionDCAuth = session.DCAuth
+	// SessionFutureSalt is a scheduled server salt, part of SessionState.
+	SessionFutureSalt = session.FutureSalt
+	// KVStore is a minimal key-value store a session can be kept in, see NewKVSession.
+	KVStore = session.KVStore
+)
+
+// NewFileSession stores the session in a file at path, in the default session file format.
+func NewFileSession(path string) SessionStorage {
+	return session.NewFromFile(joinAbsWorkingDir(path), "")
+}
+
+// NewEncryptedFileSession stores the session in a file at path, encrypted with aesKey.
+func NewEncryptedFileSession(path, aesKey string) SessionStorage {
+	return session.NewFromFile(joinAbsWorkingDir(path), aesKey)
+}
+
+// NewMemorySession keeps the session in memory only; it is lost when the process exits.
+func NewMemorySession() SessionStorage {
+	return session.NewInMemory()
+}
+
+// NewKVSession stores the session under key in store. The value is encrypted
+// when an aesKey is given, otherwise it is plain JSON.
+func NewKVSession(store KVStore, key string, aesKey ...string) SessionStorage {
+	return session.NewKV(store, key, getVariadic(aesKey, ""))
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegramtest_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/amarnathcjd/gogram/telegram"
+	"github.com/amarnathcjd/gogram/telegram/telegramtest"
+)
+
+// Logging in through the auth methods themselves, as the gateway does, resumes
+// updates like Login does.
+func TestRawLoginResumesUpdates(t *testing.T) {
+	srv, err := telegramtest.NewServer(telegramtest.Config{})
+	if err != nil {
+		t.Fatalf("starting server: %v", err)
+	}
+	t.Cleanup(func() { srv.Close() })
+	srv.Respond("AuthCheckPassword", &telegram.AuthAuthorizationObj{User: self})
+	srv.Respond("UpdatesGetState", &telegram.UpdatesState{Pts: 5, Date: int32(time.Now().Unix())})
+
+	store := telegram.NewFileUpdateStateStore(filepath.Join(t.TempDir(), "state.json"))
+	config := srv.ClientConfig()
+	config.UpdateStateStore = store
+	client := newClient(t, config)
+	if err := client.Connect(); err != nil {
+		t.Fatalf("connecting: %v", err)
+	}
+	if _, err := client.AuthCheckPassword(&telegram.InputCheckPasswordEmpty{}); err != nil {
+		t.Fatalf("auth.checkPassword: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		saved, err := store.LoadUpdateState()
+		if err != nil {
+			t.Fatalf("loading update state: %v", err)
+		}
+		if saved != nil && saved.Pts == 5 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("update state not resumed, saved %+v", saved)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
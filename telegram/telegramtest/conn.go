@@ -0,0 +1,446 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegramtest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	ige "github.com/amarnathcjd/gogram/internal/aes_ige"
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+	"github.com/amarnathcjd/gogram/internal/mode"
+	"github.com/amarnathcjd/gogram/internal/mtproto/messages"
+	"github.com/amarnathcjd/gogram/internal/mtproto/objects"
+	"github.com/amarnathcjd/gogram/internal/utils"
+	"github.com/amarnathcjd/gogram/telegram"
+)
+
+const (
+	// transport error the server answers with when it does not know the auth key
+	codeAuthKeyNotFound = -404
+
+	// frames queued for writing before writers block, see serverConn.out
+	outboxSize = 1024
+)
+
+// serverConn is the server side of one client connection.
+type serverConn struct {
+	srv  *Server
+	conn net.Conn
+	mode mode.Mode
+
+	handshake *handshake
+
+	writeMu   sync.Mutex
+	authKey   []byte
+	authKeyID uint64
+	salt      int64
+	sessionID int64
+	seqNo     int32
+	lastMsgID int64
+
+	wantsUpdates atomic.Bool
+
+	// frames are written by their own goroutine, so the connection keeps reading
+	// while the client is blocked writing, as it would with a socket buffer
+	out  chan []byte
+	done chan struct{}
+}
+
+func newServerConn(srv *Server, conn net.Conn) *serverConn {
+	return &serverConn{
+		srv:  srv,
+		conn: conn,
+		out:  make(chan []byte, outboxSize),
+		done: make(chan struct{}),
+	}
+}
+
+// fullReader makes every Read fill the whole buffer, which the transport modes rely on.
+type fullReader struct {
+	r io.Reader
+	w io.Writer
+}
+
+func (f *fullReader) Read(b []byte) (int, error)  { return io.ReadFull(f.r, b) }
+func (f *fullReader) Write(b []byte) (int, error) { return f.w.Write(b) }
+
+func (c *serverConn) serve() {
+	defer c.conn.Close()
+
+	var err error
+	c.mode, err = c.detectMode()
+	if err != nil {
+		return
+	}
+	defer close(c.done)
+	go c.writeLoop()
+
+	for {
+		frame, err := c.mode.ReadMsg()
+		if err != nil {
+			return
+		}
+		if err := c.handleFrame(frame); err != nil {
+			return
+		}
+	}
+}
+
+func (c *serverConn) writeLoop() {
+	for {
+		select {
+		case frame := <-c.out:
+			if err := c.mode.WriteMsg(frame); err != nil {
+				c.conn.Close()
+				return
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// writeFrame queues a frame for writing.
+func (c *serverConn) writeFrame(frame []byte) error {
+	select {
+	case c.out <- frame:
+		return nil
+	case <-c.done:
+		return errors.New("connection closed")
+	}
+}
+
+// detectMode reads the transport announcement the client starts the connection with.
+func (c *serverConn) detectMode() (mode.Mode, error) {
+	r := bufio.NewReader(c.conn)
+	rw := &fullReader{r: r, w: c.conn}
+
+	first, err := r.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+	if first[0] == 0xef {
+		r.Discard(1)
+		return mode.NewWithoutAnnouncement(mode.Abridged, rw)
+	}
+
+	tag, err := r.Peek(4)
+	if err != nil {
+		return nil, err
+	}
+	switch binary.LittleEndian.Uint32(tag) {
+	case 0xeeeeeeee:
+		r.Discard(4)
+		return mode.NewWithoutAnnouncement(mode.Intermediate, rw)
+	case 0xdddddddd:
+		r.Discard(4)
+		return mode.NewWithoutAnnouncement(mode.PaddedIntermediate, rw)
+	}
+	// the full transport has no announcement, the first word is already a packet length
+	return mode.NewWithoutAnnouncement(mode.Full, rw)
+}
+
+func (c *serverConn) handleFrame(frame []byte) error {
+	if len(frame) < tl.LongLen {
+		return errors.New("frame too short")
+	}
+
+	keyID := binary.LittleEndian.Uint64(frame[:tl.LongLen])
+	if keyID == 0 {
+		// messages.DeserializeUnencrypted expects server message IDs, so the header is read here
+		const headerLen = 2*tl.LongLen + tl.WordLen
+		if len(frame) < headerLen || int(binary.LittleEndian.Uint32(frame[2*tl.LongLen:])) != len(frame)-headerLen {
+			return errors.New("malformed unencrypted message")
+		}
+		return c.handleHandshake(frame[headerLen:])
+	}
+
+	if keyID != c.authKeyID {
+		key, ok := c.srv.authKey(keyID)
+		if !ok {
+			return c.writeCode(codeAuthKeyNotFound)
+		}
+		c.writeMu.Lock()
+		c.authKey, c.authKeyID, c.salt = key.key, keyID, key.salt
+		c.writeMu.Unlock()
+	}
+
+	msgID, sessionID, body, err := c.decrypt(frame)
+	if err != nil {
+		return fmt.Errorf("decrypting message: %w", err)
+	}
+
+	c.writeMu.Lock()
+	newSession := sessionID != c.sessionID
+	if newSession {
+		c.sessionID = sessionID
+		c.seqNo = 0
+	}
+	salt := c.salt
+	c.writeMu.Unlock()
+
+	if newSession {
+		if err := c.writeObject(&objects.NewSessionCreated{
+			FirstMsgID: msgID,
+			UniqueID:   utils.GenerateSessionID(),
+			ServerSalt: salt,
+		}, false); err != nil {
+			return err
+		}
+	}
+	return c.handleMessage(msgID, body)
+}
+
+// decrypt opens an encrypted message of the client.
+func (c *serverConn) decrypt(frame []byte) (msgID, sessionID int64, body []byte, err error) {
+	const headerLen = tl.LongLen + tl.Int128Len
+	const innerHeaderLen = 32 // salt, session id, msg id, seq no, length
+
+	if len(frame) <= headerLen {
+		return 0, 0, nil, errors.New("message too short")
+	}
+	msgKey := frame[tl.LongLen:headerLen]
+	plain, err := ige.DecryptServer(frame[headerLen:], c.authKey, msgKey)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if !bytes.Equal(ige.MessageKey(c.authKey, plain, false), msgKey) {
+		return 0, 0, nil, errors.New("wrong message key")
+	}
+	if len(plain) < innerHeaderLen {
+		return 0, 0, nil, errors.New("message too short")
+	}
+
+	sessionID = int64(binary.LittleEndian.Uint64(plain[8:]))
+	msgID = int64(binary.LittleEndian.Uint64(plain[16:]))
+	length := int(binary.LittleEndian.Uint32(plain[28:]))
+	if length < 0 || innerHeaderLen+length > len(plain) {
+		return 0, 0, nil, fmt.Errorf("message length %d out of range", length)
+	}
+	return msgID, sessionID, plain[innerHeaderLen : innerHeaderLen+length], nil
+}
+
+func (c *serverConn) handleMessage(msgID int64, body []byte) error {
+	obj, err := tl.DecodeUnknownObject(body)
+	if err != nil {
+		return c.writeRPCError(msgID, 400, "INPUT_METHOD_INVALID")
+	}
+	return c.handleObject(msgID, obj)
+}
+
+func (c *serverConn) handleObject(msgID int64, obj tl.Object) error {
+	switch obj := obj.(type) {
+	case *objects.MessageContainer:
+		for _, msg := range *obj {
+			if err := c.handleMessage(msg.MsgID, msg.Msg); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case *objects.GzipPacked:
+		return c.handleObject(msgID, obj.Obj)
+
+	case *objects.MsgsAck, *objects.MsgResendReq, *objects.MsgResendAnsReq:
+		return nil
+
+	case *objects.PingParams:
+		return c.writeObject(&objects.Pong{MsgID: msgID, PingID: obj.PingID}, true)
+
+	case *utils.PingParams:
+		return c.writeObject(&objects.Pong{MsgID: msgID, PingID: obj.PingID}, true)
+
+	case *objects.PingDelayDisconnectParams:
+		return c.writeObject(&objects.Pong{MsgID: msgID, PingID: obj.PingID}, true)
+
+	case *objects.GetFutureSaltsParams:
+		c.writeMu.Lock()
+		salt := c.salt
+		c.writeMu.Unlock()
+
+		now := int32(time.Now().Unix())
+		salts := &objects.FutureSalts{ReqMsgID: msgID, Now: now}
+		for i := range max(obj.Num, 1) {
+			salts.Salts = append(salts.Salts, &objects.FutureSalt{
+				ValidSince: now + i*3600,
+				ValidUntil: now + (i+1)*3600,
+				Salt:       salt,
+			})
+		}
+		return c.writeObject(salts, true)
+
+	case *objects.MsgsStateReq:
+		// every message is reported as received; answers are never lost here
+		return c.writeObject(&objects.MsgsStateInfo{ReqMsgID: msgID, Info: bytes.Repeat([]byte{4}, len(obj.MsgIDs))}, true)
+	}
+
+	return c.call(msgID, obj)
+}
+
+// call runs a request through its handler and writes the rpc_result.
+func (c *serverConn) call(msgID int64, req tl.Object) error {
+	if err := c.answer(msgID, req); err != nil {
+		return err
+	}
+	if c.srv.afterCall != nil {
+		c.srv.afterCall()
+	}
+	return nil
+}
+
+func (c *serverConn) answer(msgID int64, req tl.Object) error {
+	query, withoutUpdates := unwrapQuery(req)
+	if !withoutUpdates {
+		c.wantsUpdates.Store(true)
+	}
+
+	method := utils.FmtMethod(query)
+	h := c.srv.handler(method)
+	if h == nil {
+		return c.writeRPCError(msgID, 400, "INPUT_METHOD_INVALID")
+	}
+
+	result, err := h(query)
+	if err != nil {
+		var rpcErr *Error
+		if errors.As(err, &rpcErr) {
+			return c.writeRPCError(msgID, rpcErr.Code, rpcErr.Message)
+		}
+		return c.writeRPCError(msgID, 500, err.Error())
+	}
+	if result == nil {
+		return c.writeRPCError(msgID, 500, "handler of "+method+" returned no result")
+	}
+
+	data, ok := result.(rawResult)
+	if !ok {
+		if data, err = tl.Marshal(result); err != nil {
+			return c.writeRPCError(msgID, 500, "marshaling result: "+err.Error())
+		}
+	}
+
+	// rpc_result is written by hand, its result may be a bool or a vector
+	buf := bytes.NewBuffer(nil)
+	e := tl.NewEncoder(buf)
+	e.PutUint(objects.CrcRpcResult)
+	e.PutLong(msgID)
+	e.PutRawBytes(data)
+	return c.writeEncrypted(buf.Bytes(), true)
+}
+
+// rawResult is an already TL-encoded result, written as is.
+type rawResult []byte
+
+func (c *serverConn) writeRPCError(msgID int64, code int32, message string) error {
+	return c.writeObject(&objects.RpcResult{
+		ReqMsgID: msgID,
+		Obj:      &objects.RpcError{ErrorCode: code, ErrorMessage: message},
+	}, true)
+}
+
+// unwrapQuery strips invokeWithLayer, initConnection and the other invoke
+// wrappers off a request, reporting whether one of them was invokeWithoutUpdates.
+func unwrapQuery(req tl.Object) (tl.Object, bool) {
+	withoutUpdates := false
+	for {
+		switch q := req.(type) {
+		case *telegram.InvokeWithLayerParams:
+			req = q.Query
+		case *telegram.InitConnectionParams:
+			req = q.Query
+		case *telegram.InvokeWithoutUpdatesParams:
+			withoutUpdates = true
+			req = q.Query
+		case *telegram.InvokeWithMessagesRangeParams:
+			req = q.Query
+		case *telegram.InvokeWithTakeoutParams:
+			req = q.Query
+		default:
+			return req, withoutUpdates
+		}
+	}
+}
+
+func (c *serverConn) writeObject(obj tl.Object, response bool) error {
+	data, err := tl.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("marshaling %T: %w", obj, err)
+	}
+	return c.writeEncrypted(data, response)
+}
+
+// writeEncrypted sends a content-related message encrypted with the auth key of the connection.
+func (c *serverConn) writeEncrypted(msg []byte, response bool) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if c.authKey == nil {
+		return errors.New("no auth key negotiated yet")
+	}
+
+	buf := bytes.NewBuffer(nil)
+	e := tl.NewEncoder(buf)
+	e.PutLong(c.salt)
+	e.PutLong(c.sessionID)
+	e.PutLong(c.nextMsgID(response))
+	e.PutInt(c.seqNo*2 + 1)
+	e.PutInt(int32(len(msg)))
+	e.PutRawBytes(msg)
+	c.seqNo++
+
+	encrypted, msgKey, err := ige.EncryptServer(buf.Bytes(), c.authKey)
+	if err != nil {
+		return fmt.Errorf("encrypting: %w", err)
+	}
+
+	frame := make([]byte, 0, tl.LongLen+tl.Int128Len+len(encrypted))
+	frame = binary.LittleEndian.AppendUint64(frame, c.authKeyID)
+	frame = append(frame, msgKey...)
+	frame = append(frame, encrypted...)
+	return c.writeFrame(frame)
+}
+
+func (c *serverConn) writeUnencrypted(obj tl.Object) error {
+	data, err := tl.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("marshaling %T: %w", obj, err)
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	msg := &messages.Unencrypted{Msg: data, MsgID: c.nextMsgID(true)}
+	frame, _ := msg.Serialize(nil)
+	return c.writeFrame(frame)
+}
+
+// writeCode sends a bare transport error code, like the server does for unknown auth keys.
+func (c *serverConn) writeCode(code int32) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.writeFrame(binary.LittleEndian.AppendUint32(nil, uint32(code)))
+}
+
+// nextMsgID returns a strictly increasing server message ID, ending in 1 for
+// responses and in 3 for everything else. Callers hold writeMu.
+func (c *serverConn) nextMsgID(response bool) int64 {
+	kind := int64(3)
+	if response {
+		kind = 1
+	}
+	id := time.Now().Unix()<<32 | kind
+	if id <= c.lastMsgID {
+		id = (c.lastMsgID&^3 + 4) | kind
+	}
+	c.lastMsgID = id
+	return id
+}
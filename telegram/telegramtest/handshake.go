@@ -0,0 +1,186 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegramtest
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	ige "github.com/amarnathcjd/gogram/internal/aes_ige"
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+	"github.com/amarnathcjd/gogram/internal/keys"
+	"github.com/amarnathcjd/gogram/internal/math"
+	"github.com/amarnathcjd/gogram/internal/mtproto/objects"
+	"github.com/amarnathcjd/gogram/internal/utils"
+)
+
+const dhGenerator = 3
+
+// handshake is the state of an auth key exchange in progress.
+// See https://core.telegram.org/mtproto/auth_key.
+type handshake struct {
+	nonce       *tl.Int128
+	serverNonce *tl.Int128
+	pq          *big.Int
+	newNonce    *tl.Int256
+	a           *big.Int // server DH secret
+}
+
+// handleHandshake answers the unencrypted messages of the auth key exchange.
+func (c *serverConn) handleHandshake(msg []byte) error {
+	obj, err := tl.DecodeUnknownObject(msg)
+	if err != nil {
+		return fmt.Errorf("decoding handshake message: %w", err)
+	}
+
+	switch req := obj.(type) {
+	case *objects.ReqPQParams:
+		return c.reqPQ(req.Nonce)
+	case *objects.ReqPQMultiParams:
+		return c.reqPQ(req.Nonce)
+	case *objects.ReqDHParamsParams:
+		return c.reqDHParams(req)
+	case *objects.SetClientDHParamsParams:
+		return c.setClientDHParams(req)
+	}
+	return fmt.Errorf("unexpected handshake message %T", obj)
+}
+
+func (c *serverConn) reqPQ(nonce *tl.Int128) error {
+	p, err := rand.Prime(rand.Reader, 31)
+	if err != nil {
+		return err
+	}
+	q, err := rand.Prime(rand.Reader, 31)
+	if err != nil {
+		return err
+	}
+	c.handshake = &handshake{
+		nonce:       nonce,
+		serverNonce: tl.RandomInt128(),
+		pq:          new(big.Int).Mul(p, q),
+	}
+
+	fingerprint := int64(binary.LittleEndian.Uint64(keys.RSAFingerprint(c.srv.PublicKey())))
+	return c.writeUnencrypted(&objects.ResPQ{
+		Nonce:        nonce,
+		ServerNonce:  c.handshake.serverNonce,
+		Pq:           c.handshake.pq.Bytes(),
+		Fingerprints: []int64{fingerprint},
+	})
+}
+
+func (c *serverConn) reqDHParams(req *objects.ReqDHParamsParams) error {
+	h := c.handshake
+	if h == nil || h.nonce.Cmp(req.Nonce.Int) != 0 || h.serverNonce.Cmp(req.ServerNonce.Int) != 0 {
+		return errors.New("req_DH_params: nonce mismatch")
+	}
+
+	// the client pads sha1(data) + data to 255 bytes and applies raw RSA
+	block := new(big.Int).Exp(new(big.Int).SetBytes(req.EncryptedData), c.srv.key.D, c.srv.key.N).Bytes()
+	if len(block) < 255 {
+		block = append(make([]byte, 255-len(block)), block...)
+	}
+	inner, err := tl.DecodeUnknownObject(block[20:])
+	if err != nil {
+		return fmt.Errorf("req_DH_params: decoding inner data: %w", err)
+	}
+
+	var newNonce *tl.Int256
+	switch data := inner.(type) {
+	case *objects.PQInnerData:
+		newNonce = data.NewNonce
+	case *objects.PQInnerDataTempDc:
+		newNonce = data.NewNonce
+	default:
+		return fmt.Errorf("req_DH_params: unexpected inner data %T", inner)
+	}
+	if pq := new(big.Int).Mul(new(big.Int).SetBytes(req.P), new(big.Int).SetBytes(req.Q)); pq.Cmp(h.pq) != 0 || len(req.P) == 0 || len(req.Q) == 0 {
+		return errors.New("req_DH_params: wrong factorization")
+	}
+
+	_, dhPrime, _ := loadTestKeys()
+	h.newNonce = newNonce
+	h.a, err = rand.Int(rand.Reader, dhPrime)
+	if err != nil {
+		return err
+	}
+
+	answer, err := tl.Marshal(&objects.ServerDHInnerData{
+		Nonce:       h.nonce,
+		ServerNonce: h.serverNonce,
+		G:           dhGenerator,
+		DhPrime:     dhPrime.Bytes(),
+		GA:          new(big.Int).Exp(big.NewInt(dhGenerator), h.a, dhPrime).Bytes(),
+		ServerTime:  int32(time.Now().Unix()),
+	})
+	if err != nil {
+		return err
+	}
+	encrypted, err := ige.EncryptMessageWithTempKeys(answer, newNonce.Int, h.serverNonce.Int)
+	if err != nil {
+		return err
+	}
+
+	return c.writeUnencrypted(&objects.ServerDHParamsOk{
+		Nonce:           h.nonce,
+		ServerNonce:     h.serverNonce,
+		EncryptedAnswer: encrypted,
+	})
+}
+
+func (c *serverConn) setClientDHParams(req *objects.SetClientDHParamsParams) error {
+	h := c.handshake
+	if h == nil || h.newNonce == nil || h.nonce.Cmp(req.Nonce.Int) != 0 || h.serverNonce.Cmp(req.ServerNonce.Int) != 0 {
+		return errors.New("set_client_DH_params: nonce mismatch")
+	}
+	c.handshake = nil
+
+	decrypted, err := ige.DecryptMessageWithTempKeys(req.EncryptedData, h.newNonce.Int, h.serverNonce.Int)
+	if err != nil {
+		return fmt.Errorf("set_client_DH_params: %w", err)
+	}
+	inner, err := tl.DecodeUnknownObject(decrypted)
+	if err != nil {
+		return fmt.Errorf("set_client_DH_params: decoding inner data: %w", err)
+	}
+	data, ok := inner.(*objects.ClientDHInnerData)
+	if !ok {
+		return fmt.Errorf("set_client_DH_params: unexpected inner data %T", inner)
+	}
+
+	// derived exactly like the client does, see makeAuthKeyInternal
+	_, dhPrime, _ := loadTestKeys()
+	gab := new(big.Int).Exp(new(big.Int).SetBytes(data.GB), h.a, dhPrime).Bytes()
+
+	t4 := make([]byte, 32+1+8)
+	copy(t4, h.newNonce.Bytes())
+	t4[32] = 1
+	copy(t4[33:], utils.Sha1Byte(gab)[:8])
+	nonceHash1 := utils.Sha1Byte(t4)[4:20]
+
+	salt := make([]byte, tl.LongLen)
+	copy(salt, h.newNonce.Bytes()[:8])
+	math.XOR(salt, h.serverNonce.Bytes()[:8])
+
+	id := binary.LittleEndian.Uint64(utils.AuthKeyHash(gab))
+	key := authKey{key: gab, salt: int64(binary.LittleEndian.Uint64(salt))}
+	c.srv.storeAuthKey(id, key)
+
+	if err := c.writeUnencrypted(&objects.DHGenOk{
+		Nonce:         h.nonce,
+		ServerNonce:   h.serverNonce,
+		NewNonceHash1: &tl.Int128{Int: new(big.Int).SetBytes(nonceHash1)},
+	}); err != nil {
+		return err
+	}
+
+	c.writeMu.Lock()
+	c.authKey, c.authKeyID, c.salt = key.key, id, key.salt
+	c.writeMu.Unlock()
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegramtest_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/amarnathcjd/gogram/internal/mtproto/objects"
+	"github.com/amarnathcjd/gogram/telegram"
+)
+
+// Service messages bypass the interceptor chain.
+func TestInterceptorSkipsServiceMessages(t *testing.T) {
+	srv := newServer(t)
+	srv.Respond("HelpGetNearestDc", &telegram.NearestDc{Country: "NL", ThisDc: 2, NearestDc: 2})
+	client := newClient(t, srv.ClientConfig())
+	if err := client.Connect(); err != nil {
+		t.Fatalf("connecting: %v", err)
+	}
+
+	var mu sync.Mutex
+	var methods []string
+	client.AddInterceptor(func(ctx context.Context, info *telegram.RequestInfo, req telegram.TLObject, next telegram.Invoker) (any, error) {
+		mu.Lock()
+		methods = append(methods, info.Method)
+		mu.Unlock()
+		return next(ctx, req)
+	})
+
+	if _, err := client.MTProto.MakeRequest(&objects.MsgsAck{MsgIDs: []int64{1}}); err != nil {
+		t.Fatalf("msgs_ack: %v", err)
+	}
+	if _, err := client.HelpGetNearestDc(); err != nil {
+		t.Fatalf("help.getNearestDc: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if !slices.Equal(methods, []string{"HelpGetNearestDc"}) {
+		t.Fatalf("intercepted %v, want only HelpGetNearestDc", methods)
+	}
+}
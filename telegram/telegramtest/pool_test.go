@@ -0,0 +1,89 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegramtest_test
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/amarnathcjd/gogram/telegram"
+	"github.com/amarnathcjd/gogram/telegram/telegramtest"
+)
+
+func TestConnPoolStartsOnAuthorization(t *testing.T) {
+	srv, err := telegramtest.NewServer(telegramtest.Config{})
+	if err != nil {
+		t.Fatalf("starting server: %v", err)
+	}
+	t.Cleanup(func() { srv.Close() })
+	srv.Respond("AuthImportBotAuthorization", &telegram.AuthAuthorizationObj{User: self})
+
+	config := srv.ClientConfig()
+	config.PoolSize = 2
+	config.EnableMetrics = true
+	client := newClient(t, config)
+	if err := client.Connect(); err != nil {
+		t.Fatalf("connecting: %v", err)
+	}
+	time.Sleep(200 * time.Millisecond)
+	if stats := client.MTProto.PoolStats(); stats != nil {
+		t.Fatalf("pool attached before authorization: %v", stats)
+	}
+
+	if _, err := client.AuthImportBotAuthorization(1, client.AppID(), client.AppHash(), "1:token"); err != nil {
+		t.Fatalf("auth.importBotAuthorization: %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for client.MTProto.PoolStats() == nil {
+		if time.Now().After(deadline) {
+			t.Fatal("pool not attached after authorization")
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if stats := client.MTProto.PoolStats(); len(stats) != 2 {
+		t.Fatalf("got pool stats %v, want 2 connections", stats)
+	}
+
+	var buf bytes.Buffer
+	client.Metrics().WriteTo(&buf)
+	if bytes.Contains(buf.Bytes(), []byte("gogram_exported_senders_created_total{")) {
+		t.Fatalf("pooled connections counted as exported senders:\n%s", buf.String())
+	}
+}
+
+// Every path to an authorized client builds the pool, but only once per home DC.
+func TestConnPoolBuiltOnce(t *testing.T) {
+	srv := newServer(t)
+	srv.Respond("AuthImportBotAuthorization", &telegram.AuthAuthorizationObj{User: self})
+
+	var dials atomic.Int32
+	config := srv.ClientConfig()
+	config.PoolSize = 3
+	config.Dialer = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dials.Add(1)
+		return srv.DialPipe(ctx, network, addr)
+	}
+	client := newClient(t, config)
+	if err := client.Connect(); err != nil {
+		t.Fatalf("connecting: %v", err)
+	}
+	if _, err := client.AuthImportBotAuthorization(1, client.AppID(), client.AppHash(), "1:token"); err != nil {
+		t.Fatalf("auth.importBotAuthorization: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(client.MTProto.PoolStats()) != 3 {
+		if time.Now().After(deadline) {
+			t.Fatalf("got pool stats %v, want 3 connections", client.MTProto.PoolStats())
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	time.Sleep(200 * time.Millisecond)
+	if n := dials.Load(); n != 3 {
+		t.Fatalf("dialed %d connections, want 3", n)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegramtest_test
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/amarnathcjd/gogram/telegram"
+	"github.com/amarnathcjd/gogram/telegram/telegramtest"
+)
+
+// The rate limiter learns flood waits that FloodHandler absorbs by retrying.
+func TestRateLimiterLearnsRetriedFloodWait(t *testing.T) {
+	srv := newServer(t)
+	var calls atomic.Int32
+	srv.Handle("HelpGetNearestDc", func(telegram.TLObject) (any, error) {
+		if calls.Add(1) == 1 {
+			return nil, telegramtest.NewError(420, "FLOOD_WAIT_30")
+		}
+		return &telegram.NearestDc{Country: "NL", ThisDc: 2, NearestDc: 2}, nil
+	})
+
+	limiter := telegram.NewRateLimiter(telegram.RateLimiterConfig{})
+	config := srv.ClientConfig()
+	config.RateLimiter = limiter
+	config.FloodHandler = func(error) bool { return true }
+	client := newClient(t, config)
+	if err := client.Connect(); err != nil {
+		t.Fatalf("connecting: %v", err)
+	}
+
+	if _, err := client.HelpGetNearestDc(); err != nil {
+		t.Fatalf("help.getNearestDc: %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("%d calls, want 2", calls.Load())
+	}
+	if _, ok := limiter.FloodWaits()["HelpGetNearestDc"]; !ok {
+		t.Fatalf("flood waits %v, want HelpGetNearestDc", limiter.FloodWaits())
+	}
+}
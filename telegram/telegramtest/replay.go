@@ -0,0 +1,158 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegramtest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+	"github.com/amarnathcjd/gogram/internal/utils"
+	"github.com/amarnathcjd/gogram/telegram"
+)
+
+// NewReplayServer starts a server that plays back a journal written by a
+// telegram.Recorder: it answers requests with the recorded results and pushes
+// the recorded updates, so a captured session can be reproduced without network.
+//
+// A request is answered by the first unused recorded call with the same method
+// and arguments; RandomID fields are ignored, as they differ on every run. Once
+// all matching calls are used, the last of them answers repeats, and requests
+// without any match fail with 400 REPLAY_NO_MATCH. Methods that never appear in
+// the journal are left to the handlers of a regular server.
+//
+// Updates are pushed in journal order, each once as many recorded calls have
+// been answered as preceded it in the journal.
+func NewReplayServer(journal io.Reader, cfg Config) (*Server, error) {
+	entries, err := telegram.ReadJournal(journal)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &replay{calls: make(map[string][]*replayCall)}
+	for i, entry := range entries {
+		if entry.Kind == telegram.JournalUpdate {
+			r.updates = append(r.updates, replayUpdate{data: entry.Data, after: r.total})
+			continue
+		}
+
+		req, err := tl.DecodeUnknownObject(entry.Data)
+		if err != nil {
+			return nil, fmt.Errorf("journal entry %d (%s): decoding request: %w", i+1, entry.Method, err)
+		}
+		query, _ := unwrapQuery(req)
+		key, err := requestKey(query)
+		if err != nil {
+			return nil, fmt.Errorf("journal entry %d (%s): %w", i+1, entry.Method, err)
+		}
+		method := utils.FmtMethod(query)
+		r.calls[method] = append(r.calls[method], &replayCall{key: key, result: entry.Result, err: entry.Error})
+		r.total++
+	}
+
+	s, err := NewServer(cfg)
+	if err != nil {
+		return nil, err
+	}
+	r.srv = s
+	for method := range r.calls {
+		s.Handle(method, func(req telegram.TLObject) (any, error) {
+			return r.answer(method, req)
+		})
+	}
+	s.afterCall = r.pushUpdates
+	return s, nil
+}
+
+// replay is the state of a journal being played back.
+type replay struct {
+	srv *Server
+
+	mu      sync.Mutex
+	calls   map[string][]*replayCall // by method
+	updates []replayUpdate
+	total   int // recorded calls
+	used    int // recorded calls answered so far
+	next    int // index of the next update to push
+}
+
+type replayCall struct {
+	key    []byte // request as encoded by requestKey
+	result []byte
+	err    *telegram.JournalError
+	used   bool
+}
+
+func (c *replayCall) answer() (any, error) {
+	if c.err != nil {
+		return nil, NewError(c.err.Code, c.err.Message)
+	}
+	return rawResult(c.result), nil
+}
+
+type replayUpdate struct {
+	data  []byte
+	after int // recorded calls preceding the update
+}
+
+func (r *replay) answer(method string, req telegram.TLObject) (any, error) {
+	key, err := requestKey(req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var last *replayCall
+	for _, call := range r.calls[method] {
+		if !bytes.Equal(call.key, key) {
+			continue
+		}
+		if !call.used {
+			call.used = true
+			r.used++
+			return call.answer()
+		}
+		last = call
+	}
+	if last != nil {
+		return last.answer()
+	}
+	return nil, NewError(400, "REPLAY_NO_MATCH")
+}
+
+// pushUpdates pushes the updates whose preceding calls have all been answered.
+func (r *replay) pushUpdates() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for r.next < len(r.updates) && r.updates[r.next].after <= r.used {
+		if err := r.srv.pushRaw(r.updates[r.next].data); err != nil {
+			return
+		}
+		r.next++
+	}
+}
+
+// requestKey encodes req with its RandomID field cleared, which is random on every run.
+func requestKey(req tl.Object) ([]byte, error) {
+	v := reflect.ValueOf(req)
+	if v.Kind() == reflect.Pointer && v.Elem().Kind() == reflect.Struct {
+		if f := v.Elem().FieldByName("RandomID"); f.IsValid() && !f.IsZero() {
+			clone := reflect.New(v.Elem().Type())
+			clone.Elem().Set(v.Elem())
+			clone.Elem().FieldByName("RandomID").SetZero()
+			req = clone.Interface().(tl.Object)
+		}
+	}
+
+	key, err := tl.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("encoding request: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegramtest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/amarnathcjd/gogram/telegram"
+	"github.com/amarnathcjd/gogram/telegram/telegramtest"
+)
+
+var self = &telegram.UserObj{ID: 1, FirstName: "Test", Self: true}
+
+func newServer(t *testing.T) *telegramtest.Server {
+	t.Helper()
+	srv, err := telegramtest.NewServer(telegramtest.Config{Self: self})
+	if err != nil {
+		t.Fatalf("starting server: %v", err)
+	}
+	t.Cleanup(func() { srv.Close() })
+	return srv
+}
+
+func newClient(t *testing.T, config telegram.ClientConfig) *telegram.Client {
+	t.Helper()
+	client, err := telegram.NewClient(config)
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+	t.Cleanup(func() { client.Terminate() })
+	return client
+}
+
+func TestInvoke(t *testing.T) {
+	srv := newServer(t)
+	srv.Respond("HelpGetNearestDc", &telegram.NearestDc{Country: "NL", ThisDc: 2, NearestDc: 2})
+
+	pipe := srv.ClientConfig()
+	pipe.Dialer = srv.DialPipe
+	for name, config := range map[string]telegram.ClientConfig{"tcp": srv.ClientConfig(), "pipe": pipe} {
+		t.Run(name, func(t *testing.T) {
+			client := newClient(t, config)
+			if err := client.Connect(); err != nil {
+				t.Fatalf("connecting: %v", err)
+			}
+			dc, err := client.HelpGetNearestDc()
+			if err != nil {
+				t.Fatalf("help.getNearestDc: %v", err)
+			}
+			if dc.Country != "NL" || dc.ThisDc != 2 {
+				t.Fatalf("got %+v", dc)
+			}
+		})
+	}
+
+	srv.Fail("HelpGetNearestDc", 400, "TEST_FAILED")
+	client := newClient(t, srv.ClientConfig())
+	if err := client.Connect(); err != nil {
+		t.Fatalf("connecting: %v", err)
+	}
+	if _, err := client.HelpGetNearestDc(); !telegram.MatchError(err, "TEST_FAILED") {
+		t.Fatalf("help.getNearestDc: %v, want TEST_FAILED", err)
+	}
+}
+
+func TestPushUpdates(t *testing.T) {
+	srv := newServer(t)
+	client := newClient(t, srv.ClientConfig())
+	if err := client.Start(); err != nil {
+		t.Fatalf("starting: %v", err)
+	}
+	if me := client.Me(); me == nil || me.ID != self.ID {
+		t.Fatalf("logged in as %+v, want %d", me, self.ID)
+	}
+
+	received := make(chan *telegram.NewMessage, 1)
+	client.On(telegram.OnNewMessage, func(m *telegram.NewMessage) error {
+		received <- m
+		return nil
+	})
+
+	sender := &telegram.UserObj{ID: 2, FirstName: "Sender", AccessHash: 22}
+	err := srv.PushUpdates(&telegram.UpdatesObj{
+		Updates: []telegram.Update{&telegram.UpdateNewMessage{
+			Message: &telegram.MessageObj{
+				ID:      10,
+				FromID:  &telegram.PeerUser{UserID: sender.ID},
+				PeerID:  &telegram.PeerUser{UserID: sender.ID},
+				Date:    int32(time.Now().Unix()),
+				Message: "hello",
+			},
+			Pts:      1,
+			PtsCount: 1,
+		}},
+		Users: []telegram.User{sender},
+		Date:  int32(time.Now().Unix()),
+	})
+	if err != nil {
+		t.Fatalf("pushing updates: %v", err)
+	}
+
+	select {
+	case m := <-received:
+		if m.ID != 10 || m.Text() != "hello" || m.SenderID() != sender.ID {
+			t.Fatalf("got message %d %q from %d", m.ID, m.Text(), m.SenderID())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message received")
+	}
+}
+
+// A request still waiting for its answer when the client terminates fails.
+func TestInvokeTerminated(t *testing.T) {
+	srv := newServer(t)
+	called, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	srv.Handle("HelpGetNearestDc", func(telegram.TLObject) (any, error) {
+		close(called)
+		<-release
+		return &telegram.NearestDc{}, nil
+	})
+
+	client := newClient(t, srv.ClientConfig())
+	if err := client.Connect(); err != nil {
+		t.Fatalf("connecting: %v", err)
+	}
+	errs := make(chan error, 1)
+	go func() {
+		_, err := client.MTProto.MakeRequest(&telegram.HelpGetNearestDcParams{})
+		errs <- err
+	}()
+	<-called
+	client.Terminate()
+	select {
+	case err := <-errs:
+		if err == nil {
+			t.Fatal("request cut off by Terminate returned no error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("request still waiting after Terminate")
+	}
+}
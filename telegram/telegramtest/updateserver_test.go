@@ -0,0 +1,131 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegramtest_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/amarnathcjd/gogram/telegram"
+)
+
+func TestUpdateServerServesMarshalJSON(t *testing.T) {
+	srv := newServer(t)
+	client := newClient(t, srv.ClientConfig())
+	if err := client.Start(); err != nil {
+		t.Fatalf("starting: %v", err)
+	}
+	updates, err := client.NewUpdateServer(&telegram.UpdateServerOptions{NoRaw: true})
+	if err != nil {
+		t.Fatalf("creating update server: %v", err)
+	}
+	t.Cleanup(func() { updates.Close() })
+	hs := httptest.NewServer(updates.Handler())
+	t.Cleanup(hs.Close)
+
+	sender := &telegram.UserObj{ID: 2, FirstName: "Sender", AccessHash: 22}
+	err = srv.PushUpdates(&telegram.UpdatesObj{
+		Updates: []telegram.Update{&telegram.UpdateNewMessage{
+			Message: &telegram.MessageObj{
+				ID:      10,
+				FromID:  &telegram.PeerUser{UserID: sender.ID},
+				PeerID:  &telegram.PeerUser{UserID: sender.ID},
+				Date:    int32(time.Now().Unix()),
+				Message: "hello",
+			},
+			Pts:      1,
+			PtsCount: 1,
+		}},
+		Users: []telegram.User{sender},
+		Date:  int32(time.Now().Unix()),
+	})
+	if err != nil {
+		t.Fatalf("pushing updates: %v", err)
+	}
+
+	result := getUpdates(t, hs.URL+"/getUpdates?timeout=5")
+	if len(result) != 1 || result[0].Type != telegram.StreamMessage {
+		t.Fatalf("got %+v, want one message", result)
+	}
+	var msg telegram.Message
+	if err := telegram.UnmarshalJSON(result[0].Data, &msg); err != nil {
+		t.Fatalf("decoding %s: %v", result[0].Data, err)
+	}
+	if m, ok := msg.(*telegram.MessageObj); !ok || m.ID != 10 || m.Message != "hello" {
+		t.Fatalf("got %#v", msg)
+	}
+}
+
+// A message is served once, as a message, and not a second time as a raw update.
+func TestUpdateServerServesMessagesOnce(t *testing.T) {
+	srv := newServer(t)
+	client := newClient(t, srv.ClientConfig())
+	if err := client.Start(); err != nil {
+		t.Fatalf("starting: %v", err)
+	}
+	updates, err := client.NewUpdateServer()
+	if err != nil {
+		t.Fatalf("creating update server: %v", err)
+	}
+	t.Cleanup(func() { updates.Close() })
+	hs := httptest.NewServer(updates.Handler())
+	t.Cleanup(hs.Close)
+
+	sender := &telegram.UserObj{ID: 2, FirstName: "Sender", AccessHash: 22}
+	err = srv.PushUpdates(&telegram.UpdatesObj{
+		Updates: []telegram.Update{
+			&telegram.UpdateNewMessage{
+				Message: &telegram.MessageObj{
+					ID:      10,
+					FromID:  &telegram.PeerUser{UserID: sender.ID},
+					PeerID:  &telegram.PeerUser{UserID: sender.ID},
+					Date:    int32(time.Now().Unix()),
+					Message: "hello",
+				},
+				Pts:      1,
+				PtsCount: 1,
+			},
+			&telegram.UpdateUserTyping{UserID: sender.ID, Action: &telegram.SendMessageTypingAction{}},
+		},
+		Users: []telegram.User{sender},
+		Date:  int32(time.Now().Unix()),
+	})
+	if err != nil {
+		t.Fatalf("pushing updates: %v", err)
+	}
+
+	var result []telegram.StreamUpdate
+	deadline := time.Now().Add(5 * time.Second)
+	for len(result) < 2 && time.Now().Before(deadline) {
+		result = getUpdates(t, hs.URL+"/getUpdates?timeout=1")
+	}
+	time.Sleep(100 * time.Millisecond)
+	result = getUpdates(t, hs.URL+"/getUpdates")
+	types := make(map[string]int)
+	for _, u := range result {
+		types[u.Type]++
+	}
+	if len(result) != 2 || types[telegram.StreamMessage] != 1 || types[telegram.StreamRaw] != 1 {
+		t.Fatalf("got %+v, want one message and one raw update", result)
+	}
+}
+
+func getUpdates(t *testing.T, url string) []telegram.StreamUpdate {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("getting updates: %v", err)
+	}
+	defer resp.Body.Close()
+	var body struct {
+		OK     bool                    `json:"ok"`
+		Result []telegram.StreamUpdate `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil || !body.OK {
+		t.Fatalf("decoding updates: ok=%v, %v", body.OK, err)
+	}
+	return body.Result
+}
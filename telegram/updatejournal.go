@@ -0,0 +1,244 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	mtproto "github.com/amarnathcjd/gogram"
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
+)
+
+const (
+	defaultJournalMaxSize  = 64 << 20
+	defaultJournalMaxFiles = 5
+)
+
+// ErrReplayWrite is returned for requests that would change something, sent by
+// handlers while updates are replayed without ReplayOptions.Redirect.
+var ErrReplayWrite = errors.New("request not sent while replaying updates")
+
+// UpdateJournalOptions configures the rotation of an UpdateJournal.
+type UpdateJournalOptions struct {
+	MaxSize  int64 // Size in bytes at which the file is rotated (default: 64 MiB)
+	MaxFiles int   // Rotated files kept, as path.1 (newest) to path.N (default: 5)
+}
+
+// UpdateJournal appends the updates a client receives, with the users and chats
+// that came with them, to a rotating file of newline-delimited JSON. The file is
+// a journal as written by a Recorder, holding updates only, so it can be read
+// with ReadJournal, replayed with Client.ReplayUpdates, or served by telegramtest.
+// It is safe for concurrent use.
+type UpdateJournal struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+	err  error
+}
+
+// NewUpdateJournal opens the journal at path, appending to it if it exists.
+func NewUpdateJournal(path string, opts ...*UpdateJournalOptions) (*UpdateJournal, error) {
+	opt := getVariadic(opts, &UpdateJournalOptions{})
+	j := &UpdateJournal{
+		path:     joinAbsWorkingDir(path),
+		maxSize:  getValue(opt.MaxSize, defaultJournalMaxSize),
+		maxFiles: getValue(opt.MaxFiles, defaultJournalMaxFiles),
+	}
+	if err := j.open(); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *UpdateJournal) open() error {
+	file, err := os.OpenFile(j.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("opening update journal: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("opening update journal: %w", err)
+	}
+	j.file, j.size = file, info.Size()
+	return nil
+}
+
+// rotate moves the current file to path.1, shifting the older ones up and
+// dropping the one past MaxFiles, and starts a new file.
+func (j *UpdateJournal) rotate() error {
+	if err := j.file.Close(); err != nil {
+		return fmt.Errorf("rotating update journal: %w", err)
+	}
+	os.Remove(fmt.Sprintf("%s.%d", j.path, j.maxFiles))
+	for i := j.maxFiles - 1; i >= 1; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", j.path, i), fmt.Sprintf("%s.%d", j.path, i+1))
+	}
+	if err := os.Rename(j.path, j.path+".1"); err != nil {
+		return fmt.Errorf("rotating update journal: %w", err)
+	}
+	return j.open()
+}
+
+// record appends update, received on dc, to the journal.
+func (j *UpdateJournal) record(dc int, update tl.Object) {
+	data, err := tl.Marshal(update)
+	if err != nil {
+		j.fail(fmt.Errorf("marshaling %T: %w", update, err))
+		return
+	}
+	line, err := json.Marshal(&JournalEntry{
+		Time: time.Now(),
+		Kind: JournalUpdate,
+		DC:   dc,
+		Type: fmt.Sprintf("%T", update),
+		Data: data,
+	})
+	if err != nil {
+		j.fail(fmt.Errorf("encoding %T: %w", update, err))
+		return
+	}
+	line = append(line, '\n')
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.err != nil {
+		return
+	}
+	if j.size > 0 && j.size+int64(len(line)) > j.maxSize {
+		if j.err = j.rotate(); j.err != nil {
+			return
+		}
+	}
+	n, err := j.file.Write(line)
+	j.size += int64(n)
+	if err != nil {
+		j.err = fmt.Errorf("writing update journal: %w", err)
+	}
+}
+
+func (j *UpdateJournal) fail(err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.err == nil {
+		j.err = err
+	}
+}
+
+// Err returns the error that stopped the journal, if any.
+func (j *UpdateJournal) Err() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.err
+}
+
+// Close closes the file of the journal. Updates received afterwards are not recorded.
+func (j *UpdateJournal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.err == nil {
+		j.err = os.ErrClosed
+	}
+	return j.file.Close()
+}
+
+// ReplayOptions configures Client.ReplayUpdates.
+type ReplayOptions struct {
+	Redirect Invoker                        // Answers requests that would change something; they fail with ErrReplayWrite if nil
+	Filter   func(entry *JournalEntry) bool // Replays only the entries it returns true for
+}
+
+// ReplayUpdates reads a journal written by an UpdateJournal or a Recorder from r
+// and passes its updates through the handlers, filters and middlewares of the
+// client as if they came from the server, leaving the update state alone. While
+// it runs, requests that only read are sent as usual; any other request goes to
+// ReplayOptions.Redirect, which must return the type the method expects, or fails
+// with ErrReplayWrite. This applies to every request of the client, so replay on
+// a client of its own. Messages the client has seen already may be skipped as
+// duplicates. It returns once the handlers are done, with the number of updates
+// replayed.
+func (c *Client) ReplayUpdates(r io.Reader, opts ...*ReplayOptions) (int, error) {
+	d := c.dispatcher
+	if d == nil {
+		return 0, errors.New("replaying updates: updates are disabled")
+	}
+	opt := getVariadic(opts, &ReplayOptions{})
+	if !d.replaying.CompareAndSwap(nil, opt) {
+		return 0, errors.New("replaying updates: another replay is running")
+	}
+	defer func() {
+		d.waitIdle()
+		d.replaying.Store(nil)
+	}()
+	d.replayOnce.Do(func() { c.AddInterceptor(c.replayInterceptor) })
+
+	dec := json.NewDecoder(r)
+	var replayed int
+	for {
+		var entry JournalEntry
+		if err := dec.Decode(&entry); err != nil {
+			if err == io.EOF {
+				return replayed, nil
+			}
+			return replayed, fmt.Errorf("reading journal: %w", err)
+		}
+		if entry.Kind != JournalUpdate || (opt.Filter != nil && !opt.Filter(&entry)) {
+			continue
+		}
+		obj, err := tl.DecodeUnknownObject(entry.Data)
+		if err != nil {
+			return replayed, fmt.Errorf("decoding journaled %s: %w", entry.Type, err)
+		}
+		c.replayUpdate(obj)
+		replayed++
+	}
+}
+
+// replayUpdate dispatches the updates in u like HandleIncomingUpdates does.
+func (c *Client) replayUpdate(u tl.Object) {
+	switch upd := u.(type) {
+	case *UpdatesObj:
+		c.Cache.UpdatePeersToCache(upd.Users, upd.Chats)
+		for _, update := range upd.Updates {
+			c.dispatchUpdate(update, nil)
+		}
+	case *UpdatesCombined:
+		c.Cache.UpdatePeersToCache(upd.Users, upd.Chats)
+		for _, update := range upd.Updates {
+			c.dispatchUpdate(update, nil)
+		}
+	case *UpdateShort:
+		c.dispatchUpdate(upd.Update, nil)
+	case *UpdateShortMessage, *UpdateShortChatMessage, *UpdateShortSentMessage:
+		newMessage := shortMessage(upd.(Updates))
+		c.runUpdate(newMessage, func() { c.fetchPeersBeforeUpdate(newMessage.Message, newMessage.Pts) })
+		c.runUpdate(newMessage, func() { c.handleRawUpdate(newMessage) })
+	case Update:
+		c.dispatchUpdate(upd, nil)
+	default:
+		c.Log.Debug("replaying updates: skipping %T", u)
+	}
+}
+
+// replayInterceptor keeps handlers from changing anything while updates are replayed.
+func (c *Client) replayInterceptor(ctx context.Context, info *RequestInfo, req TLObject, next Invoker) (any, error) {
+	opt := c.dispatcher.replaying.Load()
+	if opt == nil || mtproto.IsReadMethod(req) {
+		return next(ctx, req)
+	}
+	if opt.Redirect != nil {
+		return opt.Redirect(ctx, req)
+	}
+	return nil, fmt.Errorf("%s: %w", info.Method, ErrReplayWrite)
+}
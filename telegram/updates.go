@@ -210,6 +210,13 @@ type CallbackHandler func(m *CallbackQuery) error
 type InlineCallbackHandler func(m *InlineCallbackQuery) error
 type ParticipantHandler func(m *ParticipantUpdate) error
 type PendingJoinHandler func(m *JoinRequestUpdate) error
+type ReactionHandler func(m *ReactionUpdate) error
+type BotReactionHandler func(m *BotReactionUpdate) error
+type PollHandler func(m *PollUpdate) error
+type PollVoteHandler func(m *PollVoteUpdate) error
+type UserStatusHandler func(m *UserStatusUpdate) error
+type TypingHandler func(m *TypingUpdate) error
+type ReadHandler func(m *ReadUpdate) error
 type RawHandler func(m Update, c *Client) error
 type E2EHandler func(update Update, c *Client) error
 
@@ -334,6 +341,13 @@ type joinRequestHandle struct {
 	Handler PendingJoinHandler
 }
 
+// eventHandle is the handle of the typed events of events.go.
+type eventHandle[E any] struct {
+	baseHandle
+	Handler func(m E) error
+	Filters []Filter
+}
+
 type rawHandle struct {
 	baseHandle
 	updateType   Update
@@ -354,7 +368,11 @@ type albumBox struct {
 
 func (a *albumBox) WaitAndTrigger(d *UpdateDispatcher, c *Client) {
 	time.Sleep(time.Duration(c.clientData.albumWaitTime) * time.Millisecond)
+	a.trigger(d, c)
+}
 
+// trigger runs the album handlers on the messages collected so far.
+func (a *albumBox) trigger(d *UpdateDispatcher, c *Client) {
 	for gp, handlers := range d.albumHandles {
 		for _, handler := range handlers {
 			handle := func(h *albumHandle) error {
@@ -370,7 +388,7 @@ func (a *albumBox) WaitAndTrigger(d *UpdateDispatcher, c *Client) {
 			}
 
 			if gp == DefaultGroup {
-				go func() {
+				d.spawn(func() {
 					err := handle(handler)
 					if err != nil {
 						if errors.Is(err, ErrEndGroup) {
@@ -379,7 +397,7 @@ func (a *albumBox) WaitAndTrigger(d *UpdateDispatcher, c *Client) {
 
 						c.Log.WithError(err).Error("[AlbumHandler]")
 					}
-				}()
+				})
 			} else {
 				if err := handle(handler); err != nil && errors.Is(err, ErrEndGroup) {
 					break
@@ -430,6 +448,13 @@ type UpdateDispatcher struct {
 	inlineCallbackHandles map[int][]*inlineCallbackHandle
 	participantHandles    map[int][]*participantHandle
 	joinRequestHandles    map[int][]*joinRequestHandle
+	reactionHandles       map[int][]*eventHandle[*ReactionUpdate]
+	botReactionHandles    map[int][]*eventHandle[*BotReactionUpdate]
+	pollHandles           map[int][]*eventHandle[*PollUpdate]
+	pollVoteHandles       map[int][]*eventHandle[*PollVoteUpdate]
+	userStatusHandles     map[int][]*eventHandle[*UserStatusUpdate]
+	typingHandles         map[int][]*eventHandle[*TypingUpdate]
+	readHandles           map[int][]*eventHandle[*ReadUpdate]
 	messageEditHandles    map[int][]*messageEditHandle
 	actionHandles         map[int][]*chatActionHandle
 	messageDeleteHandles  map[int][]*messageDeleteHandle
@@ -450,12 +475,23 @@ type UpdateDispatcher struct {
 	stopChan              chan struct{}
 	patternCache          *patternCache
 	lifecycleHooks        *LifecycleHooks
+	stateSaver            *updateStateSaver // nil unless ClientConfig.UpdateStateStore is set
+	stateResumed          atomic.Bool
+	holds                 map[*stateHold]struct{} // state not to save past, see stateHold
+	workers               *workerPool             // nil unless ClientConfig.WorkerPool is set
+	journal               *UpdateJournal          // nil unless ClientConfig.UpdateJournal is set
+	busy                  busyCounter             // handlers queued or running
+	replaying             atomic.Pointer[ReplayOptions]
+	replayOnce            sync.Once
 }
 
 func (d *UpdateDispatcher) SetPts(pts int32) {
 	d.Lock()
 	defer d.Unlock()
-	d.state.Pts = pts
+	if d.state.Pts != pts {
+		d.state.Pts = pts
+		d.stateChanged()
+	}
 }
 
 func (d *UpdateDispatcher) GetPts() int32 {
@@ -467,7 +503,10 @@ func (d *UpdateDispatcher) GetPts() int32 {
 func (d *UpdateDispatcher) SetQts(qts int32) {
 	d.Lock()
 	defer d.Unlock()
-	d.state.Qts = qts
+	if d.state.Qts != qts {
+		d.state.Qts = qts
+		d.stateChanged()
+	}
 }
 
 func (d *UpdateDispatcher) GetQts() int32 {
@@ -479,7 +518,10 @@ func (d *UpdateDispatcher) GetQts() int32 {
 func (d *UpdateDispatcher) SetSeq(seq int32) {
 	d.Lock()
 	defer d.Unlock()
-	d.state.Seq = seq
+	if d.state.Seq != seq {
+		d.state.Seq = seq
+		d.stateChanged()
+	}
 }
 
 func (d *UpdateDispatcher) GetSeq() int32 {
@@ -491,7 +533,10 @@ func (d *UpdateDispatcher) GetSeq() int32 {
 func (d *UpdateDispatcher) SetDate(date int32) {
 	d.Lock()
 	defer d.Unlock()
-	d.state.Date = date
+	if d.state.Date != date {
+		d.state.Date = date
+		d.stateChanged()
+	}
 }
 
 func (d *UpdateDispatcher) GetDate() int32 {
@@ -507,10 +552,14 @@ func (d *UpdateDispatcher) SetChannelPts(channelID int64, pts int32) {
 		d.channelStates = make(map[int64]*channelState)
 	}
 	if state, ok := d.channelStates[channelID]; ok {
+		if state.pts == pts {
+			return
+		}
 		state.pts = pts
 	} else {
 		d.channelStates[channelID] = &channelState{pts: pts}
 	}
+	d.stateChanged()
 }
 
 func (d *UpdateDispatcher) GetChannelPts(channelID int64) int32 {
@@ -555,6 +604,13 @@ func (c *Client) NewUpdateDispatcher(sessionName ...string) {
 		inlineCallbackHandles: make(map[int][]*inlineCallbackHandle),
 		participantHandles:    make(map[int][]*participantHandle),
 		joinRequestHandles:    make(map[int][]*joinRequestHandle),
+		reactionHandles:       make(map[int][]*eventHandle[*ReactionUpdate]),
+		botReactionHandles:    make(map[int][]*eventHandle[*BotReactionUpdate]),
+		pollHandles:           make(map[int][]*eventHandle[*PollUpdate]),
+		pollVoteHandles:       make(map[int][]*eventHandle[*PollVoteUpdate]),
+		userStatusHandles:     make(map[int][]*eventHandle[*UserStatusUpdate]),
+		typingHandles:         make(map[int][]*eventHandle[*TypingUpdate]),
+		readHandles:           make(map[int][]*eventHandle[*ReadUpdate]),
 		messageEditHandles:    make(map[int][]*messageEditHandle),
 		actionHandles:         make(map[int][]*chatActionHandle),
 		messageDeleteHandles:  make(map[int][]*messageDeleteHandle),
@@ -600,6 +656,20 @@ func (c *Client) removeHandle(handle Handle) error {
 		removeHandleFromMap(h, c.dispatcher.participantHandles)
 	case *joinRequestHandle:
 		removeHandleFromMap(h, c.dispatcher.joinRequestHandles)
+	case *eventHandle[*ReactionUpdate]:
+		removeHandleFromMap(h, c.dispatcher.reactionHandles)
+	case *eventHandle[*BotReactionUpdate]:
+		removeHandleFromMap(h, c.dispatcher.botReactionHandles)
+	case *eventHandle[*PollUpdate]:
+		removeHandleFromMap(h, c.dispatcher.pollHandles)
+	case *eventHandle[*PollVoteUpdate]:
+		removeHandleFromMap(h, c.dispatcher.pollVoteHandles)
+	case *eventHandle[*UserStatusUpdate]:
+		removeHandleFromMap(h, c.dispatcher.userStatusHandles)
+	case *eventHandle[*TypingUpdate]:
+		removeHandleFromMap(h, c.dispatcher.typingHandles)
+	case *eventHandle[*ReadUpdate]:
+		removeHandleFromMap(h, c.dispatcher.readHandles)
 	case *messageEditHandle:
 		removeHandleFromMap(h, c.dispatcher.messageEditHandles)
 	case *chatActionHandle:
@@ -840,7 +910,7 @@ func (c *Client) handleMessageUpdate(update Message) {
 				}
 
 				if group == DefaultGroup {
-					go func() {
+					c.dispatcher.spawn(func() {
 						err := handle(h)
 						if err != nil {
 							if errors.Is(err, ErrEndGroup) {
@@ -848,7 +918,7 @@ func (c *Client) handleMessageUpdate(update Message) {
 							}
 							c.Log.WithError(err).Error("[ChatActionHandler]")
 						}
-					}()
+					})
 				} else {
 					if err := handle(h); err != nil && errors.Is(err, ErrEndGroup) {
 						break
@@ -878,6 +948,17 @@ func (c *Client) handleAlbum(message MessageObj) {
 		}
 		c.dispatcher.activeAlbums[message.GroupedID] = albBox
 		c.dispatcher.Unlock()
+		if d := c.dispatcher; d.workers != nil {
+			// the rest of the album is queued behind this message, so wait without
+			// holding the worker, then queue the album behind the updates of its chat
+			update := &UpdateNewMessage{Message: &message}
+			d.busy.Add(1)
+			time.AfterFunc(time.Duration(c.clientData.albumWaitTime)*time.Millisecond, func() {
+				defer d.busy.Add(-1)
+				c.runUpdate(update, func() { albBox.trigger(d, c) })
+			})
+			return
+		}
 		albBox.WaitAndTrigger(c.dispatcher, c)
 	}
 }
@@ -945,7 +1026,7 @@ func (c *Client) handleEditUpdate(update Message) {
 					}
 
 					if group == DefaultGroup {
-						go func() {
+						c.dispatcher.spawn(func() {
 							err := handle(handler)
 							if err != nil {
 								if errors.Is(err, ErrEndGroup) {
@@ -953,7 +1034,7 @@ func (c *Client) handleEditUpdate(update Message) {
 								}
 								c.Log.WithError(err).Error("[EditMessageHandler]")
 							}
-						}()
+						})
 					} else {
 						if err := handle(handler); err != nil && errors.Is(err, ErrEndGroup) {
 							break
@@ -992,7 +1073,7 @@ func (c *Client) handleCallbackUpdate(update *UpdateBotCallbackQuery) {
 				}
 
 				if group == DefaultGroup {
-					go func() {
+					c.dispatcher.spawn(func() {
 						err := handle(handler)
 						if err != nil {
 							if errors.Is(err, ErrEndGroup) {
@@ -1000,7 +1081,7 @@ func (c *Client) handleCallbackUpdate(update *UpdateBotCallbackQuery) {
 							}
 							c.Log.WithError(err).Error("[CallbackQueryHandler]")
 						}
-					}()
+					})
 				} else {
 					if err := handle(handler); err != nil && errors.Is(err, ErrEndGroup) {
 						break
@@ -1028,7 +1109,7 @@ func (c *Client) handleInlineCallbackUpdate(update *UpdateInlineBotCallbackQuery
 				}
 
 				if group == DefaultGroup {
-					go func() {
+					c.dispatcher.spawn(func() {
 						err := handle(handler)
 						if err != nil {
 							if errors.Is(err, ErrEndGroup) {
@@ -1036,7 +1117,7 @@ func (c *Client) handleInlineCallbackUpdate(update *UpdateInlineBotCallbackQuery
 							}
 							c.Log.WithError(err).Error("[InlineCallbackHandler]")
 						}
-					}()
+					})
 				} else {
 					if err := handle(handler); err != nil && errors.Is(err, ErrEndGroup) {
 						break
@@ -1069,7 +1150,7 @@ func (c *Client) handleParticipantUpdate(update *UpdateChannelParticipant) {
 			}
 
 			if group == DefaultGroup {
-				go func() {
+				c.dispatcher.spawn(func() {
 					err := handle(handler)
 					if err != nil {
 						if errors.Is(err, ErrEndGroup) {
@@ -1077,7 +1158,7 @@ func (c *Client) handleParticipantUpdate(update *UpdateChannelParticipant) {
 						}
 						c.Log.WithError(err).Error("[ParticipantUpdateHandler]")
 					}
-				}()
+				})
 			} else {
 				if err := handle(handler); err != nil && errors.Is(err, ErrEndGroup) {
 					break
@@ -1104,7 +1185,7 @@ func (c *Client) handleInlineUpdate(update *UpdateBotInlineQuery) {
 				}
 
 				if group == DefaultGroup {
-					go func() {
+					c.dispatcher.spawn(func() {
 						err := handle(handler)
 						if err != nil {
 							if errors.Is(err, ErrEndGroup) {
@@ -1112,7 +1193,7 @@ func (c *Client) handleInlineUpdate(update *UpdateBotInlineQuery) {
 							}
 							c.Log.WithError(err).Error("[InlineQueryHandler]")
 						}
-					}()
+					})
 				} else {
 					if err := handle(handler); err != nil && errors.Is(err, ErrEndGroup) {
 						break
@@ -1139,7 +1220,7 @@ func (c *Client) handleInlineSendUpdate(update *UpdateBotInlineSend) {
 			}
 
 			if group == DefaultGroup {
-				go func() {
+				c.dispatcher.spawn(func() {
 					err := handle(handler)
 					if err != nil {
 						if errors.Is(err, ErrEndGroup) {
@@ -1147,7 +1228,7 @@ func (c *Client) handleInlineSendUpdate(update *UpdateBotInlineSend) {
 						}
 						c.Log.WithError(err).Error("[InlineSendHandler]")
 					}
-				}()
+				})
 			} else {
 				if err := handle(handler); err != nil && errors.Is(err, ErrEndGroup) {
 					break
@@ -1173,7 +1254,7 @@ func (c *Client) handleDeleteUpdate(update Update) {
 			}
 
 			if group == DefaultGroup {
-				go func() {
+				c.dispatcher.spawn(func() {
 					err := handle(handler)
 					if err != nil {
 						if errors.Is(err, ErrEndGroup) {
@@ -1181,7 +1262,7 @@ func (c *Client) handleDeleteUpdate(update Update) {
 						}
 						c.Log.WithError(err).Error("[DeleteMessageHandler]")
 					}
-				}()
+				})
 			} else {
 				if err := handle(handler); err != nil && errors.Is(err, ErrEndGroup) {
 					break
@@ -1213,7 +1294,7 @@ func (c *Client) handleJoinRequestUpdate(update Update) {
 			}
 
 			if group == DefaultGroup {
-				go func() {
+				c.dispatcher.spawn(func() {
 					err := handle(handler)
 					if err != nil {
 						if errors.Is(err, ErrEndGroup) {
@@ -1221,7 +1302,7 @@ func (c *Client) handleJoinRequestUpdate(update Update) {
 						}
 						c.Log.WithError(err).Error("[JoinRequestHandler]")
 					}
-				}()
+				})
 			} else {
 				if err := handle(handler); err != nil && errors.Is(err, ErrEndGroup) {
 					break
@@ -1251,7 +1332,7 @@ func (c *Client) handleRawUpdate(update Update) {
 				}
 
 				if group == DefaultGroup {
-					go func() {
+					c.dispatcher.spawn(func() {
 						err := handle(handler)
 						if err != nil {
 							if errors.Is(err, ErrEndGroup) {
@@ -1259,7 +1340,7 @@ func (c *Client) handleRawUpdate(update Update) {
 							}
 							c.Log.WithError(err).Error("[RawUpdateHandler]")
 						}
-					}()
+					})
 				} else {
 					if err := handle(handler); err != nil && errors.Is(err, ErrEndGroup) {
 						break
@@ -1270,6 +1351,55 @@ func (c *Client) handleRawUpdate(update Update) {
 	}
 }
 
+// dispatchEvent runs the handlers of a typed event, group by group, those of
+// DefaultGroup concurrently. name tags the errors of the handlers in the log.
+func dispatchEvent[E any](c *Client, handles map[int][]*eventHandle[E], event E, name string) {
+	c.dispatcher.RLock()
+	groups := make(map[int][]*eventHandle[E], len(handles))
+	maps.Copy(groups, handles)
+	c.dispatcher.RUnlock()
+
+	for _, group := range slices.Sorted(maps.Keys(groups)) {
+		for _, handler := range groups[group] {
+			if !handler.runFilterChain(event) {
+				continue
+			}
+			handle := func(h *eventHandle[E]) error {
+				defer c.NewRecovery()()
+				return h.Handler(event)
+			}
+
+			if group == DefaultGroup {
+				c.dispatcher.spawn(func() {
+					err := handle(handler)
+					if err != nil {
+						if errors.Is(err, ErrEndGroup) {
+							return
+						}
+						c.Log.WithError(err).Error(name)
+					}
+				})
+			} else {
+				if err := handle(handler); err != nil {
+					if errors.Is(err, ErrEndGroup) {
+						break
+					}
+					c.Log.WithError(err).Error(name)
+				}
+			}
+		}
+	}
+}
+
+func (h *eventHandle[E]) runFilterChain(event E) bool {
+	for _, f := range h.Filters {
+		if !f.checkEvent(event) {
+			return false
+		}
+	}
+	return true
+}
+
 func (h *inlineHandle) IsMatch(text string) bool {
 	switch pattern := h.Pattern.(type) {
 	case string:
@@ -1419,6 +1549,7 @@ type Filter struct {
 	MediaTypes   []string
 	Func         func(m *NewMessage) bool
 	FuncCallback func(c *CallbackQuery) bool
+	FuncEvent    func(e any) bool
 	orFilters    []Filter
 }
 
@@ -1602,30 +1733,64 @@ func CustomCallback(fn func(*CallbackQuery) bool) Filter {
 	return Filter{FuncCallback: fn}
 }
 
+// CustomEvent allows any func(e E) bool as a filter of the typed events, like
+// *ReactionUpdate or *TypingUpdate; it rejects events of other types.
+func CustomEvent[E any](fn func(E) bool) Filter {
+	return Filter{FuncEvent: func(e any) bool {
+		event, ok := e.(E)
+		return ok && fn(event)
+	}}
+}
+
+// Not, Any and All combine filters of messages as well as of typed events, checking
+// the latter through checkEvent.
 func Not(f Filter) Filter {
-	return Filter{Func: func(m *NewMessage) bool { return !f.check(m) }}
+	return Filter{
+		Func:      func(m *NewMessage) bool { return !f.check(m) },
+		FuncEvent: func(e any) bool { return !f.checkEvent(e) },
+	}
 }
 
 func Any(fs ...Filter) Filter {
-	return Filter{Func: func(m *NewMessage) bool {
-		for _, f := range fs {
-			if f.check(m) {
-				return true
+	return Filter{
+		Func: func(m *NewMessage) bool {
+			for _, f := range fs {
+				if f.check(m) {
+					return true
+				}
 			}
-		}
-		return false
-	}}
+			return false
+		},
+		FuncEvent: func(e any) bool {
+			for _, f := range fs {
+				if f.checkEvent(e) {
+					return true
+				}
+			}
+			return false
+		},
+	}
 }
 
 func All(fs ...Filter) Filter {
-	return Filter{Func: func(m *NewMessage) bool {
-		for _, f := range fs {
-			if !f.check(m) {
-				return false
+	return Filter{
+		Func: func(m *NewMessage) bool {
+			for _, f := range fs {
+				if !f.check(m) {
+					return false
+				}
 			}
-		}
-		return true
-	}}
+			return true
+		},
+		FuncEvent: func(e any) bool {
+			for _, f := range fs {
+				if !f.checkEvent(e) {
+					return false
+				}
+			}
+			return true
+		},
+	}
 }
 
 func (f Filter) check(m *NewMessage) bool {
@@ -1725,6 +1890,58 @@ func (f Filter) checkCallback(c *CallbackQuery) bool {
 	return true
 }
 
+// checkEvent checks a typed event against the chat type flags, the user, chat and
+// channel lists and FuncEvent. Events without a user or a chat fail the lists,
+// unless they are blacklists.
+func (f Filter) checkEvent(e any) bool {
+	if len(f.orFilters) > 0 {
+		for _, orFilter := range f.orFilters {
+			if orFilter.checkEvent(e) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var chatType string
+	if ev, ok := e.(interface{ ChatType() string }); ok {
+		chatType = ev.ChatType()
+	}
+	if f.flags.Has(FPrivate) && chatType != EntityUser {
+		return false
+	}
+	if f.flags.Has(FGroup) && chatType != EntityChat {
+		return false
+	}
+	if f.flags.Has(FChannel) && chatType != EntityChannel {
+		return false
+	}
+
+	isBlacklist := f.flags.Has(FBlacklist)
+	if len(f.Users) > 0 {
+		ev, ok := e.(interface{ UserID() int64 })
+		if ok && slices.Contains(f.Users, ev.UserID()) == isBlacklist || !ok && !isBlacklist {
+			return false
+		}
+	}
+	if len(f.Chats) > 0 {
+		ev, ok := e.(interface{ ChatID() int64 })
+		if ok && slices.Contains(f.Chats, ev.ChatID()) == isBlacklist || !ok && !isBlacklist {
+			return false
+		}
+	}
+	if len(f.Channels) > 0 {
+		ev, ok := e.(interface{ ChannelID() int64 })
+		if ok && slices.Contains(f.Channels, ev.ChannelID()) == isBlacklist || !ok && !isBlacklist {
+			return false
+		}
+	}
+	if f.FuncEvent != nil && !f.FuncEvent(e) {
+		return false
+	}
+	return true
+}
+
 func (f Filter) Or(other Filter) Filter {
 	if len(f.orFilters) == 0 {
 		f.orFilters = []Filter{f, other}
@@ -2021,6 +2238,48 @@ func (c *Client) AddParticipantHandler(handler ParticipantHandler) Handle {
 	return addHandleToMap(c.dispatcher.participantHandles, h)
 }
 
+func (c *Client) AddReactionHandler(handler ReactionHandler, filters ...Filter) Handle {
+	return addEventHandler(c, c.dispatcher.reactionHandles, handler, filters)
+}
+
+func (c *Client) AddBotReactionHandler(handler BotReactionHandler, filters ...Filter) Handle {
+	return addEventHandler(c, c.dispatcher.botReactionHandles, handler, filters)
+}
+
+func (c *Client) AddPollHandler(handler PollHandler, filters ...Filter) Handle {
+	return addEventHandler(c, c.dispatcher.pollHandles, handler, filters)
+}
+
+func (c *Client) AddPollVoteHandler(handler PollVoteHandler, filters ...Filter) Handle {
+	return addEventHandler(c, c.dispatcher.pollVoteHandles, handler, filters)
+}
+
+func (c *Client) AddUserStatusHandler(handler UserStatusHandler, filters ...Filter) Handle {
+	return addEventHandler(c, c.dispatcher.userStatusHandles, handler, filters)
+}
+
+func (c *Client) AddTypingHandler(handler TypingHandler, filters ...Filter) Handle {
+	return addEventHandler(c, c.dispatcher.typingHandles, handler, filters)
+}
+
+func (c *Client) AddReadHandler(handler ReadHandler, filters ...Filter) Handle {
+	return addEventHandler(c, c.dispatcher.readHandles, handler, filters)
+}
+
+func addEventHandler[E any](c *Client, handles map[int][]*eventHandle[E], handler func(m E) error, filters []Filter) Handle {
+	c.dispatcher.Lock()
+	defer c.dispatcher.Unlock()
+	handleID := nextHandleID()
+	h := &eventHandle[E]{
+		Handler:    handler,
+		Filters:    filters,
+		baseHandle: baseHandle{id: handleID, Group: DefaultGroup},
+	}
+	h.onGroupChanged = makeGroupChangeCallback(handles, h, handleID, &c.dispatcher.RWMutex)
+	h.onPriorityChanged = makePriorityChangeCallback(handles, h, handleID, h.GetGroup, h.GetPriority, &c.dispatcher.RWMutex)
+	return addHandleToMap(handles, h)
+}
+
 func (c *Client) AddRawHandler(updateType Update, handler RawHandler) Handle {
 	c.dispatcher.Lock()
 	defer c.dispatcher.Unlock()
@@ -2058,6 +2317,9 @@ func HandleIncomingUpdates(u any, c *Client) bool {
 	// Update last update time for 15-minute timeout monitoring
 	c.dispatcher.UpdateLastUpdateTime()
 	c.dispatcher.nextUpdatesDeadline = time.Now().Add(time.Minute * 15)
+	if obj, ok := u.(TLObject); ok && c.dispatcher.journal != nil {
+		c.dispatcher.journal.record(c.GetDC(), obj)
+	}
 
 UpdateTypeSwitching:
 	switch upd := u.(type) {
@@ -2068,69 +2330,11 @@ UpdateTypeSwitching:
 
 		go c.Cache.UpdatePeersToCache(upd.Users, upd.Chats)
 		for _, update := range upd.Updates {
+			hold := c.holdUpdate(update)
+			c.dispatchUpdate(update, hold)
+			c.applySequence(update)
+			hold.release()
 			switch update := update.(type) {
-			case *UpdateNewMessage:
-				go c.handleMessageUpdate(update.Message)
-				c.managePts(update.Pts, update.PtsCount)
-			case *UpdateNewChannelMessage:
-				channelID := getChannelIDFromMessage(update.Message)
-				if channelID != 0 {
-					go c.handleMessageUpdate(update.Message)
-					c.manageChannelPts(channelID, update.Pts, update.PtsCount)
-				} else {
-					go c.handleMessageUpdate(update.Message)
-					c.managePts(update.Pts, update.PtsCount)
-				}
-			case *UpdateNewScheduledMessage:
-				go c.handleMessageUpdate(update.Message)
-			case *UpdateEditMessage:
-				go c.handleEditUpdate(update.Message)
-				c.managePts(update.Pts, update.PtsCount)
-			case *UpdateEditChannelMessage:
-				channelID := getChannelIDFromMessage(update.Message)
-				if channelID != 0 {
-					go c.handleEditUpdate(update.Message)
-					c.manageChannelPts(channelID, update.Pts, update.PtsCount)
-				} else {
-					go c.handleEditUpdate(update.Message)
-					c.managePts(update.Pts, update.PtsCount)
-				}
-			case *UpdateDeleteMessages:
-				go c.handleDeleteUpdate(update)
-				c.managePts(update.Pts, update.PtsCount)
-			case *UpdateDeleteChannelMessages:
-				go c.handleDeleteUpdate(update)
-				c.manageChannelPts(update.ChannelID, update.Pts, update.PtsCount)
-			case *UpdateReadHistoryInbox:
-				c.managePts(update.Pts, update.PtsCount)
-			case *UpdateReadHistoryOutbox:
-				c.managePts(update.Pts, update.PtsCount)
-			case *UpdateWebPage:
-				c.managePts(update.Pts, update.PtsCount)
-			case *UpdateReadMessagesContents:
-				c.managePts(update.Pts, update.PtsCount)
-			case *UpdateReadChannelInbox:
-				c.manageChannelPts(update.ChannelID, update.Pts, 0)
-			case *UpdateChannelWebPage:
-				c.manageChannelPts(update.ChannelID, update.Pts, update.PtsCount)
-			case *UpdateFolderPeers:
-				c.managePts(update.Pts, update.PtsCount)
-			case *UpdatePinnedMessages:
-				c.managePts(update.Pts, update.PtsCount)
-			case *UpdatePinnedChannelMessages:
-				c.manageChannelPts(update.ChannelID, update.Pts, update.PtsCount)
-			case *UpdateBotInlineQuery:
-				go c.handleInlineUpdate(update)
-			case *UpdateBotCallbackQuery:
-				go c.handleCallbackUpdate(update)
-			case *UpdateInlineBotCallbackQuery:
-				go c.handleInlineCallbackUpdate(update)
-			case *UpdateChannelParticipant:
-				go c.handleParticipantUpdate(update)
-			case *UpdatePendingJoinRequests, *UpdateBotChatInviteRequester:
-				go c.handleJoinRequestUpdate(update)
-			case *UpdateBotInlineSend:
-				go c.handleInlineSendUpdate(update)
 			case *UpdateChannelTooLong:
 				currentPts := c.dispatcher.GetChannelPts(update.ChannelID)
 				if update.Pts != 0 {
@@ -2140,19 +2344,17 @@ UpdateTypeSwitching:
 			case *UpdateEncryption, *UpdateNewEncryptedMessage:
 				go c.HandleSecretChatUpdate(update)
 			}
-			go c.handleRawUpdate(update)
 		}
 	case *UpdateShort:
+		hold := c.holdUpdate(upd.Update)
 		switch upd := upd.Update.(type) {
 		case *UpdateNewMessage:
-			go c.fetchPeersBeforeUpdate(upd.Message, upd.Pts)
+			hold.run(c, upd, func() { c.fetchPeersBeforeUpdate(upd.Message, upd.Pts) })
 		case *UpdateNewChannelMessage:
-			channelID := getChannelIDFromMessage(upd.Message)
-			if channelID != 0 {
-				go c.handleMessageUpdate(upd.Message)
-				c.manageChannelPts(channelID, upd.Pts, upd.PtsCount)
+			if getChannelIDFromMessage(upd.Message) != 0 {
+				hold.run(c, upd, func() { c.handleMessageUpdate(upd.Message) })
 			} else {
-				go c.fetchPeersBeforeUpdate(upd.Message, upd.Pts)
+				hold.run(c, upd, func() { c.fetchPeersBeforeUpdate(upd.Message, upd.Pts) })
 			}
 		case *UpdateChannelTooLong:
 			currentPts := c.dispatcher.GetChannelPts(upd.ChannelID)
@@ -2161,26 +2363,26 @@ UpdateTypeSwitching:
 			}
 			go c.FetchChannelDifference(upd.ChannelID, currentPts, 50)
 		}
-		go c.handleRawUpdate(upd.Update)
-	case *UpdateShortMessage:
-		update := &MessageObj{ID: upd.ID, Out: upd.Out, Mentioned: upd.Mentioned, Message: upd.Message, MediaUnread: upd.MediaUnread, FromID: getPeerUser(upd.UserID), PeerID: getPeerUser(upd.UserID), Date: upd.Date, Entities: upd.Entities, FwdFrom: upd.FwdFrom, ReplyTo: upd.ReplyTo, ViaBotID: upd.ViaBotID, TtlPeriod: upd.TtlPeriod, Silent: upd.Silent}
-		go c.fetchPeersBeforeUpdate(update, upd.Pts)
-		go c.handleRawUpdate(&UpdateNewMessage{Message: update, Pts: upd.Pts, PtsCount: 0})
-	case *UpdateShortChatMessage:
-		update := &MessageObj{ID: upd.ID, Out: upd.Out, Mentioned: upd.Mentioned, Message: upd.Message, MediaUnread: upd.MediaUnread, FromID: getPeerUser(upd.FromID), PeerID: &PeerChat{ChatID: upd.ChatID}, Date: upd.Date, Entities: upd.Entities, FwdFrom: upd.FwdFrom, ReplyTo: upd.ReplyTo, ViaBotID: upd.ViaBotID, TtlPeriod: upd.TtlPeriod, Silent: upd.Silent}
-		go c.fetchPeersBeforeUpdate(update, upd.Pts)
-		go c.handleRawUpdate(&UpdateNewMessage{Message: update, Pts: upd.Pts, PtsCount: 0})
-	case *UpdateShortSentMessage:
-		update := &MessageObj{ID: upd.ID, Out: upd.Out, Date: upd.Date, Media: upd.Media, Entities: upd.Entities, TtlPeriod: upd.TtlPeriod}
-		go c.fetchPeersBeforeUpdate(update, upd.Pts)
-		go c.handleRawUpdate(&UpdateNewMessage{Message: update, Pts: upd.Pts, PtsCount: 0})
+		if isEventUpdate(upd.Update) {
+			hold.run(c, upd.Update, func() { c.handleEventUpdate(upd.Update) })
+		}
+		hold.run(c, upd.Update, func() { c.handleRawUpdate(upd.Update) })
+		c.applySequence(upd.Update)
+		hold.release()
+	case *UpdateShortMessage, *UpdateShortChatMessage, *UpdateShortSentMessage:
+		newMessage := shortMessage(upd.(Updates))
+		hold := c.holdUpdate(newMessage)
+		hold.run(c, newMessage, func() { c.fetchPeersBeforeUpdate(newMessage.Message, newMessage.Pts) })
+		hold.run(c, newMessage, func() { c.handleRawUpdate(newMessage) })
+		c.applySequence(newMessage)
+		hold.release()
 	case *UpdatesCombined:
 		if !c.manageSeq(upd.Seq, upd.SeqStart) {
 			return false
 		}
 
-		u = upd.Updates
-		go c.Cache.UpdatePeersToCache(upd.Users, upd.Chats)
+		// its seq is checked above, so the updates go through as a seq-less UpdatesObj
+		u = &UpdatesObj{Updates: upd.Updates, Users: upd.Users, Chats: upd.Chats, Date: upd.Date}
 		goto UpdateTypeSwitching
 	case *UpdateChannelTooLong:
 		currentPts := c.dispatcher.GetChannelPts(upd.ChannelID)
@@ -2196,6 +2398,60 @@ UpdateTypeSwitching:
 	return true
 }
 
+// dispatchUpdate runs the handlers of update, leaving the update state alone.
+// hold, if any, is kept until they are done.
+func (c *Client) dispatchUpdate(update Update, hold *stateHold) {
+	switch update := update.(type) {
+	case *UpdateNewMessage:
+		hold.run(c, update, func() { c.handleMessageUpdate(update.Message) })
+	case *UpdateNewChannelMessage:
+		hold.run(c, update, func() { c.handleMessageUpdate(update.Message) })
+	case *UpdateNewScheduledMessage:
+		hold.run(c, update, func() { c.handleMessageUpdate(update.Message) })
+	case *UpdateEditMessage:
+		hold.run(c, update, func() { c.handleEditUpdate(update.Message) })
+	case *UpdateEditChannelMessage:
+		hold.run(c, update, func() { c.handleEditUpdate(update.Message) })
+	case *UpdateDeleteMessages, *UpdateDeleteChannelMessages:
+		hold.run(c, update, func() { c.handleDeleteUpdate(update) })
+	case *UpdateBotInlineQuery:
+		hold.run(c, update, func() { c.handleInlineUpdate(update) })
+	case *UpdateBotCallbackQuery:
+		hold.run(c, update, func() { c.handleCallbackUpdate(update) })
+	case *UpdateInlineBotCallbackQuery:
+		hold.run(c, update, func() { c.handleInlineCallbackUpdate(update) })
+	case *UpdateChannelParticipant:
+		hold.run(c, update, func() { c.handleParticipantUpdate(update) })
+	case *UpdatePendingJoinRequests, *UpdateBotChatInviteRequester:
+		hold.run(c, update, func() { c.handleJoinRequestUpdate(update) })
+	case *UpdateBotInlineSend:
+		hold.run(c, update, func() { c.handleInlineSendUpdate(update) })
+	}
+	if isEventUpdate(update) {
+		hold.run(c, update, func() { c.handleEventUpdate(update) })
+	}
+	hold.run(c, update, func() { c.handleRawUpdate(update) })
+}
+
+// shortMessage unpacks the message of an UpdateShortMessage, UpdateShortChatMessage
+// or UpdateShortSentMessage.
+func shortMessage(u Updates) *UpdateNewMessage {
+	var msg *MessageObj
+	var pts, ptsCount int32
+	switch upd := u.(type) {
+	case *UpdateShortMessage:
+		msg = &MessageObj{ID: upd.ID, Out: upd.Out, Mentioned: upd.Mentioned, Message: upd.Message, MediaUnread: upd.MediaUnread, FromID: getPeerUser(upd.UserID), PeerID: getPeerUser(upd.UserID), Date: upd.Date, Entities: upd.Entities, FwdFrom: upd.FwdFrom, ReplyTo: upd.ReplyTo, ViaBotID: upd.ViaBotID, TtlPeriod: upd.TtlPeriod, Silent: upd.Silent}
+		pts, ptsCount = upd.Pts, upd.PtsCount
+	case *UpdateShortChatMessage:
+		msg = &MessageObj{ID: upd.ID, Out: upd.Out, Mentioned: upd.Mentioned, Message: upd.Message, MediaUnread: upd.MediaUnread, FromID: getPeerUser(upd.FromID), PeerID: &PeerChat{ChatID: upd.ChatID}, Date: upd.Date, Entities: upd.Entities, FwdFrom: upd.FwdFrom, ReplyTo: upd.ReplyTo, ViaBotID: upd.ViaBotID, TtlPeriod: upd.TtlPeriod, Silent: upd.Silent}
+		pts, ptsCount = upd.Pts, upd.PtsCount
+	case *UpdateShortSentMessage:
+		msg = &MessageObj{ID: upd.ID, Out: upd.Out, Date: upd.Date, Media: upd.Media, Entities: upd.Entities, TtlPeriod: upd.TtlPeriod}
+		pts, ptsCount = upd.Pts, upd.PtsCount
+	}
+	return &UpdateNewMessage{Message: msg, Pts: pts, PtsCount: ptsCount}
+}
+
 func getChannelIDFromMessage(msg Message) int64 {
 	if m, ok := msg.(*MessageObj); ok {
 		if peer, ok := m.PeerID.(*PeerChannel); ok {
@@ -2214,10 +2470,12 @@ func (c *Client) FetchDifference(fromPts int32, limit int32) {
 	c.dispatcher.recoveringDifference = true
 	c.dispatcher.Unlock()
 
+	hold := c.dispatcher.hold(fromPts, c.dispatcher.GetQts(), 0, 0)
 	defer func() {
 		c.dispatcher.Lock()
 		c.dispatcher.recoveringDifference = false
 		c.dispatcher.Unlock()
+		hold.release()
 	}()
 
 	if limit == 0 {
@@ -2269,7 +2527,7 @@ func (c *Client) FetchDifference(fromPts int32, limit int32) {
 
 			for _, message := range u.NewMessages {
 				if msg, ok := message.(*MessageObj); ok {
-					go c.handleMessageUpdate(msg)
+					hold.run(c, &UpdateNewMessage{Message: msg}, func() { c.handleMessageUpdate(msg) })
 					totalFetched++
 				}
 			}
@@ -2290,7 +2548,7 @@ func (c *Client) FetchDifference(fromPts int32, limit int32) {
 
 			for _, message := range u.NewMessages {
 				if msg, ok := message.(*MessageObj); ok {
-					go c.handleMessageUpdate(msg)
+					hold.run(c, &UpdateNewMessage{Message: msg}, func() { c.handleMessageUpdate(msg) })
 					totalFetched++
 				}
 			}
@@ -2364,18 +2622,15 @@ func (c *Client) managePts(pts int32, ptsCount int32) bool {
 			return true
 		}
 
+		// the missing updates come after currentPts; until they are fetched and
+		// handled, the saved state stays there
+		hold := c.dispatcher.hold(currentPts, 0, 0, 0)
 		c.dispatcher.SetPts(pts)
 
-		gapPts := expectedPts
 		go func() {
+			defer hold.release()
 			time.Sleep(500 * time.Millisecond)
-
-			newCurrentPts := c.dispatcher.GetPts()
-			if newCurrentPts >= pts {
-				return
-			}
-
-			c.FetchDifference(gapPts, gap+10)
+			c.FetchDifference(currentPts, gap+10)
 		}()
 
 		return true
@@ -2445,18 +2700,13 @@ func (c *Client) manageChannelPts(channelID int64, pts int32, ptsCount int32) bo
 			return true
 		}
 
+		hold := c.dispatcher.hold(0, 0, channelID, currentPts)
 		c.dispatcher.SetChannelPts(channelID, pts)
 
-		gapPts := expectedPts
 		go func() {
+			defer hold.release()
 			time.Sleep(500 * time.Millisecond)
-
-			newCurrentPts := c.dispatcher.GetChannelPts(channelID)
-			if newCurrentPts >= pts {
-				return
-			}
-
-			c.FetchChannelDifference(channelID, gapPts, 100)
+			c.FetchChannelDifference(channelID, currentPts, 100)
 		}()
 
 		return true
@@ -2503,6 +2753,12 @@ func (c *Client) GetDifference(Pts, Limit int32) (Message, error) {
 // FetchChannelDifference fetches updates difference for a specific channel
 // Use limit 10-100 as recommended for channels
 func (c *Client) FetchChannelDifference(channelID int64, fromPts int32, limit int32) {
+	c.fetchChannelDifference(channelID, fromPts, limit, false)
+}
+
+// fetchChannelDifference fetches the difference of a channel, page after page for
+// open chats or when untilFinal is set, and only the first page otherwise.
+func (c *Client) fetchChannelDifference(channelID int64, fromPts int32, limit int32, untilFinal bool) {
 	c.dispatcher.Lock()
 	if c.dispatcher.recoveringChannels == nil {
 		c.dispatcher.recoveringChannels = make(map[int64]bool)
@@ -2514,10 +2770,12 @@ func (c *Client) FetchChannelDifference(channelID int64, fromPts int32, limit in
 	c.dispatcher.recoveringChannels[channelID] = true
 	c.dispatcher.Unlock()
 
+	hold := c.dispatcher.hold(0, 0, channelID, fromPts)
 	defer func() {
 		c.dispatcher.Lock()
 		delete(c.dispatcher.recoveringChannels, channelID)
 		c.dispatcher.Unlock()
+		hold.release()
 	}()
 
 	if limit == 0 {
@@ -2581,7 +2839,7 @@ func (c *Client) FetchChannelDifference(channelID int64, fromPts int32, limit in
 
 			for _, message := range d.NewMessages {
 				if msg, ok := message.(*MessageObj); ok {
-					go c.handleMessageUpdate(msg)
+					hold.run(c, &UpdateNewMessage{Message: msg}, func() { c.handleMessageUpdate(msg) })
 					totalFetched++
 				}
 			}
@@ -2601,7 +2859,7 @@ func (c *Client) FetchChannelDifference(channelID int64, fromPts int32, limit in
 			isOpen := channelState != nil && channelState.isOpen
 			c.dispatcher.RUnlock()
 
-			if !isOpen {
+			if !isOpen && !untilFinal {
 				return
 			}
 
@@ -2611,7 +2869,7 @@ func (c *Client) FetchChannelDifference(channelID int64, fromPts int32, limit in
 			c.Cache.UpdatePeersToCache(d.Users, d.Chats)
 			for _, message := range d.Messages {
 				if msg, ok := message.(*MessageObj); ok {
-					go c.handleMessageUpdate(msg)
+					hold.run(c, &UpdateNewMessage{Message: msg}, func() { c.handleMessageUpdate(msg) })
 					totalFetched++
 				}
 			}
@@ -2625,7 +2883,7 @@ func (c *Client) FetchChannelDifference(channelID int64, fromPts int32, limit in
 					isOpen := channelState != nil && channelState.isOpen
 					c.dispatcher.RUnlock()
 
-					if isOpen {
+					if isOpen || untilFinal {
 						req.Pts = dialogChannel.Pts
 						continue
 					}
@@ -2708,6 +2966,7 @@ func (c *Client) OpenChat(channel *InputChannelObj, timeoutSeconds int32) {
 			accessHash: channel.AccessHash,
 			isOpen:     true,
 		}
+		c.dispatcher.stateChanged()
 	}
 	c.dispatcher.Unlock()
 
@@ -2764,7 +3023,7 @@ func (c *Client) pollOpenChat(channelID int64, chat *openChat) {
 			c.Cache.UpdatePeersToCache(d.Users, d.Chats)
 			for _, msg := range d.NewMessages {
 				if msgObj, ok := msg.(*MessageObj); ok {
-					go c.handleMessageUpdate(msgObj)
+					c.runUpdate(&UpdateNewMessage{Message: msgObj}, func() { c.handleMessageUpdate(msgObj) })
 				}
 			}
 			if len(d.OtherUpdates) > 0 {
@@ -2780,7 +3039,7 @@ func (c *Client) pollOpenChat(channelID int64, chat *openChat) {
 			c.Cache.UpdatePeersToCache(d.Users, d.Chats)
 			for _, msg := range d.Messages {
 				if msgObj, ok := msg.(*MessageObj); ok {
-					go c.handleMessageUpdate(msgObj)
+					c.runUpdate(&UpdateNewMessage{Message: msgObj}, func() { c.handleMessageUpdate(msgObj) })
 				}
 			}
 			chat.Lock()
@@ -2840,6 +3099,7 @@ func (c *Client) ExportPts() int32 {
 
 // FetchDifferenceOnStartup fetches any missed updates since last disconnect.
 // Should be called on startup after logging in to catch up on missed events.
+// Clients with a ClientConfig.UpdateStateStore do this on their own in Start.
 func (c *Client) FetchDifferenceOnStartup(pts int32) {
 	c.Log.Debug("fetching missed updates (pts=%d)", pts)
 	c.FetchDifference(pts, 5000)
@@ -2861,6 +3121,13 @@ var (
 	OnChosenInline   ev = "choseninline"
 	OnParticipant    ev = "participant"
 	OnJoinRequest    ev = "joinrequest"
+	OnReaction       ev = "reaction"
+	OnBotReaction    ev = "botreaction"
+	OnPoll           ev = "poll"
+	OnPollVote       ev = "pollvote"
+	OnUserStatus     ev = "userstatus"
+	OnTyping         ev = "typing"
+	OnRead           ev = "read"
 	OnRaw            ev = "raw"
 )
 
@@ -2907,6 +3174,13 @@ var handlerTypes = map[string]string{
 	"func(*telegram.InlineCallbackQuery) error":     "inlinecallback",
 	"func(*telegram.ParticipantUpdate) error":       "participant",
 	"func(*telegram.JoinRequestUpdate) error":       "joinrequest",
+	"func(*telegram.ReactionUpdate) error":          "reaction",
+	"func(*telegram.BotReactionUpdate) error":       "botreaction",
+	"func(*telegram.PollUpdate) error":              "poll",
+	"func(*telegram.PollVoteUpdate) error":          "pollvote",
+	"func(*telegram.UserStatusUpdate) error":        "userstatus",
+	"func(*telegram.TypingUpdate) error":            "typing",
+	"func(*telegram.ReadUpdate) error":              "read",
 	"func(telegram.Update, *telegram.Client) error": "raw",
 }
 
@@ -2933,6 +3207,13 @@ var handlerTypes = map[string]string{
 //	client.On("album", handler)                // Media albums
 //	client.On("participant", handler)          // Member updates
 //	client.On("joinrequest", handler)          // Join requests
+//	client.On("reaction", handler)             // Reaction counters of messages
+//	client.On("botreaction", handler)          // Reactions of users, for bots
+//	client.On("poll", handler)                 // Poll results
+//	client.On("pollvote", handler)             // Poll votes, for bots
+//	client.On("userstatus", handler)           // Online status of users
+//	client.On("typing", handler)               // Typing and other chat actions
+//	client.On("read", handler)                 // Read receipts
 //
 //	// Raw updates
 //	client.On("raw", handler)                  // All raw updates
@@ -3079,6 +3360,48 @@ func (c *Client) On(args ...any) Handle {
 		}
 		c.Log.Error("On(joinrequest): invalid handler type %T, expected func(*JoinRequestUpdate) error", handler)
 
+	case "reaction":
+		if h, ok := handler.(func(m *ReactionUpdate) error); ok {
+			return c.AddReactionHandler(h, filters...)
+		}
+		c.Log.Error("On(reaction): invalid handler type %T, expected func(*ReactionUpdate) error", handler)
+
+	case "botreaction":
+		if h, ok := handler.(func(m *BotReactionUpdate) error); ok {
+			return c.AddBotReactionHandler(h, filters...)
+		}
+		c.Log.Error("On(botreaction): invalid handler type %T, expected func(*BotReactionUpdate) error", handler)
+
+	case "poll":
+		if h, ok := handler.(func(m *PollUpdate) error); ok {
+			return c.AddPollHandler(h, filters...)
+		}
+		c.Log.Error("On(poll): invalid handler type %T, expected func(*PollUpdate) error", handler)
+
+	case "pollvote":
+		if h, ok := handler.(func(m *PollVoteUpdate) error); ok {
+			return c.AddPollVoteHandler(h, filters...)
+		}
+		c.Log.Error("On(pollvote): invalid handler type %T, expected func(*PollVoteUpdate) error", handler)
+
+	case "userstatus", "status":
+		if h, ok := handler.(func(m *UserStatusUpdate) error); ok {
+			return c.AddUserStatusHandler(h, filters...)
+		}
+		c.Log.Error("On(userstatus): invalid handler type %T, expected func(*UserStatusUpdate) error", handler)
+
+	case "typing", "chataction":
+		if h, ok := handler.(func(m *TypingUpdate) error); ok {
+			return c.AddTypingHandler(h, filters...)
+		}
+		c.Log.Error("On(typing): invalid handler type %T, expected func(*TypingUpdate) error", handler)
+
+	case "read", "readhistory":
+		if h, ok := handler.(func(m *ReadUpdate) error); ok {
+			return c.AddReadHandler(h, filters...)
+		}
+		c.Log.Error("On(read): invalid handler type %T, expected func(*ReadUpdate) error", handler)
+
 	case "raw", "*":
 		if h, ok := handler.(func(m Update, c *Client) error); ok {
 			return c.AddRawHandler(nil, h)
@@ -3113,6 +3436,20 @@ func (c *Client) On(args ...any) Handle {
 			return c.AddParticipantHandler(h)
 		case func(m *JoinRequestUpdate) error:
 			return c.AddJoinRequestHandler(h)
+		case func(m *ReactionUpdate) error:
+			return c.AddReactionHandler(h, filters...)
+		case func(m *BotReactionUpdate) error:
+			return c.AddBotReactionHandler(h, filters...)
+		case func(m *PollUpdate) error:
+			return c.AddPollHandler(h, filters...)
+		case func(m *PollVoteUpdate) error:
+			return c.AddPollVoteHandler(h, filters...)
+		case func(m *UserStatusUpdate) error:
+			return c.AddUserStatusHandler(h, filters...)
+		case func(m *TypingUpdate) error:
+			return c.AddTypingHandler(h, filters...)
+		case func(m *ReadUpdate) error:
+			return c.AddReadHandler(h, filters...)
 		case func(m Update, c *Client) error:
 			return c.AddRawHandler(nil, h)
 		default:
@@ -3186,6 +3523,34 @@ func (c *Client) OnJoinRequest(handler func(m *JoinRequestUpdate) error) Handle
 	return c.AddJoinRequestHandler(handler)
 }
 
+func (c *Client) OnReaction(handler func(m *ReactionUpdate) error, filters ...Filter) Handle {
+	return c.AddReactionHandler(handler, filters...)
+}
+
+func (c *Client) OnBotReaction(handler func(m *BotReactionUpdate) error, filters ...Filter) Handle {
+	return c.AddBotReactionHandler(handler, filters...)
+}
+
+func (c *Client) OnPoll(handler func(m *PollUpdate) error, filters ...Filter) Handle {
+	return c.AddPollHandler(handler, filters...)
+}
+
+func (c *Client) OnPollVote(handler func(m *PollVoteUpdate) error, filters ...Filter) Handle {
+	return c.AddPollVoteHandler(handler, filters...)
+}
+
+func (c *Client) OnUserStatus(handler func(m *UserStatusUpdate) error, filters ...Filter) Handle {
+	return c.AddUserStatusHandler(handler, filters...)
+}
+
+func (c *Client) OnTyping(handler func(m *TypingUpdate) error, filters ...Filter) Handle {
+	return c.AddTypingHandler(handler, filters...)
+}
+
+func (c *Client) OnRead(handler func(m *ReadUpdate) error, filters ...Filter) Handle {
+	return c.AddReadHandler(handler, filters...)
+}
+
 func (c *Client) OnRaw(updateType Update, handler func(m Update, c *Client) error) Handle {
 	return c.AddRawHandler(updateType, handler)
 }
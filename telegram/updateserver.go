@@ -0,0 +1,388 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultStreamBufferSize = 1000
+	maxGetUpdatesLimit      = 100
+	maxGetUpdatesTimeout    = 60 * time.Second
+	streamHeartbeat         = 15 * time.Second
+)
+
+// Types of StreamUpdate.
+const (
+	StreamMessage       = "message"
+	StreamEditedMessage = "edited_message"
+	StreamCallbackQuery = "callback_query"
+	StreamRaw           = "raw"
+)
+
+// StreamUpdate is an update as served by an UpdateServer.
+type StreamUpdate struct {
+	UpdateID int64           `json:"update_id"` // Increases by one with every update
+	Type     string          `json:"type"`      // StreamMessage, StreamEditedMessage, StreamCallbackQuery or StreamRaw
+	Data     json.RawMessage `json:"data"`      // The update as written by MarshalJSON
+}
+
+// UpdateServerOptions configures Client.NewUpdateServer.
+type UpdateServerOptions struct {
+	Group      int    // Dispatcher group the handlers of the server run in (default: DefaultGroup)
+	BufferSize int    // Updates kept for getUpdates and reconnecting streams (default: 1000)
+	Token      string // Required as "Authorization: Bearer <token>" or ?token= if set
+	NoRaw      bool   // Leave out raw updates, which carry every update not served as another type
+}
+
+// UpdateServer serves the updates of a client to other processes over HTTP, so
+// that they need no session of their own. It registers handlers in a dispatcher
+// group, alongside those of the client, and keeps the latest updates in a buffer
+// served by two endpoints:
+//
+//	GET /events      Server-Sent Events, one event per StreamUpdate with its ID and type;
+//	                 resumes after Last-Event-ID, or from ?offset=, else from new updates
+//	GET /getUpdates  Long polling like the Bot API: ?offset= acknowledges every update
+//	                 before it, ?limit= (up to 100) and ?timeout= (seconds) bound the answer
+//
+// Both take ?types=message,callback_query to pick the types served. Acknowledged
+// updates are no longer returned by getUpdates, but stay in the buffer for /events.
+// Mount Handler on any http.ServeMux, or call ListenAndServe.
+type UpdateServer struct {
+	client  *Client
+	handles []Handle
+	size    int
+	token   string
+
+	mu      sync.Mutex
+	updates []StreamUpdate // oldest first
+	nextID  int64
+	acked   int64         // getUpdates confirmed every update up to this ID
+	notify  chan struct{} // closed and replaced on every update
+	server  *http.Server
+	closed  chan struct{}
+	stopped bool
+}
+
+// NewUpdateServer registers the handlers feeding an UpdateServer; it serves no
+// connections until mounted or started with ListenAndServe.
+func (c *Client) NewUpdateServer(opts ...*UpdateServerOptions) (*UpdateServer, error) {
+	if c.dispatcher == nil {
+		return nil, errors.New("update server: updates are disabled")
+	}
+	opt := getVariadic(opts, &UpdateServerOptions{})
+	s := &UpdateServer{
+		client: c,
+		size:   getValue(opt.BufferSize, defaultStreamBufferSize),
+		token:  opt.Token,
+		notify: make(chan struct{}),
+		closed: make(chan struct{}),
+	}
+
+	s.handles = append(s.handles,
+		c.AddMessageHandler(OnNewMessage, func(m *NewMessage) error {
+			s.publish(StreamMessage, m.OriginalUpdate)
+			return nil
+		}),
+		c.AddEditHandler(OnEditMessage, func(m *NewMessage) error {
+			s.publish(StreamEditedMessage, m.OriginalUpdate)
+			return nil
+		}),
+		c.AddCallbackHandler(OnCallbackQuery, func(b *CallbackQuery) error {
+			s.publish(StreamCallbackQuery, b.OriginalUpdate)
+			return nil
+		}),
+	)
+	if !opt.NoRaw {
+		s.handles = append(s.handles, c.AddRawHandler(nil, func(u Update, _ *Client) error {
+			if !servedTyped(u) {
+				s.publish(StreamRaw, u)
+			}
+			return nil
+		}))
+	}
+	if opt.Group != DefaultGroup {
+		for _, h := range s.handles {
+			h.SetGroup(opt.Group)
+		}
+	}
+	return s, nil
+}
+
+// servedTyped reports whether the handlers of the other types serve update, so
+// that it is not served a second time as a raw update.
+func servedTyped(update Update) bool {
+	switch u := update.(type) {
+	case *UpdateNewMessage:
+		return incomingMessage(u.Message)
+	case *UpdateNewChannelMessage:
+		return incomingMessage(u.Message)
+	case *UpdateNewScheduledMessage:
+		return incomingMessage(u.Message)
+	case *UpdateEditMessage:
+		_, ok := u.Message.(*MessageObj)
+		return ok
+	case *UpdateEditChannelMessage:
+		_, ok := u.Message.(*MessageObj)
+		return ok
+	case *UpdateBotCallbackQuery:
+		return true
+	}
+	return false
+}
+
+// incomingMessage reports whether m reaches the new message handlers, which leave
+// out service and outgoing messages.
+func incomingMessage(m Message) bool {
+	msg, ok := m.(*MessageObj)
+	return ok && !msg.Out
+}
+
+func (s *UpdateServer) publish(typ string, update any) {
+	data, err := MarshalJSON(update)
+	if err != nil {
+		s.client.Log.Error("update server: encoding %T: %v", update, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		return
+	}
+	s.nextID++
+	s.updates = append(s.updates, StreamUpdate{UpdateID: s.nextID, Type: typ, Data: data})
+	if over := len(s.updates) - s.size; over > 0 {
+		clear(s.updates[:over])
+		s.updates = s.updates[over:]
+	}
+	close(s.notify)
+	s.notify = make(chan struct{})
+}
+
+// after returns up to limit buffered updates of types past the ID after, and a
+// channel closed on the next update.
+func (s *UpdateServer) after(after int64, types map[string]bool, limit int) ([]StreamUpdate, <-chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := sort.Search(len(s.updates), func(i int) bool { return s.updates[i].UpdateID > after })
+	var updates []StreamUpdate
+	for _, u := range s.updates[i:] {
+		if limit > 0 && len(updates) == limit {
+			break
+		}
+		if types == nil || types[u.Type] {
+			updates = append(updates, u)
+		}
+	}
+	return updates, s.notify
+}
+
+// Handler returns an http.Handler serving /events and /getUpdates.
+func (s *UpdateServer) Handler() http.Handler {
+	return s
+}
+
+func (s *UpdateServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		writeStreamError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		writeStreamError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	switch path.Base(r.URL.Path) {
+	case "events":
+		s.serveEvents(w, r)
+	case "getUpdates":
+		s.serveGetUpdates(w, r)
+	default:
+		writeStreamError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *UpdateServer) authorized(r *http.Request) bool {
+	if s.token == "" {
+		return true
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		token = r.FormValue("token")
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1
+}
+
+func (s *UpdateServer) serveEvents(w http.ResponseWriter, r *http.Request) {
+	rc := http.NewResponseController(w)
+	types := streamTypes(r)
+
+	s.mu.Lock()
+	last := s.nextID
+	s.mu.Unlock()
+	if id, err := strconv.ParseInt(r.Header.Get("Last-Event-ID"), 10, 64); err == nil {
+		last = id
+	} else if offset, err := strconv.ParseInt(r.FormValue("offset"), 10, 64); err == nil && offset > 0 {
+		last = offset - 1
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		updates, notify := s.after(last, types, 0)
+		for _, u := range updates {
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", u.UpdateID, u.Type, u.Data); err != nil {
+				return
+			}
+			last = u.UpdateID
+		}
+		if len(updates) > 0 {
+			if err := rc.Flush(); err != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-notify:
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		case <-r.Context().Done():
+			return
+		case <-s.closed:
+			return
+		}
+	}
+}
+
+func (s *UpdateServer) serveGetUpdates(w http.ResponseWriter, r *http.Request) {
+	types := streamTypes(r)
+	limit := maxGetUpdatesLimit
+	if n, err := strconv.Atoi(r.FormValue("limit")); err == nil && n > 0 && n < limit {
+		limit = n
+	}
+	var timeout time.Duration
+	if secs, err := strconv.Atoi(r.FormValue("timeout")); err == nil && secs > 0 {
+		timeout = min(time.Duration(secs)*time.Second, maxGetUpdatesTimeout)
+	}
+
+	s.mu.Lock()
+	if offset, err := strconv.ParseInt(r.FormValue("offset"), 10, 64); err == nil && offset > 0 {
+		s.acked = max(s.acked, offset-1)
+	}
+	acked := s.acked
+	s.mu.Unlock()
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	for {
+		updates, notify := s.after(acked, types, limit)
+		if len(updates) > 0 || timeout == 0 {
+			writeStreamResult(w, updates)
+			return
+		}
+		select {
+		case <-notify:
+		case <-deadline.C:
+			writeStreamResult(w, nil)
+			return
+		case <-r.Context().Done():
+			return
+		case <-s.closed:
+			writeStreamResult(w, nil)
+			return
+		}
+	}
+}
+
+// streamTypes returns the types asked for with ?types=, or nil for all.
+func streamTypes(r *http.Request) map[string]bool {
+	value := r.FormValue("types")
+	if value == "" {
+		return nil
+	}
+	types := make(map[string]bool)
+	for t := range strings.SplitSeq(value, ",") {
+		types[strings.TrimSpace(t)] = true
+	}
+	return types
+}
+
+func writeStreamResult(w http.ResponseWriter, updates []StreamUpdate) {
+	if updates == nil {
+		updates = []StreamUpdate{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{"ok": true, "result": updates})
+}
+
+func writeStreamError(w http.ResponseWriter, code int, description string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]any{"ok": false, "error_code": code, "description": description})
+}
+
+// ListenAndServe serves the updates on addr, e.g. "127.0.0.1:8081", until Close.
+func (s *UpdateServer) ListenAndServe(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("update server: %w", err)
+	}
+	server := &http.Server{Handler: s, ReadHeaderTimeout: 10 * time.Second}
+
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		listener.Close()
+		return http.ErrServerClosed
+	}
+	s.server = server
+	s.mu.Unlock()
+	return server.Serve(listener)
+}
+
+// Close removes the handlers of the server, ends open streams and polls, and
+// stops ListenAndServe.
+func (s *UpdateServer) Close() error {
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		return nil
+	}
+	s.stopped = true
+	close(s.closed)
+	server := s.server
+	s.mu.Unlock()
+
+	for _, h := range s.handles {
+		s.client.RemoveHandle(h)
+	}
+	if server != nil {
+		return server.Close()
+	}
+	return nil
+}
@@ -0,0 +1,427 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+)
+
+// UpdateStateStore persists the update state of a client: how far into the
+// common and the per-channel update sequences it has got. Set one in
+// ClientConfig.UpdateStateStore and the client saves its state as the handlers
+// of updates finish, and once authorized fetches the updates it missed since the
+// saved state. Updates whose handlers did not finish before a crash are fetched
+// again, so handlers may see an update twice but never miss one.
+type UpdateStateStore interface {
+	// LoadUpdateState returns the saved state, or nil if nothing was saved yet.
+	LoadUpdateState() (*SavedUpdateState, error)
+	// SaveUpdateState replaces the saved state as a whole.
+	SaveUpdateState(state *SavedUpdateState) error
+}
+
+// SavedUpdateState is the update state kept in an UpdateStateStore.
+type SavedUpdateState struct {
+	UpdateState
+	Channels map[int64]ChannelUpdateState `json:",omitempty"`
+}
+
+// ChannelUpdateState is the update state of a channel, part of SavedUpdateState.
+type ChannelUpdateState struct {
+	Pts        int32
+	AccessHash int64 `json:",omitempty"` // zero when the client never saw it
+}
+
+type fileUpdateStateStore struct {
+	path string
+}
+
+// NewFileUpdateStateStore keeps the update state as JSON in a file at path. The
+// file is replaced atomically, so a crash while saving leaves the previous state.
+func NewFileUpdateStateStore(path string) UpdateStateStore {
+	return &fileUpdateStateStore{path: joinAbsWorkingDir(path)}
+}
+
+func (s *fileUpdateStateStore) LoadUpdateState() (*SavedUpdateState, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading update state: %w", err)
+	}
+	state := new(SavedUpdateState)
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("decoding update state %s: %w", s.path, err)
+	}
+	return state, nil
+}
+
+func (s *fileUpdateStateStore) SaveUpdateState(state *SavedUpdateState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("encoding update state: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("saving update state: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("saving update state: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("saving update state: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("saving update state: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("saving update state: %w", err)
+	}
+	return nil
+}
+
+type kvUpdateStateStore struct {
+	store KVStore
+	key   string
+}
+
+// NewKVUpdateStateStore keeps the update state as JSON under key in store.
+func NewKVUpdateStateStore(store KVStore, key string) UpdateStateStore {
+	return &kvUpdateStateStore{store: store, key: key}
+}
+
+func (s *kvUpdateStateStore) LoadUpdateState() (*SavedUpdateState, error) {
+	data, err := s.store.Get(s.key)
+	if err != nil {
+		return nil, fmt.Errorf("reading update state %q: %w", s.key, err)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	state := new(SavedUpdateState)
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("decoding update state %q: %w", s.key, err)
+	}
+	return state, nil
+}
+
+func (s *kvUpdateStateStore) SaveUpdateState(state *SavedUpdateState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("encoding update state: %w", err)
+	}
+	if err := s.store.Set(s.key, data); err != nil {
+		return fmt.Errorf("saving update state %q: %w", s.key, err)
+	}
+	return nil
+}
+
+// updateStateSaver writes the state of a dispatcher to its store whenever it
+// changes. Changes made while a save is running are coalesced into the next one,
+// so the store always ends up with the latest state without blocking updates.
+type updateStateSaver struct {
+	store UpdateStateStore
+	dirty chan struct{}
+	mu    sync.Mutex // serializes saves, so an older snapshot never overwrites a newer one
+}
+
+// setStateStore makes the dispatcher save its state to store from now on.
+func (d *UpdateDispatcher) setStateStore(store UpdateStateStore) {
+	d.stateSaver = &updateStateSaver{store: store, dirty: make(chan struct{}, 1)}
+	go d.saveStateLoop()
+}
+
+// stateChanged schedules a save. It is called with the dispatcher locked.
+func (d *UpdateDispatcher) stateChanged() {
+	if d.stateSaver == nil {
+		return
+	}
+	select {
+	case d.stateSaver.dirty <- struct{}{}:
+	default:
+	}
+}
+
+// snapshotState returns the current update state, as saved to the store.
+func (d *UpdateDispatcher) snapshotState() *SavedUpdateState {
+	d.RLock()
+	defer d.RUnlock()
+	state := &SavedUpdateState{UpdateState: d.state}
+	for h := range d.holds {
+		if h.pts > 0 && h.pts < state.Pts {
+			state.Pts = h.pts
+		}
+		if h.qts > 0 && h.qts < state.Qts {
+			state.Qts = h.qts
+		}
+	}
+	if len(d.channelStates) > 0 {
+		state.Channels = make(map[int64]ChannelUpdateState, len(d.channelStates))
+		for id, channel := range d.channelStates {
+			if channel.pts > 0 {
+				state.Channels[id] = ChannelUpdateState{Pts: channel.pts, AccessHash: channel.accessHash}
+			}
+		}
+		for h := range d.holds {
+			if channel, ok := state.Channels[h.channelID]; ok && h.channelPts > 0 && h.channelPts < channel.Pts {
+				channel.Pts = h.channelPts
+				state.Channels[h.channelID] = channel
+			}
+		}
+	}
+	return state
+}
+
+// stateHold keeps the saved update state from moving past an update, a gap or a
+// difference whose handlers are not done yet, so that after a crash meanwhile
+// they are fetched again on resume rather than lost.
+type stateHold struct {
+	d          *UpdateDispatcher
+	refs       atomic.Int32
+	pts        int32 // common pts to keep, or 0
+	qts        int32 // qts to keep, or 0
+	channelID  int64
+	channelPts int32 // pts of channelID to keep, or 0
+}
+
+// hold keeps the saved state at or below the given pts, qts and channel pts
+// until the hold is released, along with every task run through it.
+func (d *UpdateDispatcher) hold(pts, qts int32, channelID int64, channelPts int32) *stateHold {
+	h := &stateHold{d: d, pts: pts, qts: qts, channelID: channelID, channelPts: channelPts}
+	h.refs.Store(1)
+	d.Lock()
+	if d.holds == nil {
+		d.holds = make(map[*stateHold]struct{})
+	}
+	d.holds[h] = struct{}{}
+	d.Unlock()
+	return h
+}
+
+// release drops a reference to h; the state it keeps is saved once none are left.
+func (h *stateHold) release() {
+	if h == nil || h.refs.Add(-1) > 0 {
+		return
+	}
+	h.d.Lock()
+	delete(h.d.holds, h)
+	h.d.stateChanged()
+	h.d.Unlock()
+}
+
+// run runs fn like runUpdate, keeping h until fn is done. h may be nil.
+func (h *stateHold) run(c *Client, update Update, fn func()) {
+	if h == nil {
+		c.runUpdate(update, fn)
+		return
+	}
+	h.refs.Add(1)
+	if !c.runUpdate(update, func() {
+		defer h.release()
+		fn()
+	}) {
+		h.release()
+	}
+}
+
+// holdUpdate holds the state update leaves behind, or returns nil if it moves
+// no sequence. Pass it to dispatchUpdate, apply the update, then release it.
+func (c *Client) holdUpdate(update Update) *stateHold {
+	channelID, pts, ptsCount, hasPts := ptsOf(update)
+	qts := qtsOf(update)
+	if !hasPts && qts == 0 {
+		return nil
+	}
+	var before, qtsBefore, channelBefore int32
+	if hasPts {
+		if channelID != 0 {
+			channelBefore = pts - ptsCount
+		} else {
+			before = pts - ptsCount
+		}
+	}
+	if qts > 0 {
+		qtsBefore = qts - 1
+	}
+	return c.dispatcher.hold(before, qtsBefore, channelID, channelBefore)
+}
+
+// applySequence moves the common or channel pts and the qts past update,
+// fetching the difference on gaps.
+func (c *Client) applySequence(update Update) {
+	if channelID, pts, ptsCount, ok := ptsOf(update); ok {
+		if channelID != 0 {
+			c.manageChannelPts(channelID, pts, ptsCount)
+		} else {
+			c.managePts(pts, ptsCount)
+		}
+	}
+	if qts := qtsOf(update); qts > c.dispatcher.GetQts() {
+		c.dispatcher.SetQts(qts)
+	}
+}
+
+// ptsOf returns the pts and pts_count of update, with the channel whose sequence
+// it belongs to, or 0 for the common one.
+func ptsOf(update Update) (channelID int64, pts, ptsCount int32, ok bool) {
+	switch u := update.(type) {
+	case *UpdateNewMessage:
+		return 0, u.Pts, u.PtsCount, true
+	case *UpdateNewChannelMessage:
+		return getChannelIDFromMessage(u.Message), u.Pts, u.PtsCount, true
+	case *UpdateEditMessage:
+		return 0, u.Pts, u.PtsCount, true
+	case *UpdateEditChannelMessage:
+		return getChannelIDFromMessage(u.Message), u.Pts, u.PtsCount, true
+	case *UpdateDeleteMessages:
+		return 0, u.Pts, u.PtsCount, true
+	case *UpdateDeleteChannelMessages:
+		return u.ChannelID, u.Pts, u.PtsCount, true
+	case *UpdateReadHistoryInbox:
+		return 0, u.Pts, u.PtsCount, true
+	case *UpdateReadHistoryOutbox:
+		return 0, u.Pts, u.PtsCount, true
+	case *UpdateWebPage:
+		return 0, u.Pts, u.PtsCount, true
+	case *UpdateReadMessagesContents:
+		return 0, u.Pts, u.PtsCount, true
+	case *UpdateReadChannelInbox:
+		return u.ChannelID, u.Pts, 0, true
+	case *UpdateChannelWebPage:
+		return u.ChannelID, u.Pts, u.PtsCount, true
+	case *UpdateFolderPeers:
+		return 0, u.Pts, u.PtsCount, true
+	case *UpdatePinnedMessages:
+		return 0, u.Pts, u.PtsCount, true
+	case *UpdatePinnedChannelMessages:
+		return u.ChannelID, u.Pts, u.PtsCount, true
+	}
+	return 0, 0, 0, false
+}
+
+// qtsOf returns the qts of update, or 0 if it has none.
+func qtsOf(update Update) int32 {
+	switch u := update.(type) {
+	case *UpdateBotBusinessConnect:
+		return u.Qts
+	case *UpdateBotChatBoost:
+		return u.Qts
+	case *UpdateBotChatInviteRequester:
+		return u.Qts
+	case *UpdateBotDeleteBusinessMessage:
+		return u.Qts
+	case *UpdateBotEditBusinessMessage:
+		return u.Qts
+	case *UpdateBotMessageReaction:
+		return u.Qts
+	case *UpdateBotMessageReactions:
+		return u.Qts
+	case *UpdateBotNewBusinessMessage:
+		return u.Qts
+	case *UpdateBotPurchasedPaidMedia:
+		return u.Qts
+	case *UpdateBotStopped:
+		return u.Qts
+	case *UpdateBotSubscriptionExpire:
+		return u.Qts
+	case *UpdateChannelParticipant:
+		return u.Qts
+	case *UpdateChatParticipant:
+		return u.Qts
+	case *UpdateMessagePollVote:
+		return u.Qts
+	case *UpdateNewEncryptedMessage:
+		return u.Qts
+	}
+	return 0
+}
+
+// restoreState replaces the update state with a saved one.
+func (d *UpdateDispatcher) restoreState(saved *SavedUpdateState) {
+	d.Lock()
+	defer d.Unlock()
+	d.state = saved.UpdateState
+	for id, channel := range saved.Channels {
+		if state, ok := d.channelStates[id]; ok {
+			state.pts = channel.Pts
+			state.accessHash = getValue(state.accessHash, channel.AccessHash)
+		} else {
+			d.channelStates[id] = &channelState{pts: channel.Pts, accessHash: channel.AccessHash}
+		}
+	}
+}
+
+// saveState writes the current update state to the store.
+func (d *UpdateDispatcher) saveState() error {
+	if d.stateSaver == nil {
+		return nil
+	}
+	d.stateSaver.mu.Lock()
+	defer d.stateSaver.mu.Unlock()
+	return d.stateSaver.store.SaveUpdateState(d.snapshotState())
+}
+
+func (d *UpdateDispatcher) saveStateLoop() {
+	for {
+		select {
+		case <-d.stateSaver.dirty:
+			if err := d.saveState(); err != nil {
+				d.logger.Error("saving update state: %v", err)
+			}
+		case <-d.stopChan:
+			return
+		}
+	}
+}
+
+// resumeUpdates restores the update state saved in ClientConfig.UpdateStateStore
+// and fetches the updates missed since, common and of every channel with a saved
+// state, through the handlers registered so far. Without a saved state it starts
+// from the current state of the account. It runs once per client.
+func (c *Client) resumeUpdates() {
+	if c.dispatcher == nil || c.dispatcher.stateSaver == nil || !c.dispatcher.stateResumed.CompareAndSwap(false, true) {
+		return
+	}
+
+	saved, err := c.dispatcher.stateSaver.store.LoadUpdateState()
+	if err != nil {
+		c.Log.Error("loading update state: %v", err)
+		c.dispatcher.stateResumed.Store(false)
+		return
+	}
+	if saved == nil || saved.Pts == 0 {
+		state, err := c.UpdatesGetState()
+		if err != nil {
+			c.Log.Error("getting update state: %v", err)
+			c.dispatcher.stateResumed.Store(false)
+			return
+		}
+		c.dispatcher.restoreState(&SavedUpdateState{UpdateState: UpdateState{
+			Pts: state.Pts, Qts: state.Qts, Seq: state.Seq, Date: state.Date,
+		}})
+		c.Log.Debug("no saved update state, starting at pts=%d", state.Pts)
+		if err := c.dispatcher.saveState(); err != nil {
+			c.Log.Error("saving update state: %v", err)
+		}
+		return
+	}
+
+	c.dispatcher.restoreState(saved)
+	c.Log.Debug("resuming updates (pts=%d, qts=%d, channels=%d)", saved.Pts, saved.Qts, len(saved.Channels))
+	c.FetchDifference(saved.Pts, 5000)
+	for channelID, channel := range saved.Channels {
+		c.fetchChannelDifference(channelID, channel.Pts, 100, true)
+	}
+	if err := c.dispatcher.saveState(); err != nil {
+		c.Log.Error("saving update state: %v", err)
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"errors"
 
+	"github.com/amarnathcjd/gogram/internal/encoding/tl"
 	"github.com/amarnathcjd/gogram/internal/utils"
 )
 
@@ -460,6 +461,10 @@ func GetFloodWait(err error) int {
 		return 0
 	}
 
+	var floodErr *FloodWaitError
+	if errors.As(err, &floodErr) {
+		return floodErr.Seconds
+	}
 	if regexFloodWait.MatchString(err.Error()) {
 		wait, _ := strconv.Atoi(regexFloodWait.FindStringSubmatch(err.Error())[1])
 		return wait
@@ -1170,3 +1175,28 @@ func buildRecursive(v reflect.Value, _ bool) any {
 		return v.Interface()
 	}
 }
+
+// MarshalJSON encodes TL objects as JSON that UnmarshalJSON turns back into the
+// same Go values, for storing updates, messages or peers outside the session.
+// Every object carries its constructor name under "_" and its flags:
+//
+//	{"_":"inputPeerUser","UserID":777000,"AccessHash":-2217853913523521020}
+func MarshalJSON(v any) ([]byte, error) {
+	return tl.MarshalJSON(v)
+}
+
+// UnmarshalJSON decodes JSON written by MarshalJSON into v, a pointer to a TL
+// interface such as Message or Update, an object, or a slice of them.
+// Interfaces are filled with the constructor "_" names.
+func UnmarshalJSON(data []byte, v any) error {
+	return tl.UnmarshalJSON(data, v)
+}
+
+// UnmarshalJSONAs decodes JSON written by MarshalJSON into a value of type T.
+//
+//	msg, err := telegram.UnmarshalJSONAs[telegram.Message](data)
+func UnmarshalJSONAs[T any](data []byte) (T, error) {
+	var v T
+	err := tl.UnmarshalJSON(data, &v)
+	return v, err
+}
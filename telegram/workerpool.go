@@ -0,0 +1,473 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	defaultPoolWorkers   = 16
+	defaultPoolQueueSize = 4096
+)
+
+// OverflowPolicy tells what happens to updates arriving while the queue of a
+// WorkerPoolConfig is full.
+type OverflowPolicy uint8
+
+const (
+	// OverflowBlock holds updates arriving while the queue is full, in the order
+	// they came, until a worker frees a slot. The connection keeps being read
+	// meanwhile, so handlers waiting on a request still get their answer. At most
+	// QueueSize updates are held; past that, updates are dropped and counted in
+	// WorkerPoolStats.Dropped, as with OverflowDrop.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDrop drops the update and counts it in WorkerPoolStats.Dropped.
+	OverflowDrop
+)
+
+// WorkerPoolConfig configures the worker pool handlers run on, see ClientConfig.WorkerPool.
+type WorkerPoolConfig struct {
+	Workers   int                       // Goroutines running handlers (default: 16)
+	QueueSize int                       // Updates waiting or running across all keys, past which Overflow applies (default: 4096)
+	Key       func(update Update) int64 // Updates of the same key run one at a time, in order; 0 runs unordered (default: KeyByChat)
+	Overflow  OverflowPolicy            // What to do with updates arriving while the queue is full (default: OverflowBlock)
+}
+
+// WorkerPoolStats is a snapshot of the worker pool of a client.
+type WorkerPoolStats struct {
+	Workers   int   // Goroutines running handlers
+	Queued    int   // Updates waiting for a worker, including those held by OverflowBlock
+	Running   int   // Updates being handled
+	Keys      int   // Keys with updates waiting or running
+	Processed int64 // Updates handled since the client was created
+	Dropped   int64 // Updates dropped because the queue, or the updates held by OverflowBlock, were full
+}
+
+// workerPool runs the handlers of updates on a fixed number of goroutines,
+// one update of a key at a time, in the order the updates arrived.
+type workerPool struct {
+	key      func(Update) int64
+	overflow OverflowPolicy
+	workers  int
+	slots    chan struct{} // one per update waiting or running, for backpressure
+
+	mu       sync.Mutex
+	cond     *sync.Cond
+	runnable []*keyQueue // queues with work and no worker, in turn order
+	queues   map[int64]*keyQueue
+
+	stopped  bool
+	intake   []poolTask    // held by OverflowBlock until a slot frees, in arrival order
+	held     int           // updates held by OverflowBlock, in intake or being moved out of it
+	incoming chan struct{} // signals intakeLoop that intake is not empty
+	done     chan struct{} // closed by stop
+
+	running   atomic.Int64
+	processed atomic.Int64
+	dropped   atomic.Int64
+}
+
+type poolTask struct {
+	update Update
+	fn     func()
+}
+
+// keyQueue holds the pending updates of a key. It is in runnable or being worked
+// on while scheduled is set, never both, so its updates run one at a time.
+type keyQueue struct {
+	key       int64
+	tasks     []func()
+	scheduled bool
+}
+
+func newWorkerPool(cfg WorkerPoolConfig) *workerPool {
+	p := &workerPool{
+		key:      cfg.Key,
+		overflow: cfg.Overflow,
+		workers:  getValue(cfg.Workers, defaultPoolWorkers),
+		slots:    make(chan struct{}, getValue(cfg.QueueSize, defaultPoolQueueSize)),
+		queues:   make(map[int64]*keyQueue),
+		incoming: make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	if p.key == nil {
+		p.key = KeyByChat
+	}
+	p.cond = sync.NewCond(&p.mu)
+	for range p.workers {
+		go p.work()
+	}
+	if p.overflow == OverflowBlock {
+		go p.intakeLoop()
+	}
+	return p
+}
+
+// submit queues fn behind the other updates of the key of update, and reports
+// whether it did so rather than dropping it. It never blocks, as it runs on the
+// goroutine reading the connection.
+func (p *workerPool) submit(update Update, fn func()) bool {
+	if p.overflow == OverflowBlock {
+		p.mu.Lock()
+		if p.stopped {
+			p.mu.Unlock()
+			return false
+		}
+		if p.held >= cap(p.slots) {
+			p.mu.Unlock()
+			p.dropped.Add(1)
+			return false
+		}
+		p.held++
+		p.intake = append(p.intake, poolTask{update, fn})
+		p.mu.Unlock()
+		select {
+		case p.incoming <- struct{}{}:
+		default:
+		}
+		return true
+	}
+
+	select {
+	case p.slots <- struct{}{}:
+	default:
+		p.dropped.Add(1)
+		return false
+	}
+	return p.enqueue(update, fn)
+}
+
+// intakeLoop moves the updates held by OverflowBlock into the queues as slots free up.
+func (p *workerPool) intakeLoop() {
+	for {
+		p.mu.Lock()
+		tasks := p.intake
+		p.intake = nil
+		p.mu.Unlock()
+
+		if len(tasks) == 0 {
+			select {
+			case <-p.incoming:
+				continue
+			case <-p.done:
+				return
+			}
+		}
+		for i, task := range tasks {
+			select {
+			case p.slots <- struct{}{}:
+			case <-p.done:
+				return
+			}
+			tasks[i] = poolTask{}
+			p.enqueue(task.update, task.fn)
+			p.mu.Lock()
+			p.held--
+			p.mu.Unlock()
+		}
+	}
+}
+
+// enqueue queues fn, holding a slot, behind the other updates of the key of update.
+func (p *workerPool) enqueue(update Update, fn func()) bool {
+	key := p.key(update)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopped {
+		<-p.slots
+		return false
+	}
+	q := p.queues[key]
+	if q == nil || key == 0 {
+		q = &keyQueue{key: key}
+		if key != 0 {
+			p.queues[key] = q
+		}
+	}
+	q.tasks = append(q.tasks, fn)
+	if !q.scheduled {
+		q.scheduled = true
+		p.runnable = append(p.runnable, q)
+		p.cond.Signal()
+	}
+	return true
+}
+
+// stop lets the workers exit once done with the update they run; queued and
+// held updates are dropped.
+func (p *workerPool) stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopped {
+		return
+	}
+	p.stopped = true
+	p.intake = nil
+	p.held = 0
+	p.runnable = nil
+	close(p.done)
+	p.cond.Broadcast()
+}
+
+func (p *workerPool) work() {
+	for {
+		p.mu.Lock()
+		for len(p.runnable) == 0 && !p.stopped {
+			p.cond.Wait()
+		}
+		if p.stopped {
+			p.mu.Unlock()
+			return
+		}
+		q := p.runnable[0]
+		p.runnable[0] = nil
+		p.runnable = p.runnable[1:]
+		fn := q.tasks[0]
+		q.tasks[0] = nil
+		q.tasks = q.tasks[1:]
+		p.mu.Unlock()
+
+		p.running.Add(1)
+		fn()
+		p.running.Add(-1)
+		p.processed.Add(1)
+		<-p.slots
+
+		p.mu.Lock()
+		if len(q.tasks) > 0 {
+			// back of the line, so a busy key does not starve the others
+			p.runnable = append(p.runnable, q)
+			p.cond.Signal()
+		} else {
+			q.scheduled = false
+			if p.queues[q.key] == q {
+				delete(p.queues, q.key)
+			}
+		}
+		p.mu.Unlock()
+	}
+}
+
+func (p *workerPool) stats() WorkerPoolStats {
+	p.mu.Lock()
+	keys := len(p.queues)
+	held := p.held
+	p.mu.Unlock()
+	running := int(p.running.Load())
+	return WorkerPoolStats{
+		Workers:   p.workers,
+		Queued:    max(len(p.slots)-running, 0) + held,
+		Running:   running,
+		Keys:      keys,
+		Processed: p.processed.Load(),
+		Dropped:   p.dropped.Load(),
+	}
+}
+
+// WorkerPoolStats returns the state of the worker pool set in ClientConfig.WorkerPool,
+// and false if the client has none.
+func (c *Client) WorkerPoolStats() (WorkerPoolStats, bool) {
+	if c.dispatcher == nil || c.dispatcher.workers == nil {
+		return WorkerPoolStats{}, false
+	}
+	return c.dispatcher.workers.stats(), true
+}
+
+// stopWorkers stops the worker pool of the client, if any.
+func (c *Client) stopWorkers() {
+	if c.dispatcher != nil && c.dispatcher.workers != nil {
+		c.dispatcher.workers.stop()
+	}
+}
+
+// runUpdate runs fn, which handles update, on the worker pool behind the other
+// updates of its key, or on a goroutine of its own when there is no pool. It
+// reports whether fn is going to run, rather than being dropped by the pool.
+func (c *Client) runUpdate(update Update, fn func()) bool {
+	d := c.dispatcher
+	if d.workers == nil {
+		d.goHandle(fn)
+		return true
+	}
+	d.busy.Add(1)
+	if !d.workers.submit(update, func() {
+		defer d.busy.Add(-1)
+		fn()
+	}) {
+		d.busy.Add(-1)
+		return false
+	}
+	return true
+}
+
+// spawn runs fn on a goroutine of its own, or right away on a pool worker, where
+// the handlers of an update must be done before the next update of its key starts.
+func (d *UpdateDispatcher) spawn(fn func()) {
+	if d.workers != nil {
+		fn()
+		return
+	}
+	d.goHandle(fn)
+}
+
+// goHandle runs fn on a goroutine of its own, counted as a running handler.
+func (d *UpdateDispatcher) goHandle(fn func()) {
+	d.busy.Add(1)
+	go func() {
+		defer d.busy.Add(-1)
+		fn()
+	}()
+}
+
+// waitIdle blocks until no handler is queued or running.
+func (d *UpdateDispatcher) waitIdle() {
+	d.busy.wait()
+}
+
+// busyCounter counts the handlers queued or running. Unlike a sync.WaitGroup, it
+// may be added to while waited on, as updates keep arriving.
+type busyCounter struct {
+	mu   sync.Mutex
+	n    int64
+	idle *sync.Cond // signalled when n drops to zero
+}
+
+func (b *busyCounter) Add(delta int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.n += delta
+	if b.n == 0 && b.idle != nil {
+		b.idle.Broadcast()
+	}
+}
+
+// wait blocks until the count is zero.
+func (b *busyCounter) wait() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.idle == nil {
+		b.idle = sync.NewCond(&b.mu)
+	}
+	for b.n > 0 {
+		b.idle.Wait()
+	}
+}
+
+// KeyByChat keys updates by the chat they belong to, so that the updates of a chat
+// are handled in order. Updates of no particular chat are handled unordered.
+func KeyByChat(update Update) int64 {
+	switch u := update.(type) {
+	case *UpdateNewMessage:
+		return messageChatKey(u.Message)
+	case *UpdateNewChannelMessage:
+		return messageChatKey(u.Message)
+	case *UpdateNewScheduledMessage:
+		return messageChatKey(u.Message)
+	case *UpdateEditMessage:
+		return messageChatKey(u.Message)
+	case *UpdateEditChannelMessage:
+		return messageChatKey(u.Message)
+	case *UpdateBotInlineQuery:
+		return u.UserID
+	case *UpdateBotInlineSend:
+		return u.UserID
+	case *UpdateInlineBotCallbackQuery:
+		return u.UserID
+	}
+	return fieldKey(update, "Peer", "ChannelID", "ChatID", "UserID")
+}
+
+// KeyByUser keys updates by the user they come from, so that the updates of a user
+// are handled in order, across chats. Updates of no particular user are handled unordered.
+func KeyByUser(update Update) int64 {
+	switch u := update.(type) {
+	case *UpdateNewMessage:
+		return messageUserKey(u.Message)
+	case *UpdateNewChannelMessage:
+		return messageUserKey(u.Message)
+	case *UpdateNewScheduledMessage:
+		return messageUserKey(u.Message)
+	case *UpdateEditMessage:
+		return messageUserKey(u.Message)
+	case *UpdateEditChannelMessage:
+		return messageUserKey(u.Message)
+	}
+	return fieldKey(update, "UserID", "ActorID")
+}
+
+func messageChatKey(m Message) int64 {
+	switch m := m.(type) {
+	case *MessageObj:
+		return peerKey(m.PeerID)
+	case *MessageService:
+		return peerKey(m.PeerID)
+	}
+	return 0
+}
+
+func messageUserKey(m Message) int64 {
+	switch m := m.(type) {
+	case *MessageObj:
+		if m.FromID != nil {
+			return peerKey(m.FromID)
+		}
+		return peerKey(m.PeerID)
+	case *MessageService:
+		if m.FromID != nil {
+			return peerKey(m.FromID)
+		}
+		return peerKey(m.PeerID)
+	}
+	return 0
+}
+
+// peerKey returns the ID of peer in the Bot API form, which keeps users, chats and
+// channels apart.
+func peerKey(peer Peer) int64 {
+	switch p := peer.(type) {
+	case *PeerUser:
+		return p.UserID
+	case *PeerChat:
+		return -p.ChatID
+	case *PeerChannel:
+		return -1000000000000 - p.ChannelID
+	}
+	return 0
+}
+
+// fieldKey returns the key of the first of the named fields update has: a Peer,
+// or the ID of a channel, chat or user.
+func fieldKey(update Update, names ...string) int64 {
+	v := reflect.ValueOf(update)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return 0
+	}
+	v = v.Elem()
+	for _, name := range names {
+		field := v.FieldByName(name)
+		if !field.IsValid() {
+			continue
+		}
+		switch value := field.Interface().(type) {
+		case Peer:
+			if key := peerKey(value); key != 0 {
+				return key
+			}
+		case int64:
+			if value == 0 {
+				continue
+			}
+			switch name {
+			case "ChannelID":
+				return -1000000000000 - value
+			case "ChatID":
+				return -value
+			}
+			return value
+		}
+	}
+	return 0
+}
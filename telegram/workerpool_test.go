@@ -0,0 +1,103 @@
+// Copyright (c) 2025 @AmarnathCJD
+
+package telegram
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func chatUpdate(chatID int64) Update {
+	return &UpdateNewMessage{Message: &MessageObj{PeerID: &PeerChat{ChatID: chatID}}}
+}
+
+// Updates of a chat run one at a time in arrival order, while chats run in parallel.
+func TestWorkerPoolKeyOrder(t *testing.T) {
+	p := newWorkerPool(WorkerPoolConfig{Workers: 4})
+	defer p.stop()
+
+	const perChat = 20
+	chats := []int64{1, 2}
+	var (
+		mu      sync.Mutex
+		order   = make(map[int64][]int)
+		running = make(map[int64]*atomic.Int32)
+		wg      sync.WaitGroup
+	)
+	for _, chat := range chats {
+		running[chat] = new(atomic.Int32)
+	}
+	// the first update of each chat waits for the other chat's, which only starts
+	// if the chats run in parallel
+	started := make(chan int64, len(chats))
+	bothStarted := make(chan struct{})
+	go func() {
+		for range chats {
+			<-started
+		}
+		close(bothStarted)
+	}()
+
+	for i := range perChat {
+		for _, chat := range chats {
+			wg.Add(1)
+			ok := p.submit(chatUpdate(chat), func() {
+				defer wg.Done()
+				if running[chat].Add(1) > 1 {
+					t.Errorf("chat %d: two updates running at once", chat)
+				}
+				defer running[chat].Add(-1)
+				if i == 0 {
+					started <- chat
+					select {
+					case <-bothStarted:
+					case <-time.After(5 * time.Second):
+						t.Errorf("chat %d: the other chat did not run in parallel", chat)
+					}
+				}
+				mu.Lock()
+				order[chat] = append(order[chat], i)
+				mu.Unlock()
+			})
+			if !ok {
+				t.Fatalf("update %d of chat %d dropped", i, chat)
+			}
+		}
+	}
+	wg.Wait()
+
+	for _, chat := range chats {
+		if len(order[chat]) != perChat {
+			t.Fatalf("chat %d: ran %d updates, want %d", chat, len(order[chat]), perChat)
+		}
+		for i, got := range order[chat] {
+			if got != i {
+				t.Fatalf("chat %d: ran in order %v", chat, order[chat])
+			}
+		}
+	}
+}
+
+// OverflowBlock holds at most QueueSize updates past a full queue, and drops the rest.
+func TestWorkerPoolHoldLimit(t *testing.T) {
+	const queueSize = 2
+	p := newWorkerPool(WorkerPoolConfig{Workers: 1, QueueSize: queueSize})
+	defer p.stop()
+
+	release := make(chan struct{})
+	defer close(release)
+	accepted := 0
+	for i := range 10 {
+		if p.submit(chatUpdate(int64(i)), func() { <-release }) {
+			accepted++
+		}
+	}
+	if accepted > 2*queueSize {
+		t.Fatalf("accepted %d updates, want at most %d", accepted, 2*queueSize)
+	}
+	if stats := p.stats(); stats.Dropped != int64(10-accepted) {
+		t.Fatalf("dropped %d updates, want %d", stats.Dropped, 10-accepted)
+	}
+}